@@ -1,16 +1,21 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
+	// Event time zones must load even where the host has no zoneinfo
+	_ "time/tzdata"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -18,12 +23,17 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/volunteersync/backend/internal/config"
+	admincore "github.com/volunteersync/backend/internal/core/admin"
 	authcore "github.com/volunteersync/backend/internal/core/auth"
 	eventcore "github.com/volunteersync/backend/internal/core/event"
+	hourscore "github.com/volunteersync/backend/internal/core/hours"
+	organizationcore "github.com/volunteersync/backend/internal/core/organization"
 	registrationcore "github.com/volunteersync/backend/internal/core/registration"
+	skillcore "github.com/volunteersync/backend/internal/core/skill"
 	usercore "github.com/volunteersync/backend/internal/core/user"
 	"github.com/volunteersync/backend/internal/graph"
 	"github.com/volunteersync/backend/internal/graph/generated"
+	"github.com/volunteersync/backend/internal/mail"
 	mw "github.com/volunteersync/backend/internal/middleware"
 	pg "github.com/volunteersync/backend/internal/store/postgres"
 )
@@ -122,6 +132,12 @@ func setupRoutes(r *gin.Engine, db *sql.DB, cfg *config.Config) {
 	}
 
 	// GraphQL server
+	// Wire skills catalogue, which files free-text skills under canonical entries
+	var skillSvc *skillcore.Service
+	{
+		skillSvc = skillcore.NewService(pg.NewSkillStore(db))
+	}
+
 	// Wire user service
 	var userSvc *usercore.Service
 	{
@@ -131,6 +147,7 @@ func setupRoutes(r *gin.Engine, db *sql.DB, cfg *config.Config) {
 		// Postgres user store
 		store := pg.NewUserStore(db)
 		userSvc = usercore.NewService(store, files, nil, nil)
+		userSvc.SetSkillResolver(skillSvc)
 	}
 
 	// Wire auth service (uses user store for user lookup and refresh token repo from Postgres store)
@@ -154,12 +171,59 @@ func setupRoutes(r *gin.Engine, db *sql.DB, cfg *config.Config) {
 		authSvc = authcore.NewAuthService(userRepo, refreshRepo, pwd, jwtSvc, logger)
 	}
 
+	// Wire passkey (WebAuthn) service
+	var passkeySvc *authcore.PasskeyService
+	{
+		userRepo := pg.NewAuthUserRepository(db)
+		credentialRepo := pg.NewPasskeyCredentialRepository(db)
+		var err error
+		passkeySvc, err = authcore.NewPasskeyService(authcore.PasskeyConfig{
+			RPID:          cfg.WebAuthn.RPID,
+			RPDisplayName: cfg.WebAuthn.RPDisplayName,
+			RPOrigins:     cfg.WebAuthn.RPOrigins,
+		}, userRepo, credentialRepo, authSvc, slog.Default())
+		if err != nil {
+			log.Fatalf("passkey service: %v", err)
+		}
+	}
+
 	// Wire event service
 	var eventSvc *eventcore.EventService
 	{
 		// Postgres event store
 		eventStore := pg.NewEventStore(db)
 		eventSvc = eventcore.NewEventService(eventStore)
+		eventSvc.SetSkillResolver(skillSvc)
+	}
+
+	// Wire organization service
+	var organizationSvc *organizationcore.Service
+	{
+		organizationStore := pg.NewOrganizationStore(db)
+		organizationSvc = organizationcore.NewService(organizationStore, slog.Default())
+	}
+
+	// Wire volunteer hours ledger and certificates
+	var hoursSvc *hourscore.Service
+	var certificateSvc *hourscore.CertificateService
+	{
+		hoursStore := pg.NewHoursStore(db)
+		hoursSvc = hourscore.NewService(hoursStore, eventSvc, organizationSvc, slog.Default())
+		certificateSvc = hourscore.NewCertificateService(hoursStore, eventSvc, organizationSvc, userSvc, slog.Default())
+	}
+
+	// Wire outgoing mail
+	var mailer registrationcore.Mailer
+	if cfg.Mail.SMTPHost != "" {
+		mailer = mail.NewSMTPSender(mail.SMTPConfig{
+			Host:     cfg.Mail.SMTPHost,
+			Port:     cfg.Mail.SMTPPort,
+			Username: cfg.Mail.SMTPUsername,
+			Password: cfg.Mail.SMTPPassword,
+			From:     cfg.Mail.From,
+		})
+	} else {
+		mailer = mail.NewLogSender(slog.Default())
 	}
 
 	// Wire registration service
@@ -168,17 +232,244 @@ func setupRoutes(r *gin.Engine, db *sql.DB, cfg *config.Config) {
 		// Postgres registration store
 		registrationStore := pg.NewRegistrationStore(db)
 		logger := slog.Default()
-		registrationSvc = registrationcore.NewService(registrationStore, eventSvc, userSvc, logger)
+		// Answer files can hold personal documents; keep them out of the public uploads dir
+		answerFiles := registrationcore.NewLocalAnswerFileStore(cfg.Uploads.PrivateDir)
+		consent := registrationcore.GuardianConsentConfig{
+			Mailer:    mailer,
+			PublicURL: cfg.PublicURL,
+			TTL:       time.Duration(cfg.GuardianConsent.TTLHours) * time.Hour,
+		}
+		registrationSvc = registrationcore.NewService(registrationStore, eventSvc, userSvc, hoursSvc, answerFiles, consent, logger)
+		// Rescheduling and capacity changes carry through to registrations
+		eventSvc.SetRegistrantHandler(registrationSvc)
+		// Templates and duplicated events carry the registration questions
+		eventSvc.SetContentCopier(registrationSvc)
+		// Ask for reconfirmations and release places nobody reconfirmed
+		go registrationSvc.RunReconfirmations(context.Background(), time.Duration(cfg.Reconfirmation.SweepMinutes)*time.Minute)
+		go registrationSvc.RunTeamSeatReleases(context.Background(), time.Duration(cfg.TeamSeats.SweepMinutes)*time.Minute)
+	}
+
+	// Wire check-in ticket service
+	ticketSvc := registrationcore.NewTicketService(registrationSvc, cfg.Tickets.SigningSecret)
+
+	// Wire volunteer self check-in
+	attendanceSvc := registrationcore.NewAttendanceService(registrationSvc, registrationcore.GeofenceConfig{
+		RadiusMeters: cfg.CheckIn.RadiusMeters,
+		GracePeriod:  time.Duration(cfg.CheckIn.GraceMinutes) * time.Minute,
+	})
+	// Complete volunteers who skipped their later shifts
+	go attendanceSvc.RunShiftCompletions(context.Background(), time.Duration(cfg.ShiftCompletion.SweepMinutes)*time.Minute)
+
+	// Wire admin moderation service
+	var adminSvc *admincore.Service
+	{
+		adminStore := pg.NewAdminStore(db)
+		refreshRepo := pg.NewRefreshTokenRepository(db)
+		adminSvc = admincore.NewService(adminStore, refreshRepo, slog.Default())
 	}
 
 	// Auth middleware
 	authMW := mw.NewAuthMiddleware(authSvc, slog.Default())
 
-	gql := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{DB: db, UserService: userSvc, EventService: eventSvc, RegistrationService: registrationSvc}}))
-	r.POST("/graphql", authMW.OptionalAuth(), gin.WrapH(gql))
+	gql := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &graph.Resolver{DB: db, AuthService: authSvc, PasskeyService: passkeySvc, UserService: userSvc, EventService: eventSvc, RegistrationService: registrationSvc, TicketService: ticketSvc, AttendanceService: attendanceSvc, HoursService: hoursSvc, CertificateService: certificateSvc, OrganizationService: organizationSvc, AdminService: adminSvc, SkillService: skillSvc, PublicURL: cfg.PublicURL},
+		Directives: generated.DirectiveRoot{HasPermission: graph.HasPermission},
+	}))
+	r.POST("/graphql", authMW.OptionalAuth(), mw.RequestInfo(), gin.WrapH(gql))
 	r.GET("/graphql", authMW.OptionalAuth(), func(c *gin.Context) {
 		playground.Handler("GraphQL", "/graphql").ServeHTTP(c.Writer, c.Request)
 	})
+
+	// Ticket QR codes, rendered only for the registration's own volunteer
+	r.GET(registrationcore.TicketQRPath(":registrationId", "png"), authMW.RequireAuth(), ticketQRHandler(ticketSvc, "png"))
+	r.GET(registrationcore.TicketQRPath(":registrationId", "svg"), authMW.RequireAuth(), ticketQRHandler(ticketSvc, "svg"))
+
+	// Hours certificates: the PDF is for its owner, verification is public
+	r.GET(hourscore.CertificatePDFPath(":code"), authMW.RequireAuth(), certificatePDFHandler(certificateSvc, cfg.PublicURL))
+	r.GET(hourscore.CertificateVerifyPath(":code"), certificateVerifyHandler(certificateSvc))
+
+	// Registration answers: the CSV export is for event staff, answer files
+	// for the volunteer and event staff
+	r.GET(registrationcore.AnswersExportPath(":eventId"), authMW.RequireAuth(), answersExportHandler(registrationSvc))
+	r.GET(registrationcore.AnswerFilePath(":registrationId", ":questionId"), authMW.RequireAuth(), answerFileHandler(registrationSvc))
+
+	// Guardian consent: the emailed link is the guardian's only credential
+	r.GET(registrationcore.GuardianConsentPath(":token"), guardianConsentViewHandler(registrationSvc))
+	r.POST(registrationcore.GuardianConsentPath(":token"), guardianConsentResponseHandler(registrationSvc))
+}
+
+// ticketQRHandler serves the caller's ticket for a registration as a QR image
+func ticketQRHandler(tickets *registrationcore.TicketService, format string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := mw.GetUserIDFromContext(c.Request.Context())
+		ticket, err := tickets.IssueTicket(c.Request.Context(), userID, c.Param("registrationId"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "ticket not found"})
+			return
+		}
+
+		var body []byte
+		contentType := "image/svg+xml"
+		if format == "png" {
+			body, err = registrationcore.RenderTicketPNG(ticket.Token, 512)
+			contentType = "image/png"
+		} else {
+			body, err = registrationcore.RenderTicketSVG(ticket.Token)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Tickets identify the holder, so keep them out of shared caches
+		c.Header("Cache-Control", "private, no-store")
+		c.Data(http.StatusOK, contentType, body)
+	}
+}
+
+// certificatePDFHandler renders one of the caller's certificates as a PDF download
+func certificatePDFHandler(certs *hourscore.CertificateService, publicURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := mw.GetUserIDFromContext(c.Request.Context())
+		cert, err := certs.GetCertificate(c.Request.Context(), userID, c.Param("code"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "certificate not found"})
+			return
+		}
+
+		verifyURL := strings.TrimRight(publicURL, "/") + hourscore.CertificateVerifyPath(cert.Code)
+		body, err := hourscore.RenderCertificatePDF(cert, verifyURL)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Header("Cache-Control", "private, no-store")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="service-hours-%s.pdf"`, hourscore.FormatCertificateCode(cert.Code)))
+		c.Data(http.StatusOK, "application/pdf", body)
+	}
+}
+
+// certificateVerifyHandler confirms a certificate by code without requiring login
+func certificateVerifyHandler(certs *hourscore.CertificateService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cert, err := certs.Verify(c.Request.Context(), c.Param("code"))
+		if errors.Is(err, hourscore.ErrCertificateNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"valid": false})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "verification unavailable"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"valid":         true,
+			"code":          hourscore.FormatCertificateCode(cert.Code),
+			"volunteerName": cert.VolunteerName,
+			"scope":         cert.Scope,
+			"totalHours":    cert.TotalHours,
+			"rangeStart":    cert.RangeStart,
+			"rangeEnd":      cert.RangeEnd,
+			"lines":         cert.Lines,
+			"issuedAt":      cert.IssuedAt,
+		})
+	}
+}
+
+// answersExportHandler streams an event's registration answers as CSV
+func answersExportHandler(registrations *registrationcore.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := mw.GetUserIDFromContext(c.Request.Context())
+		var buf bytes.Buffer
+		if err := registrations.ExportAnswersCSV(c.Request.Context(), userID, c.Param("eventId"), &buf); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "event not found"})
+			return
+		}
+
+		c.Header("Cache-Control", "private, no-store")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="registration-answers-%s.csv"`, c.Param("eventId")))
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+	}
+}
+
+// answerFileHandler serves a file uploaded as a registration answer
+func answerFileHandler(registrations *registrationcore.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := mw.GetUserIDFromContext(c.Request.Context())
+		file, body, err := registrations.OpenAnswerFile(c.Request.Context(), userID, c.Param("registrationId"), c.Param("questionId"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "file not found"})
+			return
+		}
+		defer body.Close()
+
+		c.Header("Cache-Control", "private, no-store")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename=%q`, file.Name))
+		c.DataFromReader(http.StatusOK, file.Size, file.ContentType, body, nil)
+	}
+}
+
+// guardianConsentViewHandler shows a guardian what they are being asked to consent to
+func guardianConsentViewHandler(registrations *registrationcore.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		view, err := registrations.GetGuardianConsentView(c.Request.Context(), c.Param("token"))
+		if errors.Is(err, registrationcore.ErrGuardianConsentNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "consent request not found"})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "consent request unavailable"})
+			return
+		}
+
+		c.Header("Cache-Control", "private, no-store")
+		c.JSON(http.StatusOK, gin.H{
+			"volunteerName": view.VolunteerName,
+			"eventTitle":    view.EventTitle,
+			"eventStart":    view.EventStart,
+			"status":        view.Consent.Status,
+			"expiresAt":     view.Consent.ExpiresAt,
+			"respondedAt":   view.Consent.RespondedAt,
+		})
+	}
+}
+
+// guardianConsentResponseHandler records a guardian approving or declining
+func guardianConsentResponseHandler(registrations *registrationcore.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Approve      bool   `json:"approve"`
+			Name         string `json:"guardianName"`
+			Email        string `json:"guardianEmail"`
+			Phone        string `json:"guardianPhone"`
+			Relationship string `json:"relationship"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+			return
+		}
+
+		consent, err := registrations.RespondToGuardianConsent(c.Request.Context(), c.Param("token"), registrationcore.GuardianConsentResponse{
+			Approve:      body.Approve,
+			Name:         body.Name,
+			Email:        body.Email,
+			Phone:        body.Phone,
+			Relationship: body.Relationship,
+		}, registrationcore.SignatureEvidence{IPAddress: c.ClientIP(), UserAgent: c.Request.UserAgent()})
+		switch {
+		case errors.Is(err, registrationcore.ErrGuardianConsentNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "consent request not found"})
+		case errors.Is(err, registrationcore.ErrInvalidGuardianConsent):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, registrationcore.ErrGuardianConsentExpired), errors.Is(err, registrationcore.ErrGuardianConsentClosed):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "consent could not be recorded"})
+		default:
+			c.JSON(http.StatusOK, gin.H{"status": consent.Status, "respondedAt": consent.RespondedAt})
+		}
+	}
 }
 
 // startServerWithGracefulShutdown starts the server and handles graceful shutdown
@@ -0,0 +1,2 @@
+-- Drop passkey credentials table
+DROP TABLE IF EXISTS webauthn_credentials;
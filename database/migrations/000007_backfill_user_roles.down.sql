@@ -0,0 +1,2 @@
+-- Backfilled roles are indistinguishable from assigned ones; nothing to undo.
+SELECT 1;
@@ -0,0 +1,10 @@
+-- Existing accounts were created before roles were assigned at sign-up.
+-- Every user becomes a volunteer; anyone who already organizes events becomes an organizer.
+INSERT INTO user_roles (user_id, role)
+SELECT u.id, 'VOLUNTEER' FROM users u
+ON CONFLICT DO NOTHING;
+
+INSERT INTO user_roles (user_id, role)
+SELECT DISTINCT e.organizer_id, 'ORGANIZER' FROM events e
+WHERE e.organizer_id IS NOT NULL
+ON CONFLICT DO NOTHING;
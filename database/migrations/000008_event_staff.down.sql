@@ -0,0 +1,2 @@
+-- Drop event staff table
+DROP TABLE IF EXISTS event_staff;
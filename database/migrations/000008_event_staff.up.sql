@@ -0,0 +1,20 @@
+-- Delegated per-event staff roles (co-organizers, check-in staff, viewers)
+CREATE TABLE IF NOT EXISTS event_staff (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('CO_ORGANIZER', 'CHECKIN_STAFF', 'VIEWER')),
+    status TEXT NOT NULL DEFAULT 'INVITED' CHECK (status IN ('INVITED', 'ACTIVE', 'REMOVED')),
+    invited_by UUID NOT NULL REFERENCES users(id),
+    invited_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMPTZ,
+    removed_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One live invitation or membership per address per event
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_staff_event_email
+    ON event_staff (event_id, lower(email)) WHERE status <> 'REMOVED';
+CREATE INDEX IF NOT EXISTS idx_event_staff_user ON event_staff (user_id) WHERE status = 'ACTIVE';
+CREATE INDEX IF NOT EXISTS idx_event_staff_pending_email ON event_staff (lower(email)) WHERE status = 'INVITED';
@@ -0,0 +1,4 @@
+-- Drop organizations and event ownership
+ALTER TABLE events DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,49 @@
+-- Organizations (nonprofits, clubs) own events and have members with roles
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL UNIQUE,
+    description TEXT,
+    website TEXT,
+    contact_email TEXT,
+    logo_url TEXT,
+    verification_status TEXT NOT NULL DEFAULT 'UNVERIFIED'
+        CHECK (verification_status IN ('UNVERIFIED', 'PENDING', 'VERIFIED', 'REJECTED')),
+    verified_at TIMESTAMPTZ,
+    verified_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('OWNER', 'ADMIN', 'MEMBER')),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user ON organization_members (user_id);
+
+-- Events are owned by an organization; organizer_id records who created them
+ALTER TABLE events ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_events_organization ON events (organization_id);
+
+-- Give every existing organizer a personal organization that takes over their events
+INSERT INTO organizations (id, name, slug)
+SELECT gen_random_uuid(), u.name, 'org-' || replace(u.id::text, '-', '')
+FROM users u
+WHERE EXISTS (SELECT 1 FROM events e WHERE e.organizer_id = u.id)
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO organization_members (organization_id, user_id, role)
+SELECT o.id, u.id, 'OWNER'
+FROM users u
+JOIN organizations o ON o.slug = 'org-' || replace(u.id::text, '-', '')
+ON CONFLICT DO NOTHING;
+
+UPDATE events e
+SET organization_id = o.id
+FROM organizations o
+WHERE e.organization_id IS NULL
+  AND o.slug = 'org-' || replace(e.organizer_id::text, '-', '');
@@ -0,0 +1,4 @@
+-- Drop the admin audit trail
+DROP TRIGGER IF EXISTS admin_audit_log_no_update ON admin_audit_log;
+DROP FUNCTION IF EXISTS admin_audit_log_immutable();
+DROP TABLE IF EXISTS admin_audit_log;
@@ -0,0 +1,2 @@
+-- Drop ticket scans table
+DROP TABLE IF EXISTS ticket_scans;
@@ -0,0 +1,16 @@
+-- Ticket scans recorded by check-in desks. client_scan_id lets a desk that
+-- queued scans while offline resubmit them without double-counting.
+CREATE TABLE IF NOT EXISTS ticket_scans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    registration_id UUID NOT NULL REFERENCES registrations(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    scanned_by UUID NOT NULL REFERENCES users(id),
+    client_scan_id TEXT,
+    outcome TEXT NOT NULL CHECK (outcome IN ('CHECKED_IN', 'ALREADY_CHECKED_IN')),
+    scanned_at TIMESTAMPTZ NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ticket_scans_client_scan
+    ON ticket_scans (scanned_by, client_scan_id) WHERE client_scan_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_ticket_scans_registration ON ticket_scans (registration_id, scanned_at);
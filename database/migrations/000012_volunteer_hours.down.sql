@@ -0,0 +1,2 @@
+-- Drop volunteer hours ledger
+DROP TABLE IF EXISTS volunteer_hours;
@@ -0,0 +1,31 @@
+-- Volunteer hours ledger. Attendance credits, organizer adjustments and
+-- off-platform submissions are all rows; totals are sums over approved rows.
+CREATE TABLE IF NOT EXISTS volunteer_hours (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID REFERENCES events(id) ON DELETE SET NULL,
+    registration_id UUID REFERENCES registrations(id) ON DELETE SET NULL,
+    organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL,
+    adjusts_entry_id UUID REFERENCES volunteer_hours(id),
+    source TEXT NOT NULL CHECK (source IN ('ATTENDANCE', 'ADJUSTMENT', 'EXTERNAL')),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    hours NUMERIC(6, 2) NOT NULL,
+    category TEXT NOT NULL,
+    description TEXT,
+    reason TEXT,
+    activity_date TIMESTAMPTZ NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id),
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMPTZ,
+    review_notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Only adjustments may subtract hours
+    CHECK (hours > 0 OR source = 'ADJUSTMENT')
+);
+
+-- A registration is credited from attendance at most once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_volunteer_hours_attendance
+    ON volunteer_hours (registration_id) WHERE source = 'ATTENDANCE';
+CREATE INDEX IF NOT EXISTS idx_volunteer_hours_user_date ON volunteer_hours (user_id, activity_date);
+CREATE INDEX IF NOT EXISTS idx_volunteer_hours_pending
+    ON volunteer_hours (organization_id, created_at) WHERE status = 'PENDING';
@@ -0,0 +1,2 @@
+-- Drop hours certificates table
+DROP TABLE IF EXISTS hours_certificates;
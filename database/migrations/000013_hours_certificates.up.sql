@@ -0,0 +1,17 @@
+-- Issued service-hour certificates. Each row is an immutable snapshot of what
+-- the certificate printed, looked up by its public verification code.
+CREATE TABLE IF NOT EXISTS hours_certificates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code TEXT NOT NULL UNIQUE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    volunteer_name TEXT NOT NULL,
+    scope TEXT NOT NULL CHECK (scope IN ('EVENT', 'RANGE')),
+    event_id UUID REFERENCES events(id) ON DELETE SET NULL,
+    range_start TIMESTAMPTZ,
+    range_end TIMESTAMPTZ,
+    total_hours NUMERIC(8, 2) NOT NULL,
+    lines JSONB NOT NULL DEFAULT '[]',
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_hours_certificates_user ON hours_certificates (user_id, issued_at DESC);
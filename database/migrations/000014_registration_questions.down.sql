@@ -0,0 +1,3 @@
+-- Drop registration question tables
+DROP TABLE IF EXISTS registration_answers;
+DROP TABLE IF EXISTS event_registration_questions;
@@ -0,0 +1,34 @@
+-- Per-event registration form. Choice options and validation rules are kept
+-- as JSON so new rule kinds don't need a schema change.
+CREATE TABLE IF NOT EXISTS event_registration_questions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    label TEXT NOT NULL,
+    help_text TEXT,
+    type TEXT NOT NULL CHECK (type IN ('TEXT', 'SINGLE_CHOICE', 'MULTI_CHOICE', 'NUMBER', 'DATE', 'FILE')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options JSONB NOT NULL DEFAULT '[]',
+    validation JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_registration_questions_event ON event_registration_questions (event_id, position);
+
+-- One answer per registration and question. value holds text, the chosen
+-- option, a number or a YYYY-MM-DD date; choices holds multi-choice picks.
+CREATE TABLE IF NOT EXISTS registration_answers (
+    registration_id UUID NOT NULL REFERENCES registrations(id) ON DELETE CASCADE,
+    question_id UUID NOT NULL REFERENCES event_registration_questions(id) ON DELETE CASCADE,
+    value TEXT,
+    choices TEXT[] NOT NULL DEFAULT '{}',
+    file_name TEXT,
+    file_content_type TEXT,
+    file_size BIGINT,
+    file_path TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (registration_id, question_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_registration_answers_question ON registration_answers (question_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS waiver_signatures;
+DROP TABLE IF EXISTS event_waiver_versions;
+DROP TABLE IF EXISTS event_waivers;
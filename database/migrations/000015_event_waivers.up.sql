@@ -0,0 +1,46 @@
+-- Liability waivers attached to events. The text is versioned; a new version
+-- is added whenever the wording changes so old signatures keep pointing at
+-- what was actually signed.
+CREATE TABLE IF NOT EXISTS event_waivers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    retired_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_waivers_event ON event_waivers (event_id);
+
+CREATE TABLE IF NOT EXISTS event_waiver_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    waiver_id UUID NOT NULL REFERENCES event_waivers(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    body TEXT NOT NULL,
+    -- SHA-256 of body, hex encoded
+    content_hash TEXT NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (waiver_id, version)
+);
+
+-- One signature per volunteer and waiver version, kept as evidence even if
+-- the registration is later removed
+CREATE TABLE IF NOT EXISTS waiver_signatures (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    waiver_version_id UUID NOT NULL REFERENCES event_waiver_versions(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    registration_id UUID REFERENCES registrations(id) ON DELETE SET NULL,
+    signed_name TEXT NOT NULL,
+    ip_address TEXT,
+    user_agent TEXT,
+    document_hash TEXT NOT NULL,
+    signed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (waiver_version_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_waiver_signatures_event ON waiver_signatures (event_id);
+CREATE INDEX IF NOT EXISTS idx_waiver_signatures_user ON waiver_signatures (user_id, event_id);
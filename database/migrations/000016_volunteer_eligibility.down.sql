@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS user_trainings;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS background_check_expires_at,
+    DROP COLUMN IF EXISTS background_check_verified_at,
+    DROP COLUMN IF EXISTS date_of_birth;
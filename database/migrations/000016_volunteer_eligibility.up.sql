@@ -0,0 +1,18 @@
+-- Profile facts checked against event requirements at registration
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS date_of_birth DATE,
+    ADD COLUMN IF NOT EXISTS background_check_verified_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS background_check_expires_at TIMESTAMPTZ;
+
+-- Trainings a volunteer has completed, matched to event training
+-- requirements by name
+CREATE TABLE IF NOT EXISTS user_trainings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    completed_at DATE NOT NULL,
+    expires_at DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_trainings_user_name ON user_trainings (user_id, LOWER(name));
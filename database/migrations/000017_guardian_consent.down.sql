@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS guardian_consents;
+
+UPDATE registrations SET status = 'CANCELLED', cancelled_at = NOW() WHERE status = 'PENDING_GUARDIAN_CONSENT';
+ALTER TABLE registrations DROP CONSTRAINT IF EXISTS registrations_status_check;
+ALTER TABLE registrations ADD CONSTRAINT registrations_status_check CHECK (status IN (
+    'PENDING_APPROVAL', 'CONFIRMED', 'WAITLISTED', 'CANCELLED', 'DECLINED', 'NO_SHOW', 'COMPLETED'
+));
@@ -0,0 +1,32 @@
+-- Registrations by minors wait for a guardian before the usual approval and
+-- capacity rules apply
+ALTER TABLE registrations DROP CONSTRAINT IF EXISTS registrations_status_check;
+ALTER TABLE registrations ADD CONSTRAINT registrations_status_check CHECK (status IN (
+    'PENDING_GUARDIAN_CONSENT', 'PENDING_APPROVAL', 'CONFIRMED', 'WAITLISTED', 'CANCELLED', 'DECLINED', 'NO_SHOW', 'COMPLETED'
+));
+
+-- Consent requests emailed to guardians and their responses. Rows are kept
+-- for audits even if the registration is later removed, so the volunteer and
+-- event are recorded directly.
+CREATE TABLE IF NOT EXISTS guardian_consents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    registration_id UUID REFERENCES registrations(id) ON DELETE SET NULL,
+    user_id UUID NOT NULL REFERENCES users(id),
+    event_id UUID NOT NULL REFERENCES events(id),
+    requested_email TEXT NOT NULL,
+    -- SHA-256 of the emailed token, hex encoded; the token itself is never stored
+    token_hash TEXT NOT NULL UNIQUE,
+    status TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'DECLINED', 'SUPERSEDED')),
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    responded_at TIMESTAMPTZ,
+    guardian_name TEXT,
+    guardian_email TEXT,
+    guardian_phone TEXT,
+    relationship TEXT,
+    ip_address TEXT,
+    user_agent TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_guardian_consents_registration ON guardian_consents (registration_id);
+CREATE INDEX IF NOT EXISTS idx_guardian_consents_event ON guardian_consents (event_id);
@@ -0,0 +1,2 @@
+ALTER TABLE registrations
+    DROP COLUMN IF EXISTS reconfirmation_requested_at;
@@ -0,0 +1,4 @@
+-- Set when an event is rescheduled; cleared once the volunteer confirms they
+-- can still make the new time
+ALTER TABLE registrations
+    ADD COLUMN IF NOT EXISTS reconfirmation_requested_at TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+ALTER TABLE registrations
+    DROP COLUMN IF EXISTS reconfirmed_at,
+    DROP COLUMN IF EXISTS late_cancellation;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS reconfirmation_hours,
+    DROP COLUMN IF EXISTS block_late_cancellation;
@@ -0,0 +1,10 @@
+-- Organizers can refuse cancellations after the deadline rather than only
+-- recording them as late, and choose how long before the start confirmed
+-- volunteers must reconfirm (0 = default)
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS block_late_cancellation BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS reconfirmation_hours INT NOT NULL DEFAULT 0;
+
+ALTER TABLE registrations
+    ADD COLUMN IF NOT EXISTS late_cancellation BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS reconfirmed_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS registration_shifts;
+DROP TABLE IF EXISTS event_shifts;
@@ -0,0 +1,39 @@
+-- Shifts split an event into time slots or roles, each with its own capacity,
+-- waitlist and skill requirements. Skills are kept as JSON like the rest of
+-- the shift's definition; events without shifts are staffed as a whole.
+CREATE TABLE IF NOT EXISTS event_shifts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    role TEXT,
+    description TEXT,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    capacity INT NOT NULL CHECK (capacity > 0),
+    waitlist_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    skills JSONB NOT NULL DEFAULT '[]',
+    position INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_shifts_event ON event_shifts (event_id, position);
+
+-- A registration's place on each shift it signed up for. The service refuses
+-- to remove shifts with active sign-ups; cancelled ones go with the shift.
+CREATE TABLE IF NOT EXISTS registration_shifts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    registration_id UUID NOT NULL REFERENCES registrations(id) ON DELETE CASCADE,
+    shift_id UUID NOT NULL REFERENCES event_shifts(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'CONFIRMED', 'WAITLISTED', 'CANCELLED')),
+    waitlist_position INT,
+    confirmed_at TIMESTAMPTZ,
+    checked_in_at TIMESTAMPTZ,
+    checked_out_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (registration_id, shift_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_registration_shifts_shift ON registration_shifts (shift_id, status);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_registrations_team_registration;
+ALTER TABLE registrations DROP COLUMN IF EXISTS team_registration_id;
+DROP TABLE IF EXISTS team_registrations;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
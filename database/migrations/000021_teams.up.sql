@@ -0,0 +1,48 @@
+-- Teams let a leader register a group, such as a company volunteer day or a
+-- school club, for an event and name who fills each seat later.
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    description TEXT,
+    leader_id UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_members_user ON team_members (user_id);
+
+-- A block of seats at an event. Confirmed blocks count against the event's
+-- capacity in full until seats_released_at, when seats nobody was assigned to
+-- are given back.
+CREATE TABLE IF NOT EXISTS team_registrations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    seats INT NOT NULL CHECK (seats >= 0),
+    status TEXT NOT NULL CHECK (status IN ('CONFIRMED', 'WAITLISTED', 'CANCELLED')),
+    waitlist_position INT,
+    assignment_deadline TIMESTAMPTZ NOT NULL,
+    registered_by UUID NOT NULL REFERENCES users(id),
+    confirmed_at TIMESTAMPTZ,
+    seats_released_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_registrations_active
+    ON team_registrations (team_id, event_id) WHERE status <> 'CANCELLED';
+CREATE INDEX IF NOT EXISTS idx_team_registrations_event ON team_registrations (event_id, status);
+
+-- Members' own registrations fill the team's seats
+ALTER TABLE registrations
+    ADD COLUMN IF NOT EXISTS team_registration_id UUID REFERENCES team_registrations(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_registrations_team_registration ON registrations (team_registration_id);
@@ -0,0 +1,13 @@
+-- A volunteer moving to another date of a series. Each source registration
+-- can be transferred once, which makes retried transfers safe.
+CREATE TABLE IF NOT EXISTS registration_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_registration_id UUID NOT NULL UNIQUE REFERENCES registrations(id) ON DELETE CASCADE,
+    target_registration_id UUID NOT NULL REFERENCES registrations(id) ON DELETE CASCADE,
+    from_event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    to_event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    transferred_by UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_registration_transfers_target ON registration_transfers (target_registration_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS event_template_questions;
+DROP TABLE IF EXISTS event_templates;
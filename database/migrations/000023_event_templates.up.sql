@@ -0,0 +1,33 @@
+-- Reusable event setups. The event's details, requirements, registration
+-- settings, images and shifts are kept as one JSON snapshot, with the source
+-- event's own times so new events can move every deadline with their start.
+CREATE TABLE IF NOT EXISTS event_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    organizer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+    source_event_id UUID REFERENCES events(id) ON DELETE SET NULL,
+    content JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_templates_organizer ON event_templates (organizer_id);
+CREATE INDEX IF NOT EXISTS idx_event_templates_organization ON event_templates (organization_id) WHERE organization_id IS NOT NULL;
+
+-- A template's registration form, copied onto each event created from it
+CREATE TABLE IF NOT EXISTS event_template_questions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id UUID NOT NULL REFERENCES event_templates(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    label TEXT NOT NULL,
+    help_text TEXT,
+    type TEXT NOT NULL CHECK (type IN ('TEXT', 'SINGLE_CHOICE', 'MULTI_CHOICE', 'NUMBER', 'DATE', 'FILE')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options JSONB NOT NULL DEFAULT '[]',
+    validation JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_template_questions_template ON event_template_questions (template_id, position);
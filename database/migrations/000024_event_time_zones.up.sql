@@ -0,0 +1,4 @@
+-- The IANA time zone an event takes place in, so recurrence and wall-clock
+-- display follow local time across DST. Events saved before this are NULL
+-- until an organizer sets a zone, and can't be published without one.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS location_time_zone TEXT;
@@ -0,0 +1,56 @@
+-- Back to the fixed CHECK values. Terms the old schema had no value for,
+-- including any added since, fall back to COMMUNITY_SERVICE / ONE_TIME.
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_category_fkey;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_time_commitment_fkey;
+
+CREATE TEMPORARY TABLE category_values (slug TEXT PRIMARY KEY, old TEXT NOT NULL);
+INSERT INTO category_values VALUES
+    ('environment', 'ENVIRONMENT'),
+    ('education', 'EDUCATION'),
+    ('health', 'HEALTH'),
+    ('disaster-relief', 'DISASTER_RELIEF'),
+    ('animal-welfare', 'ANIMAL_WELFARE'),
+    ('arts-culture', 'ARTS_CULTURE'),
+    ('technology', 'TECHNOLOGY'),
+    ('sports-recreation', 'SPORTS_RECREATION'),
+    ('food-security', 'FOOD_SECURITY'),
+    ('youth-development', 'YOUTH_MENTORING'),
+    ('youth-mentoring', 'YOUTH_MENTORING'),
+    ('senior-care', 'SENIOR_CARE');
+
+CREATE TEMPORARY TABLE time_commitment_values (slug TEXT PRIMARY KEY, old TEXT NOT NULL);
+INSERT INTO time_commitment_values VALUES
+    ('short-term', 'SHORT_TERM'),
+    ('medium-term', 'MEDIUM_TERM'),
+    ('long-term', 'LONG_TERM'),
+    ('ongoing', 'ONGOING');
+
+UPDATE events e
+SET category = COALESCE((SELECT m.old FROM category_values m WHERE m.slug = e.category), 'COMMUNITY_SERVICE');
+UPDATE events e
+SET time_commitment = COALESCE((SELECT m.old FROM time_commitment_values m WHERE m.slug = e.time_commitment), 'ONE_TIME');
+
+UPDATE event_templates t
+SET content = jsonb_set(content, '{event,category}', to_jsonb(COALESCE(
+    (SELECT m.old FROM category_values m WHERE m.slug = t.content->'event'->>'category'), 'COMMUNITY_SERVICE')));
+UPDATE event_templates t
+SET content = jsonb_set(content, '{event,timeCommitment}', to_jsonb(COALESCE(
+    (SELECT m.old FROM time_commitment_values m WHERE m.slug = t.content->'event'->>'timeCommitment'), 'ONE_TIME')));
+
+UPDATE volunteer_hours h
+SET category = COALESCE((SELECT m.old FROM category_values m WHERE m.slug = h.category), 'COMMUNITY_SERVICE')
+WHERE h.source = 'ATTENDANCE';
+
+ALTER TABLE events
+    ADD CONSTRAINT events_category_check CHECK (category IN (
+        'ENVIRONMENT', 'EDUCATION', 'HEALTH', 'COMMUNITY_SERVICE',
+        'DISASTER_RELIEF', 'ANIMAL_WELFARE', 'ARTS_CULTURE', 'TECHNOLOGY',
+        'SPORTS_RECREATION', 'SENIOR_CARE', 'YOUTH_MENTORING', 'FOOD_SECURITY'
+    )),
+    ADD CONSTRAINT events_time_commitment_check CHECK (time_commitment IN (
+        'ONE_TIME', 'SHORT_TERM', 'MEDIUM_TERM', 'LONG_TERM', 'ONGOING'
+    ));
+
+DROP TABLE category_values, time_commitment_values;
+DROP TABLE IF EXISTS event_time_commitments;
+DROP TABLE IF EXISTS event_categories;
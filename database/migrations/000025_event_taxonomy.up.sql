@@ -0,0 +1,138 @@
+-- Event categories and time commitments become admin-managed data. Events
+-- keep the term's slug in events.category / events.time_commitment, now
+-- backed by a foreign key that follows slug renames. Terms may sit under a
+-- parent; searching for the parent finds events filed under its children.
+CREATE TABLE IF NOT EXISTS event_categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    description TEXT,
+    icon TEXT,
+    parent_id UUID REFERENCES event_categories(id) ON DELETE RESTRICT,
+    sort_order INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (parent_id IS NULL OR parent_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_categories_parent ON event_categories (parent_id) WHERE parent_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS event_time_commitments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    description TEXT,
+    icon TEXT,
+    parent_id UUID REFERENCES event_time_commitments(id) ON DELETE RESTRICT,
+    sort_order INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (parent_id IS NULL OR parent_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_time_commitments_parent ON event_time_commitments (parent_id) WHERE parent_id IS NOT NULL;
+
+-- The union of the old database CHECK values and the old GraphQL enum. The
+-- API used to fold HOMELESS_SERVICES, FUNDRAISING, ADVOCACY and OTHER into
+-- COMMUNITY_SERVICE; they are their own terms now.
+INSERT INTO event_categories (slug, name, icon, sort_order) VALUES
+    ('community-service', 'Community Service', 'people', 10),
+    ('environment', 'Environment', 'leaf', 20),
+    ('education', 'Education', 'book', 30),
+    ('health', 'Health & Wellness', 'heart', 40),
+    ('disaster-relief', 'Disaster Relief', 'lifebuoy', 50),
+    ('animal-welfare', 'Animal Welfare', 'paw', 60),
+    ('arts-culture', 'Arts & Culture', 'palette', 70),
+    ('technology', 'Technology', 'laptop', 80),
+    ('sports-recreation', 'Sports & Recreation', 'ball', 90),
+    ('food-security', 'Food Security & Hunger', 'utensils', 100),
+    ('youth-development', 'Youth Development', 'child', 110),
+    ('senior-care', 'Senior Care', 'hand-holding-heart', 120),
+    ('fundraising', 'Fundraising', 'coins', 130),
+    ('advocacy', 'Advocacy', 'megaphone', 140),
+    ('other', 'Other', 'ellipsis', 1000)
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO event_categories (slug, name, icon, sort_order, parent_id)
+SELECT v.slug, v.name, v.icon, v.sort_order, p.id
+FROM (VALUES
+    ('homeless-services', 'Homeless Services', 'house', 10, 'community-service'),
+    ('youth-mentoring', 'Youth Mentoring', 'user-group', 10, 'youth-development')
+) AS v(slug, name, icon, sort_order, parent_slug)
+JOIN event_categories p ON p.slug = v.parent_slug
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO event_time_commitments (slug, name, description, icon, sort_order) VALUES
+    ('one-time', 'One time', 'A single date', 'calendar-day', 10),
+    ('short-term', 'Short term', 'Less than a month', 'calendar-week', 20),
+    ('medium-term', 'Medium term', 'One to six months', 'calendar', 30),
+    ('long-term', 'Long term', 'More than six months', 'calendar-check', 40),
+    ('ongoing', 'Ongoing', 'No fixed end', 'infinity', 50)
+ON CONFLICT (slug) DO NOTHING;
+
+-- Old values, from either side, to slugs
+CREATE TEMPORARY TABLE category_slugs (old TEXT PRIMARY KEY, slug TEXT NOT NULL);
+INSERT INTO category_slugs VALUES
+    ('COMMUNITY_SERVICE', 'community-service'),
+    ('ENVIRONMENT', 'environment'),
+    ('ENVIRONMENTAL', 'environment'),
+    ('EDUCATION', 'education'),
+    ('HEALTH', 'health'),
+    ('HEALTH_WELLNESS', 'health'),
+    ('DISASTER_RELIEF', 'disaster-relief'),
+    ('ANIMAL_WELFARE', 'animal-welfare'),
+    ('ARTS_CULTURE', 'arts-culture'),
+    ('TECHNOLOGY', 'technology'),
+    ('SPORTS_RECREATION', 'sports-recreation'),
+    ('FOOD_SECURITY', 'food-security'),
+    ('FOOD_HUNGER', 'food-security'),
+    ('YOUTH_MENTORING', 'youth-mentoring'),
+    ('YOUTH_DEVELOPMENT', 'youth-development'),
+    ('SENIOR_CARE', 'senior-care'),
+    ('HOMELESS_SERVICES', 'homeless-services'),
+    ('FUNDRAISING', 'fundraising'),
+    ('ADVOCACY', 'advocacy'),
+    ('OTHER', 'other');
+
+CREATE TEMPORARY TABLE time_commitment_slugs (old TEXT PRIMARY KEY, slug TEXT NOT NULL);
+INSERT INTO time_commitment_slugs VALUES
+    ('ONE_TIME', 'one-time'),
+    ('SHORT_TERM', 'short-term'),
+    ('WEEKLY', 'short-term'),
+    ('MEDIUM_TERM', 'medium-term'),
+    ('MONTHLY', 'medium-term'),
+    ('LONG_TERM', 'long-term'),
+    ('SEASONAL', 'long-term'),
+    ('ONGOING', 'ongoing');
+
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_category_check;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_time_commitment_check;
+
+UPDATE events e SET category = m.slug FROM category_slugs m WHERE e.category = m.old;
+UPDATE events e SET time_commitment = m.slug FROM time_commitment_slugs m WHERE e.time_commitment = m.old;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_category_fkey
+        FOREIGN KEY (category) REFERENCES event_categories(slug) ON UPDATE CASCADE,
+    ADD CONSTRAINT events_time_commitment_fkey
+        FOREIGN KEY (time_commitment) REFERENCES event_time_commitments(slug) ON UPDATE CASCADE;
+
+-- Template snapshots and hours credited from attendance copy the event's value
+UPDATE event_templates t
+SET content = jsonb_set(content, '{event,category}', to_jsonb(m.slug))
+FROM category_slugs m
+WHERE t.content->'event'->>'category' = m.old;
+
+UPDATE event_templates t
+SET content = jsonb_set(content, '{event,timeCommitment}', to_jsonb(m.slug))
+FROM time_commitment_slugs m
+WHERE t.content->'event'->>'timeCommitment' = m.old;
+
+UPDATE volunteer_hours h
+SET category = m.slug
+FROM category_slugs m
+WHERE h.source = 'ATTENDANCE' AND h.category = m.old;
+
+DROP TABLE category_slugs, time_commitment_slugs;
@@ -0,0 +1,16 @@
+-- Skill names stay canonical and merged duplicates aren't restored
+UPDATE event_shifts
+SET skills = (
+    SELECT jsonb_agg(req - 'skillId' ORDER BY ord)
+    FROM jsonb_array_elements(skills) WITH ORDINALITY AS e(req, ord)
+)
+WHERE jsonb_array_length(skills) > 0;
+
+DROP INDEX IF EXISTS idx_event_skill_requirements_skill;
+DROP INDEX IF EXISTS idx_user_skills_user_skill;
+ALTER TABLE event_skill_requirements DROP COLUMN IF EXISTS skill_id;
+ALTER TABLE user_skills DROP COLUMN IF EXISTS skill_id;
+
+DROP TABLE IF EXISTS skill_synonyms;
+DROP TABLE IF EXISTS skills;
+DROP FUNCTION IF EXISTS normalize_skill_name(TEXT);
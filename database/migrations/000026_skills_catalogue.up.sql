@@ -0,0 +1,179 @@
+-- A catalogue of canonical skills. Each skill is known by one or more
+-- synonyms; free-text skill names on profiles and event requirements are
+-- matched against the synonyms' normalized form and filed under the skill's
+-- ID, so "First Aid", "first-aid" and "CPR/First Aid" all match.
+
+-- Lowercase letters and digits in words separated by single spaces. Kept in
+-- step with skill.Normalize.
+CREATE OR REPLACE FUNCTION normalize_skill_name(name TEXT) RETURNS TEXT AS $$
+    SELECT btrim(regexp_replace(lower(name), '[^a-z0-9]+', ' ', 'g'))
+$$ LANGUAGE SQL IMMUTABLE STRICT;
+
+CREATE TABLE IF NOT EXISTS skills (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    category TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_skills_category ON skills (category);
+
+-- A skill's own name is one of its synonyms. A normalized name belongs to
+-- one skill at most.
+CREATE TABLE IF NOT EXISTS skill_synonyms (
+    skill_id UUID NOT NULL REFERENCES skills(id) ON DELETE CASCADE,
+    synonym TEXT NOT NULL,
+    normalized TEXT NOT NULL UNIQUE,
+    PRIMARY KEY (skill_id, synonym)
+);
+
+-- Autocomplete matches prefixes of the normalized synonym
+CREATE INDEX IF NOT EXISTS idx_skill_synonyms_prefix ON skill_synonyms (normalized text_pattern_ops);
+
+INSERT INTO skills (slug, name, category) VALUES
+    ('first-aid', 'First Aid', 'medical'),
+    ('cpr', 'CPR', 'medical'),
+    ('nursing', 'Nursing', 'medical'),
+    ('mental-health-support', 'Mental Health Support', 'medical'),
+    ('teaching', 'Teaching', 'education'),
+    ('tutoring', 'Tutoring', 'education'),
+    ('childcare', 'Childcare', 'education'),
+    ('elder-care', 'Elder Care', 'care'),
+    ('cooking', 'Cooking', 'hospitality'),
+    ('food-handling', 'Food Handling', 'hospitality'),
+    ('customer-service', 'Customer Service', 'hospitality'),
+    ('driving', 'Driving', 'logistics'),
+    ('forklift-operation', 'Forklift Operation', 'logistics'),
+    ('warehouse-operations', 'Warehouse Operations', 'logistics'),
+    ('construction', 'Construction', 'trades'),
+    ('carpentry', 'Carpentry', 'trades'),
+    ('electrical', 'Electrical Work', 'trades'),
+    ('plumbing', 'Plumbing', 'trades'),
+    ('gardening', 'Gardening', 'outdoors'),
+    ('event-planning', 'Event Planning', 'organization'),
+    ('fundraising', 'Fundraising', 'organization'),
+    ('project-management', 'Project Management', 'organization'),
+    ('photography', 'Photography', 'creative'),
+    ('graphic-design', 'Graphic Design', 'creative'),
+    ('social-media', 'Social Media', 'communication'),
+    ('public-speaking', 'Public Speaking', 'communication'),
+    ('translation', 'Translation', 'communication'),
+    ('sign-language', 'Sign Language', 'communication'),
+    ('software-development', 'Software Development', 'technology'),
+    ('it-support', 'IT Support', 'technology'),
+    ('data-entry', 'Data Entry', 'administration'),
+    ('bookkeeping', 'Bookkeeping', 'administration')
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO skill_synonyms (skill_id, synonym, normalized)
+SELECT s.id, v.synonym, normalize_skill_name(v.synonym)
+FROM (
+    SELECT slug, name AS synonym FROM skills
+    UNION ALL
+    SELECT * FROM (VALUES
+        ('first-aid', 'CPR/First Aid'),
+        ('first-aid', 'Basic First Aid'),
+        ('first-aid', 'First Aider'),
+        ('cpr', 'Cardiopulmonary Resuscitation'),
+        ('nursing', 'Nurse'),
+        ('nursing', 'RN'),
+        ('mental-health-support', 'Counseling'),
+        ('mental-health-support', 'Counselling'),
+        ('teaching', 'Teacher'),
+        ('tutoring', 'Tutor'),
+        ('tutoring', 'Homework Help'),
+        ('childcare', 'Child Care'),
+        ('childcare', 'Babysitting'),
+        ('elder-care', 'Senior Care'),
+        ('elder-care', 'Eldercare'),
+        ('cooking', 'Cook'),
+        ('cooking', 'Meal Preparation'),
+        ('food-handling', 'Food Safety'),
+        ('food-handling', 'Food Handler'),
+        ('driving', 'Driver'),
+        ('driving', 'Delivery Driving'),
+        ('forklift-operation', 'Forklift'),
+        ('forklift-operation', 'Forklift Driver'),
+        ('warehouse-operations', 'Warehouse'),
+        ('construction', 'Building'),
+        ('carpentry', 'Woodworking'),
+        ('electrical', 'Electrician'),
+        ('plumbing', 'Plumber'),
+        ('gardening', 'Landscaping'),
+        ('gardening', 'Horticulture'),
+        ('event-planning', 'Event Management'),
+        ('fundraising', 'Grant Writing'),
+        ('graphic-design', 'Design'),
+        ('social-media', 'Social Media Marketing'),
+        ('translation', 'Interpreting'),
+        ('translation', 'Interpretation'),
+        ('sign-language', 'ASL'),
+        ('sign-language', 'American Sign Language'),
+        ('software-development', 'Programming'),
+        ('software-development', 'Coding'),
+        ('software-development', 'Web Development'),
+        ('it-support', 'Tech Support'),
+        ('it-support', 'Computer Repair'),
+        ('bookkeeping', 'Accounting')
+    ) AS extra(slug, synonym)
+) AS v
+JOIN skills s ON s.slug = v.slug
+-- Only a re-run is skipped: a synonym that normalizes like another skill's
+-- fails the migration rather than silently going missing
+ON CONFLICT (skill_id, synonym) DO NOTHING;
+
+-- Profiles and requirements keep the name as entered for skills the
+-- catalogue doesn't know; those are matched by normalized name instead.
+ALTER TABLE user_skills
+    ADD COLUMN IF NOT EXISTS skill_id UUID REFERENCES skills(id) ON DELETE SET NULL;
+ALTER TABLE event_skill_requirements
+    ADD COLUMN IF NOT EXISTS skill_id UUID REFERENCES skills(id) ON DELETE SET NULL;
+
+UPDATE user_skills us
+SET skill_id = syn.skill_id
+FROM skill_synonyms syn
+WHERE syn.normalized = normalize_skill_name(us.name);
+
+UPDATE event_skill_requirements r
+SET skill_id = syn.skill_id, skill_name = s.name
+FROM skill_synonyms syn
+JOIN skills s ON s.id = syn.skill_id
+WHERE syn.normalized = normalize_skill_name(r.skill_name);
+
+-- A volunteer who listed the same skill under two names keeps one entry:
+-- the verified one if any, then the highest proficiency.
+DELETE FROM user_skills us
+USING (
+    SELECT id, ROW_NUMBER() OVER (
+        PARTITION BY user_id, skill_id
+        ORDER BY COALESCE(verified, FALSE) DESC,
+                 array_position(ARRAY['EXPERT', 'ADVANCED', 'INTERMEDIATE', 'BEGINNER'], proficiency),
+                 created_at
+    ) AS rank
+    FROM user_skills
+    WHERE skill_id IS NOT NULL
+) ranked
+WHERE us.id = ranked.id AND ranked.rank > 1;
+
+UPDATE user_skills us
+SET name = s.name
+FROM skills s
+WHERE s.id = us.skill_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_skills_user_skill ON user_skills (user_id, skill_id) WHERE skill_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_event_skill_requirements_skill ON event_skill_requirements (skill_id) WHERE skill_id IS NOT NULL;
+
+-- Shift requirements are JSON; file each entry under its skill too
+UPDATE event_shifts sh
+SET skills = (
+    SELECT jsonb_agg(
+        CASE WHEN s.id IS NULL THEN req
+             ELSE req || jsonb_build_object('skillId', s.id::text, 'skill', s.name)
+        END ORDER BY ord)
+    FROM jsonb_array_elements(sh.skills) WITH ORDINALITY AS e(req, ord)
+    LEFT JOIN skill_synonyms syn ON syn.normalized = normalize_skill_name(req->>'skill')
+    LEFT JOIN skills s ON s.id = syn.skill_id
+)
+WHERE jsonb_array_length(sh.skills) > 0;
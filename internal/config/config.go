@@ -12,6 +12,9 @@ type Config struct {
 	Env  string `mapstructure:"APP_ENV"`
 	Host string `mapstructure:"APP_HOST"`
 	Port int    `mapstructure:"APP_PORT"`
+	// PublicURL is the externally reachable base URL, printed on documents
+	// such as hours certificates
+	PublicURL string `mapstructure:"APP_PUBLIC_URL"`
 
 	DB struct {
 		Host     string `mapstructure:"DB_HOST"`
@@ -32,6 +35,8 @@ type Config struct {
 		BaseDir string `mapstructure:"UPLOADS_BASE_DIR"`
 		BaseURL string `mapstructure:"UPLOADS_BASE_URL"`
 		MaxMB   int    `mapstructure:"UPLOADS_MAX_MB"`
+		// PrivateDir holds uploads that are only served after an access check
+		PrivateDir string `mapstructure:"UPLOADS_PRIVATE_DIR"`
 	} `mapstructure:",squash"`
 
 	JWT struct {
@@ -40,6 +45,46 @@ type Config struct {
 		AccessTTLMin   int    `mapstructure:"JWT_ACCESS_TTL_MINUTES"`
 		RefreshTTLDays int    `mapstructure:"JWT_REFRESH_TTL_DAYS"`
 	} `mapstructure:",squash"`
+
+	Tickets struct {
+		SigningSecret string `mapstructure:"TICKET_SIGNING_SECRET"`
+	} `mapstructure:",squash"`
+
+	CheckIn struct {
+		RadiusMeters float64 `mapstructure:"CHECKIN_RADIUS_METERS"`
+		GraceMinutes int     `mapstructure:"CHECKIN_GRACE_MINUTES"`
+	} `mapstructure:",squash"`
+
+	WebAuthn struct {
+		RPID          string   `mapstructure:"WEBAUTHN_RP_ID"`
+		RPDisplayName string   `mapstructure:"WEBAUTHN_RP_NAME"`
+		RPOrigins     []string `mapstructure:"WEBAUTHN_RP_ORIGINS"`
+	} `mapstructure:",squash"`
+
+	// Mail is the outgoing SMTP relay; with no host, emails are only logged
+	Mail struct {
+		SMTPHost     string `mapstructure:"SMTP_HOST"`
+		SMTPPort     int    `mapstructure:"SMTP_PORT"`
+		SMTPUsername string `mapstructure:"SMTP_USERNAME"`
+		SMTPPassword string `mapstructure:"SMTP_PASSWORD"`
+		From         string `mapstructure:"MAIL_FROM"`
+	} `mapstructure:",squash"`
+
+	GuardianConsent struct {
+		TTLHours int `mapstructure:"GUARDIAN_CONSENT_TTL_HOURS"`
+	} `mapstructure:",squash"`
+
+	Reconfirmation struct {
+		SweepMinutes int `mapstructure:"RECONFIRMATION_SWEEP_MINUTES"`
+	} `mapstructure:",squash"`
+
+	TeamSeats struct {
+		SweepMinutes int `mapstructure:"TEAM_SEAT_SWEEP_MINUTES"`
+	} `mapstructure:",squash"`
+
+	ShiftCompletion struct {
+		SweepMinutes int `mapstructure:"SHIFT_COMPLETION_SWEEP_MINUTES"`
+	} `mapstructure:",squash"`
 }
 
 // Load loads the configuration with sane defaults and environment overrides.
@@ -54,6 +99,7 @@ func Load() (*Config, error) {
 	v.SetDefault("APP_ENV", "development")
 	v.SetDefault("APP_HOST", "0.0.0.0")
 	v.SetDefault("APP_PORT", 8080)
+	v.SetDefault("APP_PUBLIC_URL", "http://localhost:8080")
 
 	v.SetDefault("DB_HOST", "localhost")
 	v.SetDefault("DB_PORT", 5432)
@@ -70,6 +116,7 @@ func Load() (*Config, error) {
 	v.SetDefault("UPLOADS_BASE_DIR", "./uploads")
 	v.SetDefault("UPLOADS_BASE_URL", "/uploads")
 	v.SetDefault("UPLOADS_MAX_MB", 5)
+	v.SetDefault("UPLOADS_PRIVATE_DIR", "./uploads-private")
 
 	// JWT defaults (development-safe but should be overridden in production)
 	v.SetDefault("JWT_ACCESS_SECRET", "dev_access_secret_change_me")
@@ -77,6 +124,37 @@ func Load() (*Config, error) {
 	v.SetDefault("JWT_ACCESS_TTL_MINUTES", 15)
 	v.SetDefault("JWT_REFRESH_TTL_DAYS", 7)
 
+	// Check-in ticket signing key (development-safe but should be overridden in production)
+	v.SetDefault("TICKET_SIGNING_SECRET", "dev_ticket_secret_change_me")
+
+	// Volunteer self check-in geofence
+	v.SetDefault("CHECKIN_RADIUS_METERS", 150)
+	v.SetDefault("CHECKIN_GRACE_MINUTES", 30)
+
+	// WebAuthn relying party defaults (must match the frontend origin)
+	v.SetDefault("WEBAUTHN_RP_ID", "localhost")
+	v.SetDefault("WEBAUTHN_RP_NAME", "VolunteerSync")
+	v.SetDefault("WEBAUTHN_RP_ORIGINS", []string{"http://localhost:3000"})
+
+	// Outgoing mail (empty SMTP_HOST logs emails instead of sending them)
+	v.SetDefault("SMTP_HOST", "")
+	v.SetDefault("SMTP_PORT", 587)
+	v.SetDefault("SMTP_USERNAME", "")
+	v.SetDefault("SMTP_PASSWORD", "")
+	v.SetDefault("MAIL_FROM", "VolunteerSync <noreply@localhost>")
+
+	// How long a guardian consent link stays valid (capped at the event start)
+	v.SetDefault("GUARDIAN_CONSENT_TTL_HOURS", 168)
+
+	// How often to send reconfirmation requests and release unconfirmed places
+	v.SetDefault("RECONFIRMATION_SWEEP_MINUTES", 15)
+
+	// How often to release unassigned team seats and seat waitlisted teams
+	v.SetDefault("TEAM_SEAT_SWEEP_MINUTES", 15)
+
+	// How often to complete registrations whose shifts have all ended
+	v.SetDefault("SHIFT_COMPLETION_SWEEP_MINUTES", 15)
+
 	// Load .env if present, ignore if missing
 	_ = v.ReadInConfig()
 
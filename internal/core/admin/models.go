@@ -0,0 +1,141 @@
+package admin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Common errors for admin package
+var (
+	ErrUserNotFound      = errors.New("user not found")
+	ErrSkillNotFound     = errors.New("skill not found")
+	ErrEventNotFound     = errors.New("event not found")
+	ErrInvalidInput      = errors.New("invalid input")
+	ErrInvalidTransition = errors.New("invalid status transition")
+)
+
+// GenesisHash is the prev_hash of the first audit entry
+var GenesisHash = strings.Repeat("0", 64)
+
+// Action identifies a moderation action recorded in the audit trail
+type Action string
+
+const (
+	ActionUnlockUser     Action = "user.unlock"
+	ActionForceLogout    Action = "user.force_logout"
+	ActionVerifyUser     Action = "user.verify"
+	ActionUnverifyUser   Action = "user.unverify"
+	ActionVerifySkill    Action = "skill.verify"
+	ActionUnverifySkill  Action = "skill.unverify"
+	ActionUnpublishEvent Action = "event.unpublish"
+	ActionArchiveEvent   Action = "event.archive"
+
+	ActionClearBackgroundCheck  Action = "user.background_check.clear"
+	ActionRevokeBackgroundCheck Action = "user.background_check.revoke"
+)
+
+// Audit target types
+const (
+	TargetUser  = "user"
+	TargetSkill = "skill"
+	TargetEvent = "event"
+)
+
+// Event statuses the moderation actions move between
+const (
+	eventStatusDraft     = "DRAFT"
+	eventStatusPublished = "PUBLISHED"
+	eventStatusArchived  = "ARCHIVED"
+)
+
+// UserAccount is the moderation view of a user, including lockout state
+type UserAccount struct {
+	ID                  string     `json:"id" db:"id"`
+	Email               string     `json:"email" db:"email"`
+	Name                string     `json:"name" db:"name"`
+	IsVerified          bool       `json:"isVerified" db:"is_verified"`
+	EmailVerified       bool       `json:"emailVerified" db:"email_verified"`
+	FailedLoginAttempts int        `json:"failedLoginAttempts" db:"failed_login_attempts"`
+	LockedUntil         *time.Time `json:"lockedUntil,omitempty" db:"locked_until"`
+	LastLogin           *time.Time `json:"lastLogin,omitempty" db:"last_login"`
+	Roles               []string   `json:"roles"`
+	CreatedAt           time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// IsLocked reports whether the account is locked out at the given time
+func (u *UserAccount) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// UserFilter narrows the admin user listing
+type UserFilter struct {
+	Query      *string // matches name or email
+	LockedOnly bool
+	Verified   *bool
+	Role       *string
+}
+
+// UserPage is one page of the admin user listing
+type UserPage struct {
+	Users      []*UserAccount
+	TotalCount int
+}
+
+// AuditEntry is one link in the tamper-evident admin audit trail
+type AuditEntry struct {
+	Sequence   int64             `json:"sequence" db:"sequence"`
+	ID         string            `json:"id" db:"id"`
+	ActorID    string            `json:"actorId" db:"actor_id"`
+	Action     Action            `json:"action" db:"action"`
+	TargetType string            `json:"targetType" db:"target_type"`
+	TargetID   string            `json:"targetId" db:"target_id"`
+	Reason     *string           `json:"reason,omitempty" db:"reason"`
+	Details    map[string]string `json:"details" db:"details"`
+	CreatedAt  time.Time         `json:"createdAt" db:"created_at"`
+	PrevHash   string            `json:"prevHash" db:"prev_hash"`
+	Hash       string            `json:"hash" db:"hash"`
+}
+
+// ComputeHash returns the SHA-256 over the entry's content and PrevHash.
+// Details are serialised with sorted keys so the hash survives a JSONB round trip.
+func (e *AuditEntry) ComputeHash() string {
+	details, _ := json.Marshal(e.Details)
+	reason := ""
+	if e.Reason != nil {
+		reason = *e.Reason
+	}
+	fields := []string{
+		strconv.FormatInt(e.Sequence, 10),
+		e.ID,
+		e.ActorID,
+		string(e.Action),
+		e.TargetType,
+		e.TargetID,
+		reason,
+		string(details),
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		e.PrevHash,
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditFilter narrows the audit log listing
+type AuditFilter struct {
+	ActorID    *string
+	Action     *Action
+	TargetType *string
+	TargetID   *string
+}
+
+// ChainVerification reports the result of re-hashing the audit trail
+type ChainVerification struct {
+	Valid            bool
+	CheckedEntries   int
+	BrokenAtSequence *int64
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the data operations behind the moderation console.
+// Every mutating method writes its audit entry in the same transaction as
+// the change; see AppendAuditEntry for how entries are chained.
+type Repository interface {
+	// Users
+	SearchUsers(ctx context.Context, filter UserFilter, limit, offset int) (*UserPage, error)
+	GetUser(ctx context.Context, userID string) (*UserAccount, error)
+	UnlockUser(ctx context.Context, userID string, entry *AuditEntry) error
+	SetUserVerified(ctx context.Context, userID string, verified bool, entry *AuditEntry) error
+	// SetBackgroundCheck records a cleared check, or removes it when verifiedAt is nil
+	SetBackgroundCheck(ctx context.Context, userID string, verifiedAt, expiresAt *time.Time, entry *AuditEntry) error
+
+	// GetSkillOwner returns the user who holds a skill, or ErrSkillNotFound
+	GetSkillOwner(ctx context.Context, skillID string) (string, error)
+	SetSkillVerified(ctx context.Context, skillID string, verified bool, entry *AuditEntry) error
+
+	// Events
+	GetEventStatus(ctx context.Context, eventID string) (string, error)
+	SetEventStatus(ctx context.Context, eventID, status string, entry *AuditEntry) error
+
+	// AppendAuditEntry serialises writers, assigns the next Sequence and the
+	// previous entry's hash as PrevHash, then seals and stores the entry
+	AppendAuditEntry(ctx context.Context, entry *AuditEntry) error
+	// ListAuditEntries returns entries newest first
+	ListAuditEntries(ctx context.Context, filter AuditFilter, limit, offset int) ([]*AuditEntry, error)
+	// ListAuditEntriesAfter returns up to limit entries with a sequence above afterSequence, oldest first
+	ListAuditEntriesAfter(ctx context.Context, afterSequence int64, limit int) ([]*AuditEntry, error)
+}
+
+// TokenRevoker revokes a user's refresh tokens (auth.RefreshTokenRepository satisfies it)
+type TokenRevoker interface {
+	RevokeAllUserTokens(ctx context.Context, userID string) error
+}
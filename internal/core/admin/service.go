@@ -0,0 +1,258 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// verifyBatchSize bounds how many audit entries are loaded at a time while verifying the chain
+const verifyBatchSize = 500
+
+// Service implements the admin moderation console. Callers must check that the
+// requester holds the admin.moderate permission; the service only records who acted.
+type Service struct {
+	repo   Repository
+	tokens TokenRevoker
+	logger *slog.Logger
+}
+
+// NewService creates a new admin service.
+func NewService(repo Repository, tokens TokenRevoker, logger *slog.Logger) *Service {
+	if repo == nil {
+		panic("admin repository is required")
+	}
+	if tokens == nil {
+		panic("token revoker is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, tokens: tokens, logger: logger}
+}
+
+// SearchUsers lists accounts with their lockout state
+func (s *Service) SearchUsers(ctx context.Context, filter UserFilter, limit, offset int) (*UserPage, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if filter.Query != nil {
+		q := strings.TrimSpace(*filter.Query)
+		if q == "" {
+			filter.Query = nil
+		} else {
+			filter.Query = &q
+		}
+	}
+	return s.repo.SearchUsers(ctx, filter, limit, offset)
+}
+
+// GetUser returns a single account
+func (s *Service) GetUser(ctx context.Context, userID string) (*UserAccount, error) {
+	return s.repo.GetUser(ctx, userID)
+}
+
+// UnlockUser clears a lockout and resets the failed login counter
+func (s *Service) UnlockUser(ctx context.Context, adminID, userID, reason string) (*UserAccount, error) {
+	account, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]string{"failedLoginAttempts": fmt.Sprint(account.FailedLoginAttempts)}
+	if account.LockedUntil != nil {
+		details["lockedUntil"] = account.LockedUntil.UTC().Format(time.RFC3339)
+	}
+	entry := newAuditEntry(adminID, ActionUnlockUser, TargetUser, userID, reason, details)
+	if err := s.repo.UnlockUser(ctx, userID, entry); err != nil {
+		return nil, fmt.Errorf("failed to unlock user: %w", err)
+	}
+
+	account.FailedLoginAttempts = 0
+	account.LockedUntil = nil
+	return account, nil
+}
+
+// ForceLogout revokes every refresh token the user holds. Access tokens
+// already issued stay valid until they expire.
+func (s *Service) ForceLogout(ctx context.Context, adminID, userID, reason string) error {
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.tokens.RevokeAllUserTokens(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke tokens: %w", err)
+	}
+
+	entry := newAuditEntry(adminID, ActionForceLogout, TargetUser, userID, reason, nil)
+	if err := s.repo.AppendAuditEntry(ctx, entry); err != nil {
+		// The tokens are gone either way; make sure the gap in the trail is noticed
+		s.logger.Error("force logout not recorded in audit trail", "userID", userID, "adminID", adminID, "error", err)
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+	return nil
+}
+
+// SetUserVerified marks a user as verified or removes the badge
+func (s *Service) SetUserVerified(ctx context.Context, adminID, userID string, verified bool, reason string) (*UserAccount, error) {
+	account, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	action := ActionVerifyUser
+	if !verified {
+		action = ActionUnverifyUser
+	}
+	entry := newAuditEntry(adminID, action, TargetUser, userID, reason, nil)
+	if err := s.repo.SetUserVerified(ctx, userID, verified, entry); err != nil {
+		return nil, fmt.Errorf("failed to update user verification: %w", err)
+	}
+
+	account.IsVerified = verified
+	return account, nil
+}
+
+// SetBackgroundCheck records that a user's background check has cleared, valid
+// until expiresAt when set, or revokes it when cleared is false
+func (s *Service) SetBackgroundCheck(ctx context.Context, adminID, userID string, cleared bool, expiresAt *time.Time, reason string) error {
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return err
+	}
+
+	action := ActionRevokeBackgroundCheck
+	details := map[string]string(nil)
+	var verifiedAt *time.Time
+	if cleared {
+		now := time.Now().UTC()
+		if expiresAt != nil && !expiresAt.After(now) {
+			return fmt.Errorf("%w: background check expiry must be in the future", ErrInvalidInput)
+		}
+		action = ActionClearBackgroundCheck
+		verifiedAt = &now
+		if expiresAt != nil {
+			details = map[string]string{"expiresAt": expiresAt.UTC().Format(time.RFC3339)}
+		}
+	} else {
+		expiresAt = nil
+	}
+	entry := newAuditEntry(adminID, action, TargetUser, userID, reason, details)
+	if err := s.repo.SetBackgroundCheck(ctx, userID, verifiedAt, expiresAt, entry); err != nil {
+		return fmt.Errorf("failed to update background check: %w", err)
+	}
+	return nil
+}
+
+// SetSkillVerified marks one of a user's skills as verified or unverified
+func (s *Service) SetSkillVerified(ctx context.Context, adminID, skillID string, verified bool, reason string) error {
+	ownerID, err := s.repo.GetSkillOwner(ctx, skillID)
+	if err != nil {
+		return err
+	}
+
+	action := ActionVerifySkill
+	if !verified {
+		action = ActionUnverifySkill
+	}
+	entry := newAuditEntry(adminID, action, TargetSkill, skillID, reason, map[string]string{"userId": ownerID})
+	if err := s.repo.SetSkillVerified(ctx, skillID, verified, entry); err != nil {
+		return fmt.Errorf("failed to update skill verification: %w", err)
+	}
+	return nil
+}
+
+// UnpublishEvent takes a published event back to draft
+func (s *Service) UnpublishEvent(ctx context.Context, adminID, eventID, reason string) error {
+	return s.moderateEvent(ctx, adminID, eventID, reason, ActionUnpublishEvent, eventStatusDraft)
+}
+
+// ArchiveEvent hides an event from listings without deleting it
+func (s *Service) ArchiveEvent(ctx context.Context, adminID, eventID, reason string) error {
+	return s.moderateEvent(ctx, adminID, eventID, reason, ActionArchiveEvent, eventStatusArchived)
+}
+
+func (s *Service) moderateEvent(ctx context.Context, adminID, eventID, reason string, action Action, status string) error {
+	current, err := s.repo.GetEventStatus(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case status == eventStatusDraft && current != eventStatusPublished:
+		return fmt.Errorf("%w: only published events can be unpublished (event is %s)", ErrInvalidTransition, current)
+	case status == eventStatusArchived && current == eventStatusArchived:
+		return fmt.Errorf("%w: event is already archived", ErrInvalidTransition)
+	}
+
+	entry := newAuditEntry(adminID, action, TargetEvent, eventID, reason, map[string]string{"from": current, "to": status})
+	if err := s.repo.SetEventStatus(ctx, eventID, status, entry); err != nil {
+		return fmt.Errorf("failed to update event status: %w", err)
+	}
+	return nil
+}
+
+// ListAuditLog returns audit entries, newest first
+func (s *Service) ListAuditLog(ctx context.Context, filter AuditFilter, limit, offset int) ([]*AuditEntry, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return s.repo.ListAuditEntries(ctx, filter, limit, offset)
+}
+
+// VerifyAuditTrail re-hashes the whole trail and reports the first entry whose
+// hash or link to its predecessor does not match.
+func (s *Service) VerifyAuditTrail(ctx context.Context) (*ChainVerification, error) {
+	result := &ChainVerification{Valid: true}
+	prevHash := GenesisHash
+	var after int64
+
+	for {
+		entries, err := s.repo.ListAuditEntriesAfter(ctx, after, verifyBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load audit entries: %w", err)
+		}
+		for _, e := range entries {
+			result.CheckedEntries++
+			if e.Sequence != after+1 || e.PrevHash != prevHash || e.ComputeHash() != e.Hash {
+				seq := e.Sequence
+				result.Valid = false
+				result.BrokenAtSequence = &seq
+				s.logger.Warn("admin audit trail verification failed", "sequence", seq)
+				return result, nil
+			}
+			prevHash = e.Hash
+			after = e.Sequence
+		}
+		if len(entries) < verifyBatchSize {
+			return result, nil
+		}
+	}
+}
+
+func newAuditEntry(actorID string, action Action, targetType, targetID, reason string, details map[string]string) *AuditEntry {
+	entry := &AuditEntry{
+		ID:         uuid.New().String(),
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Details:    details,
+		// Postgres keeps microseconds; truncate so the stored value hashes the same
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	if entry.Details == nil {
+		entry.Details = map[string]string{}
+	}
+	if r := strings.TrimSpace(reason); r != "" {
+		entry.Reason = &r
+	}
+	return entry
+}
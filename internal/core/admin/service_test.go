@@ -0,0 +1,283 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepo is an in-memory Repository that chains audit entries like the Postgres store
+type fakeRepo struct {
+	users            map[string]*UserAccount
+	skillOwners      map[string]string
+	skills           map[string]bool
+	backgroundChecks map[string]bool
+	events           map[string]string
+	entries          []*AuditEntry
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		users:            map[string]*UserAccount{},
+		skillOwners:      map[string]string{},
+		skills:           map[string]bool{},
+		backgroundChecks: map[string]bool{},
+		events:           map[string]string{},
+	}
+}
+
+func (f *fakeRepo) SearchUsers(ctx context.Context, filter UserFilter, limit, offset int) (*UserPage, error) {
+	page := &UserPage{}
+	for _, u := range f.users {
+		if filter.LockedOnly && !u.IsLocked(time.Now()) {
+			continue
+		}
+		page.Users = append(page.Users, u)
+	}
+	page.TotalCount = len(page.Users)
+	return page, nil
+}
+
+func (f *fakeRepo) GetUser(ctx context.Context, userID string) (*UserAccount, error) {
+	u, ok := f.users[userID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	copy := *u
+	return &copy, nil
+}
+
+func (f *fakeRepo) UnlockUser(ctx context.Context, userID string, entry *AuditEntry) error {
+	f.users[userID].FailedLoginAttempts = 0
+	f.users[userID].LockedUntil = nil
+	return f.AppendAuditEntry(ctx, entry)
+}
+
+func (f *fakeRepo) SetUserVerified(ctx context.Context, userID string, verified bool, entry *AuditEntry) error {
+	f.users[userID].IsVerified = verified
+	return f.AppendAuditEntry(ctx, entry)
+}
+
+func (f *fakeRepo) SetBackgroundCheck(ctx context.Context, userID string, verifiedAt, expiresAt *time.Time, entry *AuditEntry) error {
+	f.backgroundChecks[userID] = verifiedAt != nil
+	return f.AppendAuditEntry(ctx, entry)
+}
+
+func (f *fakeRepo) GetSkillOwner(ctx context.Context, skillID string) (string, error) {
+	owner, ok := f.skillOwners[skillID]
+	if !ok {
+		return "", ErrSkillNotFound
+	}
+	return owner, nil
+}
+
+func (f *fakeRepo) SetSkillVerified(ctx context.Context, skillID string, verified bool, entry *AuditEntry) error {
+	f.skills[skillID] = verified
+	return f.AppendAuditEntry(ctx, entry)
+}
+
+func (f *fakeRepo) GetEventStatus(ctx context.Context, eventID string) (string, error) {
+	status, ok := f.events[eventID]
+	if !ok {
+		return "", ErrEventNotFound
+	}
+	return status, nil
+}
+
+func (f *fakeRepo) SetEventStatus(ctx context.Context, eventID, status string, entry *AuditEntry) error {
+	f.events[eventID] = status
+	return f.AppendAuditEntry(ctx, entry)
+}
+
+func (f *fakeRepo) AppendAuditEntry(ctx context.Context, entry *AuditEntry) error {
+	entry.Sequence = int64(len(f.entries)) + 1
+	entry.PrevHash = GenesisHash
+	if len(f.entries) > 0 {
+		entry.PrevHash = f.entries[len(f.entries)-1].Hash
+	}
+	entry.Hash = entry.ComputeHash()
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+func (f *fakeRepo) ListAuditEntries(ctx context.Context, filter AuditFilter, limit, offset int) ([]*AuditEntry, error) {
+	var out []*AuditEntry
+	for i := len(f.entries) - 1; i >= 0; i-- {
+		out = append(out, f.entries[i])
+	}
+	return out, nil
+}
+
+func (f *fakeRepo) ListAuditEntriesAfter(ctx context.Context, afterSequence int64, limit int) ([]*AuditEntry, error) {
+	var out []*AuditEntry
+	for _, e := range f.entries {
+		if e.Sequence > afterSequence && len(out) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+type fakeRevoker struct{ revoked []string }
+
+func (r *fakeRevoker) RevokeAllUserTokens(ctx context.Context, userID string) error {
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+func setupService() (*Service, *fakeRepo, *fakeRevoker) {
+	repo := newFakeRepo()
+	revoker := &fakeRevoker{}
+	return NewService(repo, revoker, nil), repo, revoker
+}
+
+func TestService_UnlockUser(t *testing.T) {
+	svc, repo, _ := setupService()
+	ctx := context.Background()
+	lockedUntil := time.Now().Add(time.Hour)
+	repo.users["user1"] = &UserAccount{ID: "user1", FailedLoginAttempts: 5, LockedUntil: &lockedUntil}
+
+	account, err := svc.UnlockUser(ctx, "admin", "user1", " repeated typos ")
+
+	require.NoError(t, err)
+	assert.False(t, account.IsLocked(time.Now()))
+	assert.Equal(t, 0, repo.users["user1"].FailedLoginAttempts)
+	require.Len(t, repo.entries, 1)
+	entry := repo.entries[0]
+	assert.Equal(t, ActionUnlockUser, entry.Action)
+	assert.Equal(t, "admin", entry.ActorID)
+	assert.Equal(t, "5", entry.Details["failedLoginAttempts"])
+	require.NotNil(t, entry.Reason)
+	assert.Equal(t, "repeated typos", *entry.Reason)
+
+	_, err = svc.UnlockUser(ctx, "admin", "missing", "")
+	assert.True(t, errors.Is(err, ErrUserNotFound))
+}
+
+func TestService_ForceLogout(t *testing.T) {
+	svc, repo, revoker := setupService()
+	repo.users["user1"] = &UserAccount{ID: "user1"}
+
+	require.NoError(t, svc.ForceLogout(context.Background(), "admin", "user1", ""))
+
+	assert.Equal(t, []string{"user1"}, revoker.revoked)
+	require.Len(t, repo.entries, 1)
+	assert.Equal(t, ActionForceLogout, repo.entries[0].Action)
+	assert.Nil(t, repo.entries[0].Reason)
+}
+
+func TestService_Verification(t *testing.T) {
+	svc, repo, _ := setupService()
+	ctx := context.Background()
+	repo.users["user1"] = &UserAccount{ID: "user1"}
+	repo.skillOwners["skill1"] = "user1"
+
+	account, err := svc.SetUserVerified(ctx, "admin", "user1", true, "")
+	require.NoError(t, err)
+	assert.True(t, account.IsVerified)
+
+	require.NoError(t, svc.SetSkillVerified(ctx, "admin", "skill1", true, ""))
+	assert.True(t, repo.skills["skill1"])
+
+	err = svc.SetSkillVerified(ctx, "admin", "missing", true, "")
+	assert.True(t, errors.Is(err, ErrSkillNotFound))
+
+	require.Len(t, repo.entries, 2)
+	assert.Equal(t, ActionVerifySkill, repo.entries[1].Action)
+	assert.Equal(t, "user1", repo.entries[1].Details["userId"])
+}
+
+func TestService_SetBackgroundCheck(t *testing.T) {
+	svc, repo, _ := setupService()
+	ctx := context.Background()
+	repo.users["user1"] = &UserAccount{ID: "user1"}
+
+	past := time.Now().Add(-time.Hour)
+	err := svc.SetBackgroundCheck(ctx, "admin", "user1", true, &past, "")
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+
+	expires := time.Now().AddDate(1, 0, 0)
+	require.NoError(t, svc.SetBackgroundCheck(ctx, "admin", "user1", true, &expires, "cleared by provider"))
+	assert.True(t, repo.backgroundChecks["user1"])
+
+	require.NoError(t, svc.SetBackgroundCheck(ctx, "admin", "user1", false, nil, ""))
+	assert.False(t, repo.backgroundChecks["user1"])
+
+	require.Len(t, repo.entries, 2)
+	assert.Equal(t, ActionClearBackgroundCheck, repo.entries[0].Action)
+	assert.NotEmpty(t, repo.entries[0].Details["expiresAt"])
+	assert.Equal(t, ActionRevokeBackgroundCheck, repo.entries[1].Action)
+}
+
+func TestService_ModerateEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unpublish published event", func(t *testing.T) {
+		svc, repo, _ := setupService()
+		repo.events["event1"] = eventStatusPublished
+
+		require.NoError(t, svc.UnpublishEvent(ctx, "admin", "event1", "spam"))
+		assert.Equal(t, eventStatusDraft, repo.events["event1"])
+		assert.Equal(t, map[string]string{"from": "PUBLISHED", "to": "DRAFT"}, repo.entries[0].Details)
+	})
+
+	t.Run("unpublish draft is rejected", func(t *testing.T) {
+		svc, repo, _ := setupService()
+		repo.events["event1"] = eventStatusDraft
+
+		err := svc.UnpublishEvent(ctx, "admin", "event1", "")
+		assert.True(t, errors.Is(err, ErrInvalidTransition))
+		assert.Empty(t, repo.entries)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		svc, repo, _ := setupService()
+		repo.events["event1"] = "COMPLETED"
+
+		require.NoError(t, svc.ArchiveEvent(ctx, "admin", "event1", ""))
+		assert.Equal(t, eventStatusArchived, repo.events["event1"])
+
+		err := svc.ArchiveEvent(ctx, "admin", "event1", "")
+		assert.True(t, errors.Is(err, ErrInvalidTransition))
+	})
+}
+
+func TestService_VerifyAuditTrail(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := setupService()
+	repo.users["user1"] = &UserAccount{ID: "user1"}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, svc.ForceLogout(ctx, "admin", "user1", ""))
+	}
+
+	result, err := svc.VerifyAuditTrail(ctx)
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, 3, result.CheckedEntries)
+
+	t.Run("edited entry", func(t *testing.T) {
+		repo.entries[1].ActorID = "someone-else"
+		defer func() { repo.entries[1].ActorID = "admin" }()
+
+		result, err := svc.VerifyAuditTrail(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		require.NotNil(t, result.BrokenAtSequence)
+		assert.Equal(t, int64(2), *result.BrokenAtSequence)
+	})
+
+	t.Run("deleted entry", func(t *testing.T) {
+		original := repo.entries
+		repo.entries = []*AuditEntry{original[0], original[2]}
+		defer func() { repo.entries = original }()
+
+		result, err := svc.VerifyAuditTrail(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, int64(3), *result.BrokenAtSequence)
+	})
+}
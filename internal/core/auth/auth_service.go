@@ -47,14 +47,31 @@ func (as *AuthService) Register(ctx context.Context, req *RegisterRequest) (*Aut
 		return nil, err
 	}
 
-	// Create and store user
-	user, err := as.createUserFromRequest(ctx, req)
+	// Create and store user together with its role; new accounts start as
+	// volunteers
+	var user *User
+	err := as.userRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if user, err = as.createUserFromRequest(ctx, req); err != nil {
+			return err
+		}
+		if err := as.userRepo.AssignRole(ctx, user.ID, RoleVolunteer); err != nil {
+			as.logger.Error("failed to assign default role", "user_id", user.ID, "error", err)
+			return fmt.Errorf("failed to create user account")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := as.loadUserRoles(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Generate authentication response
-	return as.generateAuthResponse(ctx, user, []string{"user"})
+	return as.generateAuthResponse(ctx, user, roles)
 }
 
 // Login authenticates a user with email and password
@@ -81,13 +98,13 @@ func (as *AuthService) RefreshToken(ctx context.Context, refreshTokenString stri
 	}
 
 	// Validate and extract token information
-	user, claims, err := as.validateRefreshTokenAndGetUser(ctx, refreshTokenString)
+	user, _, err := as.validateRefreshTokenAndGetUser(ctx, refreshTokenString)
 	if err != nil {
 		return nil, err
 	}
 
 	// Refresh tokens
-	return as.refreshUserTokens(ctx, user, claims, refreshTokenString)
+	return as.refreshUserTokens(ctx, user, refreshTokenString)
 }
 
 // Logout revokes all refresh tokens for a user
@@ -260,6 +277,19 @@ func (as *AuthService) generateAuthResponse(ctx context.Context, user *User, rol
 	}, nil
 }
 
+// loadUserRoles fetches the user's roles from user_roles for inclusion in tokens
+func (as *AuthService) loadUserRoles(ctx context.Context, userID string) ([]string, error) {
+	roles, err := as.userRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		as.logger.Error("failed to load user roles", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("failed to load user roles")
+	}
+	if roles == nil {
+		roles = []string{}
+	}
+	return roles, nil
+}
+
 // authenticateUser retrieves and validates user credentials
 func (as *AuthService) authenticateUser(ctx context.Context, req *LoginRequest) (*User, error) {
 	// Get user by email
@@ -308,8 +338,10 @@ func (as *AuthService) handleSuccessfulLogin(ctx context.Context, user *User) (*
 	}
 
 	// Generate tokens with user roles
-	roles := []string{"user"}
-	// Add additional roles based on user properties if needed
+	roles, err := as.loadUserRoles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	return as.generateAuthResponse(ctx, user, roles)
 }
@@ -354,7 +386,7 @@ func (as *AuthService) validateRefreshTokenAndGetUser(ctx context.Context, refre
 }
 
 // refreshUserTokens generates new tokens and revokes old ones
-func (as *AuthService) refreshUserTokens(ctx context.Context, user *User, claims *UserClaims, oldTokenString string) (*AuthResponse, error) {
+func (as *AuthService) refreshUserTokens(ctx context.Context, user *User, oldTokenString string) (*AuthResponse, error) {
 	// Revoke old refresh token
 	tokenHash := as.jwtService.HashRefreshToken(oldTokenString)
 	err := as.refreshTokenRepo.RevokeRefreshToken(ctx, tokenHash)
@@ -362,8 +394,14 @@ func (as *AuthService) refreshUserTokens(ctx context.Context, user *User, claims
 		as.logger.Error("failed to revoke old refresh token", "user_id", user.ID, "error", err)
 	}
 
+	// Reload roles so grants and revocations take effect on refresh
+	roles, err := as.loadUserRoles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate new tokens
-	tokenPair, err := as.jwtService.GenerateTokenPair(user.ID, user.Email, claims.Roles)
+	tokenPair, err := as.jwtService.GenerateTokenPair(user.ID, user.Email, roles)
 	if err != nil {
 		as.logger.Error("failed to generate new tokens", "user_id", user.ID, "error", err)
 		return nil, fmt.Errorf("failed to generate new tokens")
@@ -16,6 +16,7 @@ type MockUserRepository struct {
 	users          map[string]*User
 	emailToUserID  map[string]string
 	googleToUserID map[string]string
+	roles          map[string][]string
 	shouldError    bool
 	errorMsg       string
 }
@@ -25,6 +26,7 @@ func NewMockUserRepository() *MockUserRepository {
 		users:          make(map[string]*User),
 		emailToUserID:  make(map[string]string),
 		googleToUserID: make(map[string]string),
+		roles:          make(map[string][]string),
 	}
 }
 
@@ -139,6 +141,32 @@ func (m *MockUserRepository) EmailExists(ctx context.Context, email string) (boo
 	return exists, nil
 }
 
+func (m *MockUserRepository) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+
+	return m.roles[userID], nil
+}
+
+func (m *MockUserRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (m *MockUserRepository) AssignRole(ctx context.Context, userID, role string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+
+	for _, r := range m.roles[userID] {
+		if r == role {
+			return nil
+		}
+	}
+	m.roles[userID] = append(m.roles[userID], role)
+	return nil
+}
+
 type MockRefreshTokenRepository struct {
 	tokens      map[string]*RefreshToken
 	shouldError bool
@@ -1032,3 +1060,84 @@ func TestAuthService_ValidateAccessToken(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthService_TokenRoles(t *testing.T) {
+	authService, userRepo, _ := createTestAuthService(t)
+	ctx := context.Background()
+
+	registerResponse, err := authService.Register(ctx, &RegisterRequest{
+		Name:     "Role Test User",
+		Email:    "roles@example.com",
+		Password: "TestPassword123!",
+	})
+	if err != nil {
+		t.Fatalf("Failed to register test user: %v", err)
+	}
+
+	t.Run("new accounts carry the volunteer role", func(t *testing.T) {
+		claims, err := authService.ValidateAccessToken(registerResponse.AccessToken)
+		if err != nil {
+			t.Fatalf("ValidateAccessToken() error = %v", err)
+		}
+		if len(claims.Roles) != 1 || claims.Roles[0] != RoleVolunteer {
+			t.Errorf("Register() roles = %v, want [%s]", claims.Roles, RoleVolunteer)
+		}
+	})
+
+	t.Run("refresh picks up newly granted roles", func(t *testing.T) {
+		userID := registerResponse.User.ID
+		if err := userRepo.AssignRole(ctx, userID, RoleOrganizer); err != nil {
+			t.Fatalf("AssignRole() error = %v", err)
+		}
+
+		refreshed, err := authService.RefreshToken(ctx, registerResponse.RefreshToken)
+		if err != nil {
+			t.Fatalf("RefreshToken() error = %v", err)
+		}
+		claims, err := authService.ValidateAccessToken(refreshed.AccessToken)
+		if err != nil {
+			t.Fatalf("ValidateAccessToken() error = %v", err)
+		}
+		if !RolesHavePermission(claims.Roles, PermEventCreate) {
+			t.Errorf("refreshed roles %v should grant %s", claims.Roles, PermEventCreate)
+		}
+	})
+
+	t.Run("role lookup failure blocks token issue", func(t *testing.T) {
+		userRepo.roles[registerResponse.User.ID] = nil
+		_, err := authService.loadUserRoles(ctx, registerResponse.User.ID)
+		if err != nil {
+			t.Errorf("loadUserRoles() with no roles error = %v, want nil", err)
+		}
+
+		userRepo.SetError(true, "database error")
+		defer userRepo.SetError(false, "")
+		if _, err := authService.loadUserRoles(ctx, registerResponse.User.ID); err == nil {
+			t.Error("loadUserRoles() should fail when the repository errors")
+		}
+	})
+}
+
+func TestRolesHavePermission(t *testing.T) {
+	tests := []struct {
+		roles []string
+		perm  Permission
+		want  bool
+	}{
+		{[]string{RoleVolunteer}, PermRegistrationCreate, true},
+		{[]string{RoleVolunteer}, PermEventCreate, false},
+		{[]string{RoleOrganizer}, PermEventCreate, true},
+		{[]string{RoleOrganizer}, PermRegistrationApprove, true},
+		{[]string{RoleOrganizer}, PermUserManage, false},
+		{[]string{RoleAdmin}, PermUserManage, true},
+		{[]string{"organizer"}, PermEventCreate, true},
+		{[]string{"user"}, PermRegistrationCreate, false},
+		{nil, PermRegistrationCreate, false},
+	}
+
+	for _, tt := range tests {
+		if got := RolesHavePermission(tt.roles, tt.perm); got != tt.want {
+			t.Errorf("RolesHavePermission(%v, %s) = %v, want %v", tt.roles, tt.perm, got, tt.want)
+		}
+	}
+}
@@ -13,6 +13,9 @@ var (
 	ErrTokenExpired       = errors.New("token expired")
 	ErrAccountLocked      = errors.New("account locked")
 	ErrEmailNotVerified   = errors.New("email not verified")
+	ErrPasskeyNotFound    = errors.New("passkey not found")
+	ErrPasskeyCloned      = errors.New("passkey sign counter regressed")
+	ErrPasskeySession     = errors.New("passkey session expired or invalid")
 )
 
 // User represents a user in the system
@@ -40,6 +43,30 @@ type RefreshToken struct {
 	RevokedAt *time.Time `json:"revoked_at" db:"revoked_at"`
 }
 
+// PasskeyCredential represents a WebAuthn credential registered to a user
+type PasskeyCredential struct {
+	ID              string     `json:"id" db:"id"`
+	UserID          string     `json:"user_id" db:"user_id"`
+	CredentialID    []byte     `json:"credential_id" db:"credential_id"`
+	PublicKey       []byte     `json:"-" db:"public_key"`
+	AttestationType string     `json:"attestation_type" db:"attestation_type"`
+	AAGUID          []byte     `json:"aaguid" db:"aaguid"`
+	SignCount       uint32     `json:"sign_count" db:"sign_count"`
+	Transports      []string   `json:"transports" db:"transports"`
+	BackupEligible  bool       `json:"backup_eligible" db:"backup_eligible"`
+	BackupState     bool       `json:"backup_state" db:"backup_state"`
+	Name            string     `json:"name" db:"name"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt      *time.Time `json:"last_used_at" db:"last_used_at"`
+}
+
+// PasskeyCeremony is the challenge handed to the browser to start a WebAuthn ceremony.
+// Options is the JSON document to pass to navigator.credentials.create() or .get().
+type PasskeyCeremony struct {
+	SessionID string `json:"session_id"`
+	Options   []byte `json:"options"`
+}
+
 // RegisterRequest represents a user registration request
 type RegisterRequest struct {
 	Name     string `json:"name" validate:"required,min=2,max=100"`
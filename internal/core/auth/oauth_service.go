@@ -203,7 +203,10 @@ func (os *OAuthService) loginExistingUser(ctx context.Context, user *User) (*Aut
 	}
 
 	// Generate tokens
-	roles := []string{"user"}
+	roles, err := os.authService.loadUserRoles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
 	tokenPair, err := os.authService.jwtService.GenerateTokenPair(user.ID, user.Email, roles)
 	if err != nil {
 		os.logger.Error("failed to generate tokens", "user_id", user.ID, "error", err)
@@ -261,14 +264,27 @@ func (os *OAuthService) createNewUser(ctx context.Context, userInfo *GoogleUserI
 		UpdatedAt:     time.Now(),
 	}
 
-	err := os.userRepo.CreateUser(ctx, user)
+	// New accounts start as volunteers; the user is saved with its role
+	err := os.userRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := os.userRepo.CreateUser(ctx, user); err != nil {
+			os.logger.Error("failed to create user from Google OAuth", "email", userInfo.Email, "google_id", userInfo.ID, "error", err)
+			return fmt.Errorf("failed to create user account")
+		}
+		if err := os.userRepo.AssignRole(ctx, user.ID, RoleVolunteer); err != nil {
+			os.logger.Error("failed to assign default role", "user_id", user.ID, "error", err)
+			return fmt.Errorf("failed to create user account")
+		}
+		return nil
+	})
 	if err != nil {
-		os.logger.Error("failed to create user from Google OAuth", "email", userInfo.Email, "google_id", userInfo.ID, "error", err)
-		return nil, fmt.Errorf("failed to create user account")
+		return nil, err
 	}
 
 	// Generate tokens
-	roles := []string{"user"}
+	roles, err := os.authService.loadUserRoles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
 	tokenPair, err := os.authService.jwtService.GenerateTokenPair(user.ID, user.Email, roles)
 	if err != nil {
 		os.logger.Error("failed to generate tokens for new user", "user_id", user.ID, "error", err)
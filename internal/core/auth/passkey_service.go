@@ -0,0 +1,368 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+// passkeySessionTTL bounds how long a ceremony challenge stays valid
+const passkeySessionTTL = 5 * time.Minute
+
+// PasskeyConfig represents WebAuthn relying party configuration
+type PasskeyConfig struct {
+	RPID          string
+	RPDisplayName string
+	RPOrigins     []string
+}
+
+// PasskeyService handles WebAuthn passkey registration and sign-in ceremonies
+type PasskeyService struct {
+	webAuthn       *webauthn.WebAuthn
+	userRepo       UserRepository
+	credentialRepo PasskeyCredentialRepository
+	authService    *AuthService
+	logger         *slog.Logger
+
+	mu       sync.Mutex
+	sessions map[string]*passkeySession // In production, use Redis
+}
+
+// passkeySession holds the server side of an in-flight ceremony
+type passkeySession struct {
+	userID    string
+	data      webauthn.SessionData
+	expiresAt time.Time
+}
+
+// NewPasskeyService creates a new passkey service
+func NewPasskeyService(
+	config PasskeyConfig,
+	userRepo UserRepository,
+	credentialRepo PasskeyCredentialRepository,
+	authService *AuthService,
+	logger *slog.Logger,
+) (*PasskeyService, error) {
+	if config.RPDisplayName == "" {
+		config.RPDisplayName = "VolunteerSync"
+	}
+
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          config.RPID,
+		RPDisplayName: config.RPDisplayName,
+		RPOrigins:     config.RPOrigins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationPreferred,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webauthn config: %w", err)
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &PasskeyService{
+		webAuthn:       w,
+		userRepo:       userRepo,
+		credentialRepo: credentialRepo,
+		authService:    authService,
+		logger:         logger,
+		sessions:       make(map[string]*passkeySession),
+	}, nil
+}
+
+// BeginRegistration starts a registration ceremony for an authenticated user
+func (ps *PasskeyService) BeginRegistration(ctx context.Context, userID string) (*PasskeyCeremony, error) {
+	user, err := ps.loadPasskeyUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Exclude already registered authenticators so the browser doesn't create duplicates
+	creation, session, err := ps.webAuthn.BeginRegistration(user,
+		webauthn.WithExclusions(webauthn.Credentials(user.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementPreferred),
+	)
+	if err != nil {
+		ps.logger.Error("failed to begin passkey registration", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("failed to start passkey registration")
+	}
+
+	return ps.storeSession(userID, session, creation)
+}
+
+// FinishRegistration verifies the attestation response and stores the new credential
+func (ps *PasskeyService) FinishRegistration(ctx context.Context, userID, sessionID string, response []byte, name string) (*PasskeyCredential, error) {
+	session, err := ps.takeSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.userID != userID {
+		return nil, ErrPasskeySession
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		ps.logger.Warn("invalid passkey attestation payload", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("invalid passkey response")
+	}
+
+	user, err := ps.loadPasskeyUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := ps.webAuthn.CreateCredential(user, session.data, parsed)
+	if err != nil {
+		ps.logger.Warn("passkey attestation rejected", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("passkey registration failed")
+	}
+
+	transports := make([]string, 0, len(cred.Transport))
+	for _, t := range cred.Transport {
+		transports = append(transports, string(t))
+	}
+
+	credential := &PasskeyCredential{
+		ID:              uuid.New().String(),
+		UserID:          userID,
+		CredentialID:    cred.ID,
+		PublicKey:       cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		AAGUID:          cred.Authenticator.AAGUID,
+		SignCount:       cred.Authenticator.SignCount,
+		Transports:      transports,
+		BackupEligible:  cred.Flags.BackupEligible,
+		BackupState:     cred.Flags.BackupState,
+		Name:            strings.TrimSpace(name),
+		CreatedAt:       time.Now(),
+	}
+	if credential.Name == "" {
+		credential.Name = "Passkey"
+	}
+
+	if err := ps.credentialRepo.CreateCredential(ctx, credential); err != nil {
+		ps.logger.Error("failed to store passkey", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("failed to store passkey")
+	}
+
+	ps.logger.Info("passkey registered", "user_id", userID, "credential", credential.ID)
+	return credential, nil
+}
+
+// BeginLogin starts an authentication ceremony. When email is empty, or the account
+// has no passkeys, a discoverable (usernameless) challenge is issued instead so the
+// response doesn't reveal whether the email is registered.
+func (ps *PasskeyService) BeginLogin(ctx context.Context, email string) (*PasskeyCeremony, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email != "" {
+		if authUser, err := ps.userRepo.GetUserByEmail(ctx, email); err == nil {
+			user, err := ps.loadPasskeyUser(ctx, authUser.ID)
+			if err == nil && len(user.credentials) > 0 {
+				assertion, session, err := ps.webAuthn.BeginLogin(user)
+				if err != nil {
+					ps.logger.Error("failed to begin passkey login", "user_id", authUser.ID, "error", err)
+					return nil, fmt.Errorf("failed to start passkey sign-in")
+				}
+				return ps.storeSession(authUser.ID, session, assertion)
+			}
+		}
+	}
+
+	assertion, session, err := ps.webAuthn.BeginDiscoverableLogin()
+	if err != nil {
+		ps.logger.Error("failed to begin discoverable passkey login", "error", err)
+		return nil, fmt.Errorf("failed to start passkey sign-in")
+	}
+	return ps.storeSession("", session, assertion)
+}
+
+// FinishLogin verifies an assertion response and issues tokens for the owning user
+func (ps *PasskeyService) FinishLogin(ctx context.Context, sessionID string, response []byte) (*AuthResponse, error) {
+	session, err := ps.takeSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		ps.logger.Warn("invalid passkey assertion payload", "error", err)
+		return nil, ErrInvalidCredentials
+	}
+
+	// Verify the assertion signature against the stored public key
+	var user *passkeyUser
+	var cred *webauthn.Credential
+	if session.userID != "" {
+		user, err = ps.loadPasskeyUser(ctx, session.userID)
+		if err != nil {
+			return nil, ErrInvalidCredentials
+		}
+		cred, err = ps.webAuthn.ValidateLogin(user, session.data, parsed)
+	} else {
+		var found webauthn.User
+		found, cred, err = ps.webAuthn.ValidatePasskeyLogin(func(_, userHandle []byte) (webauthn.User, error) {
+			return ps.loadPasskeyUser(ctx, string(userHandle))
+		}, session.data, parsed)
+		if err == nil {
+			user = found.(*passkeyUser)
+		}
+	}
+	if err != nil {
+		ps.logger.Warn("passkey assertion rejected", "user_id", session.userID, "error", err)
+		return nil, ErrInvalidCredentials
+	}
+
+	// Reject authenticators whose sign counter did not advance; it may be cloned
+	if cred.Authenticator.CloneWarning {
+		ps.logger.Warn("passkey sign counter regression", "user_id", user.user.ID, "credential", base64.RawURLEncoding.EncodeToString(cred.ID))
+		return nil, ErrPasskeyCloned
+	}
+
+	if user.user.IsLocked() {
+		ps.logger.Warn("passkey login attempt on locked account", "user_id", user.user.ID)
+		return nil, fmt.Errorf("account is temporarily locked")
+	}
+
+	// Only a sign-in that goes through counts as using the passkey
+	if err := ps.credentialRepo.UpdateCredentialUsage(ctx, cred.ID, cred.Authenticator.SignCount, cred.Flags.BackupState); err != nil {
+		ps.logger.Error("failed to update passkey usage", "user_id", user.user.ID, "error", err)
+		return nil, fmt.Errorf("failed to complete passkey sign-in")
+	}
+
+	return ps.authService.handleSuccessfulLogin(ctx, user.user)
+}
+
+// ListCredentials returns the passkeys registered to a user
+func (ps *PasskeyService) ListCredentials(ctx context.Context, userID string) ([]*PasskeyCredential, error) {
+	creds, err := ps.credentialRepo.GetCredentialsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list passkeys: %w", err)
+	}
+	return creds, nil
+}
+
+// DeleteCredential removes one of the user's passkeys
+func (ps *PasskeyService) DeleteCredential(ctx context.Context, userID, id string) error {
+	if err := ps.credentialRepo.DeleteCredential(ctx, userID, id); err != nil {
+		return err
+	}
+	ps.logger.Info("passkey removed", "user_id", userID, "credential", id)
+	return nil
+}
+
+// storeSession keeps ceremony state server side and returns the browser options
+func (ps *PasskeyService) storeSession(userID string, data *webauthn.SessionData, options any) (*PasskeyCeremony, error) {
+	payload, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode passkey options: %w", err)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate session id: %w", err)
+	}
+	sessionID := base64.RawURLEncoding.EncodeToString(b)
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	// Clean up expired sessions
+	now := time.Now()
+	for id, s := range ps.sessions {
+		if now.After(s.expiresAt) {
+			delete(ps.sessions, id)
+		}
+	}
+
+	ps.sessions[sessionID] = &passkeySession{
+		userID:    userID,
+		data:      *data,
+		expiresAt: now.Add(passkeySessionTTL),
+	}
+
+	return &PasskeyCeremony{SessionID: sessionID, Options: payload}, nil
+}
+
+// takeSession removes and returns a ceremony session; each challenge is single use
+func (ps *PasskeyService) takeSession(sessionID string) (*passkeySession, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	session, ok := ps.sessions[sessionID]
+	if !ok {
+		return nil, ErrPasskeySession
+	}
+	delete(ps.sessions, sessionID)
+
+	if time.Now().After(session.expiresAt) {
+		return nil, ErrPasskeySession
+	}
+	return session, nil
+}
+
+// loadPasskeyUser loads a user together with their registered credentials
+func (ps *PasskeyService) loadPasskeyUser(ctx context.Context, userID string) (*passkeyUser, error) {
+	user, err := ps.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	creds, err := ps.credentialRepo.GetCredentialsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load passkeys: %w", err)
+	}
+
+	return &passkeyUser{user: user, credentials: creds}, nil
+}
+
+// passkeyUser adapts User to the webauthn.User interface.
+// The WebAuthn user handle is the user's ID.
+type passkeyUser struct {
+	user        *User
+	credentials []*PasskeyCredential
+}
+
+func (u *passkeyUser) WebAuthnID() []byte { return []byte(u.user.ID) }
+
+func (u *passkeyUser) WebAuthnName() string { return u.user.Email }
+
+func (u *passkeyUser) WebAuthnDisplayName() string { return u.user.Name }
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	out := make([]webauthn.Credential, 0, len(u.credentials))
+	for _, c := range u.credentials {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(c.Transports))
+		for _, t := range c.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+		out = append(out, webauthn.Credential{
+			ID:              c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: c.SignCount,
+			},
+		})
+	}
+	return out
+}
@@ -0,0 +1,424 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:3000"
+)
+
+// MockPasskeyCredentialRepository is an in-memory PasskeyCredentialRepository
+type MockPasskeyCredentialRepository struct {
+	credentials map[string]*PasskeyCredential
+}
+
+func NewMockPasskeyCredentialRepository() *MockPasskeyCredentialRepository {
+	return &MockPasskeyCredentialRepository{credentials: make(map[string]*PasskeyCredential)}
+}
+
+func (m *MockPasskeyCredentialRepository) CreateCredential(ctx context.Context, c *PasskeyCredential) error {
+	m.credentials[c.ID] = c
+	return nil
+}
+
+func (m *MockPasskeyCredentialRepository) GetCredentialsByUserID(ctx context.Context, userID string) ([]*PasskeyCredential, error) {
+	var out []*PasskeyCredential
+	for _, c := range m.credentials {
+		if c.UserID == userID {
+			cp := *c
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockPasskeyCredentialRepository) UpdateCredentialUsage(ctx context.Context, credentialID []byte, signCount uint32, backupState bool) error {
+	for _, c := range m.credentials {
+		if bytes.Equal(c.CredentialID, credentialID) {
+			now := time.Now()
+			c.SignCount = signCount
+			c.BackupState = backupState
+			c.LastUsedAt = &now
+			return nil
+		}
+	}
+	return ErrPasskeyNotFound
+}
+
+func (m *MockPasskeyCredentialRepository) DeleteCredential(ctx context.Context, userID, id string) error {
+	c, ok := m.credentials[id]
+	if !ok || c.UserID != userID {
+		return ErrPasskeyNotFound
+	}
+	delete(m.credentials, id)
+	return nil
+}
+
+// softwareAuthenticator emulates a platform authenticator holding a single P-256 key
+type softwareAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func newSoftwareAuthenticator(t *testing.T) *softwareAuthenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		t.Fatalf("credential id: %v", err)
+	}
+	return &softwareAuthenticator{key: key, credentialID: id}
+}
+
+// challengeFrom extracts the challenge from a ceremony's browser options
+func challengeFrom(t *testing.T, ceremony *PasskeyCeremony) string {
+	t.Helper()
+	var opts struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+		} `json:"publicKey"`
+	}
+	if err := json.Unmarshal(ceremony.Options, &opts); err != nil {
+		t.Fatalf("decode options: %v", err)
+	}
+	if opts.PublicKey.Challenge == "" {
+		t.Fatal("options missing challenge")
+	}
+	return opts.PublicKey.Challenge
+}
+
+func (a *softwareAuthenticator) clientData(t *testing.T, typ, challenge string) []byte {
+	t.Helper()
+	b, err := json.Marshal(map[string]string{"type": typ, "challenge": challenge, "origin": testOrigin})
+	if err != nil {
+		t.Fatalf("client data: %v", err)
+	}
+	return b
+}
+
+func (a *softwareAuthenticator) authData(flags byte, attested []byte) []byte {
+	rpHash := sha256.Sum256([]byte(testRPID))
+	buf := append([]byte{}, rpHash[:]...)
+	buf = append(buf, flags)
+	buf = binary.BigEndian.AppendUint32(buf, a.signCount)
+	return append(buf, attested...)
+}
+
+// create returns a JSON PublicKeyCredential for a registration ceremony ("none" attestation)
+func (a *softwareAuthenticator) create(t *testing.T, ceremony *PasskeyCeremony) []byte {
+	t.Helper()
+	size := (a.key.Curve.Params().BitSize + 7) / 8
+	coseKey, err := cbor.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.X.FillBytes(make([]byte, size)),
+		-3: a.key.Y.FillBytes(make([]byte, size)),
+	})
+	if err != nil {
+		t.Fatalf("cose key: %v", err)
+	}
+
+	attested := make([]byte, 16) // zero AAGUID
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, coseKey...)
+
+	attObj, err := cbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(0x01|0x04|0x40, attested), // UP | UV | AT
+	})
+	if err != nil {
+		t.Fatalf("attestation object: %v", err)
+	}
+
+	return a.credentialJSON(t, map[string]string{
+		"clientDataJSON":    b64(a.clientData(t, "webauthn.create", challengeFrom(t, ceremony))),
+		"attestationObject": b64(attObj),
+	})
+}
+
+// get returns a JSON PublicKeyCredential for an authentication ceremony
+func (a *softwareAuthenticator) get(t *testing.T, ceremony *PasskeyCeremony, userHandle string) []byte {
+	t.Helper()
+	clientData := a.clientData(t, "webauthn.get", challengeFrom(t, ceremony))
+	authData := a.authData(0x01|0x04, nil)
+
+	clientHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	return a.credentialJSON(t, map[string]string{
+		"clientDataJSON":    b64(clientData),
+		"authenticatorData": b64(authData),
+		"signature":         b64(sig),
+		"userHandle":        b64([]byte(userHandle)),
+	})
+}
+
+func (a *softwareAuthenticator) credentialJSON(t *testing.T, response map[string]string) []byte {
+	t.Helper()
+	b, err := json.Marshal(map[string]any{
+		"id":       b64(a.credentialID),
+		"rawId":    b64(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatalf("credential json: %v", err)
+	}
+	return b
+}
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+func createTestPasskeyService(t *testing.T) (*PasskeyService, *MockUserRepository, *MockPasskeyCredentialRepository) {
+	authService, userRepo, _ := createTestAuthService(t)
+	credRepo := NewMockPasskeyCredentialRepository()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	ps, err := NewPasskeyService(PasskeyConfig{
+		RPID:      testRPID,
+		RPOrigins: []string{testOrigin},
+	}, userRepo, credRepo, authService, logger)
+	if err != nil {
+		t.Fatalf("NewPasskeyService() error = %v", err)
+	}
+	return ps, userRepo, credRepo
+}
+
+func createPasskeyTestUser(t *testing.T, userRepo *MockUserRepository, email string) *User {
+	t.Helper()
+	user := &User{
+		ID:        "user-" + email,
+		Email:     email,
+		Name:      "Passkey User",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := userRepo.CreateUser(context.Background(), user); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return user
+}
+
+// registerPasskey runs a full registration ceremony with the software authenticator
+func registerPasskey(t *testing.T, ps *PasskeyService, userID string, authr *softwareAuthenticator) *PasskeyCredential {
+	t.Helper()
+	ctx := context.Background()
+	ceremony, err := ps.BeginRegistration(ctx, userID)
+	if err != nil {
+		t.Fatalf("BeginRegistration() error = %v", err)
+	}
+	cred, err := ps.FinishRegistration(ctx, userID, ceremony.SessionID, authr.create(t, ceremony), "Laptop")
+	if err != nil {
+		t.Fatalf("FinishRegistration() error = %v", err)
+	}
+	return cred
+}
+
+func TestPasskeyService_Registration(t *testing.T) {
+	ps, userRepo, credRepo := createTestPasskeyService(t)
+	ctx := context.Background()
+	user := createPasskeyTestUser(t, userRepo, "passkey@example.com")
+
+	t.Run("stores credential", func(t *testing.T) {
+		authr := newSoftwareAuthenticator(t)
+		cred := registerPasskey(t, ps, user.ID, authr)
+
+		if !bytes.Equal(cred.CredentialID, authr.credentialID) {
+			t.Error("FinishRegistration() stored wrong credential ID")
+		}
+		if cred.Name != "Laptop" {
+			t.Errorf("FinishRegistration() name = %q, want Laptop", cred.Name)
+		}
+		if len(credRepo.credentials) != 1 {
+			t.Errorf("expected 1 stored credential, got %d", len(credRepo.credentials))
+		}
+	})
+
+	t.Run("session bound to user", func(t *testing.T) {
+		other := createPasskeyTestUser(t, userRepo, "other@example.com")
+		ceremony, err := ps.BeginRegistration(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("BeginRegistration() error = %v", err)
+		}
+		authr := newSoftwareAuthenticator(t)
+		_, err = ps.FinishRegistration(ctx, other.ID, ceremony.SessionID, authr.create(t, ceremony), "")
+		if !errors.Is(err, ErrPasskeySession) {
+			t.Errorf("FinishRegistration() error = %v, want %v", err, ErrPasskeySession)
+		}
+	})
+
+	t.Run("rejects unknown session", func(t *testing.T) {
+		_, err := ps.FinishRegistration(ctx, user.ID, "missing", []byte("{}"), "")
+		if !errors.Is(err, ErrPasskeySession) {
+			t.Errorf("FinishRegistration() error = %v, want %v", err, ErrPasskeySession)
+		}
+	})
+}
+
+func TestPasskeyService_Login(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("discoverable login issues tokens", func(t *testing.T) {
+		ps, userRepo, credRepo := createTestPasskeyService(t)
+		user := createPasskeyTestUser(t, userRepo, "volunteer@example.com")
+		authr := newSoftwareAuthenticator(t)
+		stored := registerPasskey(t, ps, user.ID, authr)
+
+		ceremony, err := ps.BeginLogin(ctx, "")
+		if err != nil {
+			t.Fatalf("BeginLogin() error = %v", err)
+		}
+		authr.signCount = 1
+		resp, err := ps.FinishLogin(ctx, ceremony.SessionID, authr.get(t, ceremony, user.ID))
+		if err != nil {
+			t.Fatalf("FinishLogin() error = %v", err)
+		}
+		if resp.AccessToken == "" || resp.RefreshToken == "" {
+			t.Error("FinishLogin() response missing tokens")
+		}
+		if resp.User == nil || resp.User.ID != user.ID {
+			t.Error("FinishLogin() returned wrong user")
+		}
+		if got := credRepo.credentials[stored.ID].SignCount; got != 1 {
+			t.Errorf("sign count = %d, want 1", got)
+		}
+		if credRepo.credentials[stored.ID].LastUsedAt == nil {
+			t.Error("last used timestamp not recorded")
+		}
+	})
+
+	t.Run("login by email uses allow list", func(t *testing.T) {
+		ps, userRepo, _ := createTestPasskeyService(t)
+		user := createPasskeyTestUser(t, userRepo, "email@example.com")
+		authr := newSoftwareAuthenticator(t)
+		registerPasskey(t, ps, user.ID, authr)
+
+		ceremony, err := ps.BeginLogin(ctx, "Email@Example.com")
+		if err != nil {
+			t.Fatalf("BeginLogin() error = %v", err)
+		}
+		if !bytes.Contains(ceremony.Options, []byte("allowCredentials")) {
+			t.Error("BeginLogin() with email should list allowed credentials")
+		}
+		authr.signCount = 5
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, authr.get(t, ceremony, user.ID)); err != nil {
+			t.Fatalf("FinishLogin() error = %v", err)
+		}
+	})
+
+	t.Run("session cannot be replayed", func(t *testing.T) {
+		ps, userRepo, _ := createTestPasskeyService(t)
+		user := createPasskeyTestUser(t, userRepo, "replay@example.com")
+		authr := newSoftwareAuthenticator(t)
+		registerPasskey(t, ps, user.ID, authr)
+
+		ceremony, _ := ps.BeginLogin(ctx, "")
+		authr.signCount = 1
+		assertion := authr.get(t, ceremony, user.ID)
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, assertion); err != nil {
+			t.Fatalf("FinishLogin() error = %v", err)
+		}
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, assertion); !errors.Is(err, ErrPasskeySession) {
+			t.Errorf("replayed FinishLogin() error = %v, want %v", err, ErrPasskeySession)
+		}
+	})
+
+	t.Run("sign counter regression rejected", func(t *testing.T) {
+		ps, userRepo, _ := createTestPasskeyService(t)
+		user := createPasskeyTestUser(t, userRepo, "clone@example.com")
+		authr := newSoftwareAuthenticator(t)
+		registerPasskey(t, ps, user.ID, authr)
+
+		ceremony, _ := ps.BeginLogin(ctx, "")
+		authr.signCount = 10
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, authr.get(t, ceremony, user.ID)); err != nil {
+			t.Fatalf("FinishLogin() error = %v", err)
+		}
+
+		ceremony, _ = ps.BeginLogin(ctx, "")
+		authr.signCount = 10
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, authr.get(t, ceremony, user.ID)); !errors.Is(err, ErrPasskeyCloned) {
+			t.Errorf("FinishLogin() error = %v, want %v", err, ErrPasskeyCloned)
+		}
+	})
+
+	t.Run("signature from another key rejected", func(t *testing.T) {
+		ps, userRepo, _ := createTestPasskeyService(t)
+		user := createPasskeyTestUser(t, userRepo, "forged@example.com")
+		authr := newSoftwareAuthenticator(t)
+		registerPasskey(t, ps, user.ID, authr)
+
+		forger := newSoftwareAuthenticator(t)
+		forger.credentialID = authr.credentialID
+		forger.signCount = 1
+
+		ceremony, _ := ps.BeginLogin(ctx, "")
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, forger.get(t, ceremony, user.ID)); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("FinishLogin() error = %v, want %v", err, ErrInvalidCredentials)
+		}
+	})
+
+	t.Run("locked account rejected", func(t *testing.T) {
+		ps, userRepo, credRepo := createTestPasskeyService(t)
+		user := createPasskeyTestUser(t, userRepo, "locked@example.com")
+		authr := newSoftwareAuthenticator(t)
+		cred := registerPasskey(t, ps, user.ID, authr)
+
+		lockedUntil := time.Now().Add(time.Hour)
+		user.LockedUntil = &lockedUntil
+
+		ceremony, _ := ps.BeginLogin(ctx, "")
+		authr.signCount = 1
+		if _, err := ps.FinishLogin(ctx, ceremony.SessionID, authr.get(t, ceremony, user.ID)); err == nil {
+			t.Error("FinishLogin() on locked account should fail")
+		}
+		if stored := credRepo.credentials[cred.ID]; stored.LastUsedAt != nil || stored.SignCount != 0 {
+			t.Errorf("locked sign-in recorded passkey usage: last used %v, sign count %d", stored.LastUsedAt, stored.SignCount)
+		}
+	})
+}
+
+func TestPasskeyService_DeleteCredential(t *testing.T) {
+	ps, userRepo, credRepo := createTestPasskeyService(t)
+	ctx := context.Background()
+	user := createPasskeyTestUser(t, userRepo, "delete@example.com")
+	cred := registerPasskey(t, ps, user.ID, newSoftwareAuthenticator(t))
+
+	if err := ps.DeleteCredential(ctx, "someone-else", cred.ID); !errors.Is(err, ErrPasskeyNotFound) {
+		t.Errorf("DeleteCredential() by non-owner error = %v, want %v", err, ErrPasskeyNotFound)
+	}
+	if err := ps.DeleteCredential(ctx, user.ID, cred.ID); err != nil {
+		t.Errorf("DeleteCredential() error = %v", err)
+	}
+	if len(credRepo.credentials) != 0 {
+		t.Error("credential not removed")
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import "strings"
+
+// Roles stored in user_roles
+const (
+	RoleVolunteer = "VOLUNTEER"
+	RoleOrganizer = "ORGANIZER"
+	RoleAdmin     = "ADMIN"
+)
+
+// Permission names an action a role may perform
+type Permission string
+
+const (
+	PermEventCreate         Permission = "event.create"
+	PermEventManage         Permission = "event.manage"
+	PermRegistrationCreate  Permission = "registration.create"
+	PermRegistrationApprove Permission = "registration.approve"
+	PermAttendanceManage    Permission = "attendance.manage"
+	PermUserManage          Permission = "user.manage"
+	PermAdminModerate       Permission = "admin.moderate"
+)
+
+// rolePermissions maps each role to the actions it grants.
+// Ownership checks (e.g. "is this my event") still happen in the services.
+var rolePermissions = map[string][]Permission{
+	RoleVolunteer: {
+		PermRegistrationCreate,
+	},
+	RoleOrganizer: {
+		PermRegistrationCreate,
+		PermEventCreate,
+		PermEventManage,
+		PermRegistrationApprove,
+		PermAttendanceManage,
+	},
+	RoleAdmin: {
+		PermRegistrationCreate,
+		PermEventCreate,
+		PermEventManage,
+		PermRegistrationApprove,
+		PermAttendanceManage,
+		PermUserManage,
+		PermAdminModerate,
+	},
+}
+
+// IsValidRole reports whether role is one of the roles allowed in user_roles
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[strings.ToUpper(role)]
+	return ok
+}
+
+// RolesHavePermission reports whether any of the given roles grants perm
+func RolesHavePermission(roles []string, perm Permission) bool {
+	for _, role := range roles {
+		for _, p := range rolePermissions[strings.ToUpper(role)] {
+			if p == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -30,6 +30,15 @@ type UserRepository interface {
 
 	// EmailExists checks if an email is already registered
 	EmailExists(ctx context.Context, email string) (bool, error)
+
+	// GetUserRoles lists the roles assigned to a user in user_roles
+	GetUserRoles(ctx context.Context, userID string) ([]string, error)
+
+	// AssignRole adds a role to a user if not already present
+	AssignRole(ctx context.Context, userID, role string) error
+
+	// Transaction runs fn in a transaction that the writes given its context join
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 // RefreshTokenRepository defines the interface for refresh token operations
@@ -52,3 +61,18 @@ type RefreshTokenRepository interface {
 	// CountActiveTokensForUser counts active refresh tokens for a user
 	CountActiveTokensForUser(ctx context.Context, userID string) (int, error)
 }
+
+// PasskeyCredentialRepository defines the interface for WebAuthn credential storage
+type PasskeyCredentialRepository interface {
+	// CreateCredential stores a newly registered passkey
+	CreateCredential(ctx context.Context, credential *PasskeyCredential) error
+
+	// GetCredentialsByUserID lists all passkeys registered to a user
+	GetCredentialsByUserID(ctx context.Context, userID string) ([]*PasskeyCredential, error)
+
+	// UpdateCredentialUsage records the latest sign counter and flags after a successful assertion
+	UpdateCredentialUsage(ctx context.Context, credentialID []byte, signCount uint32, backupState bool) error
+
+	// DeleteCredential removes a passkey owned by the given user
+	DeleteCredential(ctx context.Context, userID, id string) error
+}
@@ -0,0 +1,281 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultUpdateHistoryLimit = 20
+	maxUpdateHistoryLimit     = 100
+)
+
+// ErrInvalidCursor is returned for a page cursor that isn't an update ID
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// FieldChange is one field that differs between two versions of an event.
+// Nested fields use dotted names such as "location.city".
+type FieldChange struct {
+	Field      string
+	OldValue   *string
+	NewValue   *string
+	UpdateType UpdateType
+}
+
+// GetEventUpdates returns a page of an event's change history, newest
+// first. after is the cursor of the last update the caller has already seen.
+func (s *EventService) GetEventUpdates(ctx context.Context, eventID, userID string, limit int, after string) (*EventUpdateConnection, error) {
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.Authorize(ctx, evt, userID, StaffActionView); err != nil {
+		return nil, err
+	}
+
+	if after != "" {
+		if _, err := uuid.Parse(after); err != nil {
+			return nil, fmt.Errorf("%w: %q is not an update ID", ErrInvalidCursor, after)
+		}
+	}
+	if limit <= 0 {
+		limit = defaultUpdateHistoryLimit
+	}
+	if limit > maxUpdateHistoryLimit {
+		limit = maxUpdateHistoryLimit
+	}
+
+	// One extra row tells whether there is another page
+	updates, err := s.repo.GetUpdateHistory(ctx, eventID, limit+1, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event updates: %w", err)
+	}
+	return updatePage(updates, limit, after != ""), nil
+}
+
+// updatePage turns up to limit+1 updates into a page of at most limit
+func updatePage(updates []*EventUpdate, limit int, hasPrevious bool) *EventUpdateConnection {
+	page := &EventUpdateConnection{
+		Edges:    []EventUpdateEdge{},
+		PageInfo: PageInfo{HasNextPage: len(updates) > limit, HasPreviousPage: hasPrevious},
+	}
+	if len(updates) > limit {
+		updates = updates[:limit]
+	}
+	for _, u := range updates {
+		page.Edges = append(page.Edges, EventUpdateEdge{Node: u, Cursor: u.ID})
+	}
+	if n := len(page.Edges); n > 0 {
+		page.PageInfo.StartCursor = &page.Edges[0].Cursor
+		page.PageInfo.EndCursor = &page.Edges[n-1].Cursor
+	}
+	return page
+}
+
+// logChanges writes one change log entry per field that differs
+func (s *EventService) logChanges(ctx context.Context, before, after *Event, userID string) error {
+	for _, change := range diffEvent(before, after) {
+		update := &EventUpdate{
+			EventID:    after.ID,
+			UpdatedBy:  userID,
+			FieldName:  change.Field,
+			OldValue:   change.OldValue,
+			NewValue:   change.NewValue,
+			UpdateType: change.UpdateType,
+		}
+		if err := s.repo.LogUpdate(ctx, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setStatus moves evt to status and records the transition in the change
+// log, so publishing and cancelling show up in the history like any edit.
+func (s *EventService) setStatus(ctx context.Context, evt *Event, status EventStatus, userID string) error {
+	changed := *evt
+	changed.Status = status
+	return s.repo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateStatus(ctx, evt.ID, status); err != nil {
+			return err
+		}
+		if err := s.logChanges(ctx, evt, &changed, userID); err != nil {
+			return fmt.Errorf("failed to record status change: %w", err)
+		}
+		return nil
+	})
+}
+
+// diffEvent compares two versions of an event field by field. Changes that
+// affect whether or when a volunteer can take part are MAJOR; wording and
+// presentation changes are MINOR.
+func diffEvent(before, after *Event) []FieldChange {
+	var d eventDiff
+
+	d.add("title", UpdateTypeMinor, strValue(before.Title), strValue(after.Title))
+	d.add("description", UpdateTypeMinor, strValue(before.Description), strValue(after.Description))
+	d.add("shortDescription", UpdateTypeMinor, optStrValue(before.ShortDescription), optStrValue(after.ShortDescription))
+	d.add("category", UpdateTypeMinor, strValue(string(before.Category)), strValue(string(after.Category)))
+	d.add("tags", UpdateTypeMinor, setValue(before.Tags), setValue(after.Tags))
+	d.add("timeCommitment", UpdateTypeMinor, strValue(string(before.TimeCommitment)), strValue(string(after.TimeCommitment)))
+	d.add("status", UpdateTypeStatusChange, strValue(string(before.Status)), strValue(string(after.Status)))
+	d.add("startTime", UpdateTypeMajor, timeValue(before.StartTime), timeValue(after.StartTime))
+	d.add("endTime", UpdateTypeMajor, timeValue(before.EndTime), timeValue(after.EndTime))
+
+	bl, al := before.Location, after.Location
+	d.add("location.name", UpdateTypeMajor, strValue(bl.Name), strValue(al.Name))
+	d.add("location.address", UpdateTypeMajor, strValue(bl.Address), strValue(al.Address))
+	d.add("location.city", UpdateTypeMajor, strValue(bl.City), strValue(al.City))
+	d.add("location.state", UpdateTypeMajor, optStrValue(bl.State), optStrValue(al.State))
+	d.add("location.country", UpdateTypeMajor, strValue(bl.Country), strValue(al.Country))
+	d.add("location.zipCode", UpdateTypeMajor, optStrValue(bl.ZipCode), optStrValue(al.ZipCode))
+	d.add("location.coordinates", UpdateTypeMajor, coordinatesValue(bl.Coordinates), coordinatesValue(al.Coordinates))
+	d.add("location.instructions", UpdateTypeMinor, optStrValue(bl.Instructions), optStrValue(al.Instructions))
+	d.add("location.isRemote", UpdateTypeMajor, boolValue(bl.IsRemote), boolValue(al.IsRemote))
+	d.add("location.timeZone", UpdateTypeMinor, strValue(bl.TimeZone), strValue(al.TimeZone))
+
+	bc, ac := before.Capacity, after.Capacity
+	d.add("capacity.minimum", UpdateTypeMajor, intValue(bc.Minimum), intValue(ac.Minimum))
+	d.add("capacity.maximum", UpdateTypeMajor, intValue(bc.Maximum), intValue(ac.Maximum))
+	d.add("capacity.waitlistEnabled", UpdateTypeMajor, boolValue(bc.WaitlistEnabled), boolValue(ac.WaitlistEnabled))
+
+	br, ar := before.Requirements, after.Requirements
+	d.add("requirements.minimumAge", UpdateTypeMajor, optIntValue(br.MinimumAge), optIntValue(ar.MinimumAge))
+	d.add("requirements.backgroundCheck", UpdateTypeMajor, boolValue(br.BackgroundCheck), boolValue(ar.BackgroundCheck))
+	d.add("requirements.physicalRequirements", UpdateTypeMajor, optStrValue(br.PhysicalRequirements), optStrValue(ar.PhysicalRequirements))
+	d.add("requirements.skills", UpdateTypeMajor, skillsValue(br.Skills), skillsValue(ar.Skills))
+	d.add("requirements.training", UpdateTypeMajor, trainingValue(br.Training), trainingValue(ar.Training))
+	d.add("requirements.interests", UpdateTypeMinor, setValue(br.Interests), setValue(ar.Interests))
+	d.add("requirements.equipment", UpdateTypeMajor, setValue(br.Equipment), setValue(ar.Equipment))
+
+	bs, as := before.RegistrationSettings, after.RegistrationSettings
+	d.add("registrationSettings.opensAt", UpdateTypeMajor, optTimeValue(bs.OpensAt), optTimeValue(as.OpensAt))
+	d.add("registrationSettings.closesAt", UpdateTypeMajor, timeValue(bs.ClosesAt), timeValue(as.ClosesAt))
+	d.add("registrationSettings.requiresApproval", UpdateTypeMajor, boolValue(bs.RequiresApproval), boolValue(as.RequiresApproval))
+	d.add("registrationSettings.allowWaitlist", UpdateTypeMajor, boolValue(bs.AllowWaitlist), boolValue(as.AllowWaitlist))
+	d.add("registrationSettings.confirmationRequired", UpdateTypeMajor, boolValue(bs.ConfirmationRequired), boolValue(as.ConfirmationRequired))
+	d.add("registrationSettings.cancellationDeadline", UpdateTypeMajor, optTimeValue(bs.CancellationDeadline), optTimeValue(as.CancellationDeadline))
+	d.add("registrationSettings.blockLateCancellation", UpdateTypeMajor, boolValue(bs.BlockLateCancellation), boolValue(as.BlockLateCancellation))
+	d.add("registrationSettings.reconfirmationHours", UpdateTypeMajor, intValue(bs.ReconfirmationHours), intValue(as.ReconfirmationHours))
+
+	return d.changes
+}
+
+type eventDiff struct {
+	changes []FieldChange
+}
+
+func (d *eventDiff) add(field string, updateType UpdateType, from, to *string) {
+	if from == nil && to == nil {
+		return
+	}
+	if from != nil && to != nil && *from == *to {
+		return
+	}
+	d.changes = append(d.changes, FieldChange{Field: field, OldValue: from, NewValue: to, UpdateType: updateType})
+}
+
+// The value helpers render a field as it is stored in the change log. Empty
+// values become nil so clearing a field reads as "removed".
+
+func strValue(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optStrValue(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return strValue(*s)
+}
+
+func intValue(n int) *string {
+	v := strconv.Itoa(n)
+	return &v
+}
+
+func optIntValue(n *int) *string {
+	if n == nil {
+		return nil
+	}
+	return intValue(*n)
+}
+
+func boolValue(b bool) *string {
+	v := strconv.FormatBool(b)
+	return &v
+}
+
+func timeValue(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	v := t.UTC().Format(time.RFC3339)
+	return &v
+}
+
+func optTimeValue(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return timeValue(*t)
+}
+
+func coordinatesValue(c *Coordinates) *string {
+	if c == nil {
+		return nil
+	}
+	v := fmt.Sprintf("%.6f,%.6f", c.Latitude, c.Longitude)
+	return &v
+}
+
+// setValue treats a list as unordered, so reordering tags isn't a change
+func setValue(items []string) *string {
+	if len(items) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(items)
+	slices.Sort(sorted)
+	return strValue(strings.Join(sorted, "; "))
+}
+
+func skillsValue(skills []SkillRequirement) *string {
+	items := make([]string, 0, len(skills))
+	for _, s := range skills {
+		item := fmt.Sprintf("%s (%s", s.Skill, s.Proficiency)
+		if s.Required {
+			item += ", required"
+		}
+		items = append(items, item+")")
+	}
+	return setValue(items)
+}
+
+func trainingValue(training []TrainingRequirement) *string {
+	items := make([]string, 0, len(training))
+	for _, t := range training {
+		var flags []string
+		if t.Required {
+			flags = append(flags, "required")
+		}
+		if t.ProvidedByOrganizer {
+			flags = append(flags, "provided by organizer")
+		}
+		item := t.Name
+		if len(flags) > 0 {
+			item += " (" + strings.Join(flags, ", ") + ")"
+		}
+		items = append(items, item)
+	}
+	return setValue(items)
+}
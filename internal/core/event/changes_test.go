@@ -0,0 +1,178 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffEvent(t *testing.T) {
+	start := time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC)
+	age := 16
+	before := &Event{
+		Title:     "Beach Cleanup",
+		StartTime: start,
+		Tags:      []string{"outdoors", "beach"},
+		Location:  EventLocation{Name: "North Beach", City: "Springfield", Country: "US"},
+		Requirements: EventRequirements{
+			Skills: []SkillRequirement{{Skill: "First Aid", Proficiency: SkillProficiencyBeginner, Required: true}},
+		},
+	}
+
+	t.Run("identical events have no changes", func(t *testing.T) {
+		after := *before
+		after.Tags = []string{"beach", "outdoors"}
+		assert.Empty(t, diffEvent(before, &after), "tag order doesn't matter")
+	})
+
+	t.Run("nested fields are reported individually", func(t *testing.T) {
+		after := *before
+		after.Title = "Beach Cleanup Day"
+		after.Location.City = "Shelbyville"
+		after.Requirements.MinimumAge = &age
+		after.Requirements.Skills = []SkillRequirement{{Skill: "First Aid", Proficiency: SkillProficiencyAdvanced, Required: true}}
+
+		changes := diffEvent(before, &after)
+		require.Len(t, changes, 4)
+		byField := map[string]FieldChange{}
+		for _, c := range changes {
+			byField[c.Field] = c
+		}
+
+		assert.Equal(t, UpdateTypeMinor, byField["title"].UpdateType)
+		assert.Equal(t, "Shelbyville", *byField["location.city"].NewValue)
+		assert.Equal(t, UpdateTypeMajor, byField["location.city"].UpdateType)
+		assert.Nil(t, byField["requirements.minimumAge"].OldValue)
+		assert.Equal(t, "16", *byField["requirements.minimumAge"].NewValue)
+		assert.Equal(t, "First Aid (BEGINNER, required)", *byField["requirements.skills"].OldValue)
+		assert.Equal(t, "First Aid (ADVANCED, required)", *byField["requirements.skills"].NewValue)
+	})
+
+	t.Run("clearing a field records a nil new value", func(t *testing.T) {
+		after := *before
+		after.Tags = nil
+		changes := diffEvent(before, &after)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "beach; outdoors", *changes[0].OldValue)
+		assert.Nil(t, changes[0].NewValue)
+	})
+
+	t.Run("times are compared by instant", func(t *testing.T) {
+		after := *before
+		after.StartTime = start.In(time.FixedZone("EST", -5*3600))
+		assert.Empty(t, diffEvent(before, &after))
+	})
+}
+
+func TestEventService_UpdateEvent_Requirements(t *testing.T) {
+	ctx := context.Background()
+	existing := publishedEvent()
+	input := UpdateEventInput{Requirements: &EventRequirementsInput{
+		Skills:    []SkillRequirementInput{{Skill: "First Aid", Proficiency: SkillProficiencyBeginner, Required: true}},
+		Training:  []TrainingRequirementInput{{Name: "Safety briefing", Required: true, ProvidedByOrganizer: true}},
+		Interests: []string{"interest-1"},
+	}}
+
+	t.Run("requirement lists are saved with the change log", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("UpdateSkillRequirements", ctx, "event123", mock.MatchedBy(func(reqs []*SkillRequirement) bool {
+			return len(reqs) == 1 && reqs[0].Skill == "First Aid" && reqs[0].Required
+		})).Return(nil).Once()
+		repo.On("UpdateTrainingRequirements", ctx, "event123", mock.MatchedBy(func(reqs []*TrainingRequirement) bool {
+			return len(reqs) == 1 && reqs[0].Name == "Safety briefing"
+		})).Return(nil).Once()
+		repo.On("UpdateInterestRequirements", ctx, "event123", []string{"interest-1"}).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil)
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", input)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("nothing is logged when they fail to save", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("UpdateSkillRequirements", ctx, "event123", mock.Anything).Return(errors.New("connection reset")).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", input)
+		assert.ErrorContains(t, err, "failed to update requirements")
+		repo.AssertNotCalled(t, "LogUpdate", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEventService_GetEventUpdates(t *testing.T) {
+	ctx := context.Background()
+	evt := &Event{ID: "event123", OrganizerID: "organizer123"}
+	cursor := "5b0c2a57-4c8e-4f6b-9a53-2f1b1f0b9e10"
+
+	t.Run("organizer pages through history", func(t *testing.T) {
+		service, repo := createTestEventService()
+		updates := []*EventUpdate{{ID: "u1", EventID: "event123", FieldName: "title"}}
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetUpdateHistory", ctx, "event123", maxUpdateHistoryLimit+1, cursor).Return(updates, nil).Once()
+
+		got, err := service.GetEventUpdates(ctx, "event123", "organizer123", 500, cursor)
+		require.NoError(t, err)
+		require.Len(t, got.Edges, 1)
+		assert.Equal(t, updates[0], got.Edges[0].Node)
+		assert.Equal(t, "u1", got.Edges[0].Cursor)
+		assert.False(t, got.PageInfo.HasNextPage)
+		assert.True(t, got.PageInfo.HasPreviousPage)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("defaults the page size", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetUpdateHistory", ctx, "event123", defaultUpdateHistoryLimit+1, "").Return(nil, nil).Once()
+
+		got, err := service.GetEventUpdates(ctx, "event123", "organizer123", 0, "")
+		require.NoError(t, err)
+		assert.Empty(t, got.Edges)
+		assert.Nil(t, got.PageInfo.EndCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("a full page says there is more", func(t *testing.T) {
+		service, repo := createTestEventService()
+		updates := []*EventUpdate{{ID: "u3"}, {ID: "u2"}, {ID: "u1"}}
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetUpdateHistory", ctx, "event123", 3, "").Return(updates, nil).Once()
+
+		got, err := service.GetEventUpdates(ctx, "event123", "organizer123", 2, "")
+		require.NoError(t, err)
+		require.Len(t, got.Edges, 2)
+		assert.True(t, got.PageInfo.HasNextPage)
+		assert.False(t, got.PageInfo.HasPreviousPage)
+		assert.Equal(t, "u3", *got.PageInfo.StartCursor)
+		assert.Equal(t, "u2", *got.PageInfo.EndCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("volunteers can't see history", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "volunteer").Return(nil, nil).Once()
+
+		_, err := service.GetEventUpdates(ctx, "event123", "volunteer", 10, "")
+		assert.ErrorContains(t, err, "unauthorized")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects malformed cursors", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+
+		_, err := service.GetEventUpdates(ctx, "event123", "organizer123", 10, "not-a-cursor")
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+		repo.AssertNotCalled(t, "GetUpdateHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -15,35 +15,12 @@ const (
 	EventStatusArchived  EventStatus = "ARCHIVED"
 )
 
-// EventCategory represents the type/category of volunteer event
+// EventCategory is the slug of a term in the category taxonomy
 type EventCategory string
 
-const (
-	EventCategoryEnvironment      EventCategory = "ENVIRONMENT"
-	EventCategoryEducation        EventCategory = "EDUCATION"
-	EventCategoryHealth           EventCategory = "HEALTH"
-	EventCategoryCommunityService EventCategory = "COMMUNITY_SERVICE"
-	EventCategoryDisasterRelief   EventCategory = "DISASTER_RELIEF"
-	EventCategoryAnimalWelfare    EventCategory = "ANIMAL_WELFARE"
-	EventCategoryArtsCulture      EventCategory = "ARTS_CULTURE"
-	EventCategoryTechnology       EventCategory = "TECHNOLOGY"
-	EventCategorySportsRecreation EventCategory = "SPORTS_RECREATION"
-	EventCategorySeniorCare       EventCategory = "SENIOR_CARE"
-	EventCategoryYouthMentoring   EventCategory = "YOUTH_MENTORING"
-	EventCategoryFoodSecurity     EventCategory = "FOOD_SECURITY"
-)
-
-// TimeCommitmentType represents the duration commitment for an event
+// TimeCommitmentType is the slug of a term in the time-commitment taxonomy
 type TimeCommitmentType string
 
-const (
-	TimeCommitmentOneTime    TimeCommitmentType = "ONE_TIME"
-	TimeCommitmentShortTerm  TimeCommitmentType = "SHORT_TERM"  // < 1 month
-	TimeCommitmentMediumTerm TimeCommitmentType = "MEDIUM_TERM" // 1-6 months
-	TimeCommitmentLongTerm   TimeCommitmentType = "LONG_TERM"   // > 6 months
-	TimeCommitmentOngoing    TimeCommitmentType = "ONGOING"
-)
-
 // SkillProficiency represents the required skill level
 type SkillProficiency string
 
@@ -86,6 +63,46 @@ const (
 	UpdateTypeStatusChange UpdateType = "STATUS_CHANGE"
 )
 
+// CapacityReductionPolicy decides how a capacity cut below the confirmed
+// count is handled
+type CapacityReductionPolicy string
+
+const (
+	// CapacityReductionReject refuses the update
+	CapacityReductionReject CapacityReductionPolicy = "REJECT"
+	// CapacityReductionWaitlist moves the most recently confirmed volunteers
+	// to the front of the waitlist
+	CapacityReductionWaitlist CapacityReductionPolicy = "WAITLIST_LATEST"
+)
+
+// StaffRole represents a delegated per-event role
+type StaffRole string
+
+const (
+	StaffRoleCoOrganizer  StaffRole = "CO_ORGANIZER"
+	StaffRoleCheckInStaff StaffRole = "CHECKIN_STAFF"
+	StaffRoleViewer       StaffRole = "VIEWER"
+)
+
+// StaffStatus represents the lifecycle of a staff invitation
+type StaffStatus string
+
+const (
+	StaffStatusInvited StaffStatus = "INVITED"
+	StaffStatusActive  StaffStatus = "ACTIVE"
+	StaffStatusRemoved StaffStatus = "REMOVED"
+)
+
+// StaffAction is something a staff member may be allowed to do on an event
+type StaffAction string
+
+const (
+	StaffActionManage  StaffAction = "MANAGE"
+	StaffActionApprove StaffAction = "APPROVE"
+	StaffActionCheckIn StaffAction = "CHECK_IN"
+	StaffActionView    StaffAction = "VIEW"
+)
+
 // Event represents a volunteer event
 type Event struct {
 	ID                   string               `json:"id" db:"id"`
@@ -93,6 +110,7 @@ type Event struct {
 	Description          string               `json:"description" db:"description"`
 	ShortDescription     *string              `json:"shortDescription" db:"short_description"`
 	OrganizerID          string               `json:"organizerId" db:"organizer_id"`
+	OrganizationID       *string              `json:"organizationId,omitempty" db:"organization_id"`
 	Status               EventStatus          `json:"status" db:"status"`
 	StartTime            time.Time            `json:"startTime" db:"start_time"`
 	EndTime              time.Time            `json:"endTime" db:"end_time"`
@@ -124,6 +142,9 @@ type EventLocation struct {
 	Coordinates  *Coordinates `json:"coordinates,omitempty"`
 	Instructions *string      `json:"instructions,omitempty" db:"location_instructions"`
 	IsRemote     bool         `json:"isRemote" db:"is_remote"`
+	// TimeZone is the IANA zone the event's wall-clock times are in, such as
+	// America/Chicago; empty when it isn't known yet
+	TimeZone string `json:"timeZone,omitempty" db:"location_time_zone"`
 }
 
 // Coordinates represents geographic coordinates
@@ -152,11 +173,13 @@ type EventRequirements struct {
 	PhysicalRequirements *string               `json:"physicalRequirements,omitempty" db:"physical_requirements"`
 }
 
-// SkillRequirement represents a required skill for an event
+// SkillRequirement represents a required skill for an event. SkillID is the
+// skills catalogue entry the name resolved to, if any.
 type SkillRequirement struct {
 	ID          string           `json:"id,omitempty" db:"id"`
 	EventID     string           `json:"eventId,omitempty" db:"event_id"`
 	Skill       string           `json:"skill" db:"skill_name"`
+	SkillID     *string          `json:"skillId,omitempty" db:"skill_id"`
 	Proficiency SkillProficiency `json:"proficiency" db:"proficiency"`
 	Required    bool             `json:"required" db:"required"`
 	CreatedAt   time.Time        `json:"createdAt,omitempty" db:"created_at"`
@@ -195,6 +218,21 @@ type EventAnnouncement struct {
 	CreatedAt time.Time `json:"createdAt" db:"created_at"`
 }
 
+// EventStaff represents a user (or pending invitee) holding a role on an event
+type EventStaff struct {
+	ID         string      `json:"id" db:"id"`
+	EventID    string      `json:"eventId" db:"event_id"`
+	UserID     *string     `json:"userId,omitempty" db:"user_id"`
+	Email      string      `json:"email" db:"email"`
+	Role       StaffRole   `json:"role" db:"role"`
+	Status     StaffStatus `json:"status" db:"status"`
+	InvitedBy  string      `json:"invitedBy" db:"invited_by"`
+	InvitedAt  time.Time   `json:"invitedAt" db:"invited_at"`
+	AcceptedAt *time.Time  `json:"acceptedAt,omitempty" db:"accepted_at"`
+	RemovedAt  *time.Time  `json:"removedAt,omitempty" db:"removed_at"`
+	UpdatedAt  time.Time   `json:"updatedAt" db:"updated_at"`
+}
+
 // RecurrenceRule represents how an event recurs
 type RecurrenceRule struct {
 	Frequency       RecurrenceFrequency `json:"frequency"`
@@ -205,6 +243,12 @@ type RecurrenceRule struct {
 	OccurrenceCount *int                `json:"occurrenceCount,omitempty"`
 }
 
+// Reconfirmation window limits, in hours before the event starts
+const (
+	DefaultReconfirmationHours = 24
+	MaxReconfirmationHours     = 168
+)
+
 // RegistrationSettings represents event registration configuration
 type RegistrationSettings struct {
 	OpensAt              *time.Time `json:"opensAt,omitempty" db:"registration_opens_at"`
@@ -213,6 +257,22 @@ type RegistrationSettings struct {
 	AllowWaitlist        bool       `json:"allowWaitlist" db:"waitlist_enabled"`
 	ConfirmationRequired bool       `json:"confirmationRequired" db:"confirmation_required"`
 	CancellationDeadline *time.Time `json:"cancellationDeadline,omitempty" db:"cancellation_deadline"`
+	// BlockLateCancellation refuses cancellations after CancellationDeadline
+	// instead of recording them as late
+	BlockLateCancellation bool `json:"blockLateCancellation" db:"block_late_cancellation"`
+	// ReconfirmationHours is how long before the start confirmed volunteers
+	// must reconfirm when ConfirmationRequired is set; 0 uses the default
+	ReconfirmationHours int `json:"reconfirmationHours" db:"reconfirmation_hours"`
+}
+
+// ReconfirmationDeadline is when confirmed volunteers lose their place if
+// they haven't reconfirmed
+func (r RegistrationSettings) ReconfirmationDeadline(start time.Time) time.Time {
+	hours := r.ReconfirmationHours
+	if hours <= 0 {
+		hours = DefaultReconfirmationHours
+	}
+	return start.Add(-time.Duration(hours) * time.Hour)
 }
 
 // EventUpdate represents a change made to an event (audit log)
@@ -227,6 +287,18 @@ type EventUpdate struct {
 	CreatedAt  time.Time  `json:"createdAt" db:"created_at"`
 }
 
+// EventUpdateConnection is one page of an event's change history
+type EventUpdateConnection struct {
+	Edges    []EventUpdateEdge `json:"edges"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
+
+// EventUpdateEdge is an update in the history; its cursor is the update ID
+type EventUpdateEdge struct {
+	Node   *EventUpdate `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
 // CreateEventInput represents input for creating a new event
 type CreateEventInput struct {
 	Title                string                    `json:"title" validate:"required,min=3,max=200"`
@@ -242,17 +314,26 @@ type CreateEventInput struct {
 	TimeCommitment       TimeCommitmentType        `json:"timeCommitment" validate:"required"`
 	RecurrenceRule       *RecurrenceRuleInput      `json:"recurrenceRule,omitempty"`
 	RegistrationSettings RegistrationSettingsInput `json:"registrationSettings" validate:"required"`
+	OrganizationID       *string                   `json:"organizationId,omitempty"`
 }
 
 // UpdateEventInput represents input for updating an existing event
 type UpdateEventInput struct {
-	Title            *string                 `json:"title,omitempty" validate:"omitempty,min=3,max=200"`
-	Description      *string                 `json:"description,omitempty" validate:"omitempty,min=10,max=5000"`
-	ShortDescription *string                 `json:"shortDescription,omitempty" validate:"omitempty,max=300"`
-	Location         *EventLocationInput     `json:"location,omitempty"`
-	Requirements     *EventRequirementsInput `json:"requirements,omitempty"`
-	Tags             []string                `json:"tags,omitempty" validate:"max=10,dive,max=50"`
-	Category         *EventCategory          `json:"category,omitempty"`
+	Title                *string                    `json:"title,omitempty" validate:"omitempty,min=3,max=200"`
+	Description          *string                    `json:"description,omitempty" validate:"omitempty,min=10,max=5000"`
+	ShortDescription     *string                    `json:"shortDescription,omitempty" validate:"omitempty,max=300"`
+	StartTime            *time.Time                 `json:"startTime,omitempty"`
+	EndTime              *time.Time                 `json:"endTime,omitempty"`
+	Location             *EventLocationInput        `json:"location,omitempty"`
+	Capacity             *EventCapacityInput        `json:"capacity,omitempty"`
+	Requirements         *EventRequirementsInput    `json:"requirements,omitempty"`
+	Tags                 []string                   `json:"tags,omitempty" validate:"max=10,dive,max=50"`
+	Category             *EventCategory             `json:"category,omitempty"`
+	TimeCommitment       *TimeCommitmentType        `json:"timeCommitment,omitempty"`
+	RegistrationSettings *RegistrationSettingsInput `json:"registrationSettings,omitempty"`
+	// CapacityReduction decides what happens when Capacity.Maximum drops
+	// below the number of confirmed volunteers. Empty means reject.
+	CapacityReduction CapacityReductionPolicy `json:"capacityReduction,omitempty"`
 }
 
 // EventLocationInput represents input for event location
@@ -266,6 +347,9 @@ type EventLocationInput struct {
 	Coordinates  *CoordinatesInput `json:"coordinates,omitempty"`
 	Instructions *string           `json:"instructions,omitempty" validate:"omitempty,max=1000"`
 	IsRemote     bool              `json:"isRemote"`
+	// TimeZone is an IANA zone name; without it the zone is looked up from
+	// Coordinates
+	TimeZone *string `json:"timeZone,omitempty" validate:"omitempty,max=64"`
 }
 
 // CoordinatesInput represents input for geographic coordinates
@@ -319,12 +403,14 @@ type RecurrenceRuleInput struct {
 
 // RegistrationSettingsInput represents input for registration settings
 type RegistrationSettingsInput struct {
-	OpensAt              *time.Time `json:"opensAt,omitempty"`
-	ClosesAt             time.Time  `json:"closesAt" validate:"required"`
-	RequiresApproval     bool       `json:"requiresApproval"`
-	AllowWaitlist        bool       `json:"allowWaitlist"`
-	ConfirmationRequired bool       `json:"confirmationRequired"`
-	CancellationDeadline *time.Time `json:"cancellationDeadline,omitempty"`
+	OpensAt               *time.Time `json:"opensAt,omitempty"`
+	ClosesAt              time.Time  `json:"closesAt" validate:"required"`
+	RequiresApproval      bool       `json:"requiresApproval"`
+	AllowWaitlist         bool       `json:"allowWaitlist"`
+	ConfirmationRequired  bool       `json:"confirmationRequired"`
+	CancellationDeadline  *time.Time `json:"cancellationDeadline,omitempty"`
+	BlockLateCancellation bool       `json:"blockLateCancellation"`
+	ReconfirmationHours   int        `json:"reconfirmationHours" validate:"min=0,max=168"`
 }
 
 // EventSearchFilter represents filters for event search
@@ -339,6 +425,7 @@ type EventSearchFilter struct {
 	TimeCommitment    []TimeCommitmentType `json:"timeCommitment,omitempty"`
 	Tags              []string             `json:"tags,omitempty"`
 	HasAvailableSpots *bool                `json:"hasAvailableSpots,omitempty"`
+	OrganizationID    *string              `json:"organizationId,omitempty"`
 }
 
 // LocationSearchInput represents location-based search parameters
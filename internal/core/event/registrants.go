@@ -0,0 +1,68 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrCapacityBelowConfirmed is returned when a capacity cut would leave
+// confirmed volunteers without a place and the update asked to reject it
+var ErrCapacityBelowConfirmed = errors.New("capacity is below the number of confirmed volunteers")
+
+// RegistrantHandler carries an event update through to the people
+// registered for it. The registration service implements it.
+type RegistrantHandler interface {
+	// PlanEventUpdate works out what the update means for the event's
+	// registrations without saving anything
+	PlanEventUpdate(ctx context.Context, before, after *Event, updatedBy string) (RegistrantUpdate, error)
+}
+
+// RegistrantUpdate is the registration side of one event update: fitting
+// registrations to a new capacity and, when the event has moved, asking its
+// volunteers to reconfirm
+type RegistrantUpdate interface {
+	// Demoted is how many confirmed volunteers lose their place to the update
+	Demoted() int
+	// Save writes the registration changes. It runs in the transaction that
+	// saves the event, so the two are saved together or not at all.
+	Save(ctx context.Context) error
+	// Notify tells registrants what changed once the update is saved
+	Notify(ctx context.Context)
+}
+
+// SetRegistrantHandler connects the service that owns registrations. It is
+// set after construction because that service depends on this one.
+func (s *EventService) SetRegistrantHandler(h RegistrantHandler) {
+	s.registrants = h
+}
+
+// checkCapacityReduction applies the update's policy when the new maximum is
+// lower than the number of confirmed volunteers. With a registrant handler,
+// its plan says who would lose their place, counting team seats the way the
+// rebalance does.
+func (s *EventService) checkCapacityReduction(ctx context.Context, before, after *Event, policy CapacityReductionPolicy, plan RegistrantUpdate) error {
+	switch policy {
+	case "", CapacityReductionReject, CapacityReductionWaitlist:
+	default:
+		return fmt.Errorf("validation failed: unknown capacity reduction policy %q", policy)
+	}
+	if after.Capacity.Maximum >= before.Capacity.Maximum || policy == CapacityReductionWaitlist {
+		return nil
+	}
+
+	if plan != nil {
+		if demoted := plan.Demoted(); demoted > 0 {
+			return fmt.Errorf("%w: %d confirmed volunteers would lose their place at a maximum of %d", ErrCapacityBelowConfirmed, demoted, after.Capacity.Maximum)
+		}
+		return nil
+	}
+	confirmed, err := s.repo.GetCurrentCapacity(ctx, after.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count confirmed registrations: %w", err)
+	}
+	if confirmed > after.Capacity.Maximum {
+		return fmt.Errorf("%w: %d confirmed, new maximum is %d", ErrCapacityBelowConfirmed, confirmed, after.Capacity.Maximum)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingRegistrantHandler struct {
+	before, after *Event
+	updatedBy     string
+	plan          recordingRegistrantUpdate
+}
+
+func (h *recordingRegistrantHandler) PlanEventUpdate(ctx context.Context, before, after *Event, updatedBy string) (RegistrantUpdate, error) {
+	h.before, h.after, h.updatedBy = before, after, updatedBy
+	return &h.plan, nil
+}
+
+type recordingRegistrantUpdate struct {
+	demoted         int
+	saveErr         error
+	saved, notified bool
+}
+
+func (u *recordingRegistrantUpdate) Demoted() int { return u.demoted }
+
+func (u *recordingRegistrantUpdate) Save(ctx context.Context) error {
+	u.saved = true
+	return u.saveErr
+}
+
+func (u *recordingRegistrantUpdate) Notify(ctx context.Context) { u.notified = true }
+
+func publishedEvent() *Event {
+	start := time.Now().UTC().Add(72 * time.Hour).Truncate(time.Minute)
+	return &Event{
+		ID:             "event123",
+		Title:          "Park Cleanup",
+		OrganizerID:    "organizer123",
+		Status:         EventStatusPublished,
+		StartTime:      start,
+		EndTime:        start.Add(3 * time.Hour),
+		Capacity:       EventCapacity{Minimum: 1, Maximum: 10},
+		Category:       "environment",
+		TimeCommitment: "one-time",
+		RegistrationSettings: RegistrationSettings{
+			ClosesAt: start.Add(-24 * time.Hour),
+		},
+	}
+}
+
+func TestEventService_UpdateEvent_Reschedule(t *testing.T) {
+	ctx := context.Background()
+	existing := publishedEvent()
+
+	t.Run("published events can be moved", func(t *testing.T) {
+		service, repo := createTestEventService()
+		handler := &recordingRegistrantHandler{}
+		service.SetRegistrantHandler(handler)
+
+		newStart := existing.StartTime.Add(7 * 24 * time.Hour)
+		newEnd := newStart.Add(3 * time.Hour)
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil).Twice()
+
+		updated, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{StartTime: &newStart, EndTime: &newEnd})
+		require.NoError(t, err)
+		assert.Equal(t, newStart, updated.StartTime)
+		assert.Equal(t, existing, handler.before)
+		assert.Equal(t, newStart, handler.after.StartTime)
+		assert.Equal(t, "organizer123", handler.updatedBy)
+		assert.True(t, handler.plan.saved)
+		assert.True(t, handler.plan.notified)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("registrants aren't told when their changes fail to save", func(t *testing.T) {
+		service, repo := createTestEventService()
+		handler := &recordingRegistrantHandler{plan: recordingRegistrantUpdate{saveErr: errors.New("connection reset")}}
+		service.SetRegistrantHandler(handler)
+
+		newStart := existing.StartTime.Add(7 * 24 * time.Hour)
+		newEnd := newStart.Add(3 * time.Hour)
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil).Twice()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{StartTime: &newStart, EndTime: &newEnd})
+		assert.ErrorContains(t, err, "failed to update registrations")
+		assert.True(t, handler.plan.saved)
+		assert.False(t, handler.plan.notified)
+	})
+
+	t.Run("registration must still close before the new start", func(t *testing.T) {
+		service, repo := createTestEventService()
+		newStart := existing.StartTime.Add(-48 * time.Hour)
+		newEnd := newStart.Add(time.Hour)
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{StartTime: &newStart, EndTime: &newEnd})
+		assert.ErrorContains(t, err, "registration must close before event starts")
+	})
+
+	t.Run("can't move into the past", func(t *testing.T) {
+		service, repo := createTestEventService()
+		newStart := time.Now().UTC().Add(-time.Hour)
+		newEnd := newStart.Add(3 * time.Hour)
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{StartTime: &newStart, EndTime: &newEnd})
+		assert.ErrorContains(t, err, "start time cannot be in the past")
+	})
+}
+
+func TestEventService_UpdateEvent_CapacityReduction(t *testing.T) {
+	ctx := context.Background()
+	existing := publishedEvent()
+	smaller := &EventCapacityInput{Minimum: 1, Maximum: 4}
+
+	t.Run("rejected by default when volunteers would lose their place", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("GetCurrentCapacity", ctx, "event123").Return(6, nil).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{Capacity: smaller})
+		assert.ErrorIs(t, err, ErrCapacityBelowConfirmed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("allowed when everyone still fits", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("GetCurrentCapacity", ctx, "event123").Return(4, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil).Once()
+
+		updated, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{Capacity: smaller})
+		require.NoError(t, err)
+		assert.Equal(t, 4, updated.Capacity.Maximum)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("waitlist policy hands over to the registrant handler", func(t *testing.T) {
+		service, repo := createTestEventService()
+		handler := &recordingRegistrantHandler{}
+		service.SetRegistrantHandler(handler)
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{Capacity: smaller, CapacityReduction: CapacityReductionWaitlist})
+		require.NoError(t, err)
+		assert.Equal(t, 4, handler.after.Capacity.Maximum)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejected when the registrant handler would demote anyone", func(t *testing.T) {
+		service, repo := createTestEventService()
+		handler := &recordingRegistrantHandler{plan: recordingRegistrantUpdate{demoted: 2}}
+		service.SetRegistrantHandler(handler)
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{Capacity: smaller})
+		assert.ErrorIs(t, err, ErrCapacityBelowConfirmed)
+		assert.False(t, handler.plan.saved)
+		repo.AssertNotCalled(t, "GetCurrentCapacity", mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(existing, nil).Once()
+
+		_, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{Capacity: smaller, CapacityReduction: "DROP"})
+		assert.ErrorContains(t, err, "unknown capacity reduction policy")
+	})
+}
+
+func TestValidateRegistrationWindow(t *testing.T) {
+	now := time.Now().UTC()
+	start := now.Add(48 * time.Hour)
+	past := now.Add(-24 * time.Hour)
+
+	original := RegistrationSettings{OpensAt: &past, ClosesAt: now.Add(24 * time.Hour)}
+	assert.NoError(t, validateRegistrationWindow(original, original, start), "an opening date already passed is fine if unchanged")
+
+	moved := original
+	earlier := now.Add(-time.Hour)
+	moved.OpensAt = &earlier
+	assert.ErrorContains(t, validateRegistrationWindow(moved, original, start), "open time cannot be in the past")
+
+	late := original
+	late.ClosesAt = start.Add(time.Hour)
+	assert.ErrorContains(t, validateRegistrationWindow(late, original, start), "must close before event starts")
+}
+
+func TestRegistrationSettings_ReconfirmationDeadline(t *testing.T) {
+	start := time.Date(2026, 6, 6, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, start.Add(-24*time.Hour), RegistrationSettings{}.ReconfirmationDeadline(start))
+	assert.Equal(t, start.Add(-72*time.Hour), RegistrationSettings{ReconfirmationHours: 72}.ReconfirmationDeadline(start))
+
+	now := time.Now().UTC()
+	settings := RegistrationSettings{ClosesAt: now.Add(24 * time.Hour), ReconfirmationHours: MaxReconfirmationHours + 1}
+	assert.ErrorContains(t, validateRegistrationWindow(settings, RegistrationSettings{}, now.Add(48*time.Hour)), "reconfirmation hours")
+}
@@ -12,6 +12,9 @@ type Repository interface {
 	GetBySlug(ctx context.Context, slug string) (*Event, error)
 	Update(ctx context.Context, event *Event) error
 	Delete(ctx context.Context, id string) error
+	// Transaction runs fn so that the writes it makes with the context it is
+	// given, including other services' writes, are saved together or not at all
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 
 	// Event listing and searching
 	List(ctx context.Context, filter EventSearchFilter, sort *EventSortInput, limit, offset int) (*EventConnection, error)
@@ -54,9 +57,21 @@ type Repository interface {
 	UpdateAnnouncement(ctx context.Context, announcement *EventAnnouncement) error
 	DeleteAnnouncement(ctx context.Context, announcementID string) error
 
+	// Event staff
+	CreateStaffInvitation(ctx context.Context, staff *EventStaff) error
+	GetStaffByID(ctx context.Context, staffID string) (*EventStaff, error)
+	GetEventStaff(ctx context.Context, eventID string) ([]*EventStaff, error)
+	// GetStaffMember returns the active staff entry for a user, or nil if they have none
+	GetStaffMember(ctx context.Context, eventID, userID string) (*EventStaff, error)
+	GetPendingStaffInvitations(ctx context.Context, email string) ([]*EventStaff, error)
+	UpdateStaff(ctx context.Context, staff *EventStaff) error
+
+	// GetOrganizationRole returns the user's role in the organization, or "" if not a member
+	GetOrganizationRole(ctx context.Context, organizationID, userID string) (string, error)
+
 	// Event updates/audit log
 	LogUpdate(ctx context.Context, update *EventUpdate) error
-	GetUpdateHistory(ctx context.Context, eventID string, limit, offset int) ([]*EventUpdate, error)
+	GetUpdateHistory(ctx context.Context, eventID string, limit int, after string) ([]*EventUpdate, error)
 
 	// Recurring events
 	GetEventInstances(ctx context.Context, parentEventID string) ([]*Event, error)
@@ -66,6 +81,36 @@ type Repository interface {
 	GetCurrentCapacity(ctx context.Context, eventID string) (int, error)
 	IsAtCapacity(ctx context.Context, eventID string) (bool, error)
 
+	// Shifts
+	// GetShifts returns the event's shifts ordered by position
+	GetShifts(ctx context.Context, eventID string) ([]*EventShift, error)
+	// ReplaceShifts upserts shifts and deletes the event's others, in one transaction
+	ReplaceShifts(ctx context.Context, eventID string, shifts []*EventShift) error
+	// GetShiftSignups counts active sign-ups by shift ID
+	GetShiftSignups(ctx context.Context, eventID string) (map[string]ShiftSignups, error)
+
+	// Templates
+	CreateTemplate(ctx context.Context, template *EventTemplate) error
+	// GetTemplate returns the template, or ErrTemplateNotFound
+	GetTemplate(ctx context.Context, id string) (*EventTemplate, error)
+	// GetTemplatesByOrganizer returns the user's own templates, by name
+	GetTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*EventTemplate, error)
+	// GetTemplatesByOrganization returns the organization's templates, by name
+	GetTemplatesByOrganization(ctx context.Context, organizationID string) ([]*EventTemplate, error)
+	DeleteTemplate(ctx context.Context, id string) error
+
+	// Taxonomies
+	// ListTaxonomyTerms returns every term, inactive ones included, by sort order then name
+	ListTaxonomyTerms(ctx context.Context, taxonomy Taxonomy) ([]*TaxonomyTerm, error)
+	// GetTaxonomyTerm returns the term with the slug, or ErrTaxonomyTermNotFound
+	GetTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, slug string) (*TaxonomyTerm, error)
+	CreateTaxonomyTerm(ctx context.Context, term *TaxonomyTerm) error
+	// UpdateTaxonomyTerm saves the term and, when its slug changed from
+	// previousSlug, moves events and templates to the new slug in the same transaction
+	UpdateTaxonomyTerm(ctx context.Context, term *TaxonomyTerm, previousSlug string) error
+	// DeleteTaxonomyTerm returns ErrTaxonomyTermInUse while events or templates use the term
+	DeleteTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, id string) error
+
 	// Utility functions
 	EventExists(ctx context.Context, id string) (bool, error)
 	SlugExists(ctx context.Context, slug string) (bool, error)
@@ -11,7 +11,10 @@ import (
 
 // EventService provides business logic for event management
 type EventService struct {
-	repo Repository
+	repo        Repository
+	registrants RegistrantHandler
+	copier      ContentCopier
+	skills      SkillResolver
 }
 
 // NewEventService creates a new event service
@@ -28,6 +31,20 @@ func (s *EventService) CreateEvent(ctx context.Context, organizerID string, inpu
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Only organization owners and admins may create events on its behalf
+	if input.OrganizationID != nil {
+		if err := s.authorizeOrganization(ctx, *input.OrganizationID, organizerID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.validateTerm(ctx, TaxonomyCategory, string(input.Category)); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := s.validateTerm(ctx, TaxonomyTimeCommitment, string(input.TimeCommitment)); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
 	// Generate unique ID and slug
 	eventID := uuid.New().String()
 	slug := generateSlug(input.Title)
@@ -42,6 +59,7 @@ func (s *EventService) CreateEvent(ctx context.Context, organizerID string, inpu
 		Description:      input.Description,
 		ShortDescription: input.ShortDescription,
 		OrganizerID:      organizerID,
+		OrganizationID:   input.OrganizationID,
 		Status:           EventStatusDraft,
 		StartTime:        input.StartTime,
 		EndTime:          input.EndTime,
@@ -111,14 +129,24 @@ func (s *EventService) CreateEvent(ctx context.Context, organizerID string, inpu
 		}
 	}
 
+	// Record the local time zone, looked up from the coordinates unless given.
+	// A draft may leave it unknown; publishing needs it.
+	timeZone, err := resolveTimeZone(input.Location.TimeZone, event.Location.Coordinates, "")
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	event.Location.TimeZone = timeZone
+
 	// Handle registration settings
 	event.RegistrationSettings = RegistrationSettings{
-		OpensAt:              input.RegistrationSettings.OpensAt,
-		ClosesAt:             input.RegistrationSettings.ClosesAt,
-		RequiresApproval:     input.RegistrationSettings.RequiresApproval,
-		AllowWaitlist:        input.RegistrationSettings.AllowWaitlist,
-		ConfirmationRequired: input.RegistrationSettings.ConfirmationRequired,
-		CancellationDeadline: input.RegistrationSettings.CancellationDeadline,
+		OpensAt:               input.RegistrationSettings.OpensAt,
+		ClosesAt:              input.RegistrationSettings.ClosesAt,
+		RequiresApproval:      input.RegistrationSettings.RequiresApproval,
+		AllowWaitlist:         input.RegistrationSettings.AllowWaitlist,
+		ConfirmationRequired:  input.RegistrationSettings.ConfirmationRequired,
+		CancellationDeadline:  input.RegistrationSettings.CancellationDeadline,
+		BlockLateCancellation: input.RegistrationSettings.BlockLateCancellation,
+		ReconfirmationHours:   input.RegistrationSettings.ReconfirmationHours,
 	}
 
 	// Handle recurrence rule if provided
@@ -133,6 +161,10 @@ func (s *EventService) CreateEvent(ctx context.Context, organizerID string, inpu
 		}
 	}
 
+	if err := s.canonicalizeSkills(ctx, event.Requirements.Skills); err != nil {
+		return nil, fmt.Errorf("failed to resolve skills: %w", err)
+	}
+
 	// Create event in repository
 	if err := s.repo.Create(ctx, event); err != nil {
 		return nil, fmt.Errorf("failed to create event: %w", err)
@@ -158,9 +190,9 @@ func (s *EventService) UpdateEvent(ctx context.Context, eventID string, userID s
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check permissions
-	if existingEvent.OrganizerID != userID {
-		return nil, fmt.Errorf("unauthorized: user is not the organizer")
+	// Check permissions: organizer or co-organizer
+	if err := s.Authorize(ctx, existingEvent, userID, StaffActionManage); err != nil {
+		return nil, err
 	}
 
 	// Create updated event
@@ -177,9 +209,25 @@ func (s *EventService) UpdateEvent(ctx context.Context, eventID string, userID s
 	if input.ShortDescription != nil {
 		updatedEvent.ShortDescription = input.ShortDescription
 	}
-	if input.Category != nil {
+	if input.StartTime != nil {
+		updatedEvent.StartTime = *input.StartTime
+	}
+	if input.EndTime != nil {
+		updatedEvent.EndTime = *input.EndTime
+	}
+	// Retired terms stay on events that have them; only a change is checked
+	if input.Category != nil && *input.Category != existingEvent.Category {
+		if err := s.validateTerm(ctx, TaxonomyCategory, string(*input.Category)); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
 		updatedEvent.Category = *input.Category
 	}
+	if input.TimeCommitment != nil && *input.TimeCommitment != existingEvent.TimeCommitment {
+		if err := s.validateTerm(ctx, TaxonomyTimeCommitment, string(*input.TimeCommitment)); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		updatedEvent.TimeCommitment = *input.TimeCommitment
+	}
 	if len(input.Tags) > 0 {
 		updatedEvent.Tags = input.Tags
 	}
@@ -201,6 +249,18 @@ func (s *EventService) UpdateEvent(ctx context.Context, eventID string, userID s
 				Longitude: input.Location.Coordinates.Longitude,
 			}
 		}
+
+		// Keep the zone unless one is given or the event has moved, so an
+		// explicitly chosen zone survives edits to the rest of the location
+		var moved *Coordinates
+		if c := updatedEvent.Location.Coordinates; c != nil && (existingEvent.Location.Coordinates == nil || *c != *existingEvent.Location.Coordinates) {
+			moved = c
+		}
+		timeZone, err := resolveTimeZone(input.Location.TimeZone, moved, existingEvent.Location.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		updatedEvent.Location.TimeZone = timeZone
 	}
 
 	// Update requirements if provided
@@ -222,6 +282,9 @@ func (s *EventService) UpdateEvent(ctx context.Context, eventID string, userID s
 				Required:    skill.Required,
 			})
 		}
+		if err := s.canonicalizeSkills(ctx, updatedEvent.Requirements.Skills); err != nil {
+			return nil, fmt.Errorf("failed to resolve skills: %w", err)
+		}
 
 		// Convert training requirements
 		for _, training := range input.Requirements.Training {
@@ -236,19 +299,98 @@ func (s *EventService) UpdateEvent(ctx context.Context, eventID string, userID s
 		updatedEvent.Requirements.Interests = input.Requirements.Interests
 	}
 
+	// Update capacity if provided
+	if input.Capacity != nil {
+		if err := s.validateCapacity(*input.Capacity); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		updatedEvent.Capacity.Minimum = input.Capacity.Minimum
+		updatedEvent.Capacity.Maximum = input.Capacity.Maximum
+		updatedEvent.Capacity.WaitlistEnabled = input.Capacity.WaitlistEnabled
+	}
+
+	// Update registration settings if provided
+	if input.RegistrationSettings != nil {
+		updatedEvent.RegistrationSettings = RegistrationSettings{
+			OpensAt:               input.RegistrationSettings.OpensAt,
+			ClosesAt:              input.RegistrationSettings.ClosesAt,
+			RequiresApproval:      input.RegistrationSettings.RequiresApproval,
+			AllowWaitlist:         input.RegistrationSettings.AllowWaitlist,
+			ConfirmationRequired:  input.RegistrationSettings.ConfirmationRequired,
+			CancellationDeadline:  input.RegistrationSettings.CancellationDeadline,
+			BlockLateCancellation: input.RegistrationSettings.BlockLateCancellation,
+			ReconfirmationHours:   input.RegistrationSettings.ReconfirmationHours,
+		}
+	}
+
 	// Validate the updated event
 	if err := s.validateEventUpdate(ctx, &updatedEvent, existingEvent); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	// Work out what the change means for registrants: capacity moves between
+	// the confirmed list and the waitlist, and rescheduling needs reconfirmation
+	var plan RegistrantUpdate
+	if s.registrants != nil {
+		if plan, err = s.registrants.PlanEventUpdate(ctx, existingEvent, &updatedEvent, userID); err != nil {
+			return nil, fmt.Errorf("failed to plan registration changes: %w", err)
+		}
+	}
+	if err := s.checkCapacityReduction(ctx, existingEvent, &updatedEvent, input.CapacityReduction, plan); err != nil {
+		return nil, err
+	}
 
-	// Update in repository
-	if err := s.repo.Update(ctx, &updatedEvent); err != nil {
-		return nil, fmt.Errorf("failed to update event: %w", err)
+	// Save the event, its change log and the registration changes together
+	err = s.repo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, &updatedEvent); err != nil {
+			return fmt.Errorf("failed to update event: %w", err)
+		}
+		// Requirement lists live in their own tables
+		if input.Requirements != nil {
+			if err := s.saveRequirements(ctx, updatedEvent.ID, updatedEvent.Requirements); err != nil {
+				return fmt.Errorf("failed to update requirements: %w", err)
+			}
+		}
+		// Record what changed, field by field
+		if err := s.logChanges(ctx, existingEvent, &updatedEvent, userID); err != nil {
+			return fmt.Errorf("failed to record event changes: %w", err)
+		}
+		if plan != nil {
+			if err := plan.Save(ctx); err != nil {
+				return fmt.Errorf("failed to update registrations: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if plan != nil {
+		plan.Notify(ctx)
 	}
 
 	return &updatedEvent, nil
 }
 
+// saveRequirements replaces the event's skill, training and interest
+// requirements with reqs
+func (s *EventService) saveRequirements(ctx context.Context, eventID string, reqs EventRequirements) error {
+	skills := make([]*SkillRequirement, len(reqs.Skills))
+	for i := range reqs.Skills {
+		skills[i] = &reqs.Skills[i]
+	}
+	if err := s.repo.UpdateSkillRequirements(ctx, eventID, skills); err != nil {
+		return err
+	}
+	training := make([]*TrainingRequirement, len(reqs.Training))
+	for i := range reqs.Training {
+		training[i] = &reqs.Training[i]
+	}
+	if err := s.repo.UpdateTrainingRequirements(ctx, eventID, training); err != nil {
+		return err
+	}
+	return s.repo.UpdateInterestRequirements(ctx, eventID, reqs.Interests)
+}
+
 // PublishEvent publishes a draft event
 func (s *EventService) PublishEvent(ctx context.Context, eventID string, userID string) (*Event, error) {
 	// Get existing event
@@ -257,9 +399,9 @@ func (s *EventService) PublishEvent(ctx context.Context, eventID string, userID
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check permissions
-	if event.OrganizerID != userID {
-		return nil, fmt.Errorf("unauthorized: user is not the organizer")
+	// Check permissions: organizer or co-organizer
+	if err := s.Authorize(ctx, event, userID, StaffActionManage); err != nil {
+		return nil, err
 	}
 
 	// Check if event is in draft status
@@ -272,8 +414,8 @@ func (s *EventService) PublishEvent(ctx context.Context, eventID string, userID
 		return nil, fmt.Errorf("event validation failed: %w", err)
 	}
 
-	// Update status
-	if err := s.repo.UpdateStatus(ctx, eventID, EventStatusPublished); err != nil {
+	// Update status and log the transition
+	if err := s.setStatus(ctx, event, EventStatusPublished, userID); err != nil {
 		return nil, fmt.Errorf("failed to publish event: %w", err)
 	}
 
@@ -294,9 +436,9 @@ func (s *EventService) CancelEvent(ctx context.Context, eventID string, userID s
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check permissions
-	if event.OrganizerID != userID {
-		return nil, fmt.Errorf("unauthorized: user is not the organizer")
+	// Check permissions: organizer or co-organizer
+	if err := s.Authorize(ctx, event, userID, StaffActionManage); err != nil {
+		return nil, err
 	}
 
 	// Check if event can be cancelled
@@ -304,8 +446,8 @@ func (s *EventService) CancelEvent(ctx context.Context, eventID string, userID s
 		return nil, fmt.Errorf("event cannot be cancelled in current status: %s", event.Status)
 	}
 
-	// Update status
-	if err := s.repo.UpdateStatus(ctx, eventID, EventStatusCancelled); err != nil {
+	// Update status and log the transition
+	if err := s.setStatus(ctx, event, EventStatusCancelled, userID); err != nil {
 		return nil, fmt.Errorf("failed to cancel event: %w", err)
 	}
 
@@ -340,17 +482,60 @@ func (s *EventService) validateCreateEventInput(input CreateEventInput) error {
 }
 
 func (s *EventService) validateEventUpdate(ctx context.Context, updatedEvent *Event, originalEvent *Event) error {
-	// Check if critical changes are allowed
-	if updatedEvent.Status == EventStatusPublished {
-		// Only allow certain fields to be updated for published events
-		if updatedEvent.StartTime != originalEvent.StartTime {
-			return fmt.Errorf("cannot change start time for published event")
+	if updatedEvent.Status == EventStatusCancelled || updatedEvent.Status == EventStatusCompleted {
+		if updatedEvent.StartTime != originalEvent.StartTime || updatedEvent.EndTime != originalEvent.EndTime {
+			return fmt.Errorf("cannot reschedule an event in status: %s", updatedEvent.Status)
 		}
-		if updatedEvent.EndTime != originalEvent.EndTime {
-			return fmt.Errorf("cannot change end time for published event")
+	}
+
+	// Rescheduled times must be valid in their own right; unchanged ones may
+	// already be in the past for an event that is underway
+	rescheduled := !updatedEvent.StartTime.Equal(originalEvent.StartTime) || !updatedEvent.EndTime.Equal(originalEvent.EndTime)
+	if rescheduled {
+		if err := validateEventTimes(updatedEvent.StartTime, updatedEvent.EndTime); err != nil {
+			return err
 		}
 	}
 
+	if rescheduled || updatedEvent.RegistrationSettings != originalEvent.RegistrationSettings {
+		return validateRegistrationWindow(updatedEvent.RegistrationSettings, originalEvent.RegistrationSettings, updatedEvent.StartTime)
+	}
+	return nil
+}
+
+// validateRegistrationWindow checks registration settings against the event's
+// start. Dates that haven't changed may be in the past, since registration on
+// a published event has usually already opened.
+func validateRegistrationWindow(settings, original RegistrationSettings, eventStartTime time.Time) error {
+	now := time.Now().UTC()
+	changed := func(t, was *time.Time) bool {
+		return t != nil && (was == nil || !t.Equal(*was))
+	}
+
+	if changed(settings.OpensAt, original.OpensAt) && settings.OpensAt.Before(now) {
+		return fmt.Errorf("registration open time cannot be in the past")
+	}
+	if !settings.ClosesAt.Equal(original.ClosesAt) && settings.ClosesAt.Before(now) {
+		return fmt.Errorf("registration close time cannot be in the past")
+	}
+	if settings.OpensAt != nil && settings.ClosesAt.Before(*settings.OpensAt) {
+		return fmt.Errorf("registration close time cannot be before open time")
+	}
+	if settings.ClosesAt.After(eventStartTime) {
+		return fmt.Errorf("registration must close before event starts")
+	}
+	if settings.CancellationDeadline != nil {
+		if changed(settings.CancellationDeadline, original.CancellationDeadline) && settings.CancellationDeadline.Before(now) {
+			return fmt.Errorf("cancellation deadline cannot be in the past")
+		}
+		if settings.CancellationDeadline.After(eventStartTime) {
+			return fmt.Errorf("cancellation deadline must be before event starts")
+		}
+	}
+	if settings.ReconfirmationHours < 0 || settings.ReconfirmationHours > MaxReconfirmationHours {
+		return fmt.Errorf("reconfirmation hours must be between 0 and %d", MaxReconfirmationHours)
+	}
+
 	return nil
 }
 
@@ -368,6 +553,9 @@ func (s *EventService) validateEventForPublishing(ctx context.Context, event *Ev
 	if event.Capacity.Maximum <= 0 {
 		return fmt.Errorf("maximum capacity must be greater than 0")
 	}
+	if event.LocalZone() == nil {
+		return fmt.Errorf("time zone is unknown: set the location's time zone or coordinates")
+	}
 
 	// Validate times
 	if err := validateEventTimes(event.StartTime, event.EndTime); err != nil {
@@ -439,6 +627,10 @@ func (s *EventService) validateRegistrationSettings(settings RegistrationSetting
 		}
 	}
 
+	if settings.ReconfirmationHours < 0 || settings.ReconfirmationHours > MaxReconfirmationHours {
+		return fmt.Errorf("reconfirmation hours must be between 0 and %d", MaxReconfirmationHours)
+	}
+
 	return nil
 }
 
@@ -457,6 +649,35 @@ func (s *EventService) SearchEvents(ctx context.Context, filter EventSearchFilte
 	return s.repo.List(ctx, filter, sort, limit, offset)
 }
 
+// GetOrganizationEvents lists an organization's events. Non-members only see
+// published and completed events.
+func (s *EventService) GetOrganizationEvents(ctx context.Context, organizationID, requesterID string, statuses []EventStatus, limit, offset int) (*EventConnection, error) {
+	filter := EventSearchFilter{OrganizationID: &organizationID, Status: statuses}
+
+	role := ""
+	if requesterID != "" {
+		var err error
+		role, err = s.repo.GetOrganizationRole(ctx, organizationID, requesterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check organization membership: %w", err)
+		}
+	}
+	if role == "" {
+		public := []EventStatus{}
+		for _, status := range []EventStatus{EventStatusPublished, EventStatusCompleted} {
+			if len(statuses) == 0 || containsStatus(statuses, status) {
+				public = append(public, status)
+			}
+		}
+		if len(public) == 0 {
+			return &EventConnection{Edges: []EventEdge{}}, nil
+		}
+		filter.Status = public
+	}
+
+	return s.repo.List(ctx, filter, nil, limit, offset)
+}
+
 // GetUserEvents retrieves events for a specific user
 func (s *EventService) GetUserEvents(ctx context.Context, userID string, statuses []EventStatus, limit, offset int) (*EventConnection, error) {
 	events, err := s.repo.GetByOrganizer(ctx, userID)
@@ -550,9 +771,9 @@ func (s *EventService) DeleteEvent(ctx context.Context, eventID string, userID s
 		return fmt.Errorf("failed to get event: %w", err)
 	}
 
-	// Check if user is the organizer
-	if event.OrganizerID != userID {
-		return fmt.Errorf("unauthorized: only the event organizer can delete this event")
+	// Check permissions: organizer or co-organizer
+	if err := s.Authorize(ctx, event, userID, StaffActionManage); err != nil {
+		return err
 	}
 
 	// Perform the soft delete
@@ -566,6 +787,15 @@ func (s *EventService) DeleteEvent(ctx context.Context, eventID string, userID s
 
 // Helper functions
 
+func containsStatus(statuses []EventStatus, status EventStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
 func generateSlug(title string) string {
 	// Convert to lowercase and replace spaces/special chars with hyphens
 	slug := strings.ToLower(title)
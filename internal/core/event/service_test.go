@@ -46,6 +46,10 @@ func (m *mockEventRepository) Delete(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+func (m *mockEventRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func (m *mockEventRepository) List(ctx context.Context, filter EventSearchFilter, sort *EventSortInput, limit, offset int) (*EventConnection, error) {
 	args := m.Called(ctx, filter, sort, limit, offset)
 	if conn := args.Get(0); conn != nil {
@@ -182,13 +186,60 @@ func (m *mockEventRepository) DeleteAnnouncement(ctx context.Context, announceme
 	return args.Error(0)
 }
 
+func (m *mockEventRepository) CreateStaffInvitation(ctx context.Context, staff *EventStaff) error {
+	args := m.Called(ctx, staff)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) GetStaffByID(ctx context.Context, staffID string) (*EventStaff, error) {
+	args := m.Called(ctx, staffID)
+	if staff := args.Get(0); staff != nil {
+		return staff.(*EventStaff), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockEventRepository) GetEventStaff(ctx context.Context, eventID string) ([]*EventStaff, error) {
+	args := m.Called(ctx, eventID)
+	if staff := args.Get(0); staff != nil {
+		return staff.([]*EventStaff), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockEventRepository) GetStaffMember(ctx context.Context, eventID, userID string) (*EventStaff, error) {
+	args := m.Called(ctx, eventID, userID)
+	if staff := args.Get(0); staff != nil {
+		return staff.(*EventStaff), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockEventRepository) GetPendingStaffInvitations(ctx context.Context, email string) ([]*EventStaff, error) {
+	args := m.Called(ctx, email)
+	if staff := args.Get(0); staff != nil {
+		return staff.([]*EventStaff), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockEventRepository) UpdateStaff(ctx context.Context, staff *EventStaff) error {
+	args := m.Called(ctx, staff)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) GetOrganizationRole(ctx context.Context, organizationID, userID string) (string, error) {
+	args := m.Called(ctx, organizationID, userID)
+	return args.String(0), args.Error(1)
+}
+
 func (m *mockEventRepository) LogUpdate(ctx context.Context, update *EventUpdate) error {
 	args := m.Called(ctx, update)
 	return args.Error(0)
 }
 
-func (m *mockEventRepository) GetUpdateHistory(ctx context.Context, eventID string, limit, offset int) ([]*EventUpdate, error) {
-	args := m.Called(ctx, eventID, limit, offset)
+func (m *mockEventRepository) GetUpdateHistory(ctx context.Context, eventID string, limit int, after string) ([]*EventUpdate, error) {
+	args := m.Called(ctx, eventID, limit, after)
 	if updates := args.Get(0); updates != nil {
 		return updates.([]*EventUpdate), args.Error(1)
 	}
@@ -221,6 +272,92 @@ func (m *mockEventRepository) IsAtCapacity(ctx context.Context, eventID string)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *mockEventRepository) GetShifts(ctx context.Context, eventID string) ([]*EventShift, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*EventShift), args.Error(1)
+}
+
+func (m *mockEventRepository) ReplaceShifts(ctx context.Context, eventID string, shifts []*EventShift) error {
+	args := m.Called(ctx, eventID, shifts)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) GetShiftSignups(ctx context.Context, eventID string) (map[string]ShiftSignups, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]ShiftSignups), args.Error(1)
+}
+
+func (m *mockEventRepository) CreateTemplate(ctx context.Context, template *EventTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) GetTemplate(ctx context.Context, id string) (*EventTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*EventTemplate), args.Error(1)
+}
+
+func (m *mockEventRepository) GetTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*EventTemplate, error) {
+	args := m.Called(ctx, organizerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*EventTemplate), args.Error(1)
+}
+
+func (m *mockEventRepository) GetTemplatesByOrganization(ctx context.Context, organizationID string) ([]*EventTemplate, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*EventTemplate), args.Error(1)
+}
+
+func (m *mockEventRepository) DeleteTemplate(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) ListTaxonomyTerms(ctx context.Context, taxonomy Taxonomy) ([]*TaxonomyTerm, error) {
+	args := m.Called(ctx, taxonomy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*TaxonomyTerm), args.Error(1)
+}
+
+func (m *mockEventRepository) GetTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, slug string) (*TaxonomyTerm, error) {
+	args := m.Called(ctx, taxonomy, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TaxonomyTerm), args.Error(1)
+}
+
+func (m *mockEventRepository) CreateTaxonomyTerm(ctx context.Context, term *TaxonomyTerm) error {
+	args := m.Called(ctx, term)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) UpdateTaxonomyTerm(ctx context.Context, term *TaxonomyTerm, previousSlug string) error {
+	args := m.Called(ctx, term, previousSlug)
+	return args.Error(0)
+}
+
+func (m *mockEventRepository) DeleteTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, id string) error {
+	args := m.Called(ctx, taxonomy, id)
+	return args.Error(0)
+}
+
 func (m *mockEventRepository) EventExists(ctx context.Context, id string) (bool, error) {
 	args := m.Called(ctx, id)
 	return args.Bool(0), args.Error(1)
@@ -296,7 +433,7 @@ func createValidEventInput() CreateEventInput {
 		Title:            "Test Event",
 		Description:      "A test event for unit testing",
 		ShortDescription: stringPtr("Short description"),
-		Category:         EventCategoryEnvironment,
+		Category:         "environment",
 		StartTime:        startTime,
 		EndTime:          endTime,
 		Location: EventLocationInput{
@@ -322,7 +459,7 @@ func createValidEventInput() CreateEventInput {
 			ConfirmationRequired: false,
 			CancellationDeadline: nil,
 		},
-		TimeCommitment: TimeCommitmentOneTime,
+		TimeCommitment: "one-time",
 		Tags:           []string{"test", "environment"},
 	}
 }
@@ -340,6 +477,7 @@ func TestEventService_CreateEvent(t *testing.T) {
 		input := createValidEventInput()
 		organizerID := "organizer123"
 
+		expectActiveTerms(repo, ctx)
 		repo.On("Create", ctx, mock.MatchedBy(func(event *Event) bool {
 			return event.Title == input.Title &&
 				event.OrganizerID == organizerID &&
@@ -386,6 +524,7 @@ func TestEventService_CreateEvent(t *testing.T) {
 		input := createValidEventInput()
 		organizerID := "organizer123"
 
+		expectActiveTerms(repo, ctx)
 		repo.On("Create", ctx, mock.AnythingOfType("*event.Event")).Return(assert.AnError).Once()
 
 		event, err := service.CreateEvent(ctx, organizerID, input)
@@ -468,6 +607,13 @@ func TestEventService_UpdateEvent(t *testing.T) {
 				event.Description == "Updated description" &&
 				event.OrganizerID == "organizer123"
 		})).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "title" && *u.OldValue == "Original Title" && *u.NewValue == "Updated Title" &&
+				u.UpdatedBy == "organizer123" && u.UpdateType == UpdateTypeMinor
+		})).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "description"
+		})).Return(nil).Once()
 
 		event, err := service.UpdateEvent(ctx, "event123", "organizer123", input)
 
@@ -483,6 +629,7 @@ func TestEventService_UpdateEvent(t *testing.T) {
 		}
 
 		repo.On("GetByID", ctx, "event123").Return(existingEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "wronguser").Return(nil, nil).Once()
 
 		event, err := service.UpdateEvent(ctx, "event123", "wronguser", input)
 
@@ -492,6 +639,43 @@ func TestEventService_UpdateEvent(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
+	t.Run("co-organizer can update", func(t *testing.T) {
+		input := UpdateEventInput{
+			Title: stringPtr("Co-organizer Title"),
+		}
+		coOrganizer := &EventStaff{ID: "staff1", EventID: "event123", Role: StaffRoleCoOrganizer, Status: StaffStatusActive}
+
+		repo.On("GetByID", ctx, "event123").Return(existingEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "coorg").Return(coOrganizer, nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "title" && u.UpdatedBy == "coorg"
+		})).Return(nil).Once()
+
+		event, err := service.UpdateEvent(ctx, "event123", "coorg", input)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Co-organizer Title", event.Title)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("check-in staff cannot update", func(t *testing.T) {
+		input := UpdateEventInput{
+			Title: stringPtr("Updated Title"),
+		}
+		checkIn := &EventStaff{ID: "staff2", EventID: "event123", Role: StaffRoleCheckInStaff, Status: StaffStatusActive}
+
+		repo.On("GetByID", ctx, "event123").Return(existingEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "door").Return(checkIn, nil).Once()
+
+		event, err := service.UpdateEvent(ctx, "event123", "door", input)
+
+		assert.Error(t, err)
+		assert.Nil(t, event)
+		assert.Contains(t, err.Error(), "unauthorized")
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("event not found", func(t *testing.T) {
 		input := UpdateEventInput{
 			Title: stringPtr("Updated Title"),
@@ -520,7 +704,8 @@ func TestEventService_PublishEvent(t *testing.T) {
 		StartTime:   time.Now().UTC().Add(24 * time.Hour),
 		EndTime:     time.Now().UTC().Add(26 * time.Hour),
 		Location: EventLocation{
-			Name: "Valid Location",
+			Name:     "Valid Location",
+			TimeZone: "America/Chicago",
 		},
 		Capacity: EventCapacity{
 			Maximum: 10,
@@ -533,6 +718,9 @@ func TestEventService_PublishEvent(t *testing.T) {
 	t.Run("successful event publishing", func(t *testing.T) {
 		repo.On("GetByID", ctx, "event123").Return(draftEvent, nil).Once()
 		repo.On("UpdateStatus", ctx, "event123", EventStatusPublished).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "status" && u.UpdateType == UpdateTypeStatusChange && *u.NewValue == string(EventStatusPublished)
+		})).Return(nil).Once()
 		repo.On("GetByID", ctx, "event123").Return(&publishedEvent, nil).Once()
 
 		event, err := service.PublishEvent(ctx, "event123", "organizer123")
@@ -544,6 +732,7 @@ func TestEventService_PublishEvent(t *testing.T) {
 
 	t.Run("unauthorized publish - wrong organizer", func(t *testing.T) {
 		repo.On("GetByID", ctx, "event123").Return(draftEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "wronguser").Return(nil, nil).Once()
 
 		event, err := service.PublishEvent(ctx, "event123", "wronguser")
 
@@ -553,6 +742,23 @@ func TestEventService_PublishEvent(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
+	t.Run("co-organizer can publish", func(t *testing.T) {
+		coOrganizer := &EventStaff{ID: "staff1", EventID: "event123", Role: StaffRoleCoOrganizer, Status: StaffStatusActive}
+		repo.On("GetByID", ctx, "event123").Return(draftEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "coorg").Return(coOrganizer, nil).Once()
+		repo.On("UpdateStatus", ctx, "event123", EventStatusPublished).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "status" && u.UpdateType == UpdateTypeStatusChange && *u.NewValue == string(EventStatusPublished)
+		})).Return(nil).Once()
+		repo.On("GetByID", ctx, "event123").Return(&publishedEvent, nil).Once()
+
+		event, err := service.PublishEvent(ctx, "event123", "coorg")
+
+		require.NoError(t, err)
+		assert.Equal(t, EventStatusPublished, event.Status)
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("cannot publish non-draft event", func(t *testing.T) {
 		publishedEventAlready := *draftEvent
 		publishedEventAlready.Status = EventStatusPublished
@@ -566,6 +772,20 @@ func TestEventService_PublishEvent(t *testing.T) {
 		assert.Contains(t, err.Error(), "not in draft status")
 		repo.AssertExpectations(t)
 	})
+
+	t.Run("cannot publish without a time zone", func(t *testing.T) {
+		noZone := *draftEvent
+		noZone.Location.TimeZone = ""
+
+		repo.On("GetByID", ctx, "event123").Return(&noZone, nil).Once()
+
+		event, err := service.PublishEvent(ctx, "event123", "organizer123")
+
+		assert.Error(t, err)
+		assert.Nil(t, event)
+		assert.Contains(t, err.Error(), "time zone is unknown")
+		repo.AssertExpectations(t)
+	})
 }
 
 func TestEventService_CancelEvent(t *testing.T) {
@@ -585,6 +805,9 @@ func TestEventService_CancelEvent(t *testing.T) {
 	t.Run("successful event cancellation", func(t *testing.T) {
 		repo.On("GetByID", ctx, "event123").Return(publishedEvent, nil).Once()
 		repo.On("UpdateStatus", ctx, "event123", EventStatusCancelled).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "status" && u.UpdateType == UpdateTypeStatusChange && *u.NewValue == string(EventStatusCancelled)
+		})).Return(nil).Once()
 		repo.On("GetByID", ctx, "event123").Return(&cancelledEvent, nil).Once()
 
 		event, err := service.CancelEvent(ctx, "event123", "organizer123", "Event cancelled due to weather")
@@ -596,6 +819,7 @@ func TestEventService_CancelEvent(t *testing.T) {
 
 	t.Run("unauthorized cancellation", func(t *testing.T) {
 		repo.On("GetByID", ctx, "event123").Return(publishedEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "wronguser").Return(nil, nil).Once()
 
 		event, err := service.CancelEvent(ctx, "event123", "wronguser", "reason")
 
@@ -605,6 +829,23 @@ func TestEventService_CancelEvent(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
+	t.Run("co-organizer can cancel", func(t *testing.T) {
+		coOrganizer := &EventStaff{ID: "staff1", EventID: "event123", Role: StaffRoleCoOrganizer, Status: StaffStatusActive}
+		repo.On("GetByID", ctx, "event123").Return(publishedEvent, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "coorg").Return(coOrganizer, nil).Once()
+		repo.On("UpdateStatus", ctx, "event123", EventStatusCancelled).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.MatchedBy(func(u *EventUpdate) bool {
+			return u.FieldName == "status" && u.UpdateType == UpdateTypeStatusChange && *u.NewValue == string(EventStatusCancelled)
+		})).Return(nil).Once()
+		repo.On("GetByID", ctx, "event123").Return(&cancelledEvent, nil).Once()
+
+		event, err := service.CancelEvent(ctx, "event123", "coorg", "reason")
+
+		require.NoError(t, err)
+		assert.Equal(t, EventStatusCancelled, event.Status)
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("cannot cancel already cancelled event", func(t *testing.T) {
 		alreadyCancelled := *publishedEvent
 		alreadyCancelled.Status = EventStatusCancelled
@@ -642,6 +883,7 @@ func TestEventService_DeleteEvent(t *testing.T) {
 
 	t.Run("unauthorized deletion", func(t *testing.T) {
 		repo.On("GetByID", ctx, "event123").Return(event, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "wronguser").Return(nil, nil).Once()
 
 		err := service.DeleteEvent(ctx, "event123", "wronguser")
 
@@ -650,6 +892,18 @@ func TestEventService_DeleteEvent(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 
+	t.Run("check-in staff cannot delete", func(t *testing.T) {
+		checkIn := &EventStaff{ID: "staff2", EventID: "event123", Role: StaffRoleCheckInStaff, Status: StaffStatusActive}
+		repo.On("GetByID", ctx, "event123").Return(event, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "door").Return(checkIn, nil).Once()
+
+		err := service.DeleteEvent(ctx, "event123", "door")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+		repo.AssertExpectations(t)
+	})
+
 	t.Run("event not found", func(t *testing.T) {
 		repo.On("GetByID", ctx, "nonexistent").Return(nil, assert.AnError).Once()
 
@@ -0,0 +1,234 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxShiftsPerEvent keeps a single event's rota manageable
+const maxShiftsPerEvent = 50
+
+var (
+	ErrInvalidShift     = errors.New("invalid shift")
+	ErrShiftHasSignups  = errors.New("shift has volunteers signed up")
+	ErrShiftBelowSignup = errors.New("shift capacity is below the number of confirmed volunteers")
+)
+
+// EventShift is a time slot or role within an event with its own capacity.
+// Events without shifts are staffed as a whole.
+type EventShift struct {
+	ID              string             `json:"id"`
+	EventID         string             `json:"eventId"`
+	Name            string             `json:"name"`
+	Role            *string            `json:"role,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	StartTime       time.Time          `json:"startTime"`
+	EndTime         time.Time          `json:"endTime"`
+	Capacity        int                `json:"capacity"`
+	WaitlistEnabled bool               `json:"waitlistEnabled"`
+	Skills          []SkillRequirement `json:"skills"`
+	Position        int                `json:"position"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+// EventShiftInput creates a shift, or updates one when ID is set
+type EventShiftInput struct {
+	ID              *string                 `json:"id,omitempty"`
+	Name            string                  `json:"name" validate:"required,max=200"`
+	Role            *string                 `json:"role,omitempty" validate:"omitempty,max=100"`
+	Description     *string                 `json:"description,omitempty" validate:"omitempty,max=1000"`
+	StartTime       time.Time               `json:"startTime" validate:"required"`
+	EndTime         time.Time               `json:"endTime" validate:"required"`
+	Capacity        int                     `json:"capacity" validate:"required,min=1"`
+	WaitlistEnabled bool                    `json:"waitlistEnabled"`
+	Skills          []SkillRequirementInput `json:"skills,omitempty" validate:"max=20"`
+}
+
+// ShiftSignups counts a shift's active sign-ups
+type ShiftSignups struct {
+	Confirmed  int
+	Waitlisted int
+}
+
+// ShiftAvailability is a shift with how many places are taken
+type ShiftAvailability struct {
+	Shift *EventShift
+	ShiftSignups
+}
+
+// SpotsAvailable is how many more volunteers the shift can confirm
+func (a *ShiftAvailability) SpotsAvailable() int {
+	return max(a.Shift.Capacity-a.Confirmed, 0)
+}
+
+// ForShift returns a copy of the event narrowed to one shift: the shift's
+// times, and the event's skill requirements plus the shift's own. Conflict,
+// eligibility and check-in rules apply to this view.
+func (e *Event) ForShift(shift *EventShift) *Event {
+	view := *e
+	view.StartTime = shift.StartTime
+	view.EndTime = shift.EndTime
+	view.Requirements.Skills = append(slices.Clone(e.Requirements.Skills), shift.Skills...)
+	return &view
+}
+
+// GetEventShifts returns an event's shifts in display order
+func (s *EventService) GetEventShifts(ctx context.Context, eventID string) ([]*EventShift, error) {
+	shifts, err := s.repo.GetShifts(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shifts: %w", err)
+	}
+	return shifts, nil
+}
+
+// GetShiftAvailability returns an event's shifts with their sign-up counts
+func (s *EventService) GetShiftAvailability(ctx context.Context, eventID string) ([]*ShiftAvailability, error) {
+	shifts, err := s.GetEventShifts(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shifts) == 0 {
+		return nil, nil
+	}
+	counts, err := s.repo.GetShiftSignups(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count shift sign-ups: %w", err)
+	}
+
+	availability := make([]*ShiftAvailability, 0, len(shifts))
+	for _, shift := range shifts {
+		availability = append(availability, &ShiftAvailability{Shift: shift, ShiftSignups: counts[shift.ID]})
+	}
+	return availability, nil
+}
+
+// SetEventShifts replaces an event's shifts. Shifts keep their ID, and their
+// sign-ups, when passed back with it. A shift can't be removed while
+// volunteers are signed up to it, or cut below its confirmed volunteers.
+func (s *EventService) SetEventShifts(ctx context.Context, eventID, userID string, inputs []EventShiftInput) ([]*EventShift, error) {
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.Authorize(ctx, evt, userID, StaffActionManage); err != nil {
+		return nil, err
+	}
+	if evt.Status == EventStatusCancelled || evt.Status == EventStatusCompleted {
+		return nil, fmt.Errorf("%w: cannot change the shifts of a %s event", ErrInvalidShift, strings.ToLower(string(evt.Status)))
+	}
+
+	existing, err := s.repo.GetShifts(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shifts: %w", err)
+	}
+	counts, err := s.repo.GetShiftSignups(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count shift sign-ups: %w", err)
+	}
+
+	shifts, err := buildShifts(evt, existing, counts, inputs, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canonicalizeShiftSkills(ctx, shifts); err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceShifts(ctx, eventID, shifts); err != nil {
+		return nil, fmt.Errorf("failed to save shifts: %w", err)
+	}
+	return shifts, nil
+}
+
+// buildShifts validates inputs against the event and its current shifts and
+// turns them into the event's new set of shifts
+func buildShifts(evt *Event, existing []*EventShift, counts map[string]ShiftSignups, inputs []EventShiftInput, now time.Time) ([]*EventShift, error) {
+	if len(inputs) > maxShiftsPerEvent {
+		return nil, fmt.Errorf("%w: at most %d shifts per event", ErrInvalidShift, maxShiftsPerEvent)
+	}
+	byID := make(map[string]*EventShift, len(existing))
+	for _, shift := range existing {
+		byID[shift.ID] = shift
+	}
+
+	shifts := make([]*EventShift, 0, len(inputs))
+	kept := make(map[string]bool, len(inputs))
+	for i, in := range inputs {
+		name := strings.TrimSpace(in.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: shift %d needs a name", ErrInvalidShift, i+1)
+		}
+		if !in.EndTime.After(in.StartTime) {
+			return nil, fmt.Errorf("%w: %q must end after it starts", ErrInvalidShift, name)
+		}
+		if in.StartTime.Before(evt.StartTime) || in.EndTime.After(evt.EndTime) {
+			return nil, fmt.Errorf("%w: %q must fall within the event's times", ErrInvalidShift, name)
+		}
+		if in.Capacity <= 0 {
+			return nil, fmt.Errorf("%w: %q needs a capacity of at least 1", ErrInvalidShift, name)
+		}
+
+		shift := &EventShift{
+			ID:              uuid.New().String(),
+			EventID:         evt.ID,
+			Name:            name,
+			Role:            trimmedOrNil(in.Role),
+			Description:     trimmedOrNil(in.Description),
+			StartTime:       in.StartTime,
+			EndTime:         in.EndTime,
+			Capacity:        in.Capacity,
+			WaitlistEnabled: in.WaitlistEnabled,
+			Skills:          make([]SkillRequirement, 0, len(in.Skills)),
+			Position:        i,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+		for _, skill := range in.Skills {
+			shift.Skills = append(shift.Skills, SkillRequirement{
+				ID:          uuid.New().String(),
+				EventID:     evt.ID,
+				Skill:       strings.TrimSpace(skill.Skill),
+				Proficiency: skill.Proficiency,
+				Required:    skill.Required,
+			})
+		}
+
+		if in.ID != nil {
+			prev, ok := byID[*in.ID]
+			if !ok {
+				return nil, fmt.Errorf("%w: shift %s does not belong to this event", ErrInvalidShift, *in.ID)
+			}
+			if confirmed := counts[prev.ID].Confirmed; in.Capacity < confirmed {
+				return nil, fmt.Errorf("%w: %q has %d confirmed", ErrShiftBelowSignup, name, confirmed)
+			}
+			shift.ID = prev.ID
+			shift.CreatedAt = prev.CreatedAt
+			kept[prev.ID] = true
+		}
+		shifts = append(shifts, shift)
+	}
+
+	for _, prev := range existing {
+		if c := counts[prev.ID]; !kept[prev.ID] && c.Confirmed+c.Waitlisted > 0 {
+			return nil, fmt.Errorf("%w: %q can't be removed", ErrShiftHasSignups, prev.Name)
+		}
+	}
+	return shifts, nil
+}
+
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
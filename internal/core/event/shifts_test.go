@@ -0,0 +1,108 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func shiftEvent() *Event {
+	start := time.Date(2026, 7, 4, 8, 0, 0, 0, time.UTC)
+	return &Event{
+		ID:        "event123",
+		StartTime: start,
+		EndTime:   start.Add(8 * time.Hour),
+		Requirements: EventRequirements{
+			Skills: []SkillRequirement{{Skill: "First Aid", Proficiency: SkillProficiencyBeginner, Required: true}},
+		},
+	}
+}
+
+func TestBuildShifts(t *testing.T) {
+	evt := shiftEvent()
+	now := evt.StartTime.Add(-72 * time.Hour)
+	morning := EventShiftInput{Name: " Morning ", StartTime: evt.StartTime, EndTime: evt.StartTime.Add(4 * time.Hour), Capacity: 5}
+
+	t.Run("creates shifts in order", func(t *testing.T) {
+		afternoon := morning
+		afternoon.Name = "Afternoon"
+		afternoon.StartTime, afternoon.EndTime = evt.StartTime.Add(4*time.Hour), evt.EndTime
+		afternoon.Skills = []SkillRequirementInput{{Skill: " Forklift ", Proficiency: SkillProficiencyIntermediate, Required: true}}
+
+		shifts, err := buildShifts(evt, nil, nil, []EventShiftInput{morning, afternoon}, now)
+		require.NoError(t, err)
+		require.Len(t, shifts, 2)
+		assert.Equal(t, "Morning", shifts[0].Name)
+		assert.Equal(t, 1, shifts[1].Position)
+		assert.Equal(t, "Forklift", shifts[1].Skills[0].Skill)
+		assert.NotEmpty(t, shifts[1].Skills[0].ID)
+	})
+
+	t.Run("must fall within the event", func(t *testing.T) {
+		early := morning
+		early.StartTime = evt.StartTime.Add(-time.Hour)
+		_, err := buildShifts(evt, nil, nil, []EventShiftInput{early}, now)
+		assert.ErrorIs(t, err, ErrInvalidShift)
+	})
+
+	t.Run("needs a capacity", func(t *testing.T) {
+		empty := morning
+		empty.Capacity = 0
+		_, err := buildShifts(evt, nil, nil, []EventShiftInput{empty}, now)
+		assert.ErrorIs(t, err, ErrInvalidShift)
+	})
+
+	existing := &EventShift{ID: "shift1", EventID: evt.ID, Name: "Morning", Capacity: 5, CreatedAt: now.Add(-time.Hour)}
+	counts := map[string]ShiftSignups{"shift1": {Confirmed: 3, Waitlisted: 1}}
+
+	t.Run("keeps the ID of shifts passed back", func(t *testing.T) {
+		kept := morning
+		kept.ID = &existing.ID
+		shifts, err := buildShifts(evt, []*EventShift{existing}, counts, []EventShiftInput{kept}, now)
+		require.NoError(t, err)
+		assert.Equal(t, "shift1", shifts[0].ID)
+		assert.Equal(t, existing.CreatedAt, shifts[0].CreatedAt)
+	})
+
+	t.Run("can't cut capacity below confirmed", func(t *testing.T) {
+		cut := morning
+		cut.ID = &existing.ID
+		cut.Capacity = 2
+		_, err := buildShifts(evt, []*EventShift{existing}, counts, []EventShiftInput{cut}, now)
+		assert.ErrorIs(t, err, ErrShiftBelowSignup)
+	})
+
+	t.Run("can't remove a shift with sign-ups", func(t *testing.T) {
+		_, err := buildShifts(evt, []*EventShift{existing}, counts, nil, now)
+		assert.ErrorIs(t, err, ErrShiftHasSignups)
+
+		_, err = buildShifts(evt, []*EventShift{existing}, nil, nil, now)
+		assert.NoError(t, err, "an empty shift can go")
+	})
+
+	t.Run("rejects another event's shift", func(t *testing.T) {
+		foreign := morning
+		id := "elsewhere"
+		foreign.ID = &id
+		_, err := buildShifts(evt, []*EventShift{existing}, nil, []EventShiftInput{foreign}, now)
+		assert.ErrorIs(t, err, ErrInvalidShift)
+	})
+}
+
+func TestEvent_ForShift(t *testing.T) {
+	evt := shiftEvent()
+	shift := &EventShift{
+		StartTime: evt.StartTime.Add(2 * time.Hour),
+		EndTime:   evt.StartTime.Add(4 * time.Hour),
+		Skills:    []SkillRequirement{{Skill: "Forklift", Required: true}},
+	}
+
+	view := evt.ForShift(shift)
+	assert.Equal(t, shift.StartTime, view.StartTime)
+	assert.Equal(t, shift.EndTime, view.EndTime)
+	assert.Len(t, view.Requirements.Skills, 2)
+	assert.Len(t, evt.Requirements.Skills, 1, "the event itself is untouched")
+	assert.Equal(t, shiftEvent().StartTime, evt.StartTime)
+}
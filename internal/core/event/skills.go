@@ -0,0 +1,63 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/volunteersync/backend/internal/core/skill"
+)
+
+// SkillResolver maps free-text skill names onto the skills catalogue. The
+// skill service implements it.
+type SkillResolver interface {
+	Resolve(ctx context.Context, names []string) (map[string]*skill.Skill, error)
+}
+
+// SetSkillResolver connects the skills catalogue. Without one, skill
+// requirements keep the names they were given and match by name.
+func (s *EventService) SetSkillResolver(r SkillResolver) {
+	s.skills = r
+}
+
+// canonicalizeSkills files each requirement under the catalogue entry its
+// name resolves to, taking the entry's name, so volunteers match it however
+// either side spelled the skill
+func (s *EventService) canonicalizeSkills(ctx context.Context, groups ...[]SkillRequirement) error {
+	if s.skills == nil {
+		return nil
+	}
+	var names []string
+	for _, reqs := range groups {
+		for _, req := range reqs {
+			names = append(names, req.Skill)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	found, err := s.skills.Resolve(ctx, names)
+	if err != nil {
+		return err
+	}
+	for _, reqs := range groups {
+		for i := range reqs {
+			reqs[i].SkillID = nil
+			if sk, ok := found[skill.Normalize(reqs[i].Skill)]; ok {
+				reqs[i].Skill = sk.Name
+				reqs[i].SkillID = &sk.ID
+			}
+		}
+	}
+	return nil
+}
+
+func (s *EventService) canonicalizeShiftSkills(ctx context.Context, shifts []*EventShift) error {
+	groups := make([][]SkillRequirement, 0, len(shifts))
+	for _, shift := range shifts {
+		groups = append(groups, shift.Skills)
+	}
+	if err := s.canonicalizeSkills(ctx, groups...); err != nil {
+		return fmt.Errorf("failed to resolve skills: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/skill"
+)
+
+// stubSkillResolver resolves the normalized names it holds
+type stubSkillResolver map[string]*skill.Skill
+
+func (r stubSkillResolver) Resolve(ctx context.Context, names []string) (map[string]*skill.Skill, error) {
+	return r, nil
+}
+
+func TestEventService_CanonicalizeSkills(t *testing.T) {
+	ctx := context.Background()
+	firstAid := &skill.Skill{ID: "skill-first-aid", Name: "First Aid"}
+
+	t.Run("requirements take the catalogue entry and its name", func(t *testing.T) {
+		service, repo := createTestEventService()
+		service.SetSkillResolver(stubSkillResolver{"cpr first aid": firstAid})
+		expectActiveTerms(repo, ctx)
+		repo.On("Create", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+
+		input := createValidEventInput()
+		input.Requirements = &EventRequirementsInput{Skills: []SkillRequirementInput{
+			{Skill: "CPR/First Aid", Proficiency: SkillProficiencyBeginner, Required: true},
+			{Skill: "Juggling", Proficiency: SkillProficiencyExpert},
+		}}
+		evt, err := service.CreateEvent(ctx, "organizer123", input)
+		require.NoError(t, err)
+
+		skills := evt.Requirements.Skills
+		assert.Equal(t, "First Aid", skills[0].Skill)
+		assert.Equal(t, &firstAid.ID, skills[0].SkillID)
+		assert.Equal(t, "Juggling", skills[1].Skill, "names the catalogue doesn't know are kept")
+		assert.Nil(t, skills[1].SkillID)
+	})
+
+	t.Run("shift skills are filed too, and stale entries cleared", func(t *testing.T) {
+		service := NewEventService(&mockEventRepository{})
+		service.SetSkillResolver(stubSkillResolver{"first aid": firstAid})
+		stale := "skill-retired"
+		shifts := []*EventShift{
+			{Skills: []SkillRequirement{{Skill: "first-aid"}}},
+			{Skills: []SkillRequirement{{Skill: "Knots", SkillID: &stale}}},
+		}
+		require.NoError(t, service.canonicalizeShiftSkills(ctx, shifts))
+		assert.Equal(t, "First Aid", shifts[0].Skills[0].Skill)
+		assert.Equal(t, &firstAid.ID, shifts[0].Skills[0].SkillID)
+		assert.Nil(t, shifts[1].Skills[0].SkillID)
+	})
+
+	t.Run("updated requirements are saved with their catalogue entry", func(t *testing.T) {
+		service, repo := createTestEventService()
+		service.SetSkillResolver(stubSkillResolver{"cpr first aid": firstAid})
+		repo.On("GetByID", ctx, "event123").Return(publishedEvent(), nil).Once()
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("UpdateSkillRequirements", ctx, "event123", mock.MatchedBy(func(reqs []*SkillRequirement) bool {
+			return len(reqs) == 1 && reqs[0].Skill == "First Aid" && reqs[0].SkillID != nil && *reqs[0].SkillID == firstAid.ID
+		})).Return(nil).Once()
+		repo.On("UpdateTrainingRequirements", ctx, "event123", mock.Anything).Return(nil).Once()
+		repo.On("UpdateInterestRequirements", ctx, "event123", mock.Anything).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil)
+
+		input := UpdateEventInput{Requirements: &EventRequirementsInput{Skills: []SkillRequirementInput{
+			{Skill: "CPR/First Aid", Proficiency: SkillProficiencyBeginner, Required: true},
+		}}}
+		evt, err := service.UpdateEvent(ctx, "event123", "organizer123", input)
+		require.NoError(t, err)
+		assert.Equal(t, &firstAid.ID, evt.Requirements.Skills[0].SkillID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("without a catalogue names are kept as given", func(t *testing.T) {
+		service, _ := createTestEventService()
+		reqs := []SkillRequirement{{Skill: "cpr/first aid"}}
+		require.NoError(t, service.canonicalizeSkills(ctx, reqs))
+		assert.Equal(t, "cpr/first aid", reqs[0].Skill)
+	})
+}
@@ -0,0 +1,183 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Organization roles as stored in organization_members
+const (
+	orgRoleOwner  = "OWNER"
+	orgRoleAdmin  = "ADMIN"
+	orgRoleMember = "MEMBER"
+)
+
+// staffRoleActions lists what each delegated role may do. The event's
+// organizer implicitly holds every action.
+var staffRoleActions = map[StaffRole][]StaffAction{
+	StaffRoleCoOrganizer:  {StaffActionManage, StaffActionApprove, StaffActionCheckIn, StaffActionView},
+	StaffRoleCheckInStaff: {StaffActionCheckIn, StaffActionView},
+	StaffRoleViewer:       {StaffActionView},
+}
+
+// IsValidStaffRole reports whether role is one of the supported staff roles
+func IsValidStaffRole(role StaffRole) bool {
+	_, ok := staffRoleActions[role]
+	return ok
+}
+
+// Authorize checks that userID may perform action on the event, either as
+// its organizer or through an active staff role.
+func (s *EventService) Authorize(ctx context.Context, evt *Event, userID string, action StaffAction) error {
+	if userID == "" {
+		return fmt.Errorf("unauthorized: authentication required")
+	}
+	if evt.OrganizerID == userID {
+		return nil
+	}
+
+	// Owners and admins of the owning organization run all of its events;
+	// plain members may view them.
+	if evt.OrganizationID != nil {
+		role, err := s.repo.GetOrganizationRole(ctx, *evt.OrganizationID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to check organization membership: %w", err)
+		}
+		if role == orgRoleOwner || role == orgRoleAdmin || (role == orgRoleMember && action == StaffActionView) {
+			return nil
+		}
+	}
+
+	staff, err := s.repo.GetStaffMember(ctx, evt.ID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check event staff: %w", err)
+	}
+	if staff != nil {
+		for _, allowed := range staffRoleActions[staff.Role] {
+			if allowed == action {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("unauthorized: user does not have %s access to this event", strings.ToLower(string(action)))
+}
+
+// InviteStaff invites someone by email to help run an event
+func (s *EventService) InviteStaff(ctx context.Context, eventID, inviterID, email string, role StaffRole) (*EventStaff, error) {
+	email = strings.TrimSpace(email)
+	if !strings.Contains(email, "@") {
+		return nil, fmt.Errorf("validation failed: invalid email address")
+	}
+	if !IsValidStaffRole(role) {
+		return nil, fmt.Errorf("validation failed: invalid staff role %q", role)
+	}
+
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.Authorize(ctx, evt, inviterID, StaffActionManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetEventStaff(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event staff: %w", err)
+	}
+	for _, member := range existing {
+		if strings.EqualFold(member.Email, email) {
+			return nil, fmt.Errorf("%s has already been invited to this event", email)
+		}
+	}
+
+	staff := &EventStaff{
+		EventID:   eventID,
+		Email:     email,
+		Role:      role,
+		Status:    StaffStatusInvited,
+		InvitedBy: inviterID,
+	}
+	if err := s.repo.CreateStaffInvitation(ctx, staff); err != nil {
+		return nil, fmt.Errorf("failed to create staff invitation: %w", err)
+	}
+
+	return staff, nil
+}
+
+// AcceptStaffInvitation links a pending invitation to the user whose email it was sent to
+func (s *EventService) AcceptStaffInvitation(ctx context.Context, staffID, userID, email string) (*EventStaff, error) {
+	staff, err := s.repo.GetStaffByID(ctx, staffID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff invitation: %w", err)
+	}
+
+	if staff.Status != StaffStatusInvited {
+		return nil, fmt.Errorf("invitation is no longer pending")
+	}
+	if !strings.EqualFold(staff.Email, email) {
+		return nil, fmt.Errorf("unauthorized: invitation was sent to a different email address")
+	}
+
+	now := time.Now().UTC()
+	staff.UserID = &userID
+	staff.Status = StaffStatusActive
+	staff.AcceptedAt = &now
+
+	if err := s.repo.UpdateStaff(ctx, staff); err != nil {
+		return nil, fmt.Errorf("failed to accept staff invitation: %w", err)
+	}
+
+	return staff, nil
+}
+
+// RemoveStaff revokes a staff role or pending invitation. Staff may also remove themselves.
+func (s *EventService) RemoveStaff(ctx context.Context, staffID, userID string) (*EventStaff, error) {
+	staff, err := s.repo.GetStaffByID(ctx, staffID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff member: %w", err)
+	}
+	if staff.Status == StaffStatusRemoved {
+		return nil, fmt.Errorf("staff member has already been removed")
+	}
+
+	if staff.UserID == nil || *staff.UserID != userID {
+		evt, err := s.repo.GetByID(ctx, staff.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get event: %w", err)
+		}
+		if err := s.Authorize(ctx, evt, userID, StaffActionManage); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	staff.Status = StaffStatusRemoved
+	staff.RemovedAt = &now
+
+	if err := s.repo.UpdateStaff(ctx, staff); err != nil {
+		return nil, fmt.Errorf("failed to remove staff member: %w", err)
+	}
+
+	return staff, nil
+}
+
+// ListEventStaff returns the current staff and pending invitations for an event
+func (s *EventService) ListEventStaff(ctx context.Context, eventID, userID string) ([]*EventStaff, error) {
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.Authorize(ctx, evt, userID, StaffActionView); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetEventStaff(ctx, eventID)
+}
+
+// GetPendingStaffInvitations returns invitations waiting on the given email address
+func (s *EventService) GetPendingStaffInvitations(ctx context.Context, email string) ([]*EventStaff, error) {
+	return s.repo.GetPendingStaffInvitations(ctx, email)
+}
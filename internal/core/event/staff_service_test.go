@@ -0,0 +1,215 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	evt := &Event{ID: "event123", OrganizerID: "organizer123"}
+
+	tests := []struct {
+		name    string
+		role    StaffRole
+		action  StaffAction
+		allowed bool
+	}{
+		{"co-organizer manage", StaffRoleCoOrganizer, StaffActionManage, true},
+		{"co-organizer approve", StaffRoleCoOrganizer, StaffActionApprove, true},
+		{"check-in staff check in", StaffRoleCheckInStaff, StaffActionCheckIn, true},
+		{"check-in staff approve", StaffRoleCheckInStaff, StaffActionApprove, false},
+		{"viewer view", StaffRoleViewer, StaffActionView, true},
+		{"viewer check in", StaffRoleViewer, StaffActionCheckIn, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := createTestEventService()
+			repo.On("GetStaffMember", ctx, "event123", "staff-user").
+				Return(&EventStaff{Role: tt.role, Status: StaffStatusActive}, nil).Once()
+
+			err := service.Authorize(ctx, evt, "staff-user", tt.action)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "unauthorized")
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("organizer has every action", func(t *testing.T) {
+		service, repo := createTestEventService()
+
+		assert.NoError(t, service.Authorize(ctx, evt, "organizer123", StaffActionManage))
+		repo.AssertNotCalled(t, "GetStaffMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("non-staff user is rejected", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetStaffMember", ctx, "event123", "stranger").Return(nil, nil).Once()
+
+		err := service.Authorize(ctx, evt, "stranger", StaffActionView)
+
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestEventService_InviteStaff(t *testing.T) {
+	ctx := context.Background()
+	evt := &Event{ID: "event123", OrganizerID: "organizer123"}
+
+	t.Run("organizer invites staff", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetEventStaff", ctx, "event123").Return([]*EventStaff{}, nil).Once()
+		repo.On("CreateStaffInvitation", ctx, mock.MatchedBy(func(s *EventStaff) bool {
+			return s.Email == "helper@example.com" &&
+				s.Role == StaffRoleCheckInStaff &&
+				s.Status == StaffStatusInvited &&
+				s.InvitedBy == "organizer123"
+		})).Return(nil).Once()
+
+		staff, err := service.InviteStaff(ctx, "event123", "organizer123", " helper@example.com ", StaffRoleCheckInStaff)
+
+		require.NoError(t, err)
+		assert.Equal(t, StaffStatusInvited, staff.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate invitation", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetEventStaff", ctx, "event123").
+			Return([]*EventStaff{{Email: "Helper@Example.com", Status: StaffStatusInvited}}, nil).Once()
+
+		staff, err := service.InviteStaff(ctx, "event123", "organizer123", "helper@example.com", StaffRoleViewer)
+
+		assert.Error(t, err)
+		assert.Nil(t, staff)
+		assert.Contains(t, err.Error(), "already been invited")
+	})
+
+	t.Run("viewer cannot invite", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "viewer").
+			Return(&EventStaff{Role: StaffRoleViewer, Status: StaffStatusActive}, nil).Once()
+
+		staff, err := service.InviteStaff(ctx, "event123", "viewer", "helper@example.com", StaffRoleViewer)
+
+		assert.Error(t, err)
+		assert.Nil(t, staff)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		service, _ := createTestEventService()
+
+		staff, err := service.InviteStaff(ctx, "event123", "organizer123", "helper@example.com", StaffRole("OWNER"))
+
+		assert.Error(t, err)
+		assert.Nil(t, staff)
+		assert.Contains(t, err.Error(), "validation failed")
+	})
+}
+
+func TestEventService_AcceptStaffInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invitee accepts", func(t *testing.T) {
+		service, repo := createTestEventService()
+		invite := &EventStaff{ID: "staff1", EventID: "event123", Email: "helper@example.com", Role: StaffRoleViewer, Status: StaffStatusInvited}
+		repo.On("GetStaffByID", ctx, "staff1").Return(invite, nil).Once()
+		repo.On("UpdateStaff", ctx, invite).Return(nil).Once()
+
+		staff, err := service.AcceptStaffInvitation(ctx, "staff1", "user456", "HELPER@example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, StaffStatusActive, staff.Status)
+		require.NotNil(t, staff.UserID)
+		assert.Equal(t, "user456", *staff.UserID)
+		assert.NotNil(t, staff.AcceptedAt)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("different email", func(t *testing.T) {
+		service, repo := createTestEventService()
+		invite := &EventStaff{ID: "staff1", Email: "helper@example.com", Status: StaffStatusInvited}
+		repo.On("GetStaffByID", ctx, "staff1").Return(invite, nil).Once()
+
+		staff, err := service.AcceptStaffInvitation(ctx, "staff1", "user456", "someone@example.com")
+
+		assert.Error(t, err)
+		assert.Nil(t, staff)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+}
+
+func TestEventService_RemoveStaff(t *testing.T) {
+	ctx := context.Background()
+	evt := &Event{ID: "event123", OrganizerID: "organizer123"}
+
+	t.Run("staff member removes themselves", func(t *testing.T) {
+		service, repo := createTestEventService()
+		userID := "user456"
+		member := &EventStaff{ID: "staff1", EventID: "event123", UserID: &userID, Role: StaffRoleViewer, Status: StaffStatusActive}
+		repo.On("GetStaffByID", ctx, "staff1").Return(member, nil).Once()
+		repo.On("UpdateStaff", ctx, member).Return(nil).Once()
+
+		staff, err := service.RemoveStaff(ctx, "staff1", "user456")
+
+		require.NoError(t, err)
+		assert.Equal(t, StaffStatusRemoved, staff.Status)
+		assert.NotNil(t, staff.RemovedAt)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("other user cannot remove", func(t *testing.T) {
+		service, repo := createTestEventService()
+		userID := "user456"
+		member := &EventStaff{ID: "staff1", EventID: "event123", UserID: &userID, Role: StaffRoleViewer, Status: StaffStatusActive}
+		repo.On("GetStaffByID", ctx, "staff1").Return(member, nil).Once()
+		repo.On("GetByID", ctx, "event123").Return(evt, nil).Once()
+		repo.On("GetStaffMember", ctx, "event123", "stranger").Return(nil, nil).Once()
+
+		staff, err := service.RemoveStaff(ctx, "staff1", "stranger")
+
+		assert.Error(t, err)
+		assert.Nil(t, staff)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestEventService_AuthorizeOrganization(t *testing.T) {
+	ctx := context.Background()
+	orgID := "org123"
+	evt := &Event{ID: "event123", OrganizerID: "organizer123", OrganizationID: &orgID}
+
+	t.Run("organization admin manages", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetOrganizationRole", ctx, "org123", "admin").Return("ADMIN", nil).Once()
+
+		assert.NoError(t, service.Authorize(ctx, evt, "admin", StaffActionManage))
+		repo.AssertNotCalled(t, "GetStaffMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("organization member only views", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetOrganizationRole", ctx, "org123", "member").Return("MEMBER", nil).Twice()
+		repo.On("GetStaffMember", ctx, "event123", "member").Return(nil, nil).Once()
+
+		assert.NoError(t, service.Authorize(ctx, evt, "member", StaffActionView))
+		err := service.Authorize(ctx, evt, "member", StaffActionManage)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,238 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxTermSlugLength = 64
+	maxTermNameLength = 100
+	maxTermIconLength = 100
+)
+
+var (
+	ErrTaxonomyTermNotFound = errors.New("taxonomy term not found")
+	ErrInvalidTaxonomyTerm  = errors.New("invalid taxonomy term")
+	// ErrTaxonomyTermInUse is returned when deleting a term that events,
+	// templates or other terms still refer to; deactivate it instead
+	ErrTaxonomyTermInUse = errors.New("taxonomy term is in use")
+)
+
+var termSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Taxonomy names one of the admin-managed vocabularies events are
+// classified by
+type Taxonomy string
+
+const (
+	TaxonomyCategory       Taxonomy = "CATEGORY"
+	TaxonomyTimeCommitment Taxonomy = "TIME_COMMITMENT"
+)
+
+// IsValid reports whether t is a known taxonomy
+func (t Taxonomy) IsValid() bool {
+	return t == TaxonomyCategory || t == TaxonomyTimeCommitment
+}
+
+// TaxonomyTerm is one entry in a taxonomy. Events refer to terms by slug;
+// a term with a parent is narrower than it, so searching for the parent
+// finds events filed under the term too.
+type TaxonomyTerm struct {
+	ID          string   `json:"id" db:"id"`
+	Taxonomy    Taxonomy `json:"taxonomy"`
+	Slug        string   `json:"slug" db:"slug"`
+	Name        string   `json:"name" db:"name"`
+	Description *string  `json:"description,omitempty" db:"description"`
+	Icon        *string  `json:"icon,omitempty" db:"icon"`
+	ParentID    *string  `json:"parentId,omitempty" db:"parent_id"`
+	SortOrder   int      `json:"sortOrder" db:"sort_order"`
+	// Inactive terms stay on the events that have them but can't be chosen
+	IsActive  bool      `json:"isActive" db:"is_active"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// TaxonomyTermInput creates a term or replaces an existing one's fields
+type TaxonomyTermInput struct {
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	ParentID    *string `json:"parentId,omitempty"`
+	SortOrder   int     `json:"sortOrder"`
+	IsActive    bool    `json:"isActive"`
+}
+
+// ListTaxonomyTerms returns a taxonomy's terms ordered for display
+func (s *EventService) ListTaxonomyTerms(ctx context.Context, taxonomy Taxonomy, includeInactive bool) ([]*TaxonomyTerm, error) {
+	if !taxonomy.IsValid() {
+		return nil, fmt.Errorf("%w: unknown taxonomy %q", ErrInvalidTaxonomyTerm, taxonomy)
+	}
+	terms, err := s.repo.ListTaxonomyTerms(ctx, taxonomy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+	if includeInactive {
+		return terms, nil
+	}
+	active := make([]*TaxonomyTerm, 0, len(terms))
+	for _, t := range terms {
+		if t.IsActive {
+			active = append(active, t)
+		}
+	}
+	return active, nil
+}
+
+// CreateTaxonomyTerm adds a term. Callers must check that the requester
+// may manage the taxonomy.
+func (s *EventService) CreateTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, input TaxonomyTermInput) (*TaxonomyTerm, error) {
+	terms, err := s.loadTaxonomy(ctx, taxonomy)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	term := &TaxonomyTerm{ID: uuid.New().String(), Taxonomy: taxonomy, CreatedAt: now}
+	if err := applyTermInput(term, input, terms, now); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateTaxonomyTerm(ctx, term); err != nil {
+		return nil, fmt.Errorf("failed to create term: %w", err)
+	}
+	return term, nil
+}
+
+// UpdateTaxonomyTerm replaces a term's fields. Renaming the slug moves the
+// events and templates filed under it. Callers must check that the
+// requester may manage the taxonomy.
+func (s *EventService) UpdateTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, id string, input TaxonomyTermInput) (*TaxonomyTerm, error) {
+	terms, err := s.loadTaxonomy(ctx, taxonomy)
+	if err != nil {
+		return nil, err
+	}
+	existing := findTerm(terms, id)
+	if existing == nil {
+		return nil, ErrTaxonomyTermNotFound
+	}
+	term := *existing
+	if err := applyTermInput(&term, input, terms, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateTaxonomyTerm(ctx, &term, existing.Slug); err != nil {
+		return nil, fmt.Errorf("failed to update term: %w", err)
+	}
+	return &term, nil
+}
+
+// DeleteTaxonomyTerm removes a term nothing refers to. Callers must check
+// that the requester may manage the taxonomy.
+func (s *EventService) DeleteTaxonomyTerm(ctx context.Context, taxonomy Taxonomy, id string) error {
+	terms, err := s.loadTaxonomy(ctx, taxonomy)
+	if err != nil {
+		return err
+	}
+	if findTerm(terms, id) == nil {
+		return ErrTaxonomyTermNotFound
+	}
+	for _, t := range terms {
+		if t.ParentID != nil && *t.ParentID == id {
+			return fmt.Errorf("%w: it has narrower terms", ErrTaxonomyTermInUse)
+		}
+	}
+	return s.repo.DeleteTaxonomyTerm(ctx, taxonomy, id)
+}
+
+// validateTerm checks that slug names an active term an event may be filed under
+func (s *EventService) validateTerm(ctx context.Context, taxonomy Taxonomy, slug string) error {
+	label := "category"
+	if taxonomy == TaxonomyTimeCommitment {
+		label = "time commitment"
+	}
+	if slug == "" {
+		return fmt.Errorf("%s is required", label)
+	}
+	term, err := s.repo.GetTaxonomyTerm(ctx, taxonomy, slug)
+	if errors.Is(err, ErrTaxonomyTermNotFound) {
+		return fmt.Errorf("%w: unknown %s %q", ErrInvalidTaxonomyTerm, label, slug)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up %s: %w", label, err)
+	}
+	if !term.IsActive {
+		return fmt.Errorf("%w: %s %q is no longer available", ErrInvalidTaxonomyTerm, label, slug)
+	}
+	return nil
+}
+
+func (s *EventService) loadTaxonomy(ctx context.Context, taxonomy Taxonomy) ([]*TaxonomyTerm, error) {
+	if !taxonomy.IsValid() {
+		return nil, fmt.Errorf("%w: unknown taxonomy %q", ErrInvalidTaxonomyTerm, taxonomy)
+	}
+	terms, err := s.repo.ListTaxonomyTerms(ctx, taxonomy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+	return terms, nil
+}
+
+// applyTermInput validates input against the taxonomy's other terms and
+// copies it onto term
+func applyTermInput(term *TaxonomyTerm, input TaxonomyTermInput, terms []*TaxonomyTerm, now time.Time) error {
+	slug := strings.TrimSpace(input.Slug)
+	name := strings.TrimSpace(input.Name)
+	switch {
+	case !termSlugPattern.MatchString(slug) || len(slug) > maxTermSlugLength:
+		return fmt.Errorf("%w: slug must be lowercase letters, digits and single hyphens, at most %d characters", ErrInvalidTaxonomyTerm, maxTermSlugLength)
+	case name == "" || len(name) > maxTermNameLength:
+		return fmt.Errorf("%w: name is required and at most %d characters", ErrInvalidTaxonomyTerm, maxTermNameLength)
+	case input.Icon != nil && len(*input.Icon) > maxTermIconLength:
+		return fmt.Errorf("%w: icon is at most %d characters", ErrInvalidTaxonomyTerm, maxTermIconLength)
+	}
+	for _, t := range terms {
+		if t.Slug == slug && t.ID != term.ID {
+			return fmt.Errorf("%w: slug %q is taken", ErrInvalidTaxonomyTerm, slug)
+		}
+	}
+
+	var parentID *string
+	if input.ParentID != nil && *input.ParentID != "" {
+		// Walk up from the new parent; meeting the term itself means a cycle
+		for id := input.ParentID; id != nil; {
+			if *id == term.ID {
+				return fmt.Errorf("%w: a term can't be narrower than itself", ErrInvalidTaxonomyTerm)
+			}
+			parent := findTerm(terms, *id)
+			if parent == nil {
+				return fmt.Errorf("%w: parent %q not found", ErrInvalidTaxonomyTerm, *id)
+			}
+			id = parent.ParentID
+		}
+		parentID = input.ParentID
+	}
+
+	term.Slug = slug
+	term.Name = name
+	term.Description = input.Description
+	term.Icon = input.Icon
+	term.ParentID = parentID
+	term.SortOrder = input.SortOrder
+	term.IsActive = input.IsActive
+	term.UpdatedAt = now
+	return nil
+}
+
+func findTerm(terms []*TaxonomyTerm, id string) *TaxonomyTerm {
+	for _, t := range terms {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// expectActiveTerms accepts the category and time commitment of one new event
+func expectActiveTerms(repo *mockEventRepository, ctx context.Context) {
+	repo.On("GetTaxonomyTerm", ctx, mock.AnythingOfType("event.Taxonomy"), mock.AnythingOfType("string")).
+		Return(&TaxonomyTerm{IsActive: true}, nil).Twice()
+}
+
+func taxonomyFixture() []*TaxonomyTerm {
+	community := "community"
+	homeless := "homeless"
+	return []*TaxonomyTerm{
+		{ID: "community", Taxonomy: TaxonomyCategory, Slug: "community-service", Name: "Community Service", IsActive: true},
+		{ID: "homeless", Taxonomy: TaxonomyCategory, Slug: "homeless-services", Name: "Homeless Services", ParentID: &community, IsActive: true},
+		{ID: "shelters", Taxonomy: TaxonomyCategory, Slug: "shelters", Name: "Shelters", ParentID: &homeless, IsActive: true},
+		{ID: "retired", Taxonomy: TaxonomyCategory, Slug: "retired", Name: "Retired", IsActive: false},
+	}
+}
+
+func TestApplyTermInput(t *testing.T) {
+	now := time.Now().UTC()
+	terms := taxonomyFixture()
+
+	term := &TaxonomyTerm{ID: "new"}
+	parent := "homeless"
+	require.NoError(t, applyTermInput(term, TaxonomyTermInput{Slug: " food-banks ", Name: "Food Banks", ParentID: &parent, IsActive: true}, terms, now))
+	assert.Equal(t, "food-banks", term.Slug)
+	assert.Equal(t, &parent, term.ParentID)
+	assert.Equal(t, now, term.UpdatedAt)
+
+	for _, slug := range []string{"Food Banks", "food_banks", "food--banks", "-food", ""} {
+		err := applyTermInput(&TaxonomyTerm{ID: "new"}, TaxonomyTermInput{Slug: slug, Name: "Food"}, terms, now)
+		assert.ErrorIs(t, err, ErrInvalidTaxonomyTerm, slug)
+	}
+
+	err := applyTermInput(&TaxonomyTerm{ID: "new"}, TaxonomyTermInput{Slug: "shelters", Name: "Shelters"}, terms, now)
+	assert.ErrorIs(t, err, ErrInvalidTaxonomyTerm, "slugs are unique")
+
+	missing := "missing"
+	err = applyTermInput(&TaxonomyTerm{ID: "new"}, TaxonomyTermInput{Slug: "food", Name: "Food", ParentID: &missing}, terms, now)
+	assert.ErrorIs(t, err, ErrInvalidTaxonomyTerm)
+
+	shelters := "shelters"
+	community := *terms[0]
+	err = applyTermInput(&community, TaxonomyTermInput{Slug: "community-service", Name: "Community", ParentID: &shelters}, terms, now)
+	require.ErrorIs(t, err, ErrInvalidTaxonomyTerm)
+	assert.Contains(t, err.Error(), "narrower than itself")
+}
+
+func TestEventService_TaxonomyTerms(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("inactive terms are hidden unless asked for", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("ListTaxonomyTerms", ctx, TaxonomyCategory).Return(taxonomyFixture(), nil)
+
+		active, err := service.ListTaxonomyTerms(ctx, TaxonomyCategory, false)
+		require.NoError(t, err)
+		assert.Len(t, active, 3)
+
+		all, err := service.ListTaxonomyTerms(ctx, TaxonomyCategory, true)
+		require.NoError(t, err)
+		assert.Len(t, all, 4)
+
+		_, err = service.ListTaxonomyTerms(ctx, Taxonomy("COLOUR"), false)
+		assert.ErrorIs(t, err, ErrInvalidTaxonomyTerm)
+	})
+
+	t.Run("renaming a slug passes the old one to the store", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("ListTaxonomyTerms", ctx, TaxonomyCategory).Return(taxonomyFixture(), nil)
+		repo.On("UpdateTaxonomyTerm", ctx, mock.MatchedBy(func(term *TaxonomyTerm) bool {
+			return term.ID == "homeless" && term.Slug == "housing"
+		}), "homeless-services").Return(nil).Once()
+
+		term, err := service.UpdateTaxonomyTerm(ctx, TaxonomyCategory, "homeless", TaxonomyTermInput{Slug: "housing", Name: "Housing", IsActive: true})
+		require.NoError(t, err)
+		assert.Nil(t, term.ParentID, "the input replaces every field")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("terms with narrower terms can't be deleted", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("ListTaxonomyTerms", ctx, TaxonomyCategory).Return(taxonomyFixture(), nil)
+
+		err := service.DeleteTaxonomyTerm(ctx, TaxonomyCategory, "homeless")
+		assert.ErrorIs(t, err, ErrTaxonomyTermInUse)
+		err = service.DeleteTaxonomyTerm(ctx, TaxonomyCategory, "missing")
+		assert.ErrorIs(t, err, ErrTaxonomyTermNotFound)
+		repo.AssertNotCalled(t, "DeleteTaxonomyTerm", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("events need an active category", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetTaxonomyTerm", ctx, TaxonomyCategory, "retired").Return(&TaxonomyTerm{Slug: "retired"}, nil)
+		repo.On("GetTaxonomyTerm", ctx, TaxonomyCategory, "FOOD_HUNGER").Return(nil, ErrTaxonomyTermNotFound)
+
+		input := createValidEventInput()
+		input.Category = "retired"
+		_, err := service.CreateEvent(ctx, "organizer123", input)
+		require.ErrorIs(t, err, ErrInvalidTaxonomyTerm)
+		assert.Contains(t, err.Error(), "no longer available")
+
+		input.Category = "FOOD_HUNGER"
+		_, err = service.CreateEvent(ctx, "organizer123", input)
+		require.ErrorIs(t, err, ErrInvalidTaxonomyTerm)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("an event keeps a retired category until it changes", func(t *testing.T) {
+		service, repo := createTestEventService()
+		evt := publishedEvent()
+		evt.Category = "retired"
+		repo.On("GetByID", ctx, "event123").Return(evt, nil)
+		repo.On("Update", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+		repo.On("LogUpdate", ctx, mock.AnythingOfType("*event.EventUpdate")).Return(nil)
+
+		category := EventCategory("retired")
+		title := "Riverside Cleanup"
+		updated, err := service.UpdateEvent(ctx, "event123", "organizer123", UpdateEventInput{Title: &title, Category: &category})
+		require.NoError(t, err)
+		assert.Equal(t, category, updated.Category)
+		repo.AssertNotCalled(t, "GetTaxonomyTerm", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
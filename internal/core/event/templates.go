@@ -0,0 +1,348 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxTemplateNameLength matches the limit on event titles
+const maxTemplateNameLength = 200
+
+var (
+	ErrTemplateNotFound = errors.New("event template not found")
+	ErrInvalidTemplate  = errors.New("invalid event template")
+)
+
+// EventTemplate is an event's setup saved for reuse: details, requirements,
+// registration settings, images and shifts, without its dates being fixed.
+// Event keeps the source's own times, so every deadline can be moved by as
+// much as the start when an event is created from it.
+type EventTemplate struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	OrganizerID    string        `json:"organizerId"`
+	OrganizationID *string       `json:"organizationId,omitempty"`
+	SourceEventID  *string       `json:"sourceEventId,omitempty"`
+	Event          Event         `json:"event"`
+	Shifts         []*EventShift `json:"shifts"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
+}
+
+// ContentCopier copies what other services keep about an event, such as its
+// registration questions, into templates and onto new events. The
+// registration service implements it.
+type ContentCopier interface {
+	// CopyEventToTemplate saves the event's content with the template
+	CopyEventToTemplate(ctx context.Context, eventID, templateID string) error
+	// CopyTemplateToEvent gives a new event the template's content
+	CopyTemplateToEvent(ctx context.Context, templateID, eventID string) error
+	// CopyEvent gives a new event the source event's content
+	CopyEvent(ctx context.Context, sourceEventID, eventID string) error
+}
+
+// SetContentCopier connects the services that own the rest of an event's
+// content. Like SetRegistrantHandler, it is set after construction.
+func (s *EventService) SetContentCopier(c ContentCopier) {
+	s.copier = c
+}
+
+// CreateEventTemplate saves an event's setup as a template. Anyone who can
+// manage the event may; the template belongs to them, and to the event's
+// organization if it has one. An empty name uses the event's title.
+func (s *EventService) CreateEventTemplate(ctx context.Context, userID, eventID, name string) (*EventTemplate, error) {
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.Authorize(ctx, evt, userID, StaffActionManage); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = evt.Title
+	}
+	if len(name) > maxTemplateNameLength {
+		return nil, fmt.Errorf("%w: name must be at most %d characters", ErrInvalidTemplate, maxTemplateNameLength)
+	}
+
+	shifts, err := s.repo.GetShifts(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shifts: %w", err)
+	}
+
+	now := time.Now().UTC()
+	template := &EventTemplate{
+		ID:             uuid.New().String(),
+		Name:           name,
+		OrganizerID:    userID,
+		OrganizationID: evt.OrganizationID,
+		SourceEventID:  &evt.ID,
+		Event:          templateEvent(evt),
+		Shifts:         shifts,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := s.repo.CreateTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to save template: %w", err)
+	}
+	if s.copier != nil {
+		if err := s.copier.CopyEventToTemplate(ctx, eventID, template.ID); err != nil {
+			return nil, fmt.Errorf("failed to copy event content: %w", err)
+		}
+	}
+	return template, nil
+}
+
+// GetEventTemplate returns a template its owner or organization may use
+func (s *EventService) GetEventTemplate(ctx context.Context, userID, templateID string) (*EventTemplate, error) {
+	template, err := s.repo.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeTemplate(ctx, template, userID); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// ListEventTemplates returns the organization's templates, or the user's own
+// when organizationID is nil
+func (s *EventService) ListEventTemplates(ctx context.Context, userID string, organizationID *string) ([]*EventTemplate, error) {
+	if organizationID == nil {
+		return s.repo.GetTemplatesByOrganizer(ctx, userID)
+	}
+	if err := s.authorizeOrganization(ctx, *organizationID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetTemplatesByOrganization(ctx, *organizationID)
+}
+
+// DeleteEventTemplate removes a template. Events created from it are kept.
+func (s *EventService) DeleteEventTemplate(ctx context.Context, userID, templateID string) error {
+	if _, err := s.GetEventTemplate(ctx, userID, templateID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteTemplate(ctx, templateID); err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	return nil
+}
+
+// CreateEventFromTemplate creates a draft event from a template, starting at
+// startTime. Registration deadlines and shifts move with the start.
+func (s *EventService) CreateEventFromTemplate(ctx context.Context, userID, templateID string, startTime time.Time) (*Event, error) {
+	template, err := s.GetEventTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	evt, err := s.createCopy(ctx, userID, &template.Event, template.Shifts, startTime)
+	if err != nil {
+		return nil, err
+	}
+	if s.copier != nil {
+		if err := s.copier.CopyTemplateToEvent(ctx, template.ID, evt.ID); err != nil {
+			return nil, fmt.Errorf("failed to copy template content: %w", err)
+		}
+	}
+	return evt, nil
+}
+
+// DuplicateEvent creates a draft copy of an event starting at startTime, as
+// CreateEventFromTemplate does for a template
+func (s *EventService) DuplicateEvent(ctx context.Context, userID, eventID string, startTime time.Time) (*Event, error) {
+	source, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if err := s.Authorize(ctx, source, userID, StaffActionManage); err != nil {
+		return nil, err
+	}
+	shifts, err := s.repo.GetShifts(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shifts: %w", err)
+	}
+
+	evt, err := s.createCopy(ctx, userID, source, shifts, startTime)
+	if err != nil {
+		return nil, err
+	}
+	if s.copier != nil {
+		if err := s.copier.CopyEvent(ctx, source.ID, evt.ID); err != nil {
+			return nil, fmt.Errorf("failed to copy event content: %w", err)
+		}
+	}
+	return evt, nil
+}
+
+// createCopy validates and saves a copy of source moved to startTime, with
+// its images and shifts
+func (s *EventService) createCopy(ctx context.Context, userID string, source *Event, shifts []*EventShift, startTime time.Time) (*Event, error) {
+	now := time.Now().UTC()
+	evt, shifts := copyEvent(source, shifts, userID, startTime, now)
+
+	if err := validateEventTimes(evt.StartTime, evt.EndTime); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := validateRegistrationWindow(evt.RegistrationSettings, RegistrationSettings{}, evt.StartTime); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if evt.OrganizationID != nil {
+		if err := s.authorizeOrganization(ctx, *evt.OrganizationID, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.validateTerm(ctx, TaxonomyCategory, string(evt.Category)); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := s.validateTerm(ctx, TaxonomyTimeCommitment, string(evt.TimeCommitment)); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	// Templates may predate catalogue entries their skills now resolve to
+	if err := s.canonicalizeSkills(ctx, evt.Requirements.Skills); err != nil {
+		return nil, fmt.Errorf("failed to resolve skills: %w", err)
+	}
+	if err := s.canonicalizeShiftSkills(ctx, shifts); err != nil {
+		return nil, err
+	}
+
+	slug, err := s.repo.GenerateUniqueSlug(ctx, evt.Title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate slug: %w", err)
+	}
+	shareURL := fmt.Sprintf("/events/%s", slug)
+	evt.Slug = &slug
+	evt.ShareURL = &shareURL
+
+	if err := s.repo.Create(ctx, evt); err != nil {
+		return nil, fmt.Errorf("failed to create event: %w", err)
+	}
+	for i := range evt.Images {
+		if err := s.repo.CreateEventImage(ctx, &evt.Images[i]); err != nil {
+			return nil, fmt.Errorf("failed to copy image: %w", err)
+		}
+	}
+	if len(shifts) > 0 {
+		if err := s.repo.ReplaceShifts(ctx, evt.ID, shifts); err != nil {
+			return nil, fmt.Errorf("failed to copy shifts: %w", err)
+		}
+	}
+	return evt, nil
+}
+
+// authorizeTemplate lets a template's owner use it, and the owners and
+// admins of its organization
+func (s *EventService) authorizeTemplate(ctx context.Context, template *EventTemplate, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("unauthorized: authentication required")
+	}
+	if template.OrganizerID == userID {
+		return nil
+	}
+	if template.OrganizationID != nil {
+		return s.authorizeOrganization(ctx, *template.OrganizationID, userID)
+	}
+	return fmt.Errorf("unauthorized: user cannot use this template")
+}
+
+// authorizeOrganization checks that the user may create events for the
+// organization, which only its owners and admins may
+func (s *EventService) authorizeOrganization(ctx context.Context, organizationID, userID string) error {
+	role, err := s.repo.GetOrganizationRole(ctx, organizationID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if role != orgRoleOwner && role != orgRoleAdmin {
+		return fmt.Errorf("unauthorized: user cannot create events for this organization")
+	}
+	return nil
+}
+
+// templateEvent strips what belongs to one occurrence of an event: its
+// identity, status, series, sign-ups and the IDs of its requirement rows
+func templateEvent(evt *Event) Event {
+	t := *evt
+	t.ID = ""
+	t.OrganizerID = ""
+	t.Status = EventStatusDraft
+	t.RecurrenceRule = nil
+	t.ParentEventID = nil
+	t.Slug = nil
+	t.ShareURL = nil
+	t.PublishedAt = nil
+	t.Capacity.Current = 0
+	t.Capacity.WaitlistSize = 0
+	t.Requirements.Skills = slices.Clone(evt.Requirements.Skills)
+	for i := range t.Requirements.Skills {
+		t.Requirements.Skills[i].ID = ""
+		t.Requirements.Skills[i].EventID = ""
+	}
+	t.Requirements.Training = slices.Clone(evt.Requirements.Training)
+	for i := range t.Requirements.Training {
+		t.Requirements.Training[i].ID = ""
+		t.Requirements.Training[i].EventID = ""
+	}
+	t.Requirements.Interests = slices.Clone(evt.Requirements.Interests)
+	t.Images = slices.Clone(evt.Images)
+	t.Tags = slices.Clone(evt.Tags)
+	return t
+}
+
+// copyEvent builds a new draft event from source, moved so it starts at
+// startTime. The end, shifts and every registration deadline move by the
+// same amount. A registration opening that would already have passed is
+// dropped, so registration opens straight away.
+func copyEvent(source *Event, shifts []*EventShift, organizerID string, startTime, now time.Time) (*Event, []*EventShift) {
+	offset := startTime.Sub(source.StartTime)
+	move := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+		moved := t.Add(offset)
+		return &moved
+	}
+
+	evt := templateEvent(source)
+	evt.ID = uuid.New().String()
+	evt.OrganizerID = organizerID
+	evt.StartTime = startTime
+	evt.EndTime = source.EndTime.Add(offset)
+	evt.CreatedAt = now
+	evt.UpdatedAt = now
+
+	settings := &evt.RegistrationSettings
+	settings.OpensAt = move(settings.OpensAt)
+	if settings.OpensAt != nil && settings.OpensAt.Before(now) {
+		settings.OpensAt = nil
+	}
+	settings.ClosesAt = settings.ClosesAt.Add(offset)
+	settings.CancellationDeadline = move(settings.CancellationDeadline)
+
+	for i := range evt.Images {
+		evt.Images[i].ID = uuid.New().String()
+		evt.Images[i].EventID = evt.ID
+		evt.Images[i].CreatedAt = now
+	}
+
+	copied := make([]*EventShift, 0, len(shifts))
+	for _, shift := range shifts {
+		c := *shift
+		c.ID = uuid.New().String()
+		c.EventID = evt.ID
+		c.StartTime = shift.StartTime.Add(offset)
+		c.EndTime = shift.EndTime.Add(offset)
+		c.Skills = slices.Clone(shift.Skills)
+		c.CreatedAt = now
+		c.UpdatedAt = now
+		copied = append(copied, &c)
+	}
+	return &evt, copied
+}
@@ -0,0 +1,171 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingContentCopier struct {
+	source, target string
+}
+
+func (c *recordingContentCopier) CopyEventToTemplate(ctx context.Context, eventID, templateID string) error {
+	c.source, c.target = eventID, templateID
+	return nil
+}
+
+func (c *recordingContentCopier) CopyTemplateToEvent(ctx context.Context, templateID, eventID string) error {
+	c.source, c.target = templateID, eventID
+	return nil
+}
+
+func (c *recordingContentCopier) CopyEvent(ctx context.Context, sourceEventID, eventID string) error {
+	c.source, c.target = sourceEventID, eventID
+	return nil
+}
+
+func TestCopyEvent(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 7, 4, 10, 0, 0, 0, time.UTC)
+	opens := start.Add(-14 * 24 * time.Hour)
+	cancelBy := start.Add(-48 * time.Hour)
+	parent := "series"
+	slug := "park-cleanup"
+	source := &Event{
+		ID:            "source",
+		Title:         "Park Cleanup",
+		OrganizerID:   "organizer",
+		Status:        EventStatusCompleted,
+		StartTime:     start,
+		EndTime:       start.Add(3 * time.Hour),
+		ParentEventID: &parent,
+		Slug:          &slug,
+		Capacity:      EventCapacity{Maximum: 10, Current: 8},
+		Requirements: EventRequirements{
+			Skills: []SkillRequirement{{ID: "skill-row", EventID: "source", Skill: "First aid", Required: true}},
+		},
+		Images: []EventImage{{ID: "image", EventID: "source", FileID: "file", IsPrimary: true}},
+		RegistrationSettings: RegistrationSettings{
+			OpensAt:              &opens,
+			ClosesAt:             start.Add(-24 * time.Hour),
+			CancellationDeadline: &cancelBy,
+		},
+	}
+	shifts := []*EventShift{{ID: "shift", EventID: "source", Name: "Morning", StartTime: start, EndTime: start.Add(time.Hour)}}
+
+	newStart := start.Add(28 * 24 * time.Hour)
+	evt, copied := copyEvent(source, shifts, "copier", newStart, now)
+
+	assert.NotEqual(t, "source", evt.ID)
+	assert.Equal(t, "copier", evt.OrganizerID)
+	assert.Equal(t, EventStatusDraft, evt.Status)
+	assert.Nil(t, evt.ParentEventID, "a copy stands on its own")
+	assert.Nil(t, evt.Slug)
+	assert.Equal(t, 0, evt.Capacity.Current)
+	assert.Equal(t, 10, evt.Capacity.Maximum)
+
+	assert.Equal(t, newStart, evt.StartTime)
+	assert.Equal(t, newStart.Add(3*time.Hour), evt.EndTime)
+	require.NotNil(t, evt.RegistrationSettings.OpensAt)
+	assert.Equal(t, newStart.Add(-14*24*time.Hour), *evt.RegistrationSettings.OpensAt)
+	assert.Equal(t, newStart.Add(-24*time.Hour), evt.RegistrationSettings.ClosesAt)
+	assert.Equal(t, newStart.Add(-48*time.Hour), *evt.RegistrationSettings.CancellationDeadline)
+
+	require.Len(t, evt.Requirements.Skills, 1)
+	assert.Empty(t, evt.Requirements.Skills[0].ID)
+	assert.Equal(t, "skill-row", source.Requirements.Skills[0].ID, "the source is left alone")
+	require.Len(t, evt.Images, 1)
+	assert.Equal(t, evt.ID, evt.Images[0].EventID)
+	assert.Equal(t, "file", evt.Images[0].FileID)
+
+	require.Len(t, copied, 1)
+	assert.NotEqual(t, "shift", copied[0].ID)
+	assert.Equal(t, evt.ID, copied[0].EventID)
+	assert.Equal(t, newStart, copied[0].StartTime)
+
+	soon := now.Add(5 * 24 * time.Hour)
+	evt, _ = copyEvent(source, nil, "copier", soon, now)
+	assert.Nil(t, evt.RegistrationSettings.OpensAt, "an opening already passed means registration is open")
+	assert.Equal(t, soon.Add(-24*time.Hour), evt.RegistrationSettings.ClosesAt)
+}
+
+func TestEventService_DuplicateEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copies the event and its content to the new date", func(t *testing.T) {
+		service, repo := createTestEventService()
+		copier := &recordingContentCopier{}
+		service.SetContentCopier(copier)
+		source := publishedEvent()
+		newStart := source.StartTime.Add(7 * 24 * time.Hour)
+
+		repo.On("GetByID", ctx, "event123").Return(source, nil).Once()
+		repo.On("GetShifts", ctx, "event123").Return([]*EventShift{}, nil).Once()
+		expectActiveTerms(repo, ctx)
+		repo.On("GenerateUniqueSlug", ctx, "Park Cleanup").Return("park-cleanup-1", nil).Once()
+		repo.On("Create", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+
+		evt, err := service.DuplicateEvent(ctx, "organizer123", "event123", newStart)
+		require.NoError(t, err)
+		assert.Equal(t, EventStatusDraft, evt.Status)
+		assert.Equal(t, newStart, evt.StartTime)
+		assert.Equal(t, newStart.Add(-24*time.Hour), evt.RegistrationSettings.ClosesAt)
+		assert.Equal(t, "park-cleanup-1", *evt.Slug)
+		assert.Equal(t, "event123", copier.source)
+		assert.Equal(t, evt.ID, copier.target)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a start that leaves registration closed", func(t *testing.T) {
+		service, repo := createTestEventService()
+		source := publishedEvent()
+
+		repo.On("GetByID", ctx, "event123").Return(source, nil).Once()
+		repo.On("GetShifts", ctx, "event123").Return([]*EventShift{}, nil).Once()
+
+		_, err := service.DuplicateEvent(ctx, "organizer123", "event123", time.Now().UTC().Add(time.Hour))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "registration close time cannot be in the past")
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEventService_CreateEventFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	source := publishedEvent()
+	template := &EventTemplate{ID: "template1", OrganizerID: "organizer123", Event: templateEvent(source)}
+
+	t.Run("only the owner or their organization may use a template", func(t *testing.T) {
+		service, repo := createTestEventService()
+		repo.On("GetTemplate", ctx, "template1").Return(template, nil).Once()
+
+		_, err := service.CreateEventFromTemplate(ctx, "someone-else", "template1", source.StartTime)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("creates a draft with the template's content", func(t *testing.T) {
+		service, repo := createTestEventService()
+		copier := &recordingContentCopier{}
+		service.SetContentCopier(copier)
+		newStart := source.StartTime.Add(30 * 24 * time.Hour)
+
+		repo.On("GetTemplate", ctx, "template1").Return(template, nil).Once()
+		expectActiveTerms(repo, ctx)
+		repo.On("GenerateUniqueSlug", ctx, "Park Cleanup").Return("park-cleanup", nil).Once()
+		repo.On("Create", ctx, mock.AnythingOfType("*event.Event")).Return(nil).Once()
+
+		evt, err := service.CreateEventFromTemplate(ctx, "organizer123", "template1", newStart)
+		require.NoError(t, err)
+		assert.Equal(t, "organizer123", evt.OrganizerID)
+		assert.Equal(t, newStart.Add(3*time.Hour), evt.EndTime)
+		assert.Equal(t, "template1", copier.source)
+		assert.Equal(t, evt.ID, copier.target)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,233 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxOccurrenceScan bounds how many recurrence periods are examined, so a
+// rule that rarely or never matches (the 31st, every other February) stops
+const maxOccurrenceScan = 5000
+
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+// zoneRegion maps a latitude/longitude box to the zone most of it observes
+type zoneRegion struct {
+	minLat, maxLat float64
+	minLng, maxLng float64
+	zone           string
+}
+
+// zoneRegions is a coarse lookup for where events are mostly held. The first
+// box containing a point wins, so exceptions come before the zones around
+// them: Maine before the Maritimes, the Alaska panhandle before British
+// Columbia, the Yukon before Alaska and northern Mexico, which has no DST,
+// before the US. Near a border the guess can be wrong, or name a neighbour
+// with the same rules (Toronto gets America/New_York); organizers can always
+// pass the zone explicitly, and anywhere outside these boxes they have to.
+var zoneRegions = []zoneRegion{
+	{18.5, 22.5, -160.5, -154.5, "Pacific/Honolulu"},
+	{31.3, 37, -114.82, -109.05, "America/Phoenix"},
+	{54.6, 56.5, -136, -130, "America/Juneau"},
+	{56.5, 58.5, -137, -132.5, "America/Juneau"},
+	{58.5, 60, -139, -135, "America/Juneau"},
+	{60, 62, -141, -124, "America/Whitehorse"},
+	{62, 70, -141, -133, "America/Whitehorse"},
+	{49, 60, -134, -120, "America/Vancouver"},
+	{51, 72, -180, -129.9, "America/Anchorage"},
+	{49, 60, -120, -110, "America/Edmonton"},
+	{49, 60, -110, -101.5, "America/Regina"},
+	{49, 60, -101.5, -95, "America/Winnipeg"},
+	{46.5, 52, -59.5, -52.5, "America/St_Johns"},
+	{43, 47.1, -71.1, -67.8, "America/New_York"},
+	{44.3, 45, -67.8, -66.95, "America/New_York"},
+	{43, 48.1, -69, -59.5, "America/Halifax"},
+	{24, 27.2, -102, -99.6, "America/Monterrey"},
+	{24, 25.9, -99.6, -99, "America/Monterrey"},
+	{24, 49, -125, -114.5, "America/Los_Angeles"},
+	{31, 49, -114.5, -102, "America/Denver"},
+	{25, 49, -102, -86.5, "America/Chicago"},
+	{24, 47.5, -86.5, -66.9, "America/New_York"},
+	{47.5, 52, -79.5, -63.5, "America/Toronto"},
+	{54, 55.4, -8.2, -5.4, "Europe/London"},
+	{51.3, 55.5, -10.7, -5.9, "Europe/Dublin"},
+	{49.8, 61, -8.7, 1.8, "Europe/London"},
+}
+
+// timeZoneAt looks up the zone at a point in zoneRegions
+func timeZoneAt(c Coordinates) (string, bool) {
+	for _, r := range zoneRegions {
+		if c.Latitude >= r.minLat && c.Latitude <= r.maxLat && c.Longitude >= r.minLng && c.Longitude <= r.maxLng {
+			return r.zone, true
+		}
+	}
+	return "", false
+}
+
+// resolveTimeZone picks an event's zone: the one given explicitly, else the
+// one at its coordinates, else the one it already has
+func resolveTimeZone(explicit *string, coords *Coordinates, current string) (string, error) {
+	if explicit != nil && strings.TrimSpace(*explicit) != "" {
+		name := strings.TrimSpace(*explicit)
+		// "Local" would mean wherever the server runs
+		if name == "Local" {
+			return "", fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+		}
+		if _, err := time.LoadLocation(name); err != nil {
+			return "", fmt.Errorf("%w: %q is not an IANA time zone", ErrInvalidTimeZone, name)
+		}
+		return name, nil
+	}
+	if coords != nil {
+		if zone, ok := timeZoneAt(*coords); ok {
+			return zone, nil
+		}
+	}
+	return current, nil
+}
+
+// LocalZone is the event's time zone, or nil when it isn't known
+func (e *Event) LocalZone() *time.Location {
+	if e.Location.TimeZone == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(e.Location.TimeZone)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+// InLocalZone returns t in the event's time zone, or in UTC when it isn't
+// known, for showing to people
+func (e *Event) InLocalZone(t time.Time) time.Time {
+	if loc := e.LocalZone(); loc != nil {
+		return t.In(loc)
+	}
+	return t.UTC()
+}
+
+// Occurrence is one date of an event, with times in the event's zone
+type Occurrence struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Occurrences returns up to limit dates of the event that haven't ended by
+// after. A recurrence rule is evaluated in the event's local time, so a
+// Saturday 9am event stays at 9am on both sides of a DST change; events with
+// an unknown zone are evaluated in UTC. Every date lasts as long as the
+// first, and the first date counts towards OccurrenceCount.
+func (e *Event) Occurrences(after time.Time, limit int) []Occurrence {
+	if limit <= 0 {
+		return nil
+	}
+	loc := e.LocalZone()
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := e.StartTime.In(loc)
+	duration := e.EndTime.Sub(e.StartTime)
+
+	out := []Occurrence{}
+	rule := e.RecurrenceRule
+	if rule == nil {
+		if e.EndTime.After(after) {
+			out = append(out, Occurrence{StartTime: start, EndTime: start.Add(duration)})
+		}
+		return out
+	}
+
+	var lastDay time.Time
+	if rule.EndDate != nil {
+		y, m, d := rule.EndDate.In(loc).Date()
+		lastDay = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	}
+	count := 0
+	// emit records a candidate date and reports whether to keep going
+	emit := func(t time.Time) bool {
+		if t.Before(start) {
+			return true
+		}
+		if rule.EndDate != nil && !t.Before(lastDay) {
+			return false
+		}
+		count++
+		if rule.OccurrenceCount != nil && count > *rule.OccurrenceCount {
+			return false
+		}
+		if end := t.Add(duration); end.After(after) {
+			out = append(out, Occurrence{StartTime: t, EndTime: end})
+		}
+		return len(out) < limit
+	}
+
+	interval := max(rule.Interval, 1)
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hh, mm, ss, start.Nanosecond(), loc)
+	}
+	// Weekly rules run on the listed days, or else the first date's weekday
+	monday := d - (int(start.Weekday())+6)%7
+	offsets := weekdayOffsets(rule.DaysOfWeek, start.Weekday())
+
+	for i := 0; i < maxOccurrenceScan; i++ {
+		switch rule.Frequency {
+		case RecurrenceFrequencyDaily:
+			if !emit(at(y, m, d+i*interval)) {
+				return out
+			}
+		case RecurrenceFrequencyMonthly:
+			day := d
+			if rule.DayOfMonth != nil {
+				day = *rule.DayOfMonth
+			}
+			// Months without the day are skipped rather than rolled over
+			if t := at(y, m+time.Month(i*interval), day); t.Day() == day && !emit(t) {
+				return out
+			}
+		case RecurrenceFrequencyYearly:
+			if t := at(y+i*interval, m, d); t.Day() == d && !emit(t) {
+				return out
+			}
+		default:
+			for _, offset := range offsets {
+				if !emit(at(y, m, monday+i*7*interval+offset)) {
+					return out
+				}
+			}
+		}
+	}
+	return out
+}
+
+// weekdayOffsets turns days of the week into sorted offsets from Monday
+func weekdayOffsets(days []DayOfWeek, fallback time.Weekday) []int {
+	weekdays := map[DayOfWeek]time.Weekday{
+		DayOfWeekMonday:    time.Monday,
+		DayOfWeekTuesday:   time.Tuesday,
+		DayOfWeekWednesday: time.Wednesday,
+		DayOfWeekThursday:  time.Thursday,
+		DayOfWeekFriday:    time.Friday,
+		DayOfWeekSaturday:  time.Saturday,
+		DayOfWeekSunday:    time.Sunday,
+	}
+	offsets := []int{}
+	for _, day := range days {
+		if wd, ok := weekdays[day]; ok {
+			offset := (int(wd) + 6) % 7
+			if !slices.Contains(offsets, offset) {
+				offsets = append(offsets, offset)
+			}
+		}
+	}
+	if len(offsets) == 0 {
+		offsets = append(offsets, (int(fallback)+6)%7)
+	}
+	slices.Sort(offsets)
+	return offsets
+}
@@ -0,0 +1,156 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTimeZone(t *testing.T) {
+	chicago := &Coordinates{Latitude: 41.88, Longitude: -87.63}
+	explicit := "America/Detroit"
+
+	zone, err := resolveTimeZone(&explicit, chicago, "")
+	require.NoError(t, err)
+	assert.Equal(t, "America/Detroit", zone, "an explicit zone wins over coordinates")
+
+	zone, err = resolveTimeZone(nil, chicago, "")
+	require.NoError(t, err)
+	assert.Equal(t, "America/Chicago", zone)
+
+	zone, err = resolveTimeZone(nil, &Coordinates{Latitude: 33.45, Longitude: -112.07}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "America/Phoenix", zone, "Arizona doesn't observe DST")
+
+	zone, err = resolveTimeZone(nil, &Coordinates{Latitude: -33.87, Longitude: 151.21}, "Australia/Sydney")
+	require.NoError(t, err)
+	assert.Equal(t, "Australia/Sydney", zone, "outside the lookup the current zone stays")
+
+	zone, err = resolveTimeZone(nil, nil, "")
+	require.NoError(t, err)
+	assert.Empty(t, zone)
+
+	for _, bad := range []string{"Central Time", "Local"} {
+		_, err = resolveTimeZone(&bad, nil, "")
+		assert.ErrorIs(t, err, ErrInvalidTimeZone, bad)
+	}
+}
+
+func TestTimeZoneAt(t *testing.T) {
+	tests := []struct {
+		place    string
+		lat, lng float64
+		zone     string
+	}{
+		{"Bangor", 44.8, -68.77, "America/New_York"},
+		{"Bar Harbor", 44.39, -68.2, "America/New_York"},
+		{"Machias", 44.72, -67.46, "America/New_York"},
+		{"Moncton", 46.09, -64.78, "America/Halifax"},
+		{"Gaspé", 48.83, -64.48, "America/Toronto"},
+		{"Whitehorse", 60.72, -135.06, "America/Whitehorse"},
+		{"Dawson City", 64.06, -139.43, "America/Whitehorse"},
+		{"Ketchikan", 55.34, -131.65, "America/Juneau"},
+		{"Juneau", 58.3, -134.42, "America/Juneau"},
+		{"Prince Rupert", 54.31, -130.32, "America/Vancouver"},
+		{"Anchorage", 61.22, -149.9, "America/Anchorage"},
+		{"Monterrey", 25.67, -100.31, "America/Monterrey"},
+		{"Brownsville", 25.9, -97.5, "America/Chicago"},
+		{"Laredo", 27.5, -99.5, "America/Chicago"},
+	}
+	for _, tt := range tests {
+		zone, ok := timeZoneAt(Coordinates{Latitude: tt.lat, Longitude: tt.lng})
+		assert.True(t, ok, tt.place)
+		assert.Equal(t, tt.zone, zone, tt.place)
+	}
+}
+
+func TestEvent_Occurrences(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	// Saturday 9am, the day before DST starts
+	start := time.Date(2026, 3, 7, 9, 0, 0, 0, chicago)
+	weekly := func(rule RecurrenceRule) *Event {
+		return &Event{
+			StartTime:      start.UTC(),
+			EndTime:        start.Add(3 * time.Hour).UTC(),
+			Location:       EventLocation{TimeZone: "America/Chicago"},
+			RecurrenceRule: &rule,
+		}
+	}
+
+	t.Run("wall-clock time holds across DST", func(t *testing.T) {
+		evt := weekly(RecurrenceRule{Frequency: RecurrenceFrequencyWeekly, Interval: 1})
+		dates := evt.Occurrences(start.Add(-time.Hour), 3)
+		require.Len(t, dates, 3)
+		for _, o := range dates {
+			assert.Equal(t, 9, o.StartTime.Hour())
+			assert.Equal(t, time.Saturday, o.StartTime.Weekday())
+			assert.Equal(t, 3*time.Hour, o.EndTime.Sub(o.StartTime))
+		}
+		assert.Equal(t, 15, dates[0].StartTime.UTC().Hour())
+		assert.Equal(t, 14, dates[1].StartTime.UTC().Hour(), "9am CDT is an hour earlier in UTC")
+	})
+
+	t.Run("without a zone rules run in UTC", func(t *testing.T) {
+		evt := weekly(RecurrenceRule{Frequency: RecurrenceFrequencyWeekly, Interval: 1})
+		evt.Location.TimeZone = ""
+		dates := evt.Occurrences(start.Add(-time.Hour), 2)
+		require.Len(t, dates, 2)
+		assert.Equal(t, 15, dates[1].StartTime.Hour())
+	})
+
+	t.Run("listed days, interval and count", func(t *testing.T) {
+		count := 4
+		evt := weekly(RecurrenceRule{
+			Frequency:       RecurrenceFrequencyWeekly,
+			Interval:        2,
+			DaysOfWeek:      []DayOfWeek{DayOfWeekSaturday, DayOfWeekWednesday},
+			OccurrenceCount: &count,
+		})
+		dates := evt.Occurrences(start.Add(-time.Hour), 10)
+		require.Len(t, dates, 4)
+		got := []string{}
+		for _, o := range dates {
+			got = append(got, o.StartTime.Format("Mon 01-02"))
+		}
+		assert.Equal(t, []string{"Sat 03-07", "Wed 03-18", "Sat 03-21", "Wed 04-01"}, got,
+			"the Wednesday before the first date doesn't count")
+	})
+
+	t.Run("only dates that haven't ended", func(t *testing.T) {
+		evt := weekly(RecurrenceRule{Frequency: RecurrenceFrequencyDaily, Interval: 1})
+		dates := evt.Occurrences(start.Add(2*24*time.Hour+time.Hour), 2)
+		require.Len(t, dates, 2)
+		assert.Equal(t, 9, dates[0].StartTime.Day(), "the date underway is still listed")
+	})
+
+	t.Run("end date is inclusive", func(t *testing.T) {
+		end := time.Date(2026, 3, 21, 0, 0, 0, 0, chicago)
+		evt := weekly(RecurrenceRule{Frequency: RecurrenceFrequencyWeekly, Interval: 1, EndDate: &end})
+		assert.Len(t, evt.Occurrences(start.Add(-time.Hour), 10), 3)
+	})
+
+	t.Run("months without the day are skipped", func(t *testing.T) {
+		day := 31
+		evt := &Event{
+			StartTime:      time.Date(2026, 1, 31, 10, 0, 0, 0, chicago),
+			EndTime:        time.Date(2026, 1, 31, 12, 0, 0, 0, chicago),
+			Location:       EventLocation{TimeZone: "America/Chicago"},
+			RecurrenceRule: &RecurrenceRule{Frequency: RecurrenceFrequencyMonthly, Interval: 1, DayOfMonth: &day},
+		}
+		dates := evt.Occurrences(evt.StartTime.Add(-time.Hour), 3)
+		require.Len(t, dates, 3)
+		assert.Equal(t, time.January, dates[0].StartTime.Month())
+		assert.Equal(t, time.March, dates[1].StartTime.Month())
+		assert.Equal(t, time.May, dates[2].StartTime.Month())
+	})
+
+	t.Run("one-off events are their own date", func(t *testing.T) {
+		evt := weekly(RecurrenceRule{})
+		evt.RecurrenceRule = nil
+		assert.Len(t, evt.Occurrences(start.Add(-time.Hour), 5), 1)
+		assert.Empty(t, evt.Occurrences(start.Add(4*time.Hour), 5))
+	})
+}
@@ -0,0 +1,152 @@
+package hours
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// certificateRowLimit keeps the activity table on a single landscape page;
+// longer ranges are summarised in a final row
+const certificateRowLimit = 12
+
+// CertificatePDFPath is where the HTTP API serves a certificate's PDF to its owner
+func CertificatePDFPath(code string) string {
+	return "/certificates/" + code + "/pdf"
+}
+
+// CertificateVerifyPath is the public, login-free verification endpoint for a code
+func CertificateVerifyPath(code string) string {
+	return "/certificates/verify/" + code
+}
+
+// RenderCertificatePDF lays a certificate out as a one-page landscape PDF.
+// verifyURL is printed under the code so a reader can check it online.
+func RenderCertificatePDF(cert *Certificate, verifyURL string) ([]byte, error) {
+	pdf := fpdf.New("L", "mm", "Letter", "")
+	pdf.SetTitle("Certificate of Volunteer Service", true)
+	pdf.SetCreationDate(cert.IssuedAt)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+	// Core fonts are cp1252; translate names and titles rather than dropping accents
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pageW, pageH := pdf.GetPageSize()
+	pdf.SetDrawColor(40, 70, 120)
+	pdf.SetLineWidth(1.5)
+	pdf.Rect(10, 10, pageW-20, pageH-20, "D")
+	pdf.SetLineWidth(0.3)
+	pdf.Rect(13, 13, pageW-26, pageH-26, "D")
+
+	center := func(style string, size float64, height float64, text string) {
+		pdf.SetFont("Helvetica", style, size)
+		pdf.CellFormat(0, height, tr(text), "", 1, "C", false, 0, "")
+	}
+
+	pdf.SetY(26)
+	pdf.SetTextColor(40, 70, 120)
+	center("B", 28, 13, "Certificate of Volunteer Service")
+	pdf.SetTextColor(0, 0, 0)
+	center("", 13, 10, "This certifies that")
+	center("B", 24, 13, cert.VolunteerName)
+	center("", 13, 8, fmt.Sprintf("completed %s hours of volunteer service", formatHours(cert.TotalHours)))
+	center("", 13, 8, certificateScopeText(cert))
+
+	// Activity table
+	cols := []struct {
+		title string
+		width float64
+		align string
+	}{
+		{"Date", 28, "L"},
+		{"Activity", 80, "L"},
+		{"Organization", 60, "L"},
+		{"Location", 52, "L"},
+		{"Hours", 20, "R"},
+	}
+	tableW := 0.0
+	for _, c := range cols {
+		tableW += c.width
+	}
+	left := (pageW - tableW) / 2
+
+	pdf.SetY(pdf.GetY() + 6)
+	pdf.SetX(left)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(230, 236, 245)
+	for _, c := range cols {
+		pdf.CellFormat(c.width, 7, c.title, "B", 0, c.align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 10)
+	lines := cert.Lines
+	var overflow []CertificateLine
+	if len(lines) > certificateRowLimit {
+		lines, overflow = lines[:certificateRowLimit-1], lines[certificateRowLimit-1:]
+	}
+	row := func(values ...string) {
+		pdf.SetX(left)
+		for i, c := range cols {
+			pdf.CellFormat(c.width, 6.5, tr(truncateToWidth(pdf, values[i], c.width-2)), "", 0, c.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	for _, l := range lines {
+		row(l.Date.Format("Jan 2, 2006"), l.Title, l.Organization, l.Location, formatHours(l.Hours))
+	}
+	if len(overflow) > 0 {
+		rest := 0.0
+		for _, l := range overflow {
+			rest += l.Hours
+		}
+		row("", fmt.Sprintf("and %d more activities", len(overflow)), "", "", formatHours(roundHours(rest)))
+	}
+	pdf.SetX(left)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(tableW-cols[len(cols)-1].width, 7, "Total", "T", 0, "R", false, 0, "")
+	pdf.CellFormat(cols[len(cols)-1].width, 7, formatHours(cert.TotalHours), "T", 1, "R", false, 0, "")
+
+	// Verification footer
+	pdf.SetY(pageH - 40)
+	center("B", 11, 6, "Verification code: "+FormatCertificateCode(cert.Code))
+	center("", 9, 5, "Verify this certificate at "+verifyURL)
+	center("", 9, 5, "Issued "+cert.IssuedAt.Format("January 2, 2006")+" by VolunteerSync")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render certificate: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func certificateScopeText(cert *Certificate) string {
+	if cert.Scope == ScopeEvent && len(cert.Lines) == 1 {
+		return fmt.Sprintf("at %s on %s", cert.Lines[0].Title, cert.Lines[0].Date.Format("January 2, 2006"))
+	}
+	if cert.RangeStart != nil && cert.RangeEnd != nil {
+		// The range end is exclusive; print the last day it covers
+		return fmt.Sprintf("between %s and %s", cert.RangeStart.Format("January 2, 2006"), cert.RangeEnd.Add(-time.Nanosecond).Format("January 2, 2006"))
+	}
+	return ""
+}
+
+// truncateToWidth shortens text with an ellipsis so it fits a table cell
+func truncateToWidth(pdf *fpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// formatHours prints hours without trailing zeros, e.g. 2.5 or 3
+func formatHours(h float64) string {
+	return strconv.FormatFloat(roundHours(h), 'f', -1, 64)
+}
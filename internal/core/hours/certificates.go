@@ -0,0 +1,281 @@
+package hours
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/organization"
+	"github.com/volunteersync/backend/internal/core/user"
+)
+
+var (
+	ErrCertificateNotFound = errors.New("certificate not found")
+	ErrNothingToCertify    = errors.New("no approved hours to certify")
+)
+
+// CertificateScope is what a certificate covers
+type CertificateScope string
+
+const (
+	ScopeEvent CertificateScope = "EVENT"
+	ScopeRange CertificateScope = "RANGE"
+)
+
+// Certificate is a snapshot of approved hours, verifiable by its code. Names
+// and titles are copied at issue time so later edits don't change a printed
+// certificate's meaning.
+type Certificate struct {
+	ID            string            `json:"id"`
+	Code          string            `json:"code"`
+	UserID        string            `json:"userId"`
+	VolunteerName string            `json:"volunteerName"`
+	Scope         CertificateScope  `json:"scope"`
+	EventID       *string           `json:"eventId,omitempty"`
+	RangeStart    *time.Time        `json:"rangeStart,omitempty"`
+	RangeEnd      *time.Time        `json:"rangeEnd,omitempty"`
+	TotalHours    float64           `json:"totalHours"`
+	Lines         []CertificateLine `json:"lines"`
+	IssuedAt      time.Time         `json:"issuedAt"`
+}
+
+// CertificateLine is one event or off-platform activity on a certificate
+type CertificateLine struct {
+	EventID      *string   `json:"eventId,omitempty"`
+	Title        string    `json:"title"`
+	Organization string    `json:"organization"`
+	Location     string    `json:"location,omitempty"`
+	Date         time.Time `json:"date"`
+	Hours        float64   `json:"hours"`
+}
+
+// OrganizationLookup resolves organization names for certificates
+type OrganizationLookup interface {
+	GetOrganization(ctx context.Context, id string) (*organization.Organization, error)
+}
+
+// ProfileLookup resolves volunteer and organizer names for certificates
+type ProfileLookup interface {
+	GetProfile(ctx context.Context, userID, requesterID string, requesterRoles []string) (*user.UserProfile, error)
+}
+
+// CertificateService issues and verifies service-hour certificates from the ledger
+type CertificateService struct {
+	repo     Repository
+	events   EventAccess
+	orgs     OrganizationLookup
+	profiles ProfileLookup
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewCertificateService creates a new certificate service.
+func NewCertificateService(repo Repository, events EventAccess, orgs OrganizationLookup, profiles ProfileLookup, logger *slog.Logger) *CertificateService {
+	if repo == nil {
+		panic("hours repository is required")
+	}
+	if events == nil || orgs == nil || profiles == nil {
+		panic("event, organization and profile lookups are required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CertificateService{repo: repo, events: events, orgs: orgs, profiles: profiles, logger: logger, now: time.Now}
+}
+
+// IssueEventCertificate certifies the caller's approved hours for one event
+func (s *CertificateService) IssueEventCertificate(ctx context.Context, userID, eventID string) (*Certificate, error) {
+	approved := StatusApproved
+	entries, err := s.repo.ListUserEntries(ctx, userID, EntryFilter{EventID: &eventID, Status: &approved})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load hours: %w", err)
+	}
+	return s.issue(ctx, userID, &Certificate{Scope: ScopeEvent, EventID: &eventID}, entries)
+}
+
+// IssueRangeCertificate certifies all the caller's approved hours with an activity date in rng
+func (s *CertificateService) IssueRangeCertificate(ctx context.Context, userID string, rng DateRange) (*Certificate, error) {
+	if rng.Start == nil || rng.End == nil || !rng.End.After(*rng.Start) {
+		return nil, fmt.Errorf("%w: a certificate range needs a start before its end", ErrInvalidInput)
+	}
+	approved := StatusApproved
+	entries, err := s.repo.ListUserEntries(ctx, userID, EntryFilter{Range: rng, Status: &approved})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load hours: %w", err)
+	}
+	return s.issue(ctx, userID, &Certificate{Scope: ScopeRange, RangeStart: rng.Start, RangeEnd: rng.End}, entries)
+}
+
+// GetCertificate returns one of the caller's own certificates by code
+func (s *CertificateService) GetCertificate(ctx context.Context, userID, code string) (*Certificate, error) {
+	cert, err := s.Verify(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if cert.UserID != userID {
+		return nil, ErrCertificateNotFound
+	}
+	return cert, nil
+}
+
+// ListCertificates returns the caller's certificates, newest first
+func (s *CertificateService) ListCertificates(ctx context.Context, userID string) ([]*Certificate, error) {
+	return s.repo.ListCertificates(ctx, userID)
+}
+
+// Verify looks a certificate up by its printed code. It needs no login: the
+// code itself is the proof of possession.
+func (s *CertificateService) Verify(ctx context.Context, code string) (*Certificate, error) {
+	normalized := NormalizeCertificateCode(code)
+	if len(normalized) != certificateCodeLength {
+		return nil, ErrCertificateNotFound
+	}
+	return s.repo.GetCertificateByCode(ctx, normalized)
+}
+
+func (s *CertificateService) issue(ctx context.Context, userID string, cert *Certificate, entries []*Entry) (*Certificate, error) {
+	lines, err := s.buildLines(ctx, userID, entries)
+	if err != nil {
+		return nil, err
+	}
+	total := 0.0
+	for _, l := range lines {
+		total += l.Hours
+	}
+	if len(lines) == 0 || total <= 0 {
+		return nil, ErrNothingToCertify
+	}
+
+	volunteer, err := s.profiles.GetProfile(ctx, userID, userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load volunteer profile: %w", err)
+	}
+
+	code, err := newCertificateCode()
+	if err != nil {
+		return nil, err
+	}
+	cert.ID = uuid.New().String()
+	cert.Code = code
+	cert.UserID = userID
+	cert.VolunteerName = volunteer.Name
+	cert.TotalHours = roundHours(total)
+	cert.Lines = lines
+	cert.IssuedAt = s.now().UTC().Truncate(time.Second)
+
+	if err := s.repo.CreateCertificate(ctx, cert); err != nil {
+		return nil, fmt.Errorf("failed to issue certificate: %w", err)
+	}
+	s.logger.Info("hours certificate issued", "userID", userID, "scope", cert.Scope, "hours", cert.TotalHours)
+	return cert, nil
+}
+
+// buildLines folds ledger entries into one line per event, adjustments
+// included, plus one line per approved off-platform submission
+func (s *CertificateService) buildLines(ctx context.Context, userID string, entries []*Entry) ([]CertificateLine, error) {
+	byEvent := map[string]*CertificateLine{}
+	var lines []*CertificateLine
+	for _, e := range entries {
+		if e.EventID == nil {
+			org := s.organizationName(ctx, e.OrganizationID)
+			lines = append(lines, &CertificateLine{Title: derefOr(e.Description, e.Category), Organization: org, Date: e.ActivityDate, Hours: e.Hours})
+			continue
+		}
+		if line, ok := byEvent[*e.EventID]; ok {
+			line.Hours += e.Hours
+			continue
+		}
+
+		evt, err := s.events.GetEvent(ctx, *e.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load event %s: %w", *e.EventID, err)
+		}
+		org := s.organizationName(ctx, evt.OrganizationID)
+		if org == "" {
+			if organizer, err := s.profiles.GetProfile(ctx, evt.OrganizerID, userID, nil); err == nil {
+				org = organizer.Name
+			}
+		}
+		location := "Remote"
+		if !evt.Location.IsRemote {
+			location = strings.TrimSpace(strings.Join(nonEmpty(evt.Location.Name, evt.Location.City, evt.Location.Country), ", "))
+		}
+		line := &CertificateLine{EventID: e.EventID, Title: evt.Title, Organization: org, Location: location, Date: evt.StartTime, Hours: e.Hours}
+		byEvent[*e.EventID] = line
+		lines = append(lines, line)
+	}
+
+	out := make([]CertificateLine, 0, len(lines))
+	for _, l := range lines {
+		if l.Hours = roundHours(l.Hours); l.Hours > 0 {
+			out = append(out, *l)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out, nil
+}
+
+func (s *CertificateService) organizationName(ctx context.Context, id *string) string {
+	if id == nil {
+		return ""
+	}
+	org, err := s.orgs.GetOrganization(ctx, *id)
+	if err != nil {
+		s.logger.Warn("certificate organization lookup failed", "organizationID", *id, "error", err)
+		return ""
+	}
+	return org.Name
+}
+
+// certificateCodeLength is the number of significant characters in a code;
+// 12 base32 characters carry 60 bits, far beyond what can be guessed online
+const certificateCodeLength = 12
+
+// newCertificateCode returns a random code like "K7QX-M2PD-9F3A" without the dashes
+func newCertificateCode() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate certificate code: %w", err)
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf)[:certificateCodeLength], nil
+}
+
+// NormalizeCertificateCode strips the grouping a reader may type and upper-cases the code
+func NormalizeCertificateCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+}
+
+// FormatCertificateCode groups a code in fours for printing
+func FormatCertificateCode(code string) string {
+	var parts []string
+	for len(code) > 4 {
+		parts = append(parts, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(parts, code), "-")
+}
+
+func derefOr(s *string, fallback string) string {
+	if s == nil || *s == "" {
+		return fallback
+	}
+	return *s
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+package hours
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/organization"
+	"github.com/volunteersync/backend/internal/core/user"
+)
+
+type fakeOrgs map[string]string
+
+func (f fakeOrgs) GetOrganization(ctx context.Context, id string) (*organization.Organization, error) {
+	name, ok := f[id]
+	if !ok {
+		return nil, organization.ErrOrganizationNotFound
+	}
+	return &organization.Organization{ID: id, Name: name}, nil
+}
+
+type fakeProfiles map[string]string
+
+func (f fakeProfiles) GetProfile(ctx context.Context, userID, requesterID string, requesterRoles []string) (*user.UserProfile, error) {
+	return &user.UserProfile{ID: userID, Name: f[userID]}, nil
+}
+
+func setupCertificates() (*CertificateService, *Service, *fakeRepo) {
+	svc, repo := setupService()
+	certs := NewCertificateService(repo, &fakeEvents{organizerID: "organizer"},
+		fakeOrgs{"org1": "Riverside Food Bank"}, fakeProfiles{"user1": "Ana Müller", "organizer": "Pat Organizer"}, nil)
+	return certs, svc, repo
+}
+
+func TestCertificateCode(t *testing.T) {
+	code, err := newCertificateCode()
+	require.NoError(t, err)
+	assert.Len(t, code, certificateCodeLength)
+
+	formatted := FormatCertificateCode(code)
+	assert.Len(t, formatted, certificateCodeLength+2)
+	assert.Equal(t, code, NormalizeCertificateCode(formatted))
+	assert.Equal(t, "ABCDEFGHJKMN", NormalizeCertificateCode(" abcd-efgh jkmn "))
+}
+
+func TestCertificateService_Issue(t *testing.T) {
+	certs, svc, _ := setupCertificates()
+	ctx := context.Background()
+	date := time.Date(2026, 5, 2, 9, 0, 0, 0, time.UTC)
+
+	_, err := certs.IssueEventCertificate(ctx, "user1", "event1")
+	assert.ErrorIs(t, err, ErrNothingToCertify)
+
+	require.NoError(t, svc.CreditAttendance(ctx, AttendanceCredit{UserID: "user1", EventID: "event1", RegistrationID: "reg1", Category: "EDUCATION", Hours: 3, Date: date}))
+	_, err = svc.AdjustHours(ctx, "organizer", mustOnlyEntry(t, svc, "user1").ID, 0.5, "stayed to clean up")
+	require.NoError(t, err)
+
+	cert, err := certs.IssueEventCertificate(ctx, "user1", "event1")
+	require.NoError(t, err)
+	assert.Equal(t, ScopeEvent, cert.Scope)
+	assert.Equal(t, "Ana Müller", cert.VolunteerName)
+	assert.Equal(t, 3.5, cert.TotalHours)
+	require.Len(t, cert.Lines, 1, "adjustments fold into the event's line")
+	assert.Equal(t, "Pat Organizer", cert.Lines[0].Organization, "falls back to the organizer without an organization")
+
+	t.Run("verify by code", func(t *testing.T) {
+		found, err := certs.Verify(ctx, FormatCertificateCode(cert.Code))
+		require.NoError(t, err)
+		assert.Equal(t, cert.ID, found.ID)
+
+		_, err = certs.Verify(ctx, "NOPE")
+		assert.ErrorIs(t, err, ErrCertificateNotFound)
+	})
+
+	t.Run("only the owner can fetch the PDF", func(t *testing.T) {
+		_, err := certs.GetCertificate(ctx, "someone-else", cert.Code)
+		assert.ErrorIs(t, err, ErrCertificateNotFound)
+
+		owned, err := certs.GetCertificate(ctx, "user1", cert.Code)
+		require.NoError(t, err)
+		pdf, err := RenderCertificatePDF(owned, "https://example.org"+CertificateVerifyPath(owned.Code))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF")))
+	})
+}
+
+func TestCertificateService_IssueRange(t *testing.T) {
+	certs, svc, _ := setupCertificates()
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	_, err := certs.IssueRangeCertificate(ctx, "user1", DateRange{Start: &end, End: &start})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	entry, err := svc.SubmitExternalHours(ctx, "user1", ExternalHoursInput{OrganizationID: "org1", Hours: 2, Date: time.Now().Add(-24 * time.Hour), Category: "Food bank", Description: "Sorting donations"})
+	require.NoError(t, err)
+
+	_, err = certs.IssueRangeCertificate(ctx, "user1", DateRange{Start: &start, End: &end})
+	assert.ErrorIs(t, err, ErrNothingToCertify, "pending hours aren't certified")
+
+	_, err = svc.ReviewExternalHours(ctx, "admin", entry.ID, true, "")
+	require.NoError(t, err)
+	cert, err := certs.IssueRangeCertificate(ctx, "user1", DateRange{Start: &start, End: &end})
+	require.NoError(t, err)
+	require.Len(t, cert.Lines, 1)
+	assert.Equal(t, "Sorting donations", cert.Lines[0].Title)
+	assert.Equal(t, "Riverside Food Bank", cert.Lines[0].Organization)
+
+	list, err := certs.ListCertificates(ctx, "user1")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func mustOnlyEntry(t *testing.T, svc *Service, userID string) *Entry {
+	t.Helper()
+	entries, err := svc.repo.ListUserEntries(context.Background(), userID, EntryFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	return entries[0]
+}
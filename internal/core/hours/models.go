@@ -0,0 +1,115 @@
+package hours
+
+import (
+	"errors"
+	"time"
+)
+
+// Common errors for hours package
+var (
+	ErrEntryNotFound       = errors.New("hours entry not found")
+	ErrInvalidInput        = errors.New("invalid input")
+	ErrPermissionDenied    = errors.New("permission denied")
+	ErrNotPending          = errors.New("hours entry has already been reviewed")
+	ErrAlreadyCredited     = errors.New("attendance has already been credited")
+	ErrNotAdjustable       = errors.New("only event hours can be adjusted")
+	ErrNegativeAdjustedSum = errors.New("adjustment would leave negative hours for the event")
+)
+
+// Source records how an entry came into the ledger
+type Source string
+
+const (
+	SourceAttendance Source = "ATTENDANCE"
+	SourceAdjustment Source = "ADJUSTMENT"
+	SourceExternal   Source = "EXTERNAL"
+)
+
+// Status tracks review of an entry; only approved entries count toward totals
+type Status string
+
+const (
+	StatusPending  Status = "PENDING"
+	StatusApproved Status = "APPROVED"
+	StatusRejected Status = "REJECTED"
+)
+
+// MaxHoursPerEntry caps a single attendance credit or external submission
+const MaxHoursPerEntry = 24.0
+
+// Entry is one row of a volunteer's hours ledger
+type Entry struct {
+	ID             string     `json:"id" db:"id"`
+	UserID         string     `json:"userId" db:"user_id"`
+	EventID        *string    `json:"eventId,omitempty" db:"event_id"`
+	RegistrationID *string    `json:"registrationId,omitempty" db:"registration_id"`
+	OrganizationID *string    `json:"organizationId,omitempty" db:"organization_id"`
+	AdjustsEntryID *string    `json:"adjustsEntryId,omitempty" db:"adjusts_entry_id"`
+	Source         Source     `json:"source" db:"source"`
+	Status         Status     `json:"status" db:"status"`
+	Hours          float64    `json:"hours" db:"hours"`
+	Category       string     `json:"category" db:"category"`
+	Description    *string    `json:"description,omitempty" db:"description"`
+	Reason         *string    `json:"reason,omitempty" db:"reason"`
+	ActivityDate   time.Time  `json:"activityDate" db:"activity_date"`
+	CreatedBy      string     `json:"createdBy" db:"created_by"`
+	ReviewedBy     *string    `json:"reviewedBy,omitempty" db:"reviewed_by"`
+	ReviewedAt     *time.Time `json:"reviewedAt,omitempty" db:"reviewed_at"`
+	ReviewNotes    *string    `json:"reviewNotes,omitempty" db:"review_notes"`
+	CreatedAt      time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// AttendanceCredit is completed attendance to be added to the ledger
+type AttendanceCredit struct {
+	UserID         string
+	EventID        string
+	RegistrationID string
+	OrganizationID *string
+	Category       string
+	Hours          float64
+	Date           time.Time
+}
+
+// ExternalHoursInput is a volunteer's claim for hours worked off-platform
+type ExternalHoursInput struct {
+	OrganizationID string
+	Hours          float64
+	Date           time.Time
+	Category       string
+	Description    string
+}
+
+// DateRange bounds ledger queries by activity date; nil ends are open
+type DateRange struct {
+	Start *time.Time
+	End   *time.Time
+}
+
+// EntryFilter narrows a ledger listing
+type EntryFilter struct {
+	Range          DateRange
+	EventID        *string
+	RegistrationID *string
+	Status         *Status
+}
+
+// EventHours is approved hours for one event
+type EventHours struct {
+	EventID string
+	Hours   float64
+}
+
+// CategoryHours is approved hours for one category
+type CategoryHours struct {
+	Category string
+	Hours    float64
+}
+
+// Summary aggregates a volunteer's ledger over a date range
+type Summary struct {
+	TotalHours         float64
+	PendingHours       float64
+	EventsParticipated int
+	ByEvent            []EventHours
+	ByCategory         []CategoryHours
+}
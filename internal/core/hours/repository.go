@@ -0,0 +1,22 @@
+package hours
+
+import "context"
+
+// Repository is the persistence interface for the hours ledger
+type Repository interface {
+	// CreateEntry appends an entry. Crediting the same registration's
+	// attendance twice returns ErrAlreadyCredited.
+	CreateEntry(ctx context.Context, entry *Entry) error
+	GetEntry(ctx context.Context, id string) (*Entry, error)
+	ListUserEntries(ctx context.Context, userID string, filter EntryFilter) ([]*Entry, error)
+	ListPendingByOrganization(ctx context.Context, organizationID string) ([]*Entry, error)
+	UpdateReview(ctx context.Context, entry *Entry) error
+
+	// Summarize totals approved hours (and pending hours) for the user
+	Summarize(ctx context.Context, userID string, rng DateRange) (*Summary, error)
+
+	// Certificates
+	CreateCertificate(ctx context.Context, cert *Certificate) error
+	GetCertificateByCode(ctx context.Context, code string) (*Certificate, error)
+	ListCertificates(ctx context.Context, userID string) ([]*Certificate, error)
+}
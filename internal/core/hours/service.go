@@ -0,0 +1,329 @@
+package hours
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/organization"
+)
+
+// EventAccess is the part of the event service used to authorize organizers
+type EventAccess interface {
+	GetEvent(ctx context.Context, eventID string) (*event.Event, error)
+	Authorize(ctx context.Context, evt *event.Event, userID string, action event.StaffAction) error
+}
+
+// MembershipLookup resolves who may review hours submitted to an organization
+type MembershipLookup interface {
+	GetMembership(ctx context.Context, organizationID, userID string) (*organization.Member, error)
+}
+
+// Service maintains the volunteer hours ledger. Entries are never edited
+// after review; corrections are further entries.
+type Service struct {
+	repo    Repository
+	events  EventAccess
+	members MembershipLookup
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewService creates a new hours service.
+func NewService(repo Repository, events EventAccess, members MembershipLookup, logger *slog.Logger) *Service {
+	if repo == nil {
+		panic("hours repository is required")
+	}
+	if events == nil {
+		panic("event access is required")
+	}
+	if members == nil {
+		panic("membership lookup is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, events: events, members: members, logger: logger, now: time.Now}
+}
+
+// CreditAttendance records hours from completed attendance. Crediting the
+// same registration again is a no-op so repeated completions don't double
+// count, unless the credit was reversed, in which case it is restored.
+func (s *Service) CreditAttendance(ctx context.Context, credit AttendanceCredit) error {
+	if credit.Hours <= 0 {
+		return nil
+	}
+	entry := &Entry{
+		ID:             uuid.New().String(),
+		UserID:         credit.UserID,
+		EventID:        &credit.EventID,
+		RegistrationID: &credit.RegistrationID,
+		OrganizationID: credit.OrganizationID,
+		Source:         SourceAttendance,
+		Status:         StatusApproved,
+		Hours:          roundHours(math.Min(credit.Hours, MaxHoursPerEntry)),
+		Category:       credit.Category,
+		ActivityDate:   credit.Date,
+		CreatedBy:      credit.UserID,
+		CreatedAt:      s.now(),
+	}
+	if err := s.repo.CreateEntry(ctx, entry); err != nil {
+		if errors.Is(err, ErrAlreadyCredited) {
+			return s.restoreAttendance(ctx, entry)
+		}
+		return fmt.Errorf("failed to credit attendance: %w", err)
+	}
+	return nil
+}
+
+// ReverseAttendance takes back the hours a registration's attendance earned,
+// after staff mark a completed volunteer as a no-show. The credit stays in
+// the ledger; an adjustment by the staff member brings the registration's
+// hours to zero. Registrations without hours are left alone.
+func (s *Service) ReverseAttendance(ctx context.Context, userID, registrationID, by, reason string) error {
+	credit, total, err := s.attendanceHours(ctx, userID, registrationID)
+	if err != nil {
+		return err
+	}
+	if credit == nil || total <= 0 {
+		return nil
+	}
+	now := s.now()
+	entry := adjustmentOf(credit, -total, reason, by, now)
+	if err := s.repo.CreateEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to reverse attendance: %w", err)
+	}
+	return nil
+}
+
+// restoreAttendance credits a registration again after its attendance
+// credit was reversed, as an adjustment to that credit
+func (s *Service) restoreAttendance(ctx context.Context, credited *Entry) error {
+	credit, total, err := s.attendanceHours(ctx, credited.UserID, *credited.RegistrationID)
+	if err != nil {
+		return err
+	}
+	if credit == nil || total > 0 {
+		return nil
+	}
+	entry := adjustmentOf(credit, credited.Hours-total, "attendance completed again", credited.CreatedBy, s.now())
+	entry.ActivityDate = credited.ActivityDate
+	if err := s.repo.CreateEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to credit attendance: %w", err)
+	}
+	return nil
+}
+
+// attendanceHours returns a registration's attendance credit, or nil if it
+// has none, with the approved hours the registration stands at after adjustments
+func (s *Service) attendanceHours(ctx context.Context, userID, registrationID string) (*Entry, float64, error) {
+	approved := StatusApproved
+	entries, err := s.repo.ListUserEntries(ctx, userID, EntryFilter{RegistrationID: &registrationID, Status: &approved})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load registration hours: %w", err)
+	}
+	var credit *Entry
+	var total float64
+	for _, e := range entries {
+		if e.Source == SourceAttendance {
+			credit = e
+		}
+		total += e.Hours
+	}
+	return credit, roundHours(total), nil
+}
+
+// adjustmentOf builds an approved adjustment of target by delta hours
+func adjustmentOf(target *Entry, delta float64, reason, by string, now time.Time) *Entry {
+	return &Entry{
+		ID:             uuid.New().String(),
+		UserID:         target.UserID,
+		EventID:        target.EventID,
+		RegistrationID: target.RegistrationID,
+		OrganizationID: target.OrganizationID,
+		AdjustsEntryID: &target.ID,
+		Source:         SourceAdjustment,
+		Status:         StatusApproved,
+		Hours:          delta,
+		Category:       target.Category,
+		Reason:         &reason,
+		ActivityDate:   target.ActivityDate,
+		CreatedBy:      by,
+		ReviewedBy:     &by,
+		ReviewedAt:     &now,
+		CreatedAt:      now,
+	}
+}
+
+// AdjustHours lets an event organizer correct a volunteer's credited hours
+// for that event. delta may be negative but can't take the event total below zero.
+func (s *Service) AdjustHours(ctx context.Context, organizerID, entryID string, delta float64, reason string) (*Entry, error) {
+	reason = strings.TrimSpace(reason)
+	delta = roundHours(delta)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required", ErrInvalidInput)
+	}
+	if delta == 0 || math.Abs(delta) > MaxHoursPerEntry {
+		return nil, fmt.Errorf("%w: adjustment must be non-zero and at most %v hours", ErrInvalidInput, MaxHoursPerEntry)
+	}
+
+	target, err := s.repo.GetEntry(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if target.EventID == nil || target.Status != StatusApproved {
+		return nil, ErrNotAdjustable
+	}
+
+	evt, err := s.events.GetEvent(ctx, *target.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.events.Authorize(ctx, evt, organizerID, event.StaffActionApprove); err != nil {
+		return nil, err
+	}
+
+	approved := StatusApproved
+	existing, err := s.repo.ListUserEntries(ctx, target.UserID, EntryFilter{EventID: target.EventID, Status: &approved})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event hours: %w", err)
+	}
+	total := delta
+	for _, e := range existing {
+		total += e.Hours
+	}
+	if total < 0 {
+		return nil, ErrNegativeAdjustedSum
+	}
+
+	entry := adjustmentOf(target, delta, reason, organizerID, s.now())
+	if err := s.repo.CreateEntry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to record adjustment: %w", err)
+	}
+	s.logger.Info("volunteer hours adjusted", "entryID", target.ID, "by", organizerID, "delta", delta)
+	return entry, nil
+}
+
+// SubmitExternalHours records hours worked off-platform, pending approval by
+// the organization they were worked for
+func (s *Service) SubmitExternalHours(ctx context.Context, userID string, input ExternalHoursInput) (*Entry, error) {
+	description := strings.TrimSpace(input.Description)
+	category := strings.TrimSpace(input.Category)
+	hours := roundHours(input.Hours)
+	switch {
+	case input.OrganizationID == "":
+		return nil, fmt.Errorf("%w: organization is required", ErrInvalidInput)
+	case hours <= 0 || hours > MaxHoursPerEntry:
+		return nil, fmt.Errorf("%w: hours must be between 0 and %v", ErrInvalidInput, MaxHoursPerEntry)
+	case input.Date.After(s.now()):
+		return nil, fmt.Errorf("%w: date cannot be in the future", ErrInvalidInput)
+	case category == "" || description == "":
+		return nil, fmt.Errorf("%w: category and description are required", ErrInvalidInput)
+	}
+
+	entry := &Entry{
+		ID:             uuid.New().String(),
+		UserID:         userID,
+		OrganizationID: &input.OrganizationID,
+		Source:         SourceExternal,
+		Status:         StatusPending,
+		Hours:          hours,
+		Category:       category,
+		Description:    &description,
+		ActivityDate:   input.Date,
+		CreatedBy:      userID,
+		CreatedAt:      s.now(),
+	}
+	if err := s.repo.CreateEntry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to submit hours: %w", err)
+	}
+	return entry, nil
+}
+
+// ReviewExternalHours approves or rejects a pending submission. Reviewers
+// must own or administer the organization and can't review their own hours.
+func (s *Service) ReviewExternalHours(ctx context.Context, reviewerID, entryID string, approve bool, notes string) (*Entry, error) {
+	entry, err := s.repo.GetEntry(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status != StatusPending || entry.OrganizationID == nil {
+		return nil, ErrNotPending
+	}
+	if entry.UserID == reviewerID {
+		return nil, fmt.Errorf("%w: you cannot review your own hours", ErrPermissionDenied)
+	}
+	if err := s.requireReviewer(ctx, *entry.OrganizationID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	entry.Status = StatusRejected
+	if approve {
+		entry.Status = StatusApproved
+	}
+	entry.ReviewedBy = &reviewerID
+	entry.ReviewedAt = &now
+	if notes = strings.TrimSpace(notes); notes != "" {
+		entry.ReviewNotes = &notes
+	}
+	if err := s.repo.UpdateReview(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to review hours: %w", err)
+	}
+	return entry, nil
+}
+
+// PendingSubmissions lists submissions awaiting the organization's review
+func (s *Service) PendingSubmissions(ctx context.Context, reviewerID, organizationID string) ([]*Entry, error) {
+	if err := s.requireReviewer(ctx, organizationID, reviewerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListPendingByOrganization(ctx, organizationID)
+}
+
+// GetSummary aggregates the user's ledger over rng, with the entries behind it
+func (s *Service) GetSummary(ctx context.Context, userID string, rng DateRange) (*Summary, []*Entry, error) {
+	if rng.Start != nil && rng.End != nil && rng.End.Before(*rng.Start) {
+		return nil, nil, fmt.Errorf("%w: range ends before it starts", ErrInvalidInput)
+	}
+	summary, err := s.repo.Summarize(ctx, userID, rng)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to summarize hours: %w", err)
+	}
+	entries, err := s.repo.ListUserEntries(ctx, userID, EntryFilter{Range: rng})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list hours: %w", err)
+	}
+	return summary, entries, nil
+}
+
+// GetStats returns lifetime approved hours and events participated for profiles
+func (s *Service) GetStats(ctx context.Context, userID string) (*Summary, error) {
+	return s.repo.Summarize(ctx, userID, DateRange{})
+}
+
+func (s *Service) requireReviewer(ctx context.Context, organizationID, userID string) error {
+	member, err := s.members.GetMembership(ctx, organizationID, userID)
+	if errors.Is(err, organization.ErrMemberNotFound) {
+		return fmt.Errorf("%w: not a member of this organization", ErrPermissionDenied)
+	}
+	if err != nil {
+		return err
+	}
+	if !member.Role.CanManage() {
+		return fmt.Errorf("%w: requires admin role", ErrPermissionDenied)
+	}
+	return nil
+}
+
+// roundHours keeps ledger values to the hundredth the database stores
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
+}
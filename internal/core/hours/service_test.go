@@ -0,0 +1,269 @@
+package hours
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/organization"
+)
+
+// fakeRepo is an in-memory Repository
+type fakeRepo struct {
+	entries      []*Entry
+	certificates []*Certificate
+}
+
+func (f *fakeRepo) CreateEntry(ctx context.Context, entry *Entry) error {
+	for _, e := range f.entries {
+		if entry.Source == SourceAttendance && e.Source == SourceAttendance && *e.RegistrationID == *entry.RegistrationID {
+			return ErrAlreadyCredited
+		}
+	}
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+func (f *fakeRepo) GetEntry(ctx context.Context, id string) (*Entry, error) {
+	for _, e := range f.entries {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, ErrEntryNotFound
+}
+
+func (f *fakeRepo) ListUserEntries(ctx context.Context, userID string, filter EntryFilter) ([]*Entry, error) {
+	var out []*Entry
+	for _, e := range f.entries {
+		if e.UserID != userID ||
+			(filter.EventID != nil && (e.EventID == nil || *e.EventID != *filter.EventID)) ||
+			(filter.RegistrationID != nil && (e.RegistrationID == nil || *e.RegistrationID != *filter.RegistrationID)) ||
+			(filter.Status != nil && e.Status != *filter.Status) {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+func (f *fakeRepo) ListPendingByOrganization(ctx context.Context, organizationID string) ([]*Entry, error) {
+	var out []*Entry
+	for _, e := range f.entries {
+		if e.Status == StatusPending && e.OrganizationID != nil && *e.OrganizationID == organizationID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRepo) UpdateReview(ctx context.Context, entry *Entry) error {
+	return nil
+}
+
+func (f *fakeRepo) Summarize(ctx context.Context, userID string, rng DateRange) (*Summary, error) {
+	summary := &Summary{}
+	events := map[string]bool{}
+	for _, e := range f.entries {
+		if e.UserID != userID {
+			continue
+		}
+		switch e.Status {
+		case StatusApproved:
+			summary.TotalHours += e.Hours
+			if e.Source == SourceAttendance {
+				events[*e.EventID] = true
+			}
+		case StatusPending:
+			summary.PendingHours += e.Hours
+		}
+	}
+	summary.EventsParticipated = len(events)
+	return summary, nil
+}
+
+func (f *fakeRepo) CreateCertificate(ctx context.Context, cert *Certificate) error {
+	f.certificates = append(f.certificates, cert)
+	return nil
+}
+
+func (f *fakeRepo) GetCertificateByCode(ctx context.Context, code string) (*Certificate, error) {
+	for _, c := range f.certificates {
+		if c.Code == code {
+			return c, nil
+		}
+	}
+	return nil, ErrCertificateNotFound
+}
+
+func (f *fakeRepo) ListCertificates(ctx context.Context, userID string) ([]*Certificate, error) {
+	var out []*Certificate
+	for _, c := range f.certificates {
+		if c.UserID == userID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+type fakeEvents struct {
+	organizerID string
+}
+
+func (f *fakeEvents) GetEvent(ctx context.Context, eventID string) (*event.Event, error) {
+	return &event.Event{ID: eventID, OrganizerID: f.organizerID}, nil
+}
+
+func (f *fakeEvents) Authorize(ctx context.Context, evt *event.Event, userID string, action event.StaffAction) error {
+	if userID != evt.OrganizerID {
+		return errors.New("unauthorized: not an organizer")
+	}
+	return nil
+}
+
+type fakeMembers map[string]organization.MemberRole
+
+func (f fakeMembers) GetMembership(ctx context.Context, organizationID, userID string) (*organization.Member, error) {
+	role, ok := f[organizationID+"/"+userID]
+	if !ok {
+		return nil, organization.ErrMemberNotFound
+	}
+	return &organization.Member{OrganizationID: organizationID, UserID: userID, Role: role}, nil
+}
+
+func setupService() (*Service, *fakeRepo) {
+	repo := &fakeRepo{}
+	members := fakeMembers{"org1/admin": organization.RoleAdmin, "org1/member": organization.RoleMember}
+	return NewService(repo, &fakeEvents{organizerID: "organizer"}, members, nil), repo
+}
+
+func TestService_CreditAttendance(t *testing.T) {
+	svc, repo := setupService()
+	ctx := context.Background()
+	credit := AttendanceCredit{UserID: "user1", EventID: "event1", RegistrationID: "reg1", Category: "EDUCATION", Hours: 2.504, Date: time.Now()}
+
+	require.NoError(t, svc.CreditAttendance(ctx, credit))
+	require.NoError(t, svc.CreditAttendance(ctx, credit), "second completion is a no-op")
+
+	require.Len(t, repo.entries, 1)
+	assert.Equal(t, 2.5, repo.entries[0].Hours)
+	assert.Equal(t, StatusApproved, repo.entries[0].Status)
+
+	stats, err := svc.GetStats(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, stats.TotalHours)
+	assert.Equal(t, 1, stats.EventsParticipated)
+}
+
+func TestService_ReverseAttendance(t *testing.T) {
+	svc, repo := setupService()
+	ctx := context.Background()
+	credit := AttendanceCredit{UserID: "user1", EventID: "event1", RegistrationID: "reg1", Category: "EDUCATION", Hours: 3, Date: time.Now()}
+	require.NoError(t, svc.CreditAttendance(ctx, credit))
+	_, err := svc.AdjustHours(ctx, "organizer", repo.entries[0].ID, 1, "stayed late")
+	require.NoError(t, err)
+
+	require.NoError(t, svc.ReverseAttendance(ctx, "user1", "reg1", "organizer", "marked as a no-show"))
+	require.Len(t, repo.entries, 3)
+	reversal := repo.entries[2]
+	assert.Equal(t, SourceAdjustment, reversal.Source)
+	assert.Equal(t, -4.0, reversal.Hours, "the adjustment is taken back too")
+	assert.Equal(t, repo.entries[0].ID, *reversal.AdjustsEntryID)
+	assert.Equal(t, "organizer", reversal.CreatedBy)
+
+	stats, err := svc.GetStats(ctx, "user1")
+	require.NoError(t, err)
+	assert.Zero(t, stats.TotalHours)
+
+	require.NoError(t, svc.ReverseAttendance(ctx, "user1", "reg1", "organizer", "marked as a no-show"))
+	assert.Len(t, repo.entries, 3, "nothing left to reverse")
+	require.NoError(t, svc.ReverseAttendance(ctx, "user1", "reg2", "organizer", "marked as a no-show"))
+	assert.Len(t, repo.entries, 3, "never credited")
+
+	t.Run("completing again restores the credit", func(t *testing.T) {
+		require.NoError(t, svc.CreditAttendance(ctx, credit))
+		require.Len(t, repo.entries, 4)
+		assert.Equal(t, 3.0, repo.entries[3].Hours)
+
+		require.NoError(t, svc.CreditAttendance(ctx, credit))
+		assert.Len(t, repo.entries, 4, "still counted once")
+
+		stats, err := svc.GetStats(ctx, "user1")
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, stats.TotalHours)
+	})
+}
+
+func TestService_AdjustHours(t *testing.T) {
+	svc, repo := setupService()
+	ctx := context.Background()
+	require.NoError(t, svc.CreditAttendance(ctx, AttendanceCredit{UserID: "user1", EventID: "event1", RegistrationID: "reg1", Category: "EDUCATION", Hours: 3, Date: time.Now()}))
+	credited := repo.entries[0]
+
+	_, err := svc.AdjustHours(ctx, "organizer", credited.ID, 1, "  ")
+	assert.ErrorIs(t, err, ErrInvalidInput, "reason is required")
+
+	_, err = svc.AdjustHours(ctx, "stranger", credited.ID, 1, "stayed late")
+	assert.Error(t, err)
+
+	adj, err := svc.AdjustHours(ctx, "organizer", credited.ID, -1.5, "left early")
+	require.NoError(t, err)
+	assert.Equal(t, SourceAdjustment, adj.Source)
+	assert.Equal(t, credited.ID, *adj.AdjustsEntryID)
+	assert.Equal(t, "event1", *adj.EventID)
+
+	_, err = svc.AdjustHours(ctx, "organizer", credited.ID, -2, "never came")
+	assert.ErrorIs(t, err, ErrNegativeAdjustedSum)
+
+	stats, err := svc.GetStats(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, stats.TotalHours)
+}
+
+func TestService_ExternalHours(t *testing.T) {
+	svc, repo := setupService()
+	ctx := context.Background()
+	input := ExternalHoursInput{OrganizationID: "org1", Hours: 4, Date: time.Now().Add(-48 * time.Hour), Category: "Food bank", Description: "Sorting donations"}
+
+	_, err := svc.SubmitExternalHours(ctx, "user1", ExternalHoursInput{OrganizationID: "org1", Hours: 30, Date: input.Date, Category: "x", Description: "y"})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, err = svc.SubmitExternalHours(ctx, "user1", ExternalHoursInput{OrganizationID: "org1", Hours: 1, Date: time.Now().Add(time.Hour), Category: "x", Description: "y"})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	entry, err := svc.SubmitExternalHours(ctx, "user1", input)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, entry.Status)
+
+	stats, err := svc.GetStats(ctx, "user1")
+	require.NoError(t, err)
+	assert.Zero(t, stats.TotalHours, "pending hours don't count")
+	assert.Equal(t, 4.0, stats.PendingHours)
+
+	_, err = svc.PendingSubmissions(ctx, "member", "org1")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	pending, err := svc.PendingSubmissions(ctx, "admin", "org1")
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	_, err = svc.ReviewExternalHours(ctx, "member", entry.ID, true, "")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	reviewed, err := svc.ReviewExternalHours(ctx, "admin", entry.ID, true, "thanks!")
+	require.NoError(t, err)
+	assert.Equal(t, StatusApproved, reviewed.Status)
+	assert.Equal(t, "admin", *reviewed.ReviewedBy)
+
+	_, err = svc.ReviewExternalHours(ctx, "admin", entry.ID, false, "")
+	assert.ErrorIs(t, err, ErrNotPending)
+
+	stats, err = svc.GetStats(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, stats.TotalHours)
+	assert.Zero(t, stats.EventsParticipated)
+	assert.Len(t, repo.entries, 1)
+}
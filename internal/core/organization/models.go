@@ -0,0 +1,90 @@
+package organization
+
+import (
+	"errors"
+	"time"
+)
+
+// Common errors for organization package
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrMemberNotFound       = errors.New("organization member not found")
+	ErrInvalidInput         = errors.New("invalid input")
+	ErrPermissionDenied     = errors.New("permission denied")
+	ErrLastOwner            = errors.New("organization must keep at least one owner")
+)
+
+// MemberRole is a user's role within an organization
+type MemberRole string
+
+const (
+	RoleOwner  MemberRole = "OWNER"
+	RoleAdmin  MemberRole = "ADMIN"
+	RoleMember MemberRole = "MEMBER"
+)
+
+// IsValid reports whether r is a known member role
+func (r MemberRole) IsValid() bool {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return true
+	}
+	return false
+}
+
+// CanManage reports whether the role may edit the organization and run its events
+func (r MemberRole) CanManage() bool {
+	return r == RoleOwner || r == RoleAdmin
+}
+
+// VerificationStatus tracks whether platform admins have vetted the organization
+type VerificationStatus string
+
+const (
+	VerificationUnverified VerificationStatus = "UNVERIFIED"
+	VerificationPending    VerificationStatus = "PENDING"
+	VerificationVerified   VerificationStatus = "VERIFIED"
+	VerificationRejected   VerificationStatus = "REJECTED"
+)
+
+// Organization is a nonprofit or group that owns events
+type Organization struct {
+	ID                 string             `json:"id" db:"id"`
+	Name               string             `json:"name" db:"name"`
+	Slug               string             `json:"slug" db:"slug"`
+	Description        *string            `json:"description,omitempty" db:"description"`
+	Website            *string            `json:"website,omitempty" db:"website"`
+	ContactEmail       *string            `json:"contactEmail,omitempty" db:"contact_email"`
+	LogoURL            *string            `json:"logoUrl,omitempty" db:"logo_url"`
+	VerificationStatus VerificationStatus `json:"verificationStatus" db:"verification_status"`
+	VerifiedAt         *time.Time         `json:"verifiedAt,omitempty" db:"verified_at"`
+	VerifiedBy         *string            `json:"verifiedBy,omitempty" db:"verified_by"`
+	CreatedAt          time.Time          `json:"createdAt" db:"created_at"`
+	UpdatedAt          time.Time          `json:"updatedAt" db:"updated_at"`
+}
+
+// Member links a user to an organization
+type Member struct {
+	OrganizationID string     `json:"organizationId" db:"organization_id"`
+	UserID         string     `json:"userId" db:"user_id"`
+	Role           MemberRole `json:"role" db:"role"`
+	JoinedAt       time.Time  `json:"joinedAt" db:"joined_at"`
+}
+
+// CreateOrganizationInput represents input for creating an organization
+type CreateOrganizationInput struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description,omitempty"`
+	Website      *string `json:"website,omitempty"`
+	ContactEmail *string `json:"contactEmail,omitempty"`
+	LogoURL      *string `json:"logoUrl,omitempty"`
+}
+
+// UpdateOrganizationInput represents a partial update to an organization profile
+type UpdateOrganizationInput struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Website      *string `json:"website,omitempty"`
+	ContactEmail *string `json:"contactEmail,omitempty"`
+	LogoURL      *string `json:"logoUrl,omitempty"`
+}
@@ -0,0 +1,23 @@
+package organization
+
+import "context"
+
+// Repository defines the interface for organization data operations
+type Repository interface {
+	// Create inserts the organization and makes ownerID its first OWNER
+	Create(ctx context.Context, org *Organization, ownerID string) error
+	GetByID(ctx context.Context, id string) (*Organization, error)
+	Update(ctx context.Context, org *Organization) error
+	SlugExists(ctx context.Context, slug string) (bool, error)
+	GetByMember(ctx context.Context, userID string) ([]*Organization, error)
+
+	// Membership
+	AddMember(ctx context.Context, member *Member) error
+	GetMember(ctx context.Context, organizationID, userID string) (*Member, error)
+	GetMembers(ctx context.Context, organizationID string) ([]*Member, error)
+	UpdateMemberRole(ctx context.Context, organizationID, userID string, role MemberRole) error
+	// RemoveMember ends the membership and, when eventsTo is set, hands the
+	// organization's events userID created to that user, all or nothing. It
+	// returns how many events moved.
+	RemoveMember(ctx context.Context, organizationID, userID, eventsTo string) (int, error)
+}
@@ -0,0 +1,356 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service encapsulates the business logic for organizations and their members.
+type Service struct {
+	repo   Repository
+	logger *slog.Logger
+}
+
+// NewService creates a new organization service.
+func NewService(repo Repository, logger *slog.Logger) *Service {
+	if repo == nil {
+		panic("organization repository is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, logger: logger}
+}
+
+// CreateOrganization creates an organization with the caller as its owner
+func (s *Service) CreateOrganization(ctx context.Context, userID string, input CreateOrganizationInput) (*Organization, error) {
+	name := strings.TrimSpace(input.Name)
+	if len(name) < 2 || len(name) > 200 {
+		return nil, fmt.Errorf("%w: name must be between 2 and 200 characters", ErrInvalidInput)
+	}
+
+	slug, err := s.uniqueSlug(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	org := &Organization{
+		ID:                 uuid.New().String(),
+		Name:               name,
+		Slug:               slug,
+		Description:        input.Description,
+		Website:            input.Website,
+		ContactEmail:       input.ContactEmail,
+		LogoURL:            input.LogoURL,
+		VerificationStatus: VerificationUnverified,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if err := s.repo.Create(ctx, org, userID); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	return org, nil
+}
+
+// GetOrganization returns an organization by ID
+func (s *Service) GetOrganization(ctx context.Context, id string) (*Organization, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// GetUserOrganizations returns the organizations a user belongs to
+func (s *Service) GetUserOrganizations(ctx context.Context, userID string) ([]*Organization, error) {
+	return s.repo.GetByMember(ctx, userID)
+}
+
+// UpdateOrganization edits the organization profile. Owners and admins only.
+func (s *Service) UpdateOrganization(ctx context.Context, id, userID string, input UpdateOrganizationInput) (*Organization, error) {
+	if _, err := s.requireRole(ctx, id, userID, RoleOwner, RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	org, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if len(name) < 2 || len(name) > 200 {
+			return nil, fmt.Errorf("%w: name must be between 2 and 200 characters", ErrInvalidInput)
+		}
+		org.Name = name
+	}
+	if input.Description != nil {
+		org.Description = input.Description
+	}
+	if input.Website != nil {
+		org.Website = input.Website
+	}
+	if input.ContactEmail != nil {
+		org.ContactEmail = input.ContactEmail
+	}
+	if input.LogoURL != nil {
+		org.LogoURL = input.LogoURL
+	}
+	org.UpdatedAt = time.Now().UTC()
+
+	if err := s.repo.Update(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+	return org, nil
+}
+
+// GetMembers lists the organization's members
+func (s *Service) GetMembers(ctx context.Context, organizationID string) ([]*Member, error) {
+	return s.repo.GetMembers(ctx, organizationID)
+}
+
+// GetMembership returns the user's membership, or ErrMemberNotFound
+func (s *Service) GetMembership(ctx context.Context, organizationID, userID string) (*Member, error) {
+	return s.repo.GetMember(ctx, organizationID, userID)
+}
+
+// AddMember adds a user to the organization. Only owners may add other owners.
+func (s *Service) AddMember(ctx context.Context, organizationID, actorID, userID string, role MemberRole) (*Member, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidInput, role)
+	}
+	actor, err := s.requireRole(ctx, organizationID, actorID, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if role == RoleOwner && actor.Role != RoleOwner {
+		return nil, fmt.Errorf("%w: only owners can add owners", ErrPermissionDenied)
+	}
+
+	if existing, err := s.repo.GetMember(ctx, organizationID, userID); err == nil && existing != nil {
+		return nil, fmt.Errorf("%w: user is already a member", ErrInvalidInput)
+	} else if err != nil && !errors.Is(err, ErrMemberNotFound) {
+		return nil, err
+	}
+
+	member := &Member{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+		JoinedAt:       time.Now().UTC(),
+	}
+	if err := s.repo.AddMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add member: %w", err)
+	}
+	return member, nil
+}
+
+// UpdateMemberRole changes a member's role. Only owners may promote to or demote from OWNER.
+func (s *Service) UpdateMemberRole(ctx context.Context, organizationID, actorID, userID string, role MemberRole) (*Member, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidInput, role)
+	}
+	actor, err := s.requireRole(ctx, organizationID, actorID, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.repo.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if (role == RoleOwner || member.Role == RoleOwner) && actor.Role != RoleOwner {
+		return nil, fmt.Errorf("%w: only owners can change ownership", ErrPermissionDenied)
+	}
+	if member.Role == RoleOwner && role != RoleOwner {
+		if err := s.ensureAnotherOwner(ctx, organizationID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.UpdateMemberRole(ctx, organizationID, userID, role); err != nil {
+		return nil, fmt.Errorf("failed to update member role: %w", err)
+	}
+	member.Role = role
+	return member, nil
+}
+
+// RemoveMember removes a user from the organization, or lets a member leave.
+// Events the departing member created stay with the organization and are
+// handed to an owner.
+func (s *Service) RemoveMember(ctx context.Context, organizationID, actorID, userID string) error {
+	member, err := s.repo.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	var actor *Member
+	if actorID != userID {
+		actor, err = s.requireRole(ctx, organizationID, actorID, RoleOwner, RoleAdmin)
+		if err != nil {
+			return err
+		}
+		if member.Role == RoleOwner && actor.Role != RoleOwner {
+			return fmt.Errorf("%w: only owners can remove owners", ErrPermissionDenied)
+		}
+	}
+
+	// Pick the owner who inherits the departing member's events
+	var newOwnerID string
+	if actor != nil && actor.Role == RoleOwner {
+		newOwnerID = actor.UserID
+	} else {
+		owner, err := s.otherOwner(ctx, organizationID, userID)
+		if err != nil {
+			return err
+		}
+		if owner != nil {
+			newOwnerID = owner.UserID
+		}
+	}
+	if member.Role == RoleOwner && newOwnerID == "" {
+		return ErrLastOwner
+	}
+
+	moved, err := s.repo.RemoveMember(ctx, organizationID, userID, newOwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+	if moved > 0 {
+		s.logger.Info("reassigned organization events", "organizationID", organizationID, "from", userID, "to", newOwnerID, "count", moved)
+	}
+	return nil
+}
+
+// RequestVerification asks platform admins to verify the organization
+func (s *Service) RequestVerification(ctx context.Context, organizationID, userID string) (*Organization, error) {
+	if _, err := s.requireRole(ctx, organizationID, userID, RoleOwner, RoleAdmin); err != nil {
+		return nil, err
+	}
+	org, err := s.repo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.VerificationStatus == VerificationVerified || org.VerificationStatus == VerificationPending {
+		return nil, fmt.Errorf("%w: organization is already %s", ErrInvalidInput, strings.ToLower(string(org.VerificationStatus)))
+	}
+
+	org.VerificationStatus = VerificationPending
+	org.UpdatedAt = time.Now().UTC()
+	if err := s.repo.Update(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to request verification: %w", err)
+	}
+	return org, nil
+}
+
+// SetVerificationStatus records a platform admin's verification decision.
+// Callers must check the admin permission.
+func (s *Service) SetVerificationStatus(ctx context.Context, organizationID, adminID string, status VerificationStatus) (*Organization, error) {
+	if status != VerificationVerified && status != VerificationRejected && status != VerificationUnverified {
+		return nil, fmt.Errorf("%w: invalid verification status %q", ErrInvalidInput, status)
+	}
+	org, err := s.repo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	org.VerificationStatus = status
+	org.UpdatedAt = now
+	if status == VerificationVerified {
+		org.VerifiedAt = &now
+		org.VerifiedBy = &adminID
+	} else {
+		org.VerifiedAt = nil
+		org.VerifiedBy = nil
+	}
+
+	if err := s.repo.Update(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to update verification: %w", err)
+	}
+	return org, nil
+}
+
+// requireRole returns the user's membership if it holds one of the given roles
+func (s *Service) requireRole(ctx context.Context, organizationID, userID string, roles ...MemberRole) (*Member, error) {
+	member, err := s.repo.GetMember(ctx, organizationID, userID)
+	if errors.Is(err, ErrMemberNotFound) {
+		return nil, fmt.Errorf("%w: not a member of this organization", ErrPermissionDenied)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return member, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: requires %s role", ErrPermissionDenied, strings.ToLower(string(roles[0])))
+}
+
+// otherOwner returns an owner other than excludeUserID, or nil if there is none
+func (s *Service) otherOwner(ctx context.Context, organizationID, excludeUserID string) (*Member, error) {
+	members, err := s.repo.GetMembers(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.Role == RoleOwner && m.UserID != excludeUserID {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Service) ensureAnotherOwner(ctx context.Context, organizationID, userID string) error {
+	owner, err := s.otherOwner(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+	if owner == nil {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// uniqueSlug derives a URL slug from name, suffixing it if already taken
+func (s *Service) uniqueSlug(ctx context.Context, name string) (string, error) {
+	base := slugify(name)
+	if base == "" {
+		base = "organization"
+	}
+	slug := base
+	for i := 2; ; i++ {
+		exists, err := s.repo.SlugExists(ctx, slug)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug: %w", err)
+		}
+		if !exists {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func slugify(name string) string {
+	var b strings.Builder
+	lastHyphen := true
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			b.WriteRune(r)
+			lastHyphen = false
+		case !lastHyphen:
+			b.WriteByte('-')
+			lastHyphen = true
+		}
+	}
+	slug := strings.Trim(b.String(), "-")
+	if len(slug) > 50 {
+		slug = strings.Trim(slug[:50], "-")
+	}
+	return slug
+}
@@ -0,0 +1,208 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepo is an in-memory Repository for service tests
+type fakeRepo struct {
+	orgs      map[string]*Organization
+	members   map[string]map[string]*Member
+	reassigns []string
+	// failRemove makes RemoveMember fail without changing anything
+	failRemove error
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{orgs: map[string]*Organization{}, members: map[string]map[string]*Member{}}
+}
+
+func (f *fakeRepo) Create(ctx context.Context, org *Organization, ownerID string) error {
+	f.orgs[org.ID] = org
+	return f.AddMember(ctx, &Member{OrganizationID: org.ID, UserID: ownerID, Role: RoleOwner})
+}
+
+func (f *fakeRepo) GetByID(ctx context.Context, id string) (*Organization, error) {
+	if org, ok := f.orgs[id]; ok {
+		return org, nil
+	}
+	return nil, ErrOrganizationNotFound
+}
+
+func (f *fakeRepo) Update(ctx context.Context, org *Organization) error {
+	f.orgs[org.ID] = org
+	return nil
+}
+
+func (f *fakeRepo) SlugExists(ctx context.Context, slug string) (bool, error) {
+	for _, org := range f.orgs {
+		if org.Slug == slug {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeRepo) GetByMember(ctx context.Context, userID string) ([]*Organization, error) {
+	var out []*Organization
+	for orgID, members := range f.members {
+		if _, ok := members[userID]; ok {
+			out = append(out, f.orgs[orgID])
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRepo) AddMember(ctx context.Context, m *Member) error {
+	if f.members[m.OrganizationID] == nil {
+		f.members[m.OrganizationID] = map[string]*Member{}
+	}
+	f.members[m.OrganizationID][m.UserID] = m
+	return nil
+}
+
+func (f *fakeRepo) GetMember(ctx context.Context, organizationID, userID string) (*Member, error) {
+	if m, ok := f.members[organizationID][userID]; ok {
+		copy := *m
+		return &copy, nil
+	}
+	return nil, ErrMemberNotFound
+}
+
+func (f *fakeRepo) GetMembers(ctx context.Context, organizationID string) ([]*Member, error) {
+	var out []*Member
+	for _, m := range f.members[organizationID] {
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+func (f *fakeRepo) UpdateMemberRole(ctx context.Context, organizationID, userID string, role MemberRole) error {
+	m, ok := f.members[organizationID][userID]
+	if !ok {
+		return ErrMemberNotFound
+	}
+	m.Role = role
+	return nil
+}
+
+func (f *fakeRepo) RemoveMember(ctx context.Context, organizationID, userID, eventsTo string) (int, error) {
+	if f.failRemove != nil {
+		return 0, f.failRemove
+	}
+	delete(f.members[organizationID], userID)
+	if eventsTo == "" {
+		return 0, nil
+	}
+	f.reassigns = append(f.reassigns, userID+"->"+eventsTo)
+	return 1, nil
+}
+
+func setupOrg(t *testing.T) (*Service, *fakeRepo, *Organization) {
+	t.Helper()
+	repo := newFakeRepo()
+	svc := NewService(repo, nil)
+	org, err := svc.CreateOrganization(context.Background(), "owner", CreateOrganizationInput{Name: "Food Bank of Springfield"})
+	require.NoError(t, err)
+	return svc, repo, org
+}
+
+func TestService_CreateOrganization(t *testing.T) {
+	svc, repo, org := setupOrg(t)
+	ctx := context.Background()
+
+	assert.Equal(t, "food-bank-of-springfield", org.Slug)
+	assert.Equal(t, VerificationUnverified, org.VerificationStatus)
+	owner, err := repo.GetMember(ctx, org.ID, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, RoleOwner, owner.Role)
+
+	second, err := svc.CreateOrganization(ctx, "other", CreateOrganizationInput{Name: "Food Bank of Springfield"})
+	require.NoError(t, err)
+	assert.Equal(t, "food-bank-of-springfield-2", second.Slug)
+
+	_, err = svc.CreateOrganization(ctx, "other", CreateOrganizationInput{Name: " "})
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+}
+
+func TestService_Members(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("admin cannot add owner", func(t *testing.T) {
+		svc, _, org := setupOrg(t)
+		_, err := svc.AddMember(ctx, org.ID, "owner", "admin", RoleAdmin)
+		require.NoError(t, err)
+
+		_, err = svc.AddMember(ctx, org.ID, "admin", "someone", RoleOwner)
+		assert.True(t, errors.Is(err, ErrPermissionDenied))
+	})
+
+	t.Run("member cannot manage", func(t *testing.T) {
+		svc, _, org := setupOrg(t)
+		_, err := svc.AddMember(ctx, org.ID, "owner", "member", RoleMember)
+		require.NoError(t, err)
+
+		_, err = svc.AddMember(ctx, org.ID, "member", "someone", RoleMember)
+		assert.True(t, errors.Is(err, ErrPermissionDenied))
+		_, err = svc.UpdateOrganization(ctx, org.ID, "member", UpdateOrganizationInput{})
+		assert.True(t, errors.Is(err, ErrPermissionDenied))
+	})
+
+	t.Run("last owner cannot be demoted or leave", func(t *testing.T) {
+		svc, _, org := setupOrg(t)
+
+		_, err := svc.UpdateMemberRole(ctx, org.ID, "owner", "owner", RoleAdmin)
+		assert.True(t, errors.Is(err, ErrLastOwner))
+		err = svc.RemoveMember(ctx, org.ID, "owner", "owner")
+		assert.True(t, errors.Is(err, ErrLastOwner))
+	})
+
+	t.Run("departing organizer's events stay with the organization", func(t *testing.T) {
+		svc, repo, org := setupOrg(t)
+		_, err := svc.AddMember(ctx, org.ID, "owner", "organizer", RoleAdmin)
+		require.NoError(t, err)
+
+		require.NoError(t, svc.RemoveMember(ctx, org.ID, "organizer", "organizer"))
+
+		assert.Equal(t, []string{"organizer->owner"}, repo.reassigns)
+		_, err = repo.GetMember(ctx, org.ID, "organizer")
+		assert.True(t, errors.Is(err, ErrMemberNotFound))
+	})
+
+	t.Run("a failed removal keeps the member and their events", func(t *testing.T) {
+		svc, repo, org := setupOrg(t)
+		_, err := svc.AddMember(ctx, org.ID, "owner", "organizer", RoleAdmin)
+		require.NoError(t, err)
+		repo.failRemove = errors.New("connection reset")
+
+		require.Error(t, svc.RemoveMember(ctx, org.ID, "organizer", "organizer"))
+
+		assert.Empty(t, repo.reassigns)
+		_, err = repo.GetMember(ctx, org.ID, "organizer")
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Verification(t *testing.T) {
+	svc, _, org := setupOrg(t)
+	ctx := context.Background()
+
+	pending, err := svc.RequestVerification(ctx, org.ID, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, VerificationPending, pending.VerificationStatus)
+
+	_, err = svc.RequestVerification(ctx, org.ID, "owner")
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+
+	verified, err := svc.SetVerificationStatus(ctx, org.ID, "admin", VerificationVerified)
+	require.NoError(t, err)
+	assert.Equal(t, VerificationVerified, verified.VerificationStatus)
+	require.NotNil(t, verified.VerifiedBy)
+	assert.Equal(t, "admin", *verified.VerifiedBy)
+	assert.NotNil(t, verified.VerifiedAt)
+}
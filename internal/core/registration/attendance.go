@@ -0,0 +1,442 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/hours"
+)
+
+// recordCheckedOut is the attendance record status for a closed check-in;
+// registrations themselves move straight to COMPLETED
+const recordCheckedOut = "CHECKED_OUT"
+
+var (
+	ErrLocationRequired = errors.New("device location is required to check in to an in-person event")
+	ErrOutsideGeofence  = errors.New("you are too far from the event location")
+	ErrNoEventLocation  = errors.New("event has no map location; ask event staff to check you in")
+	ErrNotCheckedIn     = errors.New("registration is not checked in")
+	ErrAlreadyCheckedIn = errors.New("registration is already checked in")
+	ErrNotConfirmed     = errors.New("registration is not confirmed")
+)
+
+// HoursRecorder credits completed attendance to the volunteer hours ledger
+type HoursRecorder interface {
+	CreditAttendance(ctx context.Context, credit hours.AttendanceCredit) error
+	// ReverseAttendance takes back a registration's credited hours
+	ReverseAttendance(ctx context.Context, userID, registrationID, by, reason string) error
+}
+
+// GeofenceConfig controls volunteer self check-in
+type GeofenceConfig struct {
+	// RadiusMeters is how far from the event coordinates a device may be
+	RadiusMeters float64
+	// GracePeriod extends the event window on both sides
+	GracePeriod time.Duration
+}
+
+// AttendanceService lets volunteers check themselves in and out from their
+// own device, verified against the event location and time window.
+type AttendanceService struct {
+	registrations *Service
+	cfg           GeofenceConfig
+	now           func() time.Time
+}
+
+// NewAttendanceService creates a self check-in service
+func NewAttendanceService(registrations *Service, cfg GeofenceConfig) *AttendanceService {
+	if registrations == nil {
+		panic("registration service is required")
+	}
+	if cfg.RadiusMeters <= 0 {
+		cfg.RadiusMeters = 150
+	}
+	return &AttendanceService{registrations: registrations, cfg: cfg, now: time.Now}
+}
+
+// HoursWorked returns the time between check-in and check-out in hours, or
+// zero while the volunteer is still checked in
+func (a *AttendanceRecord) HoursWorked() float64 {
+	if a.CheckedInAt == nil || a.CheckedOutAt == nil || a.CheckedOutAt.Before(*a.CheckedInAt) {
+		return 0
+	}
+	return a.CheckedOutAt.Sub(*a.CheckedInAt).Hours()
+}
+
+// CheckIn checks the caller in to their own confirmed registration
+func (a *AttendanceService) CheckIn(ctx context.Context, userID, registrationID string, location *event.Coordinates) (*Registration, *AttendanceRecord, error) {
+	reg, evt, err := a.loadOwn(ctx, userID, registrationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reg.Status != StatusConfirmed {
+		return nil, nil, ErrNotConfirmed
+	}
+
+	// Volunteers on shifts check in to each shift in turn
+	now := a.now()
+	assignment, shift, err := a.registrations.attendanceShift(ctx, reg, now, a.cfg.GracePeriod)
+	if err != nil {
+		return nil, nil, err
+	}
+	window := evt
+	if assignment != nil {
+		if assignment.CheckedInAt != nil {
+			return nil, nil, ErrAlreadyCheckedIn
+		}
+		window = evt.ForShift(shift)
+	} else if reg.CheckedInAt != nil {
+		return nil, nil, ErrAlreadyCheckedIn
+	}
+
+	if err := a.checkWindow(window, now); err != nil {
+		return nil, nil, err
+	}
+	verified, err := a.checkLocation(evt, location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if reg.CheckedInAt == nil {
+		reg.CheckedInAt = &now
+		reg.CheckedInBy = &userID
+	}
+	reg.AttendanceStatus = AttendanceCheckedIn
+	reg.UpdatedAt = now
+	if err := a.registrations.repo.UpdateRegistration(ctx, reg); err != nil {
+		return nil, nil, fmt.Errorf("failed to check in: %w", err)
+	}
+	if assignment != nil {
+		assignment.CheckedInAt = &now
+		assignment.UpdatedAt = now
+		if err := a.registrations.saveShifts(ctx, reg); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	record, err := a.registrations.repo.CreateAttendanceRecord(ctx, &AttendanceRecord{
+		ID:               uuid.New().String(),
+		RegistrationID:   reg.ID,
+		Status:           string(AttendanceCheckedIn),
+		CheckedInAt:      &now,
+		CheckedInBy:      &userID,
+		LocationVerified: verified,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record attendance: %w", err)
+	}
+	return reg, record, nil
+}
+
+// CheckOut closes the caller's attendance and completes the registration.
+// The resulting record carries both timestamps, from which hours are computed.
+func (a *AttendanceService) CheckOut(ctx context.Context, userID, registrationID string, location *event.Coordinates) (*Registration, *AttendanceRecord, error) {
+	reg, evt, err := a.loadOwn(ctx, userID, registrationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reg.AttendanceStatus != AttendanceCheckedIn || reg.CheckedInAt == nil {
+		return nil, nil, ErrNotCheckedIn
+	}
+
+	now := a.now()
+	assignment, shift, err := a.registrations.attendanceShift(ctx, reg, now, a.cfg.GracePeriod)
+	if err != nil {
+		return nil, nil, err
+	}
+	window, checkedInAt := evt, reg.CheckedInAt
+	var shifts []*event.EventShift
+	if assignment != nil {
+		if assignment.CheckedInAt == nil {
+			return nil, nil, ErrNotCheckedIn
+		}
+		window, checkedInAt = evt.ForShift(shift), assignment.CheckedInAt
+		if shifts, err = a.registrations.eventService.GetEventShifts(ctx, evt.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := a.checkWindow(window, now); err != nil {
+		return nil, nil, err
+	}
+	verified, err := a.checkLocation(evt, location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Checking out of a shift completes the registration once no shifts
+	// remain; shifts that have already ended without the volunteer don't count
+	if assignment != nil {
+		assignment.CheckedOutAt = &now
+		assignment.UpdatedAt = now
+	}
+	completed := !shiftsRemaining(reg.Shifts, shiftsByID(shifts), now.Add(-a.cfg.GracePeriod))
+	if completed {
+		reg.Status = StatusCompleted
+		reg.AttendanceStatus = AttendanceCompleted
+		reg.CompletedAt = &now
+	} else {
+		reg.AttendanceStatus = AttendanceRegistered
+	}
+	reg.UpdatedAt = now
+	if err := a.registrations.repo.UpdateRegistration(ctx, reg); err != nil {
+		return nil, nil, fmt.Errorf("failed to check out: %w", err)
+	}
+	if err := a.registrations.saveShifts(ctx, reg); err != nil {
+		return nil, nil, err
+	}
+
+	record, err := a.registrations.repo.CreateAttendanceRecord(ctx, &AttendanceRecord{
+		ID:               uuid.New().String(),
+		RegistrationID:   reg.ID,
+		Status:           recordCheckedOut,
+		CheckedInAt:      checkedInAt,
+		CheckedOutAt:     &now,
+		CheckedInBy:      reg.CheckedInBy,
+		LocationVerified: verified,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record attendance: %w", err)
+	}
+
+	a.registrations.logger.Info("volunteer checked out", "registrationID", reg.ID, "hours", record.HoursWorked())
+	if completed {
+		worked := record.HoursWorked()
+		if assignment != nil {
+			worked = shiftHours(reg.Shifts)
+		}
+		a.registrations.creditHours(ctx, reg, evt, worked)
+	}
+	return reg, record, nil
+}
+
+// RunShiftCompletions processes shift completions every interval until ctx
+// is done. A non-positive interval disables it.
+func (a *AttendanceService) RunShiftCompletions(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := a.ProcessShiftCompletions(ctx, now); err != nil {
+				a.registrations.logger.Error("failed to process shift completions", "error", err)
+			}
+		}
+	}
+}
+
+// ProcessShiftCompletions completes the registrations of volunteers who
+// worked at least one of their shifts once every shift has ended. Shifts they
+// skipped earn no hours; one they never checked out of closes at its end.
+func (a *AttendanceService) ProcessShiftCompletions(ctx context.Context, now time.Time) error {
+	ids, err := a.registrations.repo.GetShiftCompletionIDs(ctx, now.Add(-a.cfg.GracePeriod))
+	if err != nil {
+		return fmt.Errorf("failed to load registrations with finished shifts: %w", err)
+	}
+	for _, id := range ids {
+		if err := a.completeShifts(ctx, id, now); err != nil {
+			a.registrations.logger.Error("failed to complete shifts", "registrationID", id, "error", err)
+		}
+	}
+	return nil
+}
+
+func (a *AttendanceService) completeShifts(ctx context.Context, registrationID string, now time.Time) error {
+	s := a.registrations
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.Status != StatusConfirmed {
+		return nil
+	}
+	evt, err := s.eventService.GetEventByID(ctx, reg.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to load event: %w", err)
+	}
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return err
+	}
+	shifts, err := s.eventService.GetEventShifts(ctx, reg.EventID)
+	if err != nil {
+		return err
+	}
+
+	byID := shiftsByID(shifts)
+	closeEndedShifts(reg.Shifts, byID, now)
+	if shiftsRemaining(reg.Shifts, byID, now.Add(-a.cfg.GracePeriod)) {
+		return nil
+	}
+	reg.Status = StatusCompleted
+	reg.AttendanceStatus = AttendanceCompleted
+	reg.CompletedAt = &now
+	reg.UpdatedAt = now
+	if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+		return fmt.Errorf("failed to complete registration: %w", err)
+	}
+	if err := s.saveShifts(ctx, reg); err != nil {
+		return err
+	}
+
+	s.logger.Info("shifts finished", "registrationID", reg.ID, "hours", shiftHours(reg.Shifts))
+	s.creditHours(ctx, reg, evt, shiftHours(reg.Shifts))
+	return nil
+}
+
+func (a *AttendanceService) loadOwn(ctx context.Context, userID, registrationID string) (*Registration, *event.Event, error) {
+	reg, err := a.registrations.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.UserID != userID {
+		return nil, nil, fmt.Errorf("unauthorized: registration belongs to another volunteer")
+	}
+	evt, err := a.registrations.eventService.GetEvent(ctx, reg.EventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("event not found: %w", err)
+	}
+	return reg, evt, nil
+}
+
+// CanCheckIn reports whether a volunteer whose registration is in status can
+// check themselves in to evt now
+func (a *AttendanceService) CanCheckIn(status RegistrationStatus, checkedIn bool, evt *event.Event) bool {
+	if status != StatusConfirmed || checkedIn {
+		return false
+	}
+	return a.checkWindow(evt, a.now()) == nil
+}
+
+// checkWindow allows self check-in and check-out from the grace period before
+// the start until the grace period after the end
+func (a *AttendanceService) checkWindow(evt *event.Event, at time.Time) error {
+	if at.Before(evt.StartTime.Add(-a.cfg.GracePeriod)) || at.After(evt.EndTime.Add(a.cfg.GracePeriod)) {
+		return ErrOutsideCheckInWindow
+	}
+	return nil
+}
+
+// checkLocation verifies the device is inside the geofence. Remote events have
+// no venue to check against, so they pass unverified.
+func (a *AttendanceService) checkLocation(evt *event.Event, location *event.Coordinates) (bool, error) {
+	if evt.Location.IsRemote {
+		return false, nil
+	}
+	if evt.Location.Coordinates == nil {
+		return false, ErrNoEventLocation
+	}
+	if location == nil {
+		return false, ErrLocationRequired
+	}
+	if haversineKm(*evt.Location.Coordinates, *location)*1000 > a.cfg.RadiusMeters {
+		return false, ErrOutsideGeofence
+	}
+	return true, nil
+}
+
+// creditHours adds completed attendance to the hours ledger. The attendance
+// itself is already recorded, so a ledger failure is logged, not returned.
+func (s *Service) creditHours(ctx context.Context, reg *Registration, evt *event.Event, worked float64) {
+	if s.hours == nil || worked <= 0 {
+		return
+	}
+	date := evt.StartTime
+	if reg.CheckedInAt != nil {
+		date = *reg.CheckedInAt
+	}
+	err := s.hours.CreditAttendance(ctx, hours.AttendanceCredit{
+		UserID:         reg.UserID,
+		EventID:        reg.EventID,
+		RegistrationID: reg.ID,
+		OrganizationID: evt.OrganizationID,
+		Category:       string(evt.Category),
+		Hours:          worked,
+		Date:           date,
+	})
+	if err != nil {
+		s.logger.Error("failed to credit volunteer hours", "registrationID", reg.ID, "error", err)
+	}
+}
+
+// reverseHours takes back the hours a volunteer was credited before staff
+// marked them a no-show. Unlike crediting, a failure is returned, so the
+// no-show isn't saved with the hours still counted.
+func (s *Service) reverseHours(ctx context.Context, reg *Registration, staffID string) error {
+	if s.hours == nil {
+		return nil
+	}
+	if err := s.hours.ReverseAttendance(ctx, reg.UserID, reg.ID, staffID, "marked as a no-show"); err != nil {
+		return fmt.Errorf("failed to reverse volunteer hours: %w", err)
+	}
+	return nil
+}
+
+// creditStaffMarked credits hours to a volunteer staff marked completed
+func (s *Service) creditStaffMarked(ctx context.Context, reg *Registration, evt *event.Event, now time.Time) {
+	if err := s.loadShifts(ctx, reg); err != nil {
+		s.logger.Error("failed to credit volunteer hours", "registrationID", reg.ID, "error", err)
+		return
+	}
+	var shifts []*event.EventShift
+	if len(reg.Shifts) > 0 {
+		var err error
+		if shifts, err = s.eventService.GetEventShifts(ctx, evt.ID); err != nil {
+			s.logger.Error("failed to credit volunteer hours", "registrationID", reg.ID, "error", err)
+			return
+		}
+	}
+	s.creditHours(ctx, reg, evt, staffMarkedHours(reg, evt, shiftsByID(shifts), now))
+}
+
+// staffMarkedHours estimates hours when staff mark a volunteer completed
+// without a check-out: from check-in (or the start) to the end of the event,
+// or to now if the event is still running. Volunteers on shifts get the time
+// they checked in and out of each confirmed shift, or else its scheduled
+// window, rather than the whole event.
+func staffMarkedHours(reg *Registration, evt *event.Event, shifts map[string]*event.EventShift, now time.Time) float64 {
+	var total float64
+	onShifts := false
+	for _, a := range reg.Shifts {
+		shift, ok := shifts[a.ShiftID]
+		if !ok || a.Status != ShiftConfirmed {
+			continue
+		}
+		onShifts = true
+		if a.CheckedInAt != nil && a.CheckedOutAt != nil {
+			total += shiftHours([]*ShiftAssignment{a})
+		} else {
+			total += hoursBetween(shift.StartTime, shift.EndTime, a.CheckedInAt, now)
+		}
+	}
+	if onShifts {
+		return total
+	}
+	return hoursBetween(evt.StartTime, evt.EndTime, reg.CheckedInAt, now)
+}
+
+// hoursBetween is the part of start to end after checkedInAt, if set, and
+// before now
+func hoursBetween(start, end time.Time, checkedInAt *time.Time, now time.Time) float64 {
+	from := start
+	if checkedInAt != nil && checkedInAt.After(from) {
+		from = *checkedInAt
+	}
+	to := end
+	if now.Before(to) {
+		to = now
+	}
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from).Hours()
+}
@@ -0,0 +1,97 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func TestAttendanceRecord_HoursWorked(t *testing.T) {
+	in := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	out := in.Add(3*time.Hour + 30*time.Minute)
+
+	assert.Equal(t, 3.5, (&AttendanceRecord{CheckedInAt: &in, CheckedOutAt: &out}).HoursWorked())
+	assert.Zero(t, (&AttendanceRecord{CheckedInAt: &in}).HoursWorked(), "still checked in")
+	assert.Zero(t, (&AttendanceRecord{CheckedInAt: &out, CheckedOutAt: &in}).HoursWorked(), "clock went backwards")
+}
+
+func TestAttendanceService_Geofence(t *testing.T) {
+	svc := &AttendanceService{cfg: GeofenceConfig{RadiusMeters: 150, GracePeriod: 30 * time.Minute}}
+	venue := &event.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	start := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	evt := testEvent("event1", start, 2, venue)
+
+	t.Run("at the venue", func(t *testing.T) {
+		// ~50 m north
+		verified, err := svc.checkLocation(evt, &event.Coordinates{Latitude: 40.71325, Longitude: -74.0060})
+		require.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("too far away", func(t *testing.T) {
+		// ~1 km north
+		_, err := svc.checkLocation(evt, &event.Coordinates{Latitude: 40.7218, Longitude: -74.0060})
+		assert.ErrorIs(t, err, ErrOutsideGeofence)
+	})
+
+	t.Run("missing device location", func(t *testing.T) {
+		_, err := svc.checkLocation(evt, nil)
+		assert.ErrorIs(t, err, ErrLocationRequired)
+	})
+
+	t.Run("remote events are not geofenced", func(t *testing.T) {
+		remote := testEvent("event2", start, 2, nil)
+		remote.Location.IsRemote = true
+		verified, err := svc.checkLocation(remote, nil)
+		require.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("venue without coordinates", func(t *testing.T) {
+		_, err := svc.checkLocation(testEvent("event3", start, 2, nil), venue)
+		assert.ErrorIs(t, err, ErrNoEventLocation)
+	})
+
+	t.Run("time window", func(t *testing.T) {
+		assert.NoError(t, svc.checkWindow(evt, start.Add(-20*time.Minute)))
+		assert.NoError(t, svc.checkWindow(evt, start.Add(2*time.Hour+20*time.Minute)))
+		assert.ErrorIs(t, svc.checkWindow(evt, start.Add(-time.Hour)), ErrOutsideCheckInWindow)
+		assert.ErrorIs(t, svc.checkWindow(evt, start.Add(3*time.Hour)), ErrOutsideCheckInWindow)
+	})
+}
+
+func TestStaffMarkedHours(t *testing.T) {
+	start := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	evt := testEvent("event1", start, 4, nil)
+	late := start.Add(time.Hour)
+
+	assert.Equal(t, 4.0, staffMarkedHours(&Registration{}, evt, nil, start.Add(24*time.Hour)), "never checked in: scheduled length")
+	assert.Equal(t, 3.0, staffMarkedHours(&Registration{CheckedInAt: &late}, evt, nil, start.Add(24*time.Hour)))
+	assert.Equal(t, 1.0, staffMarkedHours(&Registration{CheckedInAt: &late}, evt, nil, start.Add(2*time.Hour)), "event still running")
+	assert.Zero(t, staffMarkedHours(&Registration{}, evt, nil, start.Add(-time.Hour)))
+}
+
+func TestStaffMarkedHours_Shifts(t *testing.T) {
+	shifts := testShifts()
+	byID := shiftsByID(shifts)
+	evt := testEvent("festival", shifts[0].StartTime, 8, nil)
+	after := shifts[1].EndTime.Add(time.Hour)
+
+	setup := &Registration{Shifts: []*ShiftAssignment{
+		{ShiftID: "setup", Status: ShiftConfirmed},
+		{ShiftID: "afternoon", Status: ShiftCancelled},
+	}}
+	assert.Equal(t, 2.0, staffMarkedHours(setup, evt, byID, after), "one two-hour shift of an eight-hour event")
+
+	in, out := shifts[0].StartTime.Add(time.Hour), shifts[0].StartTime.Add(2*time.Hour+30*time.Minute)
+	worked := &Registration{CheckedInAt: &in, Shifts: []*ShiftAssignment{
+		{ShiftID: "morning", Status: ShiftConfirmed, CheckedInAt: &in, CheckedOutAt: &out},
+		{ShiftID: "afternoon", Status: ShiftConfirmed},
+	}}
+	assert.Equal(t, 5.5, staffMarkedHours(worked, evt, byID, after), "time checked in to the morning plus the afternoon window")
+	assert.Equal(t, 1.5, staffMarkedHours(worked, evt, byID, shifts[1].StartTime), "afternoon not started")
+}
@@ -0,0 +1,387 @@
+package registration
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+// MaxBulkAttendanceRows caps a single bulk update or sign-in sheet
+const MaxBulkAttendanceRows = 2000
+
+var ErrSignInSheetFormat = errors.New("sign-in sheet must be a CSV with an email column")
+
+// BulkAttendanceEntry is one row of a bulk attendance update. It names the
+// registration directly or, from a sign-in sheet, the volunteer's email.
+type BulkAttendanceEntry struct {
+	RegistrationID string
+	Email          string
+	Status         AttendanceStatus
+	// CheckedInAt is when the volunteer arrived, if known
+	CheckedInAt *time.Time
+	Notes       string
+}
+
+// BulkRowOutcome reports what happened to one row
+type BulkRowOutcome string
+
+const (
+	BulkRowUpdated   BulkRowOutcome = "UPDATED"
+	BulkRowUnchanged BulkRowOutcome = "UNCHANGED"
+	BulkRowFailed    BulkRowOutcome = "FAILED"
+)
+
+// BulkAttendanceRow is the report line for one input row. Row is 1-based;
+// for a sign-in sheet it is the CSV line, so the first volunteer is row 2.
+type BulkAttendanceRow struct {
+	Row            int
+	RegistrationID string
+	Email          string
+	Outcome        BulkRowOutcome
+	Error          string
+	Registration   *Registration
+}
+
+// BulkAttendanceResult reports a bulk attendance update. When a row fails and
+// the caller did not ask to skip invalid rows, nothing is applied.
+type BulkAttendanceResult struct {
+	EventID   string
+	Applied   bool
+	Updated   int
+	Unchanged int
+	Failed    int
+	Rows      []*BulkAttendanceRow
+}
+
+// AttendanceChange is one registration's part of a bulk update: the updated
+// registration, its attendance record, if the status moved, the change and,
+// for a volunteer on shifts checking in, the shift they arrived for
+type AttendanceChange struct {
+	Registration *Registration
+	Record       *AttendanceRecord
+	StatusChange *RegistrationStatusChange
+	Shift        *ShiftAssignment
+}
+
+// BulkMarkAttendance records attendance for many of an event's registrations
+// at once. All changes are written in a single transaction.
+//
+// With skipInvalid, rows that fail validation are reported and the rest are
+// applied. Otherwise any failed row leaves the event untouched.
+func (s *Service) BulkMarkAttendance(ctx context.Context, staffID, eventID string, entries []BulkAttendanceEntry, skipInvalid bool) (*BulkAttendanceResult, error) {
+	rows := make([]*BulkAttendanceRow, len(entries))
+	for i, e := range entries {
+		rows[i] = &BulkAttendanceRow{Row: i + 1, RegistrationID: e.RegistrationID, Email: e.Email}
+	}
+	return s.applyBulkAttendance(ctx, staffID, eventID, entries, rows, skipInvalid, "bulk attendance")
+}
+
+// ImportAttendanceCSV records attendance from a sign-in sheet, matching
+// volunteers to registrations by email. The sheet needs an "email" column and
+// may have "status" (CHECKED_IN, COMPLETED or NO_SHOW; COMPLETED when blank),
+// "checked_in_at" (RFC 3339) and "notes" columns.
+func (s *Service) ImportAttendanceCSV(ctx context.Context, staffID, eventID string, sheet io.Reader, skipInvalid bool) (*BulkAttendanceResult, error) {
+	entries, rows, err := parseSignInSheet(sheet)
+	if err != nil {
+		return nil, err
+	}
+	return s.applyBulkAttendance(ctx, staffID, eventID, entries, rows, skipInvalid, "sign-in sheet import")
+}
+
+func (s *Service) applyBulkAttendance(ctx context.Context, staffID, eventID string, entries []BulkAttendanceEntry, rows []*BulkAttendanceRow, skipInvalid bool, reason string) (*BulkAttendanceResult, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no attendance rows to apply")
+	}
+	if len(entries) > MaxBulkAttendanceRows {
+		return nil, fmt.Errorf("too many rows: at most %d per request", MaxBulkAttendanceRows)
+	}
+
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, staffID, event.StaffActionCheckIn); err != nil {
+		return nil, err
+	}
+
+	registrations, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registrations: %w", err)
+	}
+	shifts, err := s.eventService.GetEventShifts(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shifts) > 0 {
+		assignments, err := s.repo.GetEventShiftAssignments(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load shift sign-ups: %w", err)
+		}
+		byRegistration := map[string][]*ShiftAssignment{}
+		for _, a := range assignments {
+			byRegistration[a.RegistrationID] = append(byRegistration[a.RegistrationID], a)
+		}
+		for _, reg := range registrations {
+			reg.Shifts = byRegistration[reg.ID]
+			if reg.Shifts == nil {
+				reg.Shifts = []*ShiftAssignment{}
+			}
+		}
+	}
+	var emails map[string]string
+	for _, e := range entries {
+		if e.RegistrationID == "" {
+			if emails, err = s.repo.GetRegistrantEmails(ctx, eventID); err != nil {
+				return nil, fmt.Errorf("failed to look up registrant emails: %w", err)
+			}
+			break
+		}
+	}
+
+	completed := make(map[string]bool)
+	for _, reg := range registrations {
+		if reg.Status == StatusCompleted {
+			completed[reg.ID] = true
+		}
+	}
+	changes := planBulkAttendance(registrations, emails, shiftsByID(shifts), entries, rows, staffID, reason, time.Now())
+	result := &BulkAttendanceResult{EventID: eventID, Rows: rows}
+	for _, row := range rows {
+		switch row.Outcome {
+		case BulkRowUpdated:
+			result.Updated++
+		case BulkRowUnchanged:
+			result.Unchanged++
+		case BulkRowFailed:
+			result.Failed++
+		}
+	}
+
+	if result.Failed > 0 && !skipInvalid {
+		// Report what would have happened without writing anything
+		for _, row := range rows {
+			row.Registration = nil
+		}
+		return result, nil
+	}
+	if len(changes) > 0 {
+		err := s.repo.Transaction(ctx, func(ctx context.Context) error {
+			if err := s.repo.ApplyAttendanceChanges(ctx, changes); err != nil {
+				return fmt.Errorf("failed to apply attendance: %w", err)
+			}
+			// Volunteers marked no-show after completing lose their hours
+			for _, c := range changes {
+				if completed[c.Registration.ID] && c.Registration.Status == StatusNoShow {
+					if err := s.reverseHours(ctx, c.Registration, staffID); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.Applied = true
+
+	for _, c := range changes {
+		if c.Registration.AttendanceStatus == AttendanceCompleted {
+			s.creditStaffMarked(ctx, c.Registration, evt, c.Registration.UpdatedAt)
+		}
+	}
+	s.logger.Info("bulk attendance applied", "eventID", eventID, "by", staffID, "updated", result.Updated, "unchanged", result.Unchanged, "failed", result.Failed)
+	return result, nil
+}
+
+// planBulkAttendance resolves each entry to one of the event's registrations
+// and applies it in memory, filling in the row reports. Rows that already
+// carry an error (from parsing) are left as failed. Volunteers on shifts are
+// checked in to the shift they arrived for, as with a single check-in.
+func planBulkAttendance(registrations []*Registration, emails map[string]string, shifts map[string]*event.EventShift, entries []BulkAttendanceEntry, rows []*BulkAttendanceRow, staffID, reason string, now time.Time) []*AttendanceChange {
+	byID := make(map[string]*Registration, len(registrations))
+	byEmail := make(map[string]*Registration, len(registrations))
+	for _, reg := range registrations {
+		byID[reg.ID] = reg
+		email := strings.ToLower(emails[reg.UserID])
+		if email == "" {
+			continue
+		}
+		// Prefer a live registration over an earlier cancelled one
+		if prev, ok := byEmail[email]; !ok || prev.Status == StatusCancelled || prev.Status == StatusDeclined {
+			byEmail[email] = reg
+		}
+	}
+
+	var changes []*AttendanceChange
+	seen := map[string]int{}
+	for i, entry := range entries {
+		row := rows[i]
+		fail := func(msg string) {
+			row.Outcome = BulkRowFailed
+			row.Error = msg
+		}
+		if row.Error != "" {
+			row.Outcome = BulkRowFailed
+			continue
+		}
+
+		var reg *Registration
+		if entry.RegistrationID != "" {
+			reg = byID[entry.RegistrationID]
+		} else {
+			reg = byEmail[strings.ToLower(strings.TrimSpace(entry.Email))]
+		}
+		if reg == nil {
+			fail("no registration for this event matches the row")
+			continue
+		}
+		row.RegistrationID = reg.ID
+		if first, dup := seen[reg.ID]; dup {
+			fail(fmt.Sprintf("registration already listed in row %d", first))
+			continue
+		}
+		seen[reg.ID] = row.Row
+
+		if reg.AttendanceStatus == entry.Status {
+			row.Outcome = BulkRowUnchanged
+			row.Registration = reg
+			continue
+		}
+
+		updated := *reg
+		if err := applyAttendance(&updated, entry.Status, staffID, entry.CheckedInAt, now); err != nil {
+			fail(err.Error())
+			continue
+		}
+		row.Outcome = BulkRowUpdated
+		row.Registration = &updated
+
+		change := &AttendanceChange{
+			Registration: &updated,
+			Record: &AttendanceRecord{
+				ID:             uuid.New().String(),
+				RegistrationID: reg.ID,
+				Status:         string(entry.Status),
+				CheckedInAt:    updated.CheckedInAt,
+				CheckedInBy:    &staffID,
+				Notes:          entry.Notes,
+				CreatedAt:      now,
+			},
+		}
+		if entry.Status == AttendanceCheckedIn {
+			change.Shift = arriveForShift(&updated, shifts, *updated.CheckedInAt, now)
+		}
+		if updated.Status != reg.Status {
+			old := string(reg.Status)
+			change.StatusChange = &RegistrationStatusChange{
+				ID:             uuid.New().String(),
+				RegistrationID: reg.ID,
+				OldStatus:      &old,
+				NewStatus:      string(updated.Status),
+				ChangedBy:      &staffID,
+				Reason:         reason,
+				Notes:          entry.Notes,
+				CreatedAt:      now,
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// arriveForShift checks reg in to the shift it is arriving for at at,
+// returning the updated assignment, or nil if no shift is due. reg.Shifts is
+// replaced rather than changed in place.
+func arriveForShift(reg *Registration, shifts map[string]*event.EventShift, at, now time.Time) *ShiftAssignment {
+	assignment, _ := nextShift(reg.Shifts, shifts, at, 0)
+	if assignment == nil || assignment.CheckedInAt != nil {
+		return nil
+	}
+	arrived := *assignment
+	arrived.CheckedInAt = &at
+	arrived.UpdatedAt = now
+	reg.Shifts = slices.Clone(reg.Shifts)
+	reg.Shifts[slices.Index(reg.Shifts, assignment)] = &arrived
+	return &arrived
+}
+
+// parseSignInSheet reads a sign-in sheet CSV into entries and their report
+// rows. Problems with a single line are recorded on its row rather than
+// failing the whole sheet.
+func parseSignInSheet(sheet io.Reader) ([]BulkAttendanceEntry, []*BulkAttendanceRow, error) {
+	r := csv.NewReader(sheet)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, ErrSignInSheetFormat
+	}
+	cols := map[string]int{}
+	for i, name := range header {
+		// Spreadsheet exports often start with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		cols[strings.NewReplacer(" ", "_", "-", "_").Replace(name)] = i
+	}
+	if _, ok := cols["email"]; !ok {
+		return nil, nil, ErrSignInSheetFormat
+	}
+	field := func(record []string, name string) string {
+		if i, ok := cols[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var entries []BulkAttendanceEntry
+	var rows []*BulkAttendanceRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := r.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, fmt.Errorf("failed to read sign-in sheet: %w", err)
+			}
+			entries = append(entries, BulkAttendanceEntry{})
+			rows = append(rows, &BulkAttendanceRow{Row: parseErr.Line, Error: parseErr.Err.Error()})
+			continue
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		entry := BulkAttendanceEntry{Email: field(record, "email"), Status: AttendanceCompleted, Notes: field(record, "notes")}
+		row := &BulkAttendanceRow{Row: line, Email: entry.Email}
+		if entry.Email == "" {
+			row.Error = "email is required"
+		}
+		if status := field(record, "status"); status != "" {
+			entry.Status = AttendanceStatus(strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(status)))
+		}
+		if at := field(record, "checked_in_at"); at != "" {
+			t, err := time.Parse(time.RFC3339, at)
+			if err != nil {
+				row.Error = "checked_in_at must be an RFC 3339 timestamp"
+			} else {
+				entry.CheckedInAt = &t
+			}
+		}
+		entries = append(entries, entry)
+		rows = append(rows, row)
+	}
+	return entries, rows, nil
+}
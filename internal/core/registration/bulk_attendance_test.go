@@ -0,0 +1,110 @@
+package registration
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSignInSheet(t *testing.T) {
+	sheet := "\ufeffEmail,Status,Checked In At,Notes\n" +
+		"ana@example.org,,2026-06-01T09:05:00Z,brought gloves\n" +
+		"\n" +
+		"ben@example.org,no-show,,\n" +
+		"cara@example.org,completed,yesterday,\n" +
+		",COMPLETED,,\n"
+
+	entries, rows, err := parseSignInSheet(strings.NewReader(sheet))
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, 2, rows[0].Row)
+	assert.Equal(t, AttendanceCompleted, entries[0].Status, "blank status means completed")
+	require.NotNil(t, entries[0].CheckedInAt)
+	assert.Equal(t, "brought gloves", entries[0].Notes)
+
+	assert.Equal(t, 4, rows[1].Row, "blank lines are skipped but keep line numbers")
+	assert.Equal(t, AttendanceNoShow, entries[1].Status)
+
+	assert.NotEmpty(t, rows[2].Error, "bad timestamp")
+	assert.Equal(t, "email is required", rows[3].Error)
+
+	_, _, err = parseSignInSheet(strings.NewReader("name,status\nAna,COMPLETED\n"))
+	assert.ErrorIs(t, err, ErrSignInSheetFormat)
+}
+
+func TestPlanBulkAttendance(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	arrived := now.Add(-3 * time.Hour)
+	regs := []*Registration{
+		{ID: "r1", UserID: "u1", Status: StatusConfirmed, AttendanceStatus: AttendanceRegistered},
+		{ID: "r2", UserID: "u2", Status: StatusCompleted, AttendanceStatus: AttendanceCompleted},
+		{ID: "r3", UserID: "u3", Status: StatusWaitlisted, AttendanceStatus: AttendanceRegistered},
+		{ID: "r4-old", UserID: "u4", Status: StatusCancelled, AttendanceStatus: AttendanceCancelled},
+		{ID: "r4", UserID: "u4", Status: StatusConfirmed, AttendanceStatus: AttendanceRegistered},
+	}
+	emails := map[string]string{"u1": "ana@example.org", "u2": "ben@example.org", "u3": "cara@example.org", "u4": "dev@example.org"}
+	entries := []BulkAttendanceEntry{
+		{Email: "ANA@example.org", Status: AttendanceCompleted, CheckedInAt: &arrived},
+		{RegistrationID: "r2", Status: AttendanceCompleted},
+		{Email: "cara@example.org", Status: AttendanceCompleted},
+		{Email: "nobody@example.org", Status: AttendanceCompleted},
+		{RegistrationID: "r1", Status: AttendanceNoShow},
+		{Email: "dev@example.org", Status: AttendanceNoShow},
+	}
+	rows := make([]*BulkAttendanceRow, len(entries))
+	for i := range entries {
+		rows[i] = &BulkAttendanceRow{Row: i + 1}
+	}
+
+	changes := planBulkAttendance(regs, emails, nil, entries, rows, "staff", "bulk attendance", now)
+
+	assert.Equal(t, BulkRowUpdated, rows[0].Outcome)
+	assert.Equal(t, "r1", rows[0].RegistrationID, "matched by email, case-insensitively")
+	assert.Equal(t, BulkRowUnchanged, rows[1].Outcome)
+	assert.Equal(t, BulkRowFailed, rows[2].Outcome, "waitlisted volunteers can't be marked")
+	assert.Equal(t, BulkRowFailed, rows[3].Outcome)
+	assert.Contains(t, rows[4].Error, "row 1", "duplicates point at the first row")
+	assert.Equal(t, "r4", rows[5].RegistrationID, "the live registration wins over a cancelled one")
+
+	require.Len(t, changes, 2)
+	first := changes[0]
+	assert.Equal(t, StatusCompleted, first.Registration.Status)
+	assert.Equal(t, &arrived, first.Registration.CheckedInAt, "sign-in time backfills the check-in")
+	assert.Equal(t, "COMPLETED", first.Record.Status)
+	require.NotNil(t, first.StatusChange)
+	assert.Equal(t, "CONFIRMED", *first.StatusChange.OldStatus)
+	assert.Equal(t, StatusConfirmed, regs[0].Status, "planning doesn't touch the loaded registrations")
+}
+
+func TestPlanBulkAttendance_Shifts(t *testing.T) {
+	shifts := testShifts()
+	arrived := shifts[1].StartTime.Add(-10 * time.Minute)
+	now := shifts[1].StartTime
+	checkedOut := shifts[0].EndTime
+	regs := []*Registration{
+		{ID: "r1", UserID: "u1", Status: StatusConfirmed, AttendanceStatus: AttendanceRegistered, Shifts: []*ShiftAssignment{
+			{ID: "am", RegistrationID: "r1", ShiftID: "morning", Status: ShiftConfirmed, CheckedInAt: &shifts[0].StartTime, CheckedOutAt: &checkedOut},
+			{ID: "pm", RegistrationID: "r1", ShiftID: "afternoon", Status: ShiftConfirmed},
+		}},
+		{ID: "r2", UserID: "u2", Status: StatusConfirmed, AttendanceStatus: AttendanceRegistered},
+	}
+	entries := []BulkAttendanceEntry{
+		{RegistrationID: "r1", Status: AttendanceCheckedIn, CheckedInAt: &arrived},
+		{RegistrationID: "r2", Status: AttendanceCheckedIn},
+	}
+	rows := []*BulkAttendanceRow{{Row: 1}, {Row: 2}}
+
+	changes := planBulkAttendance(regs, nil, shiftsByID(shifts), entries, rows, "staff", "bulk attendance", now)
+
+	require.Len(t, changes, 2)
+	require.NotNil(t, changes[0].Shift)
+	assert.Equal(t, "pm", changes[0].Shift.ID, "checked in to the shift they arrived for")
+	assert.Equal(t, &arrived, changes[0].Shift.CheckedInAt)
+	assert.Same(t, changes[0].Shift, changes[0].Registration.Shifts[1])
+	assert.Nil(t, regs[0].Shifts[1].CheckedInAt, "planning doesn't touch the loaded assignments")
+	assert.Nil(t, changes[1].Shift, "registrations without shifts")
+}
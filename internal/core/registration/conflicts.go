@@ -0,0 +1,411 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/skill"
+)
+
+const (
+	// assumedTravelSpeedKmh approximates door-to-door urban travel between venues
+	assumedTravelSpeedKmh = 40.0
+	// skillCommitmentWindow is how close together two events must be for a
+	// shared required skill to count against the volunteer
+	skillCommitmentWindow = 12 * time.Hour
+	// maxSuggestions caps the alternatives offered per conflict
+	maxSuggestions = 3
+	earthRadiusKm  = 6371.0
+)
+
+// ConflictError is returned when a registration is refused because it
+// collides with one of the user's existing commitments.
+type ConflictError struct {
+	EventID   string
+	Conflicts []*RegistrationConflict
+}
+
+func (e *ConflictError) Error() string {
+	types := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		types = append(types, fmt.Sprintf("%s with event %s", c.ConflictType, c.ConflictingEventID))
+	}
+	return fmt.Sprintf("registration conflicts with existing commitments: %s", strings.Join(types, ", "))
+}
+
+// ConflictDetail is a stored conflict seen from one of its two events,
+// with alternatives the volunteer could sign up for instead.
+type ConflictDetail struct {
+	Conflict         *RegistrationConflict
+	ConflictingEvent *event.Event
+	Suggestions      []*event.Event
+}
+
+var severityRank = map[ConflictSeverity]int{
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// atLeast reports whether s is as severe as or more severe than min
+func (s ConflictSeverity) atLeast(min ConflictSeverity) bool {
+	return severityRank[s] >= severityRank[min]
+}
+
+// detectConflicts compares a candidate event against the events a user is
+// already committed to. It is pure so bulk registration can also check the
+// batch against itself before writing anything.
+func detectConflicts(userID string, candidate *event.Event, commitments []*event.Event) []*RegistrationConflict {
+	var conflicts []*RegistrationConflict
+	newConflict := func(other *event.Event, typ ConflictType, severity ConflictSeverity) {
+		conflicts = append(conflicts, &RegistrationConflict{
+			ID:                 uuid.New().String(),
+			UserID:             userID,
+			PrimaryEventID:     candidate.ID,
+			ConflictingEventID: other.ID,
+			ConflictType:       typ,
+			Severity:           severity,
+		})
+	}
+
+	var skillOverlaps []*event.Event
+	for _, other := range commitments {
+		if other.ID == candidate.ID {
+			continue
+		}
+
+		if overlap := overlapDuration(candidate, other); overlap > 0 {
+			newConflict(other, ConflictTimeOverlap, gradeTimeOverlap(candidate, other, overlap))
+			continue
+		}
+
+		if severity, ok := travelTimeConflict(candidate, other); ok {
+			newConflict(other, ConflictTravelTime, severity)
+		}
+
+		if gapBetween(candidate, other) < skillCommitmentWindow && sharesRequiredSkill(candidate, other) {
+			skillOverlaps = append(skillOverlaps, other)
+		}
+	}
+
+	// One overcommitted skill is worse the more events lean on it
+	severity := SeverityLow
+	switch {
+	case len(skillOverlaps) >= 3:
+		severity = SeverityHigh
+	case len(skillOverlaps) == 2:
+		severity = SeverityMedium
+	}
+	for _, other := range skillOverlaps {
+		newConflict(other, ConflictSkillOvercommitment, severity)
+	}
+
+	return conflicts
+}
+
+// detectShiftConflicts checks each shift the volunteer picked, or the whole
+// event, and keeps the most severe conflict of each kind per other event
+func detectShiftConflicts(userID string, views []*event.Event, commitments []*event.Event) []*RegistrationConflict {
+	type key struct {
+		eventID string
+		typ     ConflictType
+	}
+	var conflicts []*RegistrationConflict
+	index := map[key]int{}
+	for _, view := range views {
+		for _, c := range detectConflicts(userID, view, commitments) {
+			k := key{c.ConflictingEventID, c.ConflictType}
+			if i, ok := index[k]; ok {
+				if !conflicts[i].Severity.atLeast(c.Severity) {
+					conflicts[i] = c
+				}
+				continue
+			}
+			index[k] = len(conflicts)
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts
+}
+
+// gradeTimeOverlap grades by how much of the shorter event is double-booked
+func gradeTimeOverlap(a, b *event.Event, overlap time.Duration) ConflictSeverity {
+	shorter := a.EndTime.Sub(a.StartTime)
+	if d := b.EndTime.Sub(b.StartTime); d < shorter {
+		shorter = d
+	}
+	if shorter <= 0 {
+		return SeverityCritical
+	}
+	switch ratio := float64(overlap) / float64(shorter); {
+	case ratio >= 0.5:
+		return SeverityCritical
+	case ratio >= 0.25:
+		return SeverityHigh
+	default:
+		return SeverityMedium
+	}
+}
+
+// travelTimeConflict reports whether the gap between two in-person events is
+// shorter than the time needed to travel between them
+func travelTimeConflict(a, b *event.Event) (ConflictSeverity, bool) {
+	if a.Location.IsRemote || b.Location.IsRemote || a.Location.Coordinates == nil || b.Location.Coordinates == nil {
+		return "", false
+	}
+
+	distance := haversineKm(*a.Location.Coordinates, *b.Location.Coordinates)
+	travel := time.Duration(distance / assumedTravelSpeedKmh * float64(time.Hour))
+	gap := gapBetween(a, b)
+	if gap >= travel {
+		return "", false
+	}
+
+	switch shortfall := float64(travel-gap) / float64(travel); {
+	case shortfall >= 0.5:
+		return SeverityHigh, true
+	case shortfall >= 0.25:
+		return SeverityMedium, true
+	default:
+		return SeverityLow, true
+	}
+}
+
+// overlapDuration returns how long two events run at the same time
+func overlapDuration(a, b *event.Event) time.Duration {
+	start, end := a.StartTime, a.EndTime
+	if b.StartTime.After(start) {
+		start = b.StartTime
+	}
+	if b.EndTime.Before(end) {
+		end = b.EndTime
+	}
+	return end.Sub(start)
+}
+
+// gapBetween returns the time between the end of the earlier event and the start of the later one
+func gapBetween(a, b *event.Event) time.Duration {
+	if a.StartTime.After(b.StartTime) {
+		a, b = b, a
+	}
+	return b.StartTime.Sub(a.EndTime)
+}
+
+func sharesRequiredSkill(a, b *event.Event) bool {
+	required := make(map[string]bool)
+	for _, s := range a.Requirements.Skills {
+		if s.Required {
+			for _, key := range skill.MatchKeys(s.SkillID, s.Skill) {
+				required[key] = true
+			}
+		}
+	}
+	for _, s := range b.Requirements.Skills {
+		if !s.Required {
+			continue
+		}
+		for _, key := range skill.MatchKeys(s.SkillID, s.Skill) {
+			if required[key] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// haversineKm returns the great-circle distance between two points
+func haversineKm(a, b event.Coordinates) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(b.Latitude - a.Latitude)
+	dLng := toRad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.Latitude))*math.Cos(toRad(b.Latitude))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// confirmedCommitments loads the events the user holds a confirmed seat for
+func (s *Service) confirmedCommitments(ctx context.Context, userID string) ([]*event.Event, error) {
+	registrations, err := s.repo.GetRegistrationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user registrations: %w", err)
+	}
+
+	var events []*event.Event
+	for _, reg := range registrations {
+		if reg.Status != StatusConfirmed {
+			continue
+		}
+		evt, err := s.eventService.GetEvent(ctx, reg.EventID)
+		if err != nil {
+			s.logger.Warn("skipping commitment with missing event", "eventID", reg.EventID, "error", err)
+			continue
+		}
+		shifts, err := s.confirmedShifts(ctx, reg)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, shiftViews(evt, shifts)...)
+	}
+	return events, nil
+}
+
+// confirmedShifts returns the shifts a registration holds a place on; a
+// volunteer on some shifts is only committed for those
+func (s *Service) confirmedShifts(ctx context.Context, reg *Registration) ([]*event.EventShift, error) {
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return nil, err
+	}
+	if len(reg.Shifts) == 0 {
+		return nil, nil
+	}
+	shifts, err := s.eventService.GetEventShifts(ctx, reg.EventID)
+	if err != nil {
+		return nil, err
+	}
+	byID := shiftsByID(shifts)
+	var held []*event.EventShift
+	for _, a := range reg.Shifts {
+		if shift, ok := byID[a.ShiftID]; ok && a.Status == ShiftConfirmed {
+			held = append(held, shift)
+		}
+	}
+	return held, nil
+}
+
+// blockingConflicts returns the conflicts at or above min severity
+func blockingConflicts(conflicts []*RegistrationConflict, min ConflictSeverity) []*RegistrationConflict {
+	var blocking []*RegistrationConflict
+	for _, c := range conflicts {
+		if c.Severity.atLeast(min) {
+			blocking = append(blocking, c)
+		}
+	}
+	return blocking
+}
+
+// recordConflicts persists conflicts found for a registration that went ahead
+func (s *Service) recordConflicts(ctx context.Context, conflicts []*RegistrationConflict) {
+	for _, c := range conflicts {
+		if _, err := s.repo.CreateRegistrationConflict(ctx, c); err != nil {
+			s.logger.Error("failed to record registration conflict", "eventID", c.PrimaryEventID, "type", c.ConflictType, "error", err)
+		}
+	}
+}
+
+// resolveConflicts marks the user's open conflicts involving an event as resolved
+func (s *Service) resolveConflicts(ctx context.Context, userID, eventID, notes string) {
+	conflicts, err := s.repo.GetRegistrationConflictsByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to load registration conflicts", "userID", userID, "error", err)
+		return
+	}
+	for _, c := range conflicts {
+		if c.Resolved || (c.PrimaryEventID != eventID && c.ConflictingEventID != eventID) {
+			continue
+		}
+		c.Resolved = true
+		c.ResolutionNotes = notes
+		if err := s.repo.UpdateRegistrationConflict(ctx, c); err != nil {
+			s.logger.Error("failed to resolve registration conflict", "conflictID", c.ID, "error", err)
+		}
+	}
+}
+
+// GetEventConflicts returns the user's open conflicts involving an event,
+// each with same-category alternatives that fit the user's schedule.
+func (s *Service) GetEventConflicts(ctx context.Context, userID, eventID string) ([]*ConflictDetail, error) {
+	conflicts, err := s.repo.GetRegistrationConflictsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registration conflicts: %w", err)
+	}
+
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+
+	var relevant []*RegistrationConflict
+	for _, c := range conflicts {
+		if !c.Resolved && (c.PrimaryEventID == eventID || c.ConflictingEventID == eventID) {
+			relevant = append(relevant, c)
+		}
+	}
+	if len(relevant) == 0 {
+		return []*ConflictDetail{}, nil
+	}
+
+	commitments, err := s.confirmedCommitments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	suggestions, err := s.suggestAlternatives(ctx, userID, evt, commitments)
+	if err != nil {
+		// Suggestions are a convenience; still report the conflicts
+		s.logger.Warn("failed to suggest alternative events", "eventID", eventID, "error", err)
+	}
+
+	details := make([]*ConflictDetail, 0, len(relevant))
+	for _, c := range relevant {
+		otherID := c.ConflictingEventID
+		if otherID == eventID {
+			otherID = c.PrimaryEventID
+		}
+		other, err := s.eventService.GetEvent(ctx, otherID)
+		if err != nil {
+			s.logger.Warn("skipping conflict with missing event", "conflictID", c.ID, "error", err)
+			continue
+		}
+		details = append(details, &ConflictDetail{Conflict: c, ConflictingEvent: other, Suggestions: suggestions})
+	}
+	return details, nil
+}
+
+// suggestAlternatives finds upcoming published events in the same category
+// that would not conflict with anything else the user is committed to
+func (s *Service) suggestAlternatives(ctx context.Context, userID string, evt *event.Event, commitments []*event.Event) ([]*event.Event, error) {
+	filter := event.EventSearchFilter{
+		Categories: []event.EventCategory{evt.Category},
+		Status:     []event.EventStatus{event.EventStatusPublished},
+	}
+	conn, err := s.eventService.SearchEvents(ctx, filter, nil, 20, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't measure the alternative against the very event it replaces
+	var others []*event.Event
+	committed := map[string]bool{evt.ID: true}
+	for _, c := range commitments {
+		committed[c.ID] = true
+		if c.ID != evt.ID {
+			others = append(others, c)
+		}
+	}
+
+	now := time.Now()
+	suggestions := []*event.Event{}
+	for i := range conn.Edges {
+		candidate := &conn.Edges[i].Node
+		if committed[candidate.ID] || !candidate.StartTime.After(now) {
+			continue
+		}
+		if now.After(candidate.RegistrationSettings.ClosesAt) {
+			continue
+		}
+		if len(detectConflicts(userID, candidate, others)) > 0 {
+			continue
+		}
+		suggestions = append(suggestions, candidate)
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+	}
+	return suggestions, nil
+}
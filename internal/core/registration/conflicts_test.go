@@ -0,0 +1,138 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func testEvent(id string, start time.Time, hours float64, coords *event.Coordinates, skills ...string) *event.Event {
+	evt := &event.Event{
+		ID:        id,
+		StartTime: start,
+		EndTime:   start.Add(time.Duration(hours * float64(time.Hour))),
+		Location:  event.EventLocation{Coordinates: coords},
+	}
+	for _, skill := range skills {
+		evt.Requirements.Skills = append(evt.Requirements.Skills, event.SkillRequirement{Skill: skill, Required: true})
+	}
+	return evt
+}
+
+func TestDetectConflicts(t *testing.T) {
+	base := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	downtown := &event.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	// ~100 km away: about 2.5 hours at the assumed speed
+	farAway := &event.Coordinates{Latitude: 41.6, Longitude: -73.8}
+
+	t.Run("full overlap is critical", func(t *testing.T) {
+		candidate := testEvent("new", base, 3, nil)
+		existing := testEvent("old", base.Add(time.Hour), 3, nil)
+
+		conflicts := detectConflicts("user1", candidate, []*event.Event{existing})
+
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, ConflictTimeOverlap, conflicts[0].ConflictType)
+		assert.Equal(t, SeverityCritical, conflicts[0].Severity)
+		assert.Equal(t, "new", conflicts[0].PrimaryEventID)
+		assert.Equal(t, "old", conflicts[0].ConflictingEventID)
+	})
+
+	t.Run("small overlap is medium", func(t *testing.T) {
+		candidate := testEvent("new", base, 4, nil)
+		existing := testEvent("old", base.Add(215*time.Minute), 4, nil)
+
+		conflicts := detectConflicts("user1", candidate, []*event.Event{existing})
+
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, SeverityMedium, conflicts[0].Severity)
+	})
+
+	t.Run("not enough time to travel", func(t *testing.T) {
+		candidate := testEvent("new", base, 2, downtown)
+		existing := testEvent("old", base.Add(2*time.Hour+30*time.Minute), 2, farAway)
+
+		conflicts := detectConflicts("user1", candidate, []*event.Event{existing})
+
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, ConflictTravelTime, conflicts[0].ConflictType)
+		assert.Equal(t, SeverityHigh, conflicts[0].Severity)
+	})
+
+	t.Run("enough time to travel", func(t *testing.T) {
+		candidate := testEvent("new", base, 2, downtown)
+		existing := testEvent("old", base.Add(6*time.Hour), 2, farAway)
+
+		assert.Empty(t, detectConflicts("user1", candidate, []*event.Event{existing}))
+	})
+
+	t.Run("remote events never need travel", func(t *testing.T) {
+		candidate := testEvent("new", base, 2, downtown)
+		existing := testEvent("old", base.Add(2*time.Hour), 2, farAway)
+		existing.Location.IsRemote = true
+
+		assert.Empty(t, detectConflicts("user1", candidate, []*event.Event{existing}))
+	})
+
+	t.Run("skill overcommitment grows with each event", func(t *testing.T) {
+		candidate := testEvent("new", base, 1, nil, "First Aid")
+		commitments := []*event.Event{
+			testEvent("a", base.Add(2*time.Hour), 1, nil, "first aid"),
+			testEvent("b", base.Add(4*time.Hour), 1, nil, "First Aid", "Cooking"),
+			testEvent("c", base.Add(6*time.Hour), 1, nil, "Cooking"),
+			testEvent("d", base.Add(48*time.Hour), 1, nil, "First Aid"),
+		}
+
+		conflicts := detectConflicts("user1", candidate, commitments)
+
+		require.Len(t, conflicts, 2)
+		for _, c := range conflicts {
+			assert.Equal(t, ConflictSkillOvercommitment, c.ConflictType)
+			assert.Equal(t, SeverityMedium, c.Severity)
+		}
+	})
+
+	t.Run("skills match on their catalogue entry", func(t *testing.T) {
+		firstAid := "skill-first-aid"
+		candidate := testEvent("new", base, 1, nil, "CPR/First Aid")
+		candidate.Requirements.Skills[0].SkillID = &firstAid
+		existing := testEvent("old", base.Add(2*time.Hour), 1, nil, "First Aid")
+		existing.Requirements.Skills[0].SkillID = &firstAid
+
+		conflicts := detectConflicts("user1", candidate, []*event.Event{existing})
+
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, ConflictSkillOvercommitment, conflicts[0].ConflictType)
+	})
+
+	t.Run("same event is ignored", func(t *testing.T) {
+		candidate := testEvent("new", base, 2, nil)
+		assert.Empty(t, detectConflicts("user1", candidate, []*event.Event{candidate}))
+	})
+}
+
+func TestHaversineKm(t *testing.T) {
+	nyc := event.Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	london := event.Coordinates{Latitude: 51.5074, Longitude: -0.1278}
+
+	assert.InDelta(t, 5570, haversineKm(nyc, london), 10)
+	assert.Zero(t, haversineKm(nyc, nyc))
+}
+
+func TestBlockingConflicts(t *testing.T) {
+	conflicts := []*RegistrationConflict{
+		{ID: "1", Severity: SeverityLow},
+		{ID: "2", Severity: SeverityHigh},
+		{ID: "3", Severity: SeverityCritical, ConflictType: ConflictTimeOverlap},
+	}
+
+	assert.Len(t, blockingConflicts(conflicts, SeverityHigh), 2)
+	assert.Len(t, blockingConflicts(conflicts, SeverityCritical), 1)
+
+	err := &ConflictError{EventID: "new", Conflicts: blockingConflicts(conflicts, SeverityCritical)}
+	assert.Contains(t, err.Error(), "TIME_OVERLAP")
+}
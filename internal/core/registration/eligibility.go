@@ -0,0 +1,221 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/skill"
+	"github.com/volunteersync/backend/internal/core/user"
+)
+
+// ErrNotEligible is returned when a volunteer doesn't meet an event's requirements
+var ErrNotEligible = errors.New("you don't meet this event's requirements")
+
+// EligibilityCode identifies which requirement a volunteer failed
+type EligibilityCode string
+
+const (
+	EligibilityMinimumAge         EligibilityCode = "MINIMUM_AGE"
+	EligibilityDateOfBirthMissing EligibilityCode = "DATE_OF_BIRTH_MISSING"
+	EligibilitySkillMissing       EligibilityCode = "SKILL_MISSING"
+	EligibilitySkillProficiency   EligibilityCode = "SKILL_PROFICIENCY"
+	EligibilityTrainingMissing    EligibilityCode = "TRAINING_MISSING"
+	EligibilityTrainingExpired    EligibilityCode = "TRAINING_EXPIRED"
+	EligibilityBackgroundCheck    EligibilityCode = "BACKGROUND_CHECK"
+	EligibilityInterestMismatch   EligibilityCode = "INTEREST_MISMATCH"
+)
+
+// EligibilityReason explains one requirement the volunteer doesn't meet.
+// Non-blocking reasons are advisory and don't stop registration.
+type EligibilityReason struct {
+	Code        EligibilityCode
+	Requirement string
+	Message     string
+	Blocking    bool
+}
+
+// Eligibility is the outcome of checking a volunteer against an event
+type Eligibility struct {
+	EventID  string
+	Eligible bool
+	Reasons  []EligibilityReason
+}
+
+// EligibilityError carries the blocking reasons a registration was refused for
+type EligibilityError struct {
+	EventID string
+	Reasons []EligibilityReason
+}
+
+func (e *EligibilityError) Error() string {
+	parts := make([]string, 0, len(e.Reasons))
+	for _, r := range e.Reasons {
+		parts = append(parts, r.Message)
+	}
+	return fmt.Sprintf("%s: %s", ErrNotEligible, strings.Join(parts, "; "))
+}
+
+func (e *EligibilityError) Unwrap() error { return ErrNotEligible }
+
+// proficiencyRank orders skill levels so a higher level satisfies a lower requirement
+var proficiencyRank = map[string]int{
+	string(event.SkillProficiencyBeginner):     1,
+	string(event.SkillProficiencyIntermediate): 2,
+	string(event.SkillProficiencyAdvanced):     3,
+	string(event.SkillProficiencyExpert):       4,
+}
+
+// CheckEligibility reports whether the user meets the event's requirements, and
+// why not, so the UI can explain a disabled Register button
+func (s *Service) CheckEligibility(ctx context.Context, userID, eventID string) (*Eligibility, error) {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	profile, err := s.userService.GetProfileWithDetails(ctx, userID, userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	return evaluateEligibility(evt, profile, time.Now()), nil
+}
+
+// checkEligibility refuses registration with an *EligibilityError when any
+// blocking requirement isn't met
+func checkEligibility(evt *event.Event, profile *user.UserProfile) error {
+	result := evaluateEligibility(evt, profile, time.Now())
+	if result.Eligible {
+		return nil
+	}
+	var blocking []EligibilityReason
+	for _, r := range result.Reasons {
+		if r.Blocking {
+			blocking = append(blocking, r)
+		}
+	}
+	return &EligibilityError{EventID: evt.ID, Reasons: blocking}
+}
+
+// evaluateEligibility compares a volunteer's profile against the event's
+// requirements. Age, trainings and background checks are judged as of the
+// event's start, so a check that lapses before the event doesn't count.
+func evaluateEligibility(evt *event.Event, profile *user.UserProfile, now time.Time) *Eligibility {
+	result := &Eligibility{EventID: evt.ID, Eligible: true}
+	add := func(r EligibilityReason) {
+		if r.Blocking {
+			result.Eligible = false
+		}
+		result.Reasons = append(result.Reasons, r)
+	}
+
+	req := evt.Requirements
+	on := evt.StartTime
+	if on.Before(now) {
+		on = now
+	}
+
+	if req.MinimumAge != nil && *req.MinimumAge > 0 {
+		requirement := fmt.Sprintf("Age %d+", *req.MinimumAge)
+		switch {
+		case profile.DateOfBirth == nil:
+			add(EligibilityReason{Code: EligibilityDateOfBirthMissing, Requirement: requirement, Blocking: true,
+				Message: fmt.Sprintf("add your date of birth to your profile; this event requires volunteers aged %d or older", *req.MinimumAge)})
+		case ageOn(*profile.DateOfBirth, on) < *req.MinimumAge:
+			add(EligibilityReason{Code: EligibilityMinimumAge, Requirement: requirement, Blocking: true,
+				Message: fmt.Sprintf("volunteers must be at least %d years old on the day of the event", *req.MinimumAge)})
+		}
+	}
+
+	// Skills match on their catalogue entry, so "CPR/First Aid" on a profile
+	// meets a "First Aid" requirement
+	skills := make(map[string]user.Skill, 2*len(profile.Skills))
+	for _, sk := range profile.Skills {
+		for _, key := range skill.MatchKeys(sk.SkillID, sk.Name) {
+			skills[key] = sk
+		}
+	}
+	for _, sr := range req.Skills {
+		if !sr.Required {
+			continue
+		}
+		requirement := fmt.Sprintf("%s (%s)", sr.Skill, sr.Proficiency)
+		var have user.Skill
+		ok := false
+		for _, key := range skill.MatchKeys(sr.SkillID, sr.Skill) {
+			if have, ok = skills[key]; ok {
+				break
+			}
+		}
+		if !ok {
+			add(EligibilityReason{Code: EligibilitySkillMissing, Requirement: requirement, Blocking: true,
+				Message: fmt.Sprintf("requires the skill %s", sr.Skill)})
+			continue
+		}
+		if proficiencyRank[strings.ToUpper(have.Proficiency)] < proficiencyRank[string(sr.Proficiency)] {
+			add(EligibilityReason{Code: EligibilitySkillProficiency, Requirement: requirement, Blocking: true,
+				Message: fmt.Sprintf("requires %s at %s level or above; your profile lists %s", sr.Skill, strings.ToLower(string(sr.Proficiency)), strings.ToLower(have.Proficiency))})
+		}
+	}
+
+	trainings := make(map[string]user.Training, len(profile.Trainings))
+	for _, t := range profile.Trainings {
+		trainings[strings.ToLower(strings.TrimSpace(t.Name))] = t
+	}
+	for _, tr := range req.Training {
+		// Training the organizer runs on the day can't be a precondition
+		if !tr.Required || tr.ProvidedByOrganizer {
+			continue
+		}
+		have, ok := trainings[strings.ToLower(strings.TrimSpace(tr.Name))]
+		if !ok {
+			add(EligibilityReason{Code: EligibilityTrainingMissing, Requirement: tr.Name, Blocking: true,
+				Message: fmt.Sprintf("requires completed %s training", tr.Name)})
+			continue
+		}
+		if have.ExpiresAt != nil && have.ExpiresAt.Before(on) {
+			add(EligibilityReason{Code: EligibilityTrainingExpired, Requirement: tr.Name, Blocking: true,
+				Message: fmt.Sprintf("your %s training expires before the event", tr.Name)})
+		}
+	}
+
+	if req.BackgroundCheck {
+		switch {
+		case profile.BackgroundCheckVerifiedAt == nil:
+			add(EligibilityReason{Code: EligibilityBackgroundCheck, Requirement: "Background check", Blocking: true,
+				Message: "requires a verified background check"})
+		case profile.BackgroundCheckExpiresAt != nil && profile.BackgroundCheckExpiresAt.Before(on):
+			add(EligibilityReason{Code: EligibilityBackgroundCheck, Requirement: "Background check", Blocking: true,
+				Message: "your background check expires before the event"})
+		}
+	}
+
+	if len(req.Interests) > 0 && !sharesInterest(profile.Interests, req.Interests) {
+		add(EligibilityReason{Code: EligibilityInterestMismatch, Requirement: "Interests", Blocking: false,
+			Message: "this event doesn't match any of the interests on your profile"})
+	}
+
+	return result
+}
+
+// ageOn returns the age in whole years of someone born on dob, on the given day
+func ageOn(dob, on time.Time) int {
+	age := on.Year() - dob.Year()
+	if on.Month() < dob.Month() || (on.Month() == dob.Month() && on.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+func sharesInterest(have []user.Interest, wanted []string) bool {
+	for _, w := range wanted {
+		for _, h := range have {
+			if h.ID == w {
+				return true
+			}
+		}
+	}
+	return false
+}
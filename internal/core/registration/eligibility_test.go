@@ -0,0 +1,134 @@
+package registration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/user"
+)
+
+func reasonCodes(e *Eligibility) []EligibilityCode {
+	var codes []EligibilityCode
+	for _, r := range e.Reasons {
+		codes = append(codes, r.Code)
+	}
+	return codes
+}
+
+func TestEvaluateEligibility(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) *time.Time {
+		v := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	evt := &event.Event{
+		ID:        "evt-1",
+		StartTime: start,
+		Requirements: event.EventRequirements{
+			MinimumAge: intPtr(18),
+			Skills: []event.SkillRequirement{
+				{Skill: "First Aid", Proficiency: event.SkillProficiencyIntermediate, Required: true},
+				{Skill: "Photography", Proficiency: event.SkillProficiencyExpert},
+			},
+			Training: []event.TrainingRequirement{
+				{Name: "Food Safety", Required: true},
+				{Name: "Site Induction", Required: true, ProvidedByOrganizer: true},
+			},
+			Interests:       []string{"int-env"},
+			BackgroundCheck: true,
+		},
+	}
+
+	t.Run("qualified volunteer", func(t *testing.T) {
+		profile := &user.UserProfile{
+			// Turns 18 on the day of the event
+			DateOfBirth:               date(2008, time.June, 15),
+			Skills:                    []user.Skill{{Name: "first aid", Proficiency: "ADVANCED"}},
+			Trainings:                 []user.Training{{Name: "Food safety", ExpiresAt: date(2027, time.January, 1)}},
+			Interests:                 []user.Interest{{ID: "int-env"}},
+			BackgroundCheckVerifiedAt: date(2026, time.January, 1),
+		}
+		result := evaluateEligibility(evt, profile, now)
+		assert.True(t, result.Eligible)
+		assert.Empty(t, result.Reasons)
+	})
+
+	t.Run("every failure is reported", func(t *testing.T) {
+		profile := &user.UserProfile{
+			DateOfBirth:               date(2008, time.June, 16),
+			Skills:                    []user.Skill{{Name: "First Aid", Proficiency: "BEGINNER"}},
+			Trainings:                 []user.Training{{Name: "Food Safety", ExpiresAt: date(2026, time.June, 1)}},
+			BackgroundCheckVerifiedAt: date(2025, time.January, 1),
+			BackgroundCheckExpiresAt:  date(2026, time.June, 1),
+		}
+		result := evaluateEligibility(evt, profile, now)
+		assert.False(t, result.Eligible)
+		assert.Equal(t, []EligibilityCode{
+			EligibilityMinimumAge,
+			EligibilitySkillProficiency,
+			EligibilityTrainingExpired,
+			EligibilityBackgroundCheck,
+			EligibilityInterestMismatch,
+		}, reasonCodes(result))
+		assert.False(t, result.Reasons[4].Blocking, "interests are advisory")
+	})
+
+	t.Run("missing profile facts", func(t *testing.T) {
+		result := evaluateEligibility(evt, &user.UserProfile{Interests: []user.Interest{{ID: "int-env"}}}, now)
+		assert.Equal(t, []EligibilityCode{
+			EligibilityDateOfBirthMissing,
+			EligibilitySkillMissing,
+			EligibilityTrainingMissing,
+			EligibilityBackgroundCheck,
+		}, reasonCodes(result))
+	})
+
+	t.Run("skills match on their catalogue entry", func(t *testing.T) {
+		firstAid, cpr := "skill-first-aid", "skill-cpr"
+		catalogued := &event.Event{ID: "evt-3", StartTime: start, Requirements: event.EventRequirements{
+			Skills: []event.SkillRequirement{{Skill: "First Aid", SkillID: &firstAid, Proficiency: event.SkillProficiencyBeginner, Required: true}},
+		}}
+
+		result := evaluateEligibility(catalogued, &user.UserProfile{
+			Skills: []user.Skill{{Name: "CPR/First Aid", SkillID: &firstAid, Proficiency: "BEGINNER"}},
+		}, now)
+		assert.True(t, result.Eligible, "another synonym of the same entry")
+
+		result = evaluateEligibility(catalogued, &user.UserProfile{
+			Skills: []user.Skill{{Name: "first-aid", Proficiency: "BEGINNER"}},
+		}, now)
+		assert.True(t, result.Eligible, "a profile skill added before the catalogue still matches by name")
+
+		result = evaluateEligibility(catalogued, &user.UserProfile{
+			Skills: []user.Skill{{Name: "CPR", SkillID: &cpr, Proficiency: "EXPERT"}},
+		}, now)
+		assert.Equal(t, []EligibilityCode{EligibilitySkillMissing}, reasonCodes(result))
+	})
+
+	t.Run("interest mismatch alone doesn't block", func(t *testing.T) {
+		open := &event.Event{ID: "evt-2", StartTime: start, Requirements: event.EventRequirements{Interests: []string{"int-env"}}}
+		result := evaluateEligibility(open, &user.UserProfile{}, now)
+		assert.True(t, result.Eligible)
+		assert.Len(t, result.Reasons, 1)
+	})
+}
+
+func TestEligibilityError(t *testing.T) {
+	err := error(&EligibilityError{EventID: "evt-1", Reasons: []EligibilityReason{{Code: EligibilityMinimumAge, Message: "too young"}}})
+	require.ErrorIs(t, err, ErrNotEligible)
+	var eligibilityErr *EligibilityError
+	require.True(t, errors.As(err, &eligibilityErr))
+	assert.Contains(t, err.Error(), "too young")
+}
+
+func TestAgeOn(t *testing.T) {
+	dob := time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 25, ageOn(dob, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 26, ageOn(dob, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)))
+}
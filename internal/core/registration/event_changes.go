@@ -0,0 +1,360 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/mail"
+)
+
+// ErrReconfirmationNotRequested is returned when reconfirming a registration
+// whose event hasn't moved since the volunteer last confirmed
+var ErrReconfirmationNotRequested = errors.New("registration doesn't need reconfirming")
+
+// CapacityChange moves one registration between the confirmed list and the
+// waitlist, or only renumbers its waitlist place when StatusChange is nil
+type CapacityChange struct {
+	Registration *Registration
+	StatusChange *RegistrationStatusChange
+}
+
+// PlanEventUpdate implements event.RegistrantHandler. A capacity change
+// moves volunteers between the confirmed list and the waitlist; a time change
+// re-runs conflict checks and asks everyone still registered to reconfirm.
+func (s *Service) PlanEventUpdate(ctx context.Context, before, after *event.Event, updatedBy string) (event.RegistrantUpdate, error) {
+	regs, err := s.repo.GetRegistrationsByEventID(ctx, after.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registrations: %w", err)
+	}
+	plan := &eventUpdatePlan{
+		s:           s,
+		before:      before,
+		after:       after,
+		regs:        regs,
+		rescheduled: !after.StartTime.Equal(before.StartTime) || !after.EndTime.Equal(before.EndTime),
+		now:         time.Now(),
+	}
+
+	if after.Capacity.Maximum != before.Capacity.Maximum {
+		teamRegs, err := s.repo.GetEventTeamRegistrations(ctx, after.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load team registrations: %w", err)
+		}
+		// Teams keep their seats; individual volunteers share what's left
+		individual, places := individualPlaces(regs, teamRegs, after.Capacity.Maximum)
+		plan.changes = rebalanceCapacity(individual, places, updatedBy, plan.now)
+	}
+	return plan, nil
+}
+
+// eventUpdatePlan is the registration side of one event update, worked out
+// before the event is saved
+type eventUpdatePlan struct {
+	s           *Service
+	before      *event.Event
+	after       *event.Event
+	regs        []*Registration
+	changes     []*CapacityChange
+	rescheduled bool
+	now         time.Time
+}
+
+// Demoted counts the confirmed volunteers the new capacity moves to the waitlist
+func (p *eventUpdatePlan) Demoted() int {
+	n := 0
+	for _, c := range p.changes {
+		if c.StatusChange != nil && c.Registration.Status == StatusWaitlisted {
+			n++
+		}
+	}
+	return n
+}
+
+// Save applies the capacity changes and flags registrations for reconfirmation
+func (p *eventUpdatePlan) Save(ctx context.Context) error {
+	if len(p.changes) > 0 {
+		if err := p.s.repo.ApplyCapacityChanges(ctx, p.changes); err != nil {
+			return fmt.Errorf("failed to apply capacity change: %w", err)
+		}
+	}
+	if p.rescheduled {
+		if err := p.s.repo.RequestReconfirmation(ctx, p.after.ID, p.now); err != nil {
+			return fmt.Errorf("failed to request reconfirmation: %w", err)
+		}
+	}
+	return nil
+}
+
+// Notify tells volunteers whose place changed, and re-runs conflict checks
+// for everyone asked to reconfirm
+func (p *eventUpdatePlan) Notify(ctx context.Context) {
+	if len(p.changes) > 0 {
+		p.s.afterCapacityChanges(ctx, p.after, p.changes)
+	}
+	if p.rescheduled {
+		p.s.afterReschedule(ctx, p.before, p.after, p.regs, p.now)
+	}
+}
+
+// rebalanceCapacity fits the confirmed list to maximum. Over capacity, the
+// most recently confirmed volunteers go to the front of the waitlist in the
+// order they confirmed; under it, the waitlist is promoted in order. The
+// waitlist is then renumbered from 1. regs are updated in place.
+func rebalanceCapacity(regs []*Registration, maximum int, changedBy string, now time.Time) []*CapacityChange {
+	var confirmed, waitlisted []*Registration
+	for _, reg := range regs {
+		switch reg.Status {
+		case StatusConfirmed:
+			confirmed = append(confirmed, reg)
+		case StatusWaitlisted:
+			waitlisted = append(waitlisted, reg)
+		}
+	}
+	slices.SortStableFunc(confirmed, func(a, b *Registration) int {
+		return confirmedSince(a).Compare(confirmedSince(b))
+	})
+	slices.SortStableFunc(waitlisted, compareWaitlistOrder)
+
+	var changes []*CapacityChange
+	moved := map[string]bool{}
+	queue := waitlisted
+
+	if len(confirmed) > maximum {
+		demoted := confirmed[max(maximum, 0):]
+		for _, reg := range demoted {
+			reg.Status = StatusWaitlisted
+			reg.ConfirmedAt = nil
+			reg.UpdatedAt = now
+			changes = append(changes, &CapacityChange{
+				Registration: reg,
+				StatusChange: capacityStatusChange(reg, StatusConfirmed, changedBy, "event capacity reduced", now),
+			})
+			moved[reg.ID] = true
+		}
+		queue = append(slices.Clone(demoted), waitlisted...)
+	} else if open := maximum - len(confirmed); open > 0 && len(waitlisted) > 0 {
+		promoted := waitlisted[:min(open, len(waitlisted))]
+		for _, reg := range promoted {
+			reg.Status = StatusConfirmed
+			reg.ConfirmedAt = &now
+			reg.WaitlistPromotedAt = &now
+			reg.WaitlistPosition = nil
+			reg.UpdatedAt = now
+			changes = append(changes, &CapacityChange{
+				Registration: reg,
+				StatusChange: capacityStatusChange(reg, StatusWaitlisted, changedBy, "event capacity increased", now),
+			})
+		}
+		queue = waitlisted[len(promoted):]
+	}
+
+	for i, reg := range queue {
+		position := i + 1
+		if reg.WaitlistPosition != nil && *reg.WaitlistPosition == position {
+			continue
+		}
+		reg.WaitlistPosition = &position
+		reg.UpdatedAt = now
+		if !moved[reg.ID] {
+			changes = append(changes, &CapacityChange{Registration: reg})
+		}
+	}
+
+	return changes
+}
+
+// confirmedSince orders confirmations, falling back to when the volunteer
+// applied for rows confirmed before confirmed_at was recorded
+func confirmedSince(reg *Registration) time.Time {
+	if reg.ConfirmedAt != nil {
+		return *reg.ConfirmedAt
+	}
+	return reg.AppliedAt
+}
+
+// compareWaitlistOrder sorts by waitlist position, unnumbered entries last
+func compareWaitlistOrder(a, b *Registration) int {
+	switch {
+	case a.WaitlistPosition != nil && b.WaitlistPosition != nil && *a.WaitlistPosition != *b.WaitlistPosition:
+		return *a.WaitlistPosition - *b.WaitlistPosition
+	case a.WaitlistPosition != nil && b.WaitlistPosition == nil:
+		return -1
+	case a.WaitlistPosition == nil && b.WaitlistPosition != nil:
+		return 1
+	}
+	return a.AppliedAt.Compare(b.AppliedAt)
+}
+
+// capacityStatusChange records a capacity move; an empty changedBy means the
+// system made it
+func capacityStatusChange(reg *Registration, from RegistrationStatus, changedBy, reason string, now time.Time) *RegistrationStatusChange {
+	old := string(from)
+	change := &RegistrationStatusChange{
+		ID:             uuid.New().String(),
+		RegistrationID: reg.ID,
+		OldStatus:      &old,
+		NewStatus:      string(reg.Status),
+		Reason:         reason,
+		CreatedAt:      now,
+	}
+	if changedBy != "" {
+		change.ChangedBy = &changedBy
+	}
+	return change
+}
+
+// afterCapacityChanges clears promoted volunteers' waitlist entries and tells
+// everyone whose place changed or was released
+func (s *Service) afterCapacityChanges(ctx context.Context, evt *event.Event, changes []*CapacityChange) {
+	emails := s.registrantEmails(ctx, evt.ID)
+	for _, c := range changes {
+		reg := c.Registration
+		if c.StatusChange == nil {
+			continue
+		}
+		if reg.Status == StatusConfirmed {
+			entry, err := s.repo.GetWaitlistEntryByRegistrationID(ctx, reg.ID)
+			if err == nil && entry != nil {
+				if err := s.repo.RemoveWaitlistEntry(ctx, entry.ID); err != nil {
+					s.logger.Warn("failed to remove waitlist entry", "registrationID", reg.ID, "error", err)
+				}
+			}
+		}
+		if to := emails[reg.UserID]; to != "" {
+			s.sendRegistrantEmail(ctx, reg, capacityChangeEmail(to, evt, reg))
+		}
+	}
+}
+
+// afterReschedule re-runs the conflict checks of everyone asked to
+// reconfirm a rescheduled event against its new times and emails them
+func (s *Service) afterReschedule(ctx context.Context, before, after *event.Event, regs []*Registration, requestedAt time.Time) {
+	emails := s.registrantEmails(ctx, after.ID)
+	for _, reg := range regs {
+		if !needsReconfirmation(reg.Status) {
+			continue
+		}
+		reg.ReconfirmationRequestedAt = &requestedAt
+
+		s.resolveConflicts(ctx, reg.UserID, after.ID, "event rescheduled")
+		commitments, err := s.confirmedCommitments(ctx, reg.UserID)
+		if err != nil {
+			s.logger.Error("failed to recheck conflicts after reschedule", "registrationID", reg.ID, "error", err)
+		} else {
+			s.recordConflicts(ctx, detectConflicts(reg.UserID, after, commitments))
+		}
+
+		if to := emails[reg.UserID]; to != "" {
+			s.sendRegistrantEmail(ctx, reg, rescheduledEmail(to, before, after))
+		}
+	}
+}
+
+// needsReconfirmation reports whether a registration still holds or is
+// waiting for a place, and so should be asked about new event times
+func needsReconfirmation(status RegistrationStatus) bool {
+	switch status {
+	case StatusConfirmed, StatusWaitlisted, StatusPendingApproval, StatusPendingGuardianConsent:
+		return true
+	}
+	return false
+}
+
+// ReconfirmRegistration records that the volunteer can still make the
+// event, after a reschedule or ahead of a confirmation-required event
+func (s *Service) ReconfirmRegistration(ctx context.Context, userID, registrationID string) (*Registration, error) {
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg == nil {
+		return nil, fmt.Errorf("registration not found")
+	}
+	if reg.UserID != userID {
+		return nil, fmt.Errorf("user does not have permission to manage this registration")
+	}
+	if reg.ReconfirmationRequestedAt == nil {
+		return nil, ErrReconfirmationNotRequested
+	}
+
+	now := time.Now()
+	reg.ReconfirmationRequestedAt = nil
+	reg.ReconfirmedAt = &now
+	reg.UpdatedAt = now
+	if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+		return nil, fmt.Errorf("failed to reconfirm registration: %w", err)
+	}
+	return reg, nil
+}
+
+// registrantEmails returns the event's registrants' emails, or none when
+// there is no mailer to use them or they can't be loaded
+func (s *Service) registrantEmails(ctx context.Context, eventID string) map[string]string {
+	if s.consent.Mailer == nil {
+		return nil
+	}
+	emails, err := s.repo.GetRegistrantEmails(ctx, eventID)
+	if err != nil {
+		s.logger.Error("failed to load registrant emails", "eventID", eventID, "error", err)
+		return nil
+	}
+	return emails
+}
+
+// sendRegistrantEmail uses the consent mailer; failures are logged because
+// the registration change has already been saved
+func (s *Service) sendRegistrantEmail(ctx context.Context, reg *Registration, msg mail.Message) {
+	if err := s.consent.Mailer.Send(ctx, msg); err != nil {
+		s.logger.Error("failed to email registrant", "registrationID", reg.ID, "error", err)
+	}
+}
+
+func rescheduledEmail(to string, before, after *event.Event) mail.Message {
+	const layout = "Monday 2 January 2006 at 15:04 MST"
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s has been rescheduled.\n\n", after.Title)
+	fmt.Fprintf(&b, "It was: %s to %s\n", before.InLocalZone(before.StartTime).Format(layout), before.InLocalZone(before.EndTime).Format(layout))
+	fmt.Fprintf(&b, "It is now: %s to %s\n\n", after.InLocalZone(after.StartTime).Format(layout), after.InLocalZone(after.EndTime).Format(layout))
+	b.WriteString("Please sign in and reconfirm your registration if you can still make it, or cancel it so someone else can take your place.\n")
+	return mail.Message{
+		To:      to,
+		Subject: fmt.Sprintf("New date for %s: please reconfirm", after.Title),
+		Body:    b.String(),
+	}
+}
+
+func capacityChangeEmail(to string, evt *event.Event, reg *Registration) mail.Message {
+	date := evt.InLocalZone(evt.StartTime).Format("Monday 2 January 2006")
+	if reg.Status == StatusCancelled {
+		return mail.Message{
+			To:      to,
+			Subject: fmt.Sprintf("Your place at %s has been released", evt.Title),
+			Body: fmt.Sprintf("You didn't reconfirm your registration for %s on %s before the deadline, "+
+				"so your place has been offered to the next volunteer on the waitlist.\n", evt.Title, date),
+		}
+	}
+	if reg.Status == StatusConfirmed {
+		return mail.Message{
+			To:      to,
+			Subject: fmt.Sprintf("You're confirmed for %s", evt.Title),
+			Body:    fmt.Sprintf("Good news: more places opened up at %s on %s, and your registration has moved from the waitlist to confirmed.\n", evt.Title, date),
+		}
+	}
+	position := 0
+	if reg.WaitlistPosition != nil {
+		position = *reg.WaitlistPosition
+	}
+	return mail.Message{
+		To:      to,
+		Subject: fmt.Sprintf("Your place at %s has changed", evt.Title),
+		Body: fmt.Sprintf("The organizer of %s on %s has reduced the number of volunteers it can take. "+
+			"Your registration has moved to the waitlist at position %d, and you'll be confirmed automatically if a place opens up.\n", evt.Title, date, position),
+	}
+}
@@ -0,0 +1,108 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func TestRebalanceCapacity(t *testing.T) {
+	base := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	now := base.Add(24 * time.Hour)
+	at := func(h int) *time.Time {
+		t := base.Add(time.Duration(h) * time.Hour)
+		return &t
+	}
+	pos := func(p int) *int { return &p }
+	build := func() map[string]*Registration {
+		return map[string]*Registration{
+			"c1": {ID: "c1", Status: StatusConfirmed, ConfirmedAt: at(1)},
+			"c2": {ID: "c2", Status: StatusConfirmed, ConfirmedAt: at(2)},
+			"c3": {ID: "c3", Status: StatusConfirmed, ConfirmedAt: at(3)},
+			"w1": {ID: "w1", Status: StatusWaitlisted, WaitlistPosition: pos(1)},
+			"w2": {ID: "w2", Status: StatusWaitlisted, WaitlistPosition: pos(2)},
+			"x":  {ID: "x", Status: StatusCancelled},
+		}
+	}
+	list := func(m map[string]*Registration) []*Registration {
+		return []*Registration{m["w2"], m["c3"], m["x"], m["c1"], m["w1"], m["c2"]}
+	}
+
+	t.Run("shrinking demotes the latest confirmations to the front of the waitlist", func(t *testing.T) {
+		regs := build()
+		changes := rebalanceCapacity(list(regs), 1, "organizer", now)
+
+		assert.Equal(t, StatusConfirmed, regs["c1"].Status)
+		for id, want := range map[string]int{"c2": 1, "c3": 2, "w1": 3, "w2": 4} {
+			assert.Equal(t, StatusWaitlisted, regs[id].Status, id)
+			require.NotNil(t, regs[id].WaitlistPosition, id)
+			assert.Equal(t, want, *regs[id].WaitlistPosition, id)
+		}
+		assert.Nil(t, regs["c2"].ConfirmedAt)
+
+		require.Len(t, changes, 4)
+		var statusChanges int
+		for _, c := range changes {
+			if c.StatusChange != nil {
+				statusChanges++
+				assert.Equal(t, "CONFIRMED", *c.StatusChange.OldStatus)
+				assert.Equal(t, "organizer", *c.StatusChange.ChangedBy)
+			}
+		}
+		assert.Equal(t, 2, statusChanges, "renumbered waitlist entries don't change status")
+	})
+
+	t.Run("growing promotes the waitlist in order", func(t *testing.T) {
+		regs := build()
+		changes := rebalanceCapacity(list(regs), 4, "organizer", now)
+
+		assert.Equal(t, StatusConfirmed, regs["w1"].Status)
+		assert.Equal(t, now, *regs["w1"].WaitlistPromotedAt)
+		assert.Nil(t, regs["w1"].WaitlistPosition)
+		assert.Equal(t, StatusWaitlisted, regs["w2"].Status)
+		assert.Equal(t, 1, *regs["w2"].WaitlistPosition)
+		require.Len(t, changes, 2)
+		assert.Equal(t, "WAITLISTED", *changes[0].StatusChange.OldStatus)
+		assert.Nil(t, changes[1].StatusChange)
+	})
+
+	t.Run("room for everyone empties the waitlist", func(t *testing.T) {
+		regs := build()
+		changes := rebalanceCapacity(list(regs), 20, "organizer", now)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, StatusConfirmed, regs["w2"].Status)
+	})
+
+	t.Run("nothing to do", func(t *testing.T) {
+		regs := build()
+		assert.Empty(t, rebalanceCapacity(list(regs), 3, "organizer", now))
+	})
+}
+
+func TestNeedsReconfirmation(t *testing.T) {
+	assert.True(t, needsReconfirmation(StatusConfirmed))
+	assert.True(t, needsReconfirmation(StatusWaitlisted))
+	assert.True(t, needsReconfirmation(StatusPendingApproval))
+	assert.False(t, needsReconfirmation(StatusCancelled))
+	assert.False(t, needsReconfirmation(StatusCompleted))
+}
+
+func TestRescheduledEmail(t *testing.T) {
+	before := &event.Event{Title: "Food Drive", StartTime: time.Date(2026, 6, 6, 10, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 6, 6, 13, 0, 0, 0, time.UTC)}
+	after := &event.Event{Title: "Food Drive", StartTime: time.Date(2026, 6, 13, 10, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 6, 13, 13, 0, 0, 0, time.UTC)}
+
+	msg := rescheduledEmail("vol@example.org", before, after)
+	assert.Equal(t, "vol@example.org", msg.To)
+	assert.Contains(t, msg.Subject, "reconfirm")
+	assert.Contains(t, msg.Body, "Saturday 6 June 2026")
+	assert.Contains(t, msg.Body, "Saturday 13 June 2026")
+
+	after.Location.TimeZone = "America/Chicago"
+	msg = rescheduledEmail("vol@example.org", before, after)
+	assert.Contains(t, msg.Body, "Saturday 13 June 2026 at 05:00 CDT", "in the event's own time zone")
+	assert.Contains(t, msg.Body, "Saturday 6 June 2026 at 10:00 UTC", "UTC when the zone isn't known")
+}
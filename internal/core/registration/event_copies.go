@@ -0,0 +1,57 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CopyEventToTemplate implements event.ContentCopier, saving the event's
+// registration form with a new template
+func (s *Service) CopyEventToTemplate(ctx context.Context, eventID, templateID string) error {
+	questions, err := s.repo.GetEventQuestions(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load questions: %w", err)
+	}
+	return s.repo.ReplaceTemplateQuestions(ctx, templateID, copyQuestions(questions, "", time.Now()))
+}
+
+// CopyTemplateToEvent implements event.ContentCopier, giving an event
+// created from a template the template's registration form
+func (s *Service) CopyTemplateToEvent(ctx context.Context, templateID, eventID string) error {
+	questions, err := s.repo.GetTemplateQuestions(ctx, templateID)
+	if err != nil {
+		return fmt.Errorf("failed to load template questions: %w", err)
+	}
+	return s.repo.ReplaceEventQuestions(ctx, eventID, copyQuestions(questions, eventID, time.Now()))
+}
+
+// CopyEvent implements event.ContentCopier, giving a duplicated event the
+// source event's registration form
+func (s *Service) CopyEvent(ctx context.Context, sourceEventID, eventID string) error {
+	questions, err := s.repo.GetEventQuestions(ctx, sourceEventID)
+	if err != nil {
+		return fmt.Errorf("failed to load questions: %w", err)
+	}
+	return s.repo.ReplaceEventQuestions(ctx, eventID, copyQuestions(questions, eventID, time.Now()))
+}
+
+// copyQuestions gives each question a new ID, so the copy collects its own
+// answers, and moves it to eventID; templates pass an empty eventID
+func copyQuestions(questions []*Question, eventID string, now time.Time) []*Question {
+	copied := make([]*Question, 0, len(questions))
+	for _, q := range questions {
+		c := *q
+		c.ID = uuid.New().String()
+		c.EventID = eventID
+		c.Options = slices.Clone(q.Options)
+		c.Validation.AllowedFileTypes = slices.Clone(q.Validation.AllowedFileTypes)
+		c.CreatedAt = now
+		c.UpdatedAt = now
+		copied = append(copied, &c)
+	}
+	return copied
+}
@@ -0,0 +1,34 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyQuestions(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	questions := []*Question{
+		{ID: "q1", EventID: "source", Position: 0, Label: "T-shirt size", Type: QuestionSingleChoice, Options: []string{"S", "M", "L"}},
+		{ID: "q2", EventID: "source", Position: 1, Label: "Photo ID", Type: QuestionFile,
+			Validation: QuestionValidation{AllowedFileTypes: []string{"image/*"}}},
+	}
+
+	copied := copyQuestions(questions, "target", now)
+	require.Len(t, copied, 2)
+	for i, q := range copied {
+		assert.NotEqual(t, questions[i].ID, q.ID, "copies collect their own answers")
+		assert.Equal(t, "target", q.EventID)
+		assert.Equal(t, questions[i].Position, q.Position)
+		assert.Equal(t, now, q.CreatedAt)
+	}
+
+	copied[0].Options[0] = "XS"
+	copied[1].Validation.AllowedFileTypes[0] = "application/pdf"
+	assert.Equal(t, "S", questions[0].Options[0])
+	assert.Equal(t, "image/*", questions[1].Validation.AllowedFileTypes[0])
+
+	assert.Empty(t, copyQuestions(questions, "", now)[0].EventID, "template questions belong to no event")
+}
@@ -0,0 +1,85 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// LocalAnswerFileStore keeps answer files on the local filesystem under
+// baseDir. baseDir must not be served statically.
+type LocalAnswerFileStore struct {
+	baseDir string
+}
+
+// NewLocalAnswerFileStore constructs a LocalAnswerFileStore.
+func NewLocalAnswerFileStore(baseDir string) *LocalAnswerFileStore {
+	return &LocalAnswerFileStore{baseDir: baseDir}
+}
+
+func (l *LocalAnswerFileStore) Save(ctx context.Context, registrationID, questionID string, upload *FileUpload) (string, error) {
+	// The volunteer's file name is kept in the database only
+	relPath := filepath.Join("registration-answers", registrationID, questionID+"-"+uuid.New().String()+extensionForContentType(upload.ContentType))
+	absPath := filepath.Join(l.baseDir, relPath)
+
+	if err := os.MkdirAll(filepath.Dir(absPath), 0o700); err != nil {
+		return "", fmt.Errorf("mkdir: %w", err)
+	}
+	if err := os.WriteFile(absPath, upload.Data, 0o600); err != nil {
+		return "", fmt.Errorf("write: %w", err)
+	}
+	return filepath.ToSlash(relPath), nil
+}
+
+func (l *LocalAnswerFileStore) Open(ctx context.Context, storagePath string) (io.ReadCloser, error) {
+	abs, err := l.resolve(storagePath)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(abs)
+}
+
+func (l *LocalAnswerFileStore) Delete(ctx context.Context, storagePath string) error {
+	if storagePath == "" {
+		return nil
+	}
+	abs, err := l.resolve(storagePath)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(abs); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete: %w", err)
+	}
+	return nil
+}
+
+// resolve keeps stored paths inside baseDir
+func (l *LocalAnswerFileStore) resolve(storagePath string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(storagePath))
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage path")
+	}
+	return filepath.Join(l.baseDir, clean), nil
+}
+
+func extensionForContentType(contentType string) string {
+	switch contentType {
+	case "application/pdf":
+		return ".pdf"
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,391 @@
+package registration
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/user"
+	"github.com/volunteersync/backend/internal/mail"
+)
+
+// AdultAge is the age from which volunteers register without guardian consent
+const AdultAge = 18
+
+// DefaultGuardianConsentTTL is how long a consent link stays valid when the
+// event is further away than that
+const DefaultGuardianConsentTTL = 7 * 24 * time.Hour
+
+var (
+	ErrGuardianEmailRequired      = errors.New("volunteers under 18 need a parent or guardian's email address to register")
+	ErrInvalidGuardianConsent     = errors.New("invalid guardian consent")
+	ErrGuardianConsentNotFound    = errors.New("guardian consent request not found")
+	ErrGuardianConsentExpired     = errors.New("guardian consent link has expired")
+	ErrGuardianConsentClosed      = errors.New("guardian consent has already been answered or is no longer needed")
+	ErrGuardianConsentPending     = errors.New("registration is waiting for guardian consent")
+	ErrGuardianConsentUnavailable = errors.New("guardian consent email is not configured on this server")
+)
+
+// GuardianConsentStatus is where a consent request stands
+type GuardianConsentStatus string
+
+const (
+	ConsentPending  GuardianConsentStatus = "PENDING"
+	ConsentApproved GuardianConsentStatus = "APPROVED"
+	ConsentDeclined GuardianConsentStatus = "DECLINED"
+	// ConsentSuperseded requests were replaced by a newer link before anyone answered
+	ConsentSuperseded GuardianConsentStatus = "SUPERSEDED"
+)
+
+// GuardianConsent is a consent request emailed to a minor's guardian and, once
+// answered, the guardian's response. Records are kept for audits.
+type GuardianConsent struct {
+	ID             string                `json:"id"`
+	RegistrationID *string               `json:"registrationId,omitempty"`
+	UserID         string                `json:"userId"`
+	EventID        string                `json:"eventId"`
+	RequestedEmail string                `json:"requestedEmail"`
+	TokenHash      string                `json:"-"`
+	Status         GuardianConsentStatus `json:"status"`
+	RequestedAt    time.Time             `json:"requestedAt"`
+	ExpiresAt      time.Time             `json:"expiresAt"`
+	RespondedAt    *time.Time            `json:"respondedAt,omitempty"`
+	GuardianName   string                `json:"guardianName"`
+	GuardianEmail  string                `json:"guardianEmail"`
+	GuardianPhone  string                `json:"guardianPhone"`
+	Relationship   string                `json:"relationship"`
+	IPAddress      string                `json:"ipAddress"`
+	UserAgent      string                `json:"userAgent"`
+}
+
+// GuardianConsentResponse is a guardian's answer, with their own contact details
+type GuardianConsentResponse struct {
+	Approve      bool
+	Name         string
+	Email        string
+	Phone        string
+	Relationship string
+}
+
+// GuardianConsentView is what a guardian sees when they open the consent link
+type GuardianConsentView struct {
+	Consent       *GuardianConsent
+	VolunteerName string
+	EventTitle    string
+	EventStart    time.Time
+}
+
+// Mailer sends email; mail.SMTPSender and mail.LogSender satisfy it
+type Mailer interface {
+	Send(ctx context.Context, msg mail.Message) error
+}
+
+// GuardianConsentConfig controls the consent emails. Without a Mailer, minors
+// can't register.
+type GuardianConsentConfig struct {
+	Mailer Mailer
+	// PublicURL prefixes the consent link in the email
+	PublicURL string
+	// TTL defaults to DefaultGuardianConsentTTL
+	TTL time.Duration
+}
+
+// GuardianConsentPath is where the HTTP API serves the consent page for a token
+func GuardianConsentPath(token string) string {
+	return "/guardian-consent/" + token
+}
+
+// hashConsentToken returns the hex SHA-256 stored in place of a consent token
+func hashConsentToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newConsentToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// isMinor reports whether the volunteer will be under AdultAge when the event
+// starts. Volunteers without a date of birth are treated as adults; events
+// with a minimum age already require one.
+func isMinor(profile *user.UserProfile, evt *event.Event) bool {
+	return profile.DateOfBirth != nil && ageOn(*profile.DateOfBirth, evt.StartTime) < AdultAge
+}
+
+// normalizeGuardianEmail checks the address a consent request is sent to. It
+// can't be the volunteer's own.
+func normalizeGuardianEmail(email string, profile *user.UserProfile) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", ErrGuardianEmailRequired
+	}
+	addr, err := netmail.ParseAddress(email)
+	if err != nil || addr.Name != "" {
+		return "", fmt.Errorf("%w: %q is not an email address", ErrInvalidGuardianConsent, email)
+	}
+	if strings.EqualFold(addr.Address, profile.Email) {
+		return "", fmt.Errorf("%w: the guardian's email must be different from your own", ErrInvalidGuardianConsent)
+	}
+	return addr.Address, nil
+}
+
+// consentExpiry is when a link requested at now stops working: after ttl, or
+// when the event starts if that is sooner
+func consentExpiry(now time.Time, ttl time.Duration, evt *event.Event) time.Time {
+	expires := now.Add(ttl)
+	if evt.StartTime.After(now) && evt.StartTime.Before(expires) {
+		return evt.StartTime
+	}
+	return expires
+}
+
+// requestGuardianConsent records a new consent request for a registration
+// and emails the link. A failed email is logged rather than returned; the
+// volunteer can send the link again.
+func (s *Service) requestGuardianConsent(ctx context.Context, reg *Registration, evt *event.Event, profile *user.UserProfile, email string) (*GuardianConsent, error) {
+	consent, token, err := s.recordGuardianConsentRequest(ctx, reg, evt, email)
+	if err != nil {
+		return nil, err
+	}
+	s.emailGuardianConsent(ctx, consent, token, evt, profile)
+	return consent, nil
+}
+
+// recordGuardianConsentRequest stores a new consent request for a
+// registration and returns it with the token its link carries
+func (s *Service) recordGuardianConsentRequest(ctx context.Context, reg *Registration, evt *event.Event, email string) (*GuardianConsent, string, error) {
+	if s.consent.Mailer == nil {
+		return nil, "", ErrGuardianConsentUnavailable
+	}
+	token, err := newConsentToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create consent token: %w", err)
+	}
+	ttl := s.consent.TTL
+	if ttl <= 0 {
+		ttl = DefaultGuardianConsentTTL
+	}
+	now := time.Now()
+	regID := reg.ID
+	consent := &GuardianConsent{
+		ID:             uuid.New().String(),
+		RegistrationID: &regID,
+		UserID:         reg.UserID,
+		EventID:        reg.EventID,
+		RequestedEmail: email,
+		TokenHash:      hashConsentToken(token),
+		Status:         ConsentPending,
+		RequestedAt:    now,
+		ExpiresAt:      consentExpiry(now, ttl, evt),
+	}
+	if err := s.repo.CreateGuardianConsent(ctx, consent); err != nil {
+		return nil, "", fmt.Errorf("failed to record consent request: %w", err)
+	}
+	return consent, token, nil
+}
+
+// emailGuardianConsent sends the guardian the consent link, logging a failure
+func (s *Service) emailGuardianConsent(ctx context.Context, consent *GuardianConsent, token string, evt *event.Event, profile *user.UserProfile) {
+	msg := guardianConsentEmail(consent.RequestedEmail, profile.Name, evt, strings.TrimRight(s.consent.PublicURL, "/")+GuardianConsentPath(token), consent.ExpiresAt)
+	if err := s.consent.Mailer.Send(ctx, msg); err != nil {
+		s.logger.Error("failed to email guardian consent request", "registrationID", *consent.RegistrationID, "consentID", consent.ID, "error", err)
+	}
+}
+
+func guardianConsentEmail(to, volunteerName string, evt *event.Event, link string, expires time.Time) mail.Message {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s has registered to volunteer at %s on %s.\n\n", volunteerName, evt.Title, evt.InLocalZone(evt.StartTime).Format("Monday 2 January 2006"))
+	b.WriteString("Because they are under 18, a parent or guardian needs to give consent before their registration can go ahead. ")
+	b.WriteString("You can review the event and approve or decline here:\n\n")
+	fmt.Fprintf(&b, "%s\n\n", link)
+	fmt.Fprintf(&b, "This link expires on %s. If you don't know %s, you can ignore this email.\n", evt.InLocalZone(expires).Format("2 January 2006 at 15:04 MST"), volunteerName)
+	return mail.Message{
+		To:      to,
+		Subject: fmt.Sprintf("Consent needed: %s wants to volunteer at %s", volunteerName, evt.Title),
+		Body:    b.String(),
+	}
+}
+
+// ResendGuardianConsent emails a new consent link for one of the user's
+// registrations, optionally to a different guardian. Earlier links stop working.
+func (s *Service) ResendGuardianConsent(ctx context.Context, userID, registrationID string, guardianEmail *string) (*GuardianConsent, error) {
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.UserID != userID {
+		return nil, fmt.Errorf("user does not have permission to manage this registration")
+	}
+	if reg.Status != StatusPendingGuardianConsent {
+		return nil, ErrGuardianConsentClosed
+	}
+
+	evt, err := s.eventService.GetEvent(ctx, reg.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	profile, err := s.userService.GetProfile(ctx, userID, userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	var email string
+	if guardianEmail != nil {
+		email = *guardianEmail
+	} else if previous, err := s.repo.GetGuardianConsentsByRegistrationID(ctx, reg.ID); err == nil && len(previous) > 0 {
+		email = previous[0].RequestedEmail
+	}
+	email, err = normalizeGuardianEmail(email, profile)
+	if err != nil {
+		return nil, err
+	}
+	return s.requestGuardianConsent(ctx, reg, evt, profile, email)
+}
+
+// GetGuardianConsentView returns the consent request behind an emailed token
+func (s *Service) GetGuardianConsentView(ctx context.Context, token string) (*GuardianConsentView, error) {
+	consent, err := s.repo.GetGuardianConsentByTokenHash(ctx, hashConsentToken(token))
+	if err != nil {
+		return nil, err
+	}
+	evt, err := s.eventService.GetEvent(ctx, consent.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	profile, err := s.userService.GetProfile(ctx, consent.UserID, consent.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	return &GuardianConsentView{Consent: consent, VolunteerName: profile.Name, EventTitle: evt.Title, EventStart: evt.StartTime}, nil
+}
+
+// RespondToGuardianConsent records a guardian's answer. Approval sends the
+// registration through the event's usual approval and capacity rules; a
+// refusal declines it.
+func (s *Service) RespondToGuardianConsent(ctx context.Context, token string, resp GuardianConsentResponse, evidence SignatureEvidence) (*GuardianConsent, error) {
+	consent, err := s.repo.GetGuardianConsentByTokenHash(ctx, hashConsentToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if consent.Status != ConsentPending || consent.RegistrationID == nil {
+		return nil, ErrGuardianConsentClosed
+	}
+	if now.After(consent.ExpiresAt) {
+		return nil, ErrGuardianConsentExpired
+	}
+	if err := validateGuardianResponse(&resp); err != nil {
+		return nil, err
+	}
+
+	reg, err := s.repo.GetRegistrationByID(ctx, *consent.RegistrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.Status != StatusPendingGuardianConsent {
+		return nil, ErrGuardianConsentClosed
+	}
+	evt, err := s.eventService.GetEvent(ctx, reg.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return nil, err
+	}
+	if resp.Approve {
+		consent.Status = ConsentApproved
+		if err := s.setRegistrationStatus(ctx, reg, evt); err != nil {
+			return nil, fmt.Errorf("failed to set registration status: %w", err)
+		}
+	} else {
+		consent.Status = ConsentDeclined
+		reg.Status = StatusDeclined
+		reg.ApprovalNotes = "Parent or guardian declined consent"
+		cancelShifts(reg, now)
+	}
+	reg.UpdatedAt = now
+	consent.RespondedAt = &now
+	consent.GuardianName = resp.Name
+	consent.GuardianEmail = resp.Email
+	consent.GuardianPhone = resp.Phone
+	consent.Relationship = resp.Relationship
+	consent.IPAddress = evidence.IPAddress
+	consent.UserAgent = evidence.UserAgent
+
+	if err := s.repo.RecordGuardianConsent(ctx, consent, reg); err != nil {
+		return nil, err
+	}
+	if err := s.saveShifts(ctx, reg); err != nil {
+		return nil, err
+	}
+	return consent, nil
+}
+
+// validateGuardianResponse trims the guardian's details. Consent needs a name
+// and a way to reach them; a refusal needs nothing.
+func validateGuardianResponse(resp *GuardianConsentResponse) error {
+	resp.Name = strings.TrimSpace(resp.Name)
+	resp.Email = strings.TrimSpace(resp.Email)
+	resp.Phone = strings.TrimSpace(resp.Phone)
+	resp.Relationship = strings.TrimSpace(resp.Relationship)
+	if !resp.Approve {
+		return nil
+	}
+	if resp.Name == "" {
+		return fmt.Errorf("%w: guardian name is required", ErrInvalidGuardianConsent)
+	}
+	if resp.Email == "" || resp.Phone == "" {
+		return fmt.Errorf("%w: guardian email and phone number are required", ErrInvalidGuardianConsent)
+	}
+	if addr, err := netmail.ParseAddress(resp.Email); err != nil || addr.Name != "" {
+		return fmt.Errorf("%w: %q is not an email address", ErrInvalidGuardianConsent, resp.Email)
+	}
+	return nil
+}
+
+// GetGuardianConsents returns a registration's consent requests, newest
+// first, to its volunteer or to event staff
+func (s *Service) GetGuardianConsents(ctx context.Context, userID, registrationID string) ([]*GuardianConsent, error) {
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.UserID != userID {
+		evt, err := s.eventService.GetEvent(ctx, reg.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("event not found: %w", err)
+		}
+		if err := s.eventService.Authorize(ctx, evt, userID, event.StaffActionView); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.GetGuardianConsentsByRegistrationID(ctx, registrationID)
+}
+
+// GetEventGuardianConsents returns every consent record for an event, for
+// event staff and audits
+func (s *Service) GetEventGuardianConsents(ctx context.Context, userID, eventID string) ([]*GuardianConsent, error) {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, userID, event.StaffActionView); err != nil {
+		return nil, err
+	}
+	return s.repo.GetEventGuardianConsents(ctx, eventID)
+}
@@ -0,0 +1,91 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/user"
+)
+
+func TestIsMinor(t *testing.T) {
+	evt := &event.Event{StartTime: time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC)}
+	born := func(y int, m time.Month, d int) *user.UserProfile {
+		dob := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &user.UserProfile{DateOfBirth: &dob}
+	}
+
+	assert.True(t, isMinor(born(2008, time.June, 16), evt))
+	assert.False(t, isMinor(born(2008, time.June, 15), evt), "18th birthday on the day")
+	assert.False(t, isMinor(&user.UserProfile{}, evt), "unknown age is treated as adult")
+}
+
+func TestNormalizeGuardianEmail(t *testing.T) {
+	profile := &user.UserProfile{Email: "kid@example.org"}
+
+	email, err := normalizeGuardianEmail("  parent@example.org ", profile)
+	require.NoError(t, err)
+	assert.Equal(t, "parent@example.org", email)
+
+	_, err = normalizeGuardianEmail("", profile)
+	assert.ErrorIs(t, err, ErrGuardianEmailRequired)
+	_, err = normalizeGuardianEmail("not an email", profile)
+	assert.ErrorIs(t, err, ErrInvalidGuardianConsent)
+	_, err = normalizeGuardianEmail("Parent <parent@example.org>", profile)
+	assert.ErrorIs(t, err, ErrInvalidGuardianConsent)
+	_, err = normalizeGuardianEmail("KID@example.org", profile)
+	assert.ErrorIs(t, err, ErrInvalidGuardianConsent, "volunteers can't consent for themselves")
+}
+
+func TestConsentExpiry(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	ttl := 7 * 24 * time.Hour
+
+	later := &event.Event{StartTime: now.AddDate(0, 1, 0)}
+	assert.Equal(t, now.Add(ttl), consentExpiry(now, ttl, later))
+
+	soon := &event.Event{StartTime: now.Add(48 * time.Hour)}
+	assert.Equal(t, soon.StartTime, consentExpiry(now, ttl, soon), "links stop working when the event starts")
+}
+
+func TestValidateGuardianResponse(t *testing.T) {
+	approve := GuardianConsentResponse{Approve: true, Name: " Pat Doe ", Email: "pat@example.org", Phone: " 555-0100 "}
+	require.NoError(t, validateGuardianResponse(&approve))
+	assert.Equal(t, "Pat Doe", approve.Name)
+	assert.Equal(t, "555-0100", approve.Phone)
+
+	for _, resp := range []GuardianConsentResponse{
+		{Approve: true, Email: "pat@example.org", Phone: "555-0100"},
+		{Approve: true, Name: "Pat", Phone: "555-0100"},
+		{Approve: true, Name: "Pat", Email: "pat", Phone: "555-0100"},
+		{Approve: true, Name: "Pat", Email: "pat@example.org"},
+	} {
+		assert.ErrorIs(t, validateGuardianResponse(&resp), ErrInvalidGuardianConsent)
+	}
+
+	assert.NoError(t, validateGuardianResponse(&GuardianConsentResponse{Approve: false}), "declining needs no details")
+}
+
+func TestGuardianConsentEmail(t *testing.T) {
+	evt := &event.Event{Title: "River Cleanup", StartTime: time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC)}
+	msg := guardianConsentEmail("parent@example.org", "Sam", evt, "https://app.example.org/guardian-consent/abc", time.Date(2026, 6, 8, 12, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "parent@example.org", msg.To)
+	assert.Contains(t, msg.Subject, "River Cleanup")
+	assert.Contains(t, msg.Body, "https://app.example.org/guardian-consent/abc")
+	assert.Contains(t, msg.Body, "Monday 15 June 2026")
+}
+
+func TestConsentToken(t *testing.T) {
+	token, err := newConsentToken()
+	require.NoError(t, err)
+	other, err := newConsentToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, token, other)
+	assert.Len(t, hashConsentToken(token), 64)
+	assert.NotEqual(t, token, hashConsentToken(token), "only the hash is stored")
+}
@@ -7,13 +7,16 @@ import (
 type RegistrationStatus string
 
 const (
-	StatusPendingApproval RegistrationStatus = "PENDING_APPROVAL"
-	StatusConfirmed       RegistrationStatus = "CONFIRMED"
-	StatusWaitlisted      RegistrationStatus = "WAITLISTED"
-	StatusCancelled       RegistrationStatus = "CANCELLED"
-	StatusDeclined        RegistrationStatus = "DECLINED"
-	StatusNoShow          RegistrationStatus = "NO_SHOW"
-	StatusCompleted       RegistrationStatus = "COMPLETED"
+	// StatusPendingGuardianConsent holds a minor's registration until a guardian
+	// approves it; approval and capacity rules apply afterwards
+	StatusPendingGuardianConsent RegistrationStatus = "PENDING_GUARDIAN_CONSENT"
+	StatusPendingApproval        RegistrationStatus = "PENDING_APPROVAL"
+	StatusConfirmed              RegistrationStatus = "CONFIRMED"
+	StatusWaitlisted             RegistrationStatus = "WAITLISTED"
+	StatusCancelled              RegistrationStatus = "CANCELLED"
+	StatusDeclined               RegistrationStatus = "DECLINED"
+	StatusNoShow                 RegistrationStatus = "NO_SHOW"
+	StatusCompleted              RegistrationStatus = "COMPLETED"
 )
 
 type AttendanceStatus string
@@ -69,8 +72,22 @@ type Registration struct {
 	AccessibilityNeeds    string             `json:"accessibilityNeeds"`
 	CheckedInBy           *string            `json:"checkedInBy,omitempty"`
 	ApprovedBy            *string            `json:"approvedBy,omitempty"`
-	CreatedAt             time.Time          `json:"createdAt"`
-	UpdatedAt             time.Time          `json:"updatedAt"`
+	// ReconfirmationRequestedAt is set when the event is rescheduled and
+	// cleared once the volunteer reconfirms
+	ReconfirmationRequestedAt *time.Time `json:"reconfirmationRequestedAt,omitempty"`
+	// ReconfirmedAt is when the volunteer last confirmed they can still come
+	ReconfirmedAt *time.Time `json:"reconfirmedAt,omitempty"`
+	// LateCancellation marks a cancellation made after the event's
+	// cancellation deadline
+	LateCancellation bool `json:"lateCancellation"`
+	// TeamRegistrationID is set on a team member's registration, which fills
+	// one of the team's seats
+	TeamRegistrationID *string   `json:"teamRegistrationId,omitempty"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+	// Shifts are the registration's places on the event's shifts, stored
+	// apart from the registration; nil until loaded
+	Shifts []*ShiftAssignment `json:"shifts,omitempty"`
 }
 
 type RegistrationSkill struct {
@@ -133,3 +150,21 @@ type RegistrationConflict struct {
 	ResolutionNotes    string           `json:"resolutionNotes"`
 	CreatedAt          time.Time        `json:"createdAt"`
 }
+
+type ScanOutcome string
+
+const (
+	ScanCheckedIn        ScanOutcome = "CHECKED_IN"
+	ScanAlreadyCheckedIn ScanOutcome = "ALREADY_CHECKED_IN"
+)
+
+type TicketScan struct {
+	ID             string      `json:"id"`
+	RegistrationID string      `json:"registrationId"`
+	EventID        string      `json:"eventId"`
+	ScannedBy      string      `json:"scannedBy"`
+	ClientScanID   *string     `json:"clientScanId,omitempty"`
+	Outcome        ScanOutcome `json:"outcome"`
+	ScannedAt      time.Time   `json:"scannedAt"`
+	ReceivedAt     time.Time   `json:"receivedAt"`
+}
@@ -0,0 +1,680 @@
+package registration
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+// MaxAnswerFileBytes is the hard cap on an uploaded answer file; questions may
+// set a lower limit
+const MaxAnswerFileBytes int64 = 10 * 1024 * 1024
+
+// maxQuestionsPerEvent keeps registration forms to a reasonable length
+const maxQuestionsPerEvent = 50
+
+// answerDateLayout is how DATE answers and limits are written
+const answerDateLayout = "2006-01-02"
+
+var (
+	ErrInvalidQuestion    = errors.New("invalid registration question")
+	ErrInvalidAnswer      = errors.New("invalid registration answers")
+	ErrAnswerNotFound     = errors.New("answer not found")
+	ErrFileUploadDisabled = errors.New("file answers are not configured on this server")
+)
+
+// defaultAnswerFileTypes are accepted when a FILE question doesn't list its own
+var defaultAnswerFileTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+type QuestionType string
+
+const (
+	QuestionText         QuestionType = "TEXT"
+	QuestionSingleChoice QuestionType = "SINGLE_CHOICE"
+	QuestionMultiChoice  QuestionType = "MULTI_CHOICE"
+	QuestionNumber       QuestionType = "NUMBER"
+	QuestionDate         QuestionType = "DATE"
+	QuestionFile         QuestionType = "FILE"
+)
+
+// QuestionValidation holds the optional rules for a question. Only the rules
+// that fit the question's type may be set.
+type QuestionValidation struct {
+	// TEXT
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	// NUMBER
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// MULTI_CHOICE
+	MinSelections *int `json:"minSelections,omitempty"`
+	MaxSelections *int `json:"maxSelections,omitempty"`
+	// DATE, as YYYY-MM-DD
+	EarliestDate string `json:"earliestDate,omitempty"`
+	LatestDate   string `json:"latestDate,omitempty"`
+	// FILE; content types may end in /* to allow a family
+	AllowedFileTypes []string `json:"allowedFileTypes,omitempty"`
+	MaxFileBytes     int64    `json:"maxFileBytes,omitempty"`
+}
+
+// Question is one field of an event's registration form
+type Question struct {
+	ID         string             `json:"id"`
+	EventID    string             `json:"eventId"`
+	Position   int                `json:"position"`
+	Label      string             `json:"label"`
+	HelpText   string             `json:"helpText"`
+	Type       QuestionType       `json:"type"`
+	Required   bool               `json:"required"`
+	Options    []string           `json:"options"`
+	Validation QuestionValidation `json:"validation"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+// AnswerFile describes an uploaded answer; the bytes live in an AnswerFileStore
+type AnswerFile struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	StoragePath string `json:"-"`
+}
+
+// Answer is a registration's response to one question. Value holds text, the
+// chosen option, a number or a date; Choices holds multi-choice picks.
+type Answer struct {
+	RegistrationID string      `json:"registrationId"`
+	QuestionID     string      `json:"questionId"`
+	Value          string      `json:"value"`
+	Choices        []string    `json:"choices"`
+	File           *AnswerFile `json:"file,omitempty"`
+	CreatedAt      time.Time   `json:"createdAt"`
+
+	// upload is held between validation and storage
+	upload *FileUpload
+}
+
+// FileUpload is a file submitted as an answer
+type FileUpload struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// AnswerInput is a volunteer's response to one question
+type AnswerInput struct {
+	QuestionID string
+	Value      string
+	Choices    []string
+	File       *FileUpload
+}
+
+// RegistrationDetails is what a volunteer fills in when registering
+type RegistrationDetails struct {
+	PersonalMessage       string
+	EmergencyContactName  string
+	EmergencyContactPhone string
+	DietaryRestrictions   string
+	AccessibilityNeeds    string
+	Answers               []AnswerInput
+	// Waivers signs the event's waivers as part of registering
+	Waivers  []WaiverSignatureInput
+	Evidence SignatureEvidence
+	// GuardianEmail is where the consent request goes when the volunteer is a minor
+	GuardianEmail string
+	// ShiftIDs are the shifts the volunteer signs up for; required when the
+	// event has shifts
+	ShiftIDs []string
+}
+
+// AnswerProblem is why one answer was refused
+type AnswerProblem struct {
+	QuestionID string
+	Label      string
+	Message    string
+}
+
+// AnswerError lists every answer that failed validation, so a form can show
+// them all at once
+type AnswerError struct {
+	Problems []AnswerProblem
+}
+
+func (e *AnswerError) Error() string {
+	parts := make([]string, 0, len(e.Problems))
+	for _, p := range e.Problems {
+		parts = append(parts, fmt.Sprintf("%s: %s", p.Label, p.Message))
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidAnswer, strings.Join(parts, "; "))
+}
+
+func (e *AnswerError) Unwrap() error { return ErrInvalidAnswer }
+
+// AnswerFileStore keeps uploaded answer files. They can hold personal
+// documents, so they are served only through the authorized download route.
+type AnswerFileStore interface {
+	Save(ctx context.Context, registrationID, questionID string, upload *FileUpload) (storagePath string, err error)
+	Open(ctx context.Context, storagePath string) (io.ReadCloser, error)
+	Delete(ctx context.Context, storagePath string) error
+}
+
+// AnswerFilePath is where the HTTP API serves an uploaded answer file
+func AnswerFilePath(registrationID, questionID string) string {
+	return "/registrations/" + registrationID + "/answers/" + questionID + "/file"
+}
+
+// AnswersExportPath is where the HTTP API serves an event's answers as CSV
+func AnswersExportPath(eventID string) string {
+	return "/events/" + eventID + "/registration-answers.csv"
+}
+
+// GetEventQuestions returns an event's registration form in display order
+func (s *Service) GetEventQuestions(ctx context.Context, eventID string) ([]*Question, error) {
+	return s.repo.GetEventQuestions(ctx, eventID)
+}
+
+// SetEventRegistrationQuestions replaces an event's registration form. Questions keep
+// their ID (and answers) when passed back with it; questions left out are
+// removed along with their answers. An existing question can't change type.
+func (s *Service) SetEventRegistrationQuestions(ctx context.Context, userID, eventID string, questions []*Question) ([]*Question, error) {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, userID, event.StaffActionManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetEventQuestions(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+	byID := make(map[string]*Question, len(existing))
+	for _, q := range existing {
+		byID[q.ID] = q
+	}
+
+	if err := validateQuestions(questions); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i, q := range questions {
+		if q.ID == "" {
+			q.ID = uuid.New().String()
+			q.CreatedAt = now
+		} else if prev, ok := byID[q.ID]; !ok {
+			return nil, fmt.Errorf("%w: question %s does not belong to this event", ErrInvalidQuestion, q.ID)
+		} else if prev.Type != q.Type {
+			return nil, fmt.Errorf("%w: %q already has answers as %s; add a new question instead", ErrInvalidQuestion, q.Label, prev.Type)
+		} else {
+			q.CreatedAt = prev.CreatedAt
+		}
+		q.EventID = eventID
+		q.Position = i
+		q.UpdatedAt = now
+	}
+
+	if err := s.repo.ReplaceEventQuestions(ctx, eventID, questions); err != nil {
+		return nil, fmt.Errorf("failed to save questions: %w", err)
+	}
+	s.logger.Info("registration questions updated", "eventID", eventID, "by", userID, "count", len(questions))
+	return questions, nil
+}
+
+// GetRegistrationAnswers returns a registration's answers to its volunteer or
+// to event staff
+func (s *Service) GetRegistrationAnswers(ctx context.Context, requesterID, registrationID string) ([]*Answer, error) {
+	if _, err := s.loadForAnswers(ctx, requesterID, registrationID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRegistrationAnswers(ctx, registrationID)
+}
+
+// OpenAnswerFile streams an uploaded answer to its volunteer or to event staff.
+// The caller closes the reader.
+func (s *Service) OpenAnswerFile(ctx context.Context, requesterID, registrationID, questionID string) (*AnswerFile, io.ReadCloser, error) {
+	if s.files == nil {
+		return nil, nil, ErrFileUploadDisabled
+	}
+	answers, err := s.GetRegistrationAnswers(ctx, requesterID, registrationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, a := range answers {
+		if a.QuestionID == questionID && a.File != nil {
+			body, err := s.files.Open(ctx, a.File.StoragePath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to open answer file: %w", err)
+			}
+			return a.File, body, nil
+		}
+	}
+	return nil, nil, ErrAnswerNotFound
+}
+
+// ExportAnswersCSV writes one row per registration with a column per question
+func (s *Service) ExportAnswersCSV(ctx context.Context, staffID, eventID string, w io.Writer) error {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, staffID, event.StaffActionView); err != nil {
+		return err
+	}
+
+	questions, err := s.repo.GetEventQuestions(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load questions: %w", err)
+	}
+	registrations, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get registrations: %w", err)
+	}
+	answers, err := s.repo.GetAnswersByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load answers: %w", err)
+	}
+	emails, err := s.repo.GetRegistrantEmails(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to look up registrant emails: %w", err)
+	}
+
+	byRegistration := map[string]map[string]*Answer{}
+	for _, a := range answers {
+		if byRegistration[a.RegistrationID] == nil {
+			byRegistration[a.RegistrationID] = map[string]*Answer{}
+		}
+		byRegistration[a.RegistrationID][a.QuestionID] = a
+	}
+
+	out := csv.NewWriter(w)
+	header := []string{"registration_id", "name", "email", "status", "applied_at",
+		"emergency_contact_name", "emergency_contact_phone", "dietary_restrictions", "accessibility_needs"}
+	for _, q := range questions {
+		header = append(header, q.Label)
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+
+	for _, reg := range registrations {
+		name := ""
+		if profile, err := s.userService.GetProfile(ctx, reg.UserID, reg.UserID, nil); err == nil && profile != nil {
+			name = profile.Name
+		}
+		record := []string{reg.ID, name, emails[reg.UserID], string(reg.Status), reg.AppliedAt.Format(time.RFC3339),
+			reg.EmergencyContactName, reg.EmergencyContactPhone, reg.DietaryRestrictions, reg.AccessibilityNeeds}
+		for _, q := range questions {
+			record = append(record, formatAnswerCell(byRegistration[reg.ID][q.ID]))
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// loadForAnswers lets a volunteer see their own registration's answers and
+// event staff see everyone's
+func (s *Service) loadForAnswers(ctx context.Context, requesterID, registrationID string) (*Registration, error) {
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg == nil {
+		return nil, fmt.Errorf("registration not found")
+	}
+	if reg.UserID == requesterID {
+		return reg, nil
+	}
+	evt, err := s.eventService.GetEvent(ctx, reg.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, requesterID, event.StaffActionView); err != nil {
+		return nil, err
+	}
+	return reg, nil
+}
+
+// saveAnswers stores uploaded files and then the answers. On failure it
+// removes whatever files it already stored.
+func (s *Service) saveAnswers(ctx context.Context, registrationID string, answers []*Answer) error {
+	if len(answers) == 0 {
+		return nil
+	}
+	var stored []string
+	cleanup := func() {
+		for _, path := range stored {
+			if err := s.files.Delete(ctx, path); err != nil {
+				s.logger.Warn("failed to remove answer file", "path", path, "error", err)
+			}
+		}
+	}
+
+	for _, a := range answers {
+		a.RegistrationID = registrationID
+		a.CreatedAt = time.Now()
+		if a.upload == nil {
+			continue
+		}
+		if s.files == nil {
+			cleanup()
+			return ErrFileUploadDisabled
+		}
+		path, err := s.files.Save(ctx, registrationID, a.QuestionID, a.upload)
+		if err != nil {
+			cleanup()
+			return fmt.Errorf("failed to store answer file: %w", err)
+		}
+		stored = append(stored, path)
+		a.File.StoragePath = path
+	}
+
+	if err := s.repo.SaveRegistrationAnswers(ctx, registrationID, answers); err != nil {
+		cleanup()
+		return fmt.Errorf("failed to save answers: %w", err)
+	}
+	return nil
+}
+
+// validateQuestions checks a form definition and tidies labels and options
+func validateQuestions(questions []*Question) error {
+	if len(questions) > maxQuestionsPerEvent {
+		return fmt.Errorf("%w: at most %d questions per event", ErrInvalidQuestion, maxQuestionsPerEvent)
+	}
+	for i, q := range questions {
+		q.Label = strings.TrimSpace(q.Label)
+		q.HelpText = strings.TrimSpace(q.HelpText)
+		if q.Label == "" {
+			return fmt.Errorf("%w: question %d needs a label", ErrInvalidQuestion, i+1)
+		}
+		if err := validateQuestion(q); err != nil {
+			return fmt.Errorf("%w: %q %s", ErrInvalidQuestion, q.Label, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateQuestion(q *Question) error {
+	v := q.Validation
+	isChoice := q.Type == QuestionSingleChoice || q.Type == QuestionMultiChoice
+
+	switch q.Type {
+	case QuestionText, QuestionSingleChoice, QuestionMultiChoice, QuestionNumber, QuestionDate, QuestionFile:
+	default:
+		return fmt.Errorf("has unknown type %s", q.Type)
+	}
+
+	if isChoice {
+		seen := map[string]bool{}
+		options := make([]string, 0, len(q.Options))
+		for _, o := range q.Options {
+			o = strings.TrimSpace(o)
+			if o == "" || seen[o] {
+				return fmt.Errorf("has an empty or repeated option")
+			}
+			seen[o] = true
+			options = append(options, o)
+		}
+		if len(options) == 0 {
+			return fmt.Errorf("needs at least one option")
+		}
+		q.Options = options
+	} else if len(q.Options) > 0 {
+		return fmt.Errorf("only choice questions have options")
+	}
+
+	if (v.MinLength != nil || v.MaxLength != nil || v.Pattern != "") && q.Type != QuestionText {
+		return fmt.Errorf("length and pattern rules only apply to text")
+	}
+	if (v.Min != nil || v.Max != nil) && q.Type != QuestionNumber {
+		return fmt.Errorf("min and max only apply to numbers")
+	}
+	if (v.MinSelections != nil || v.MaxSelections != nil) && q.Type != QuestionMultiChoice {
+		return fmt.Errorf("selection limits only apply to multi-choice")
+	}
+	if (v.EarliestDate != "" || v.LatestDate != "") && q.Type != QuestionDate {
+		return fmt.Errorf("date limits only apply to dates")
+	}
+	if (len(v.AllowedFileTypes) > 0 || v.MaxFileBytes != 0) && q.Type != QuestionFile {
+		return fmt.Errorf("file rules only apply to files")
+	}
+
+	if v.MinLength != nil && v.MaxLength != nil && *v.MinLength > *v.MaxLength {
+		return fmt.Errorf("has a minimum length above its maximum")
+	}
+	if v.Pattern != "" {
+		if _, err := regexp.Compile(v.Pattern); err != nil {
+			return fmt.Errorf("has an invalid pattern")
+		}
+	}
+	if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+		return fmt.Errorf("has a minimum above its maximum")
+	}
+	if v.MinSelections != nil && v.MaxSelections != nil && *v.MinSelections > *v.MaxSelections {
+		return fmt.Errorf("has a minimum selection count above its maximum")
+	}
+	if v.MaxSelections != nil && *v.MaxSelections > len(q.Options) {
+		return fmt.Errorf("allows more selections than it has options")
+	}
+	var earliest, latest time.Time
+	var err error
+	if v.EarliestDate != "" {
+		if earliest, err = time.Parse(answerDateLayout, v.EarliestDate); err != nil {
+			return fmt.Errorf("has an earliest date that isn't YYYY-MM-DD")
+		}
+	}
+	if v.LatestDate != "" {
+		if latest, err = time.Parse(answerDateLayout, v.LatestDate); err != nil {
+			return fmt.Errorf("has a latest date that isn't YYYY-MM-DD")
+		}
+	}
+	if v.EarliestDate != "" && v.LatestDate != "" && latest.Before(earliest) {
+		return fmt.Errorf("has its latest date before its earliest")
+	}
+	if v.MaxFileBytes < 0 || v.MaxFileBytes > MaxAnswerFileBytes {
+		return fmt.Errorf("has a file size limit outside 1 byte to %d bytes", MaxAnswerFileBytes)
+	}
+	return nil
+}
+
+// validateAnswers checks answers against the form and returns them
+// normalized. Every problem is collected into an *AnswerError.
+func validateAnswers(questions []*Question, inputs []AnswerInput) ([]*Answer, error) {
+	byQuestion := make(map[string]AnswerInput, len(inputs))
+	var problems []AnswerProblem
+	known := make(map[string]bool, len(questions))
+	for _, q := range questions {
+		known[q.ID] = true
+	}
+	for _, in := range inputs {
+		if !known[in.QuestionID] {
+			problems = append(problems, AnswerProblem{QuestionID: in.QuestionID, Label: in.QuestionID, Message: "is not a question on this event"})
+			continue
+		}
+		if _, dup := byQuestion[in.QuestionID]; dup {
+			problems = append(problems, AnswerProblem{QuestionID: in.QuestionID, Label: in.QuestionID, Message: "was answered twice"})
+			continue
+		}
+		byQuestion[in.QuestionID] = in
+	}
+
+	var answers []*Answer
+	for _, q := range questions {
+		in, ok := byQuestion[q.ID]
+		answer, msg := validateAnswer(q, in, ok)
+		if msg != "" {
+			problems = append(problems, AnswerProblem{QuestionID: q.ID, Label: q.Label, Message: msg})
+			continue
+		}
+		if answer != nil {
+			answers = append(answers, answer)
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, &AnswerError{Problems: problems}
+	}
+	return answers, nil
+}
+
+// validateAnswer returns the normalized answer (nil when optional and left
+// blank) or a message saying what is wrong with it
+func validateAnswer(q *Question, in AnswerInput, given bool) (*Answer, string) {
+	value := strings.TrimSpace(in.Value)
+	var choices []string
+	for _, c := range in.Choices {
+		if c = strings.TrimSpace(c); c != "" {
+			choices = append(choices, c)
+		}
+	}
+	blank := !given || (value == "" && len(choices) == 0 && in.File == nil)
+	if blank {
+		if q.Required {
+			return nil, "is required"
+		}
+		return nil, ""
+	}
+
+	v := q.Validation
+	answer := &Answer{QuestionID: q.ID, Choices: []string{}}
+	switch q.Type {
+	case QuestionText:
+		n := len([]rune(value))
+		if v.MinLength != nil && n < *v.MinLength {
+			return nil, fmt.Sprintf("must be at least %d characters", *v.MinLength)
+		}
+		if v.MaxLength != nil && n > *v.MaxLength {
+			return nil, fmt.Sprintf("must be at most %d characters", *v.MaxLength)
+		}
+		if v.Pattern != "" && !regexp.MustCompile(v.Pattern).MatchString(value) {
+			return nil, "is not in the expected format"
+		}
+		answer.Value = value
+
+	case QuestionSingleChoice:
+		if !containsString(q.Options, value) {
+			return nil, "must be one of the listed options"
+		}
+		answer.Value = value
+
+	case QuestionMultiChoice:
+		seen := map[string]bool{}
+		for _, c := range choices {
+			if !containsString(q.Options, c) {
+				return nil, fmt.Sprintf("%q is not one of the listed options", c)
+			}
+			if !seen[c] {
+				seen[c] = true
+				answer.Choices = append(answer.Choices, c)
+			}
+		}
+		if v.MinSelections != nil && len(answer.Choices) < *v.MinSelections {
+			return nil, fmt.Sprintf("needs at least %d selections", *v.MinSelections)
+		}
+		if v.MaxSelections != nil && len(answer.Choices) > *v.MaxSelections {
+			return nil, fmt.Sprintf("allows at most %d selections", *v.MaxSelections)
+		}
+
+	case QuestionNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, "must be a number"
+		}
+		if v.Min != nil && n < *v.Min {
+			return nil, fmt.Sprintf("must be at least %s", strconv.FormatFloat(*v.Min, 'f', -1, 64))
+		}
+		if v.Max != nil && n > *v.Max {
+			return nil, fmt.Sprintf("must be at most %s", strconv.FormatFloat(*v.Max, 'f', -1, 64))
+		}
+		answer.Value = strconv.FormatFloat(n, 'f', -1, 64)
+
+	case QuestionDate:
+		d, err := time.Parse(answerDateLayout, value)
+		if err != nil {
+			return nil, "must be a date as YYYY-MM-DD"
+		}
+		// Limits were checked when the question was saved
+		if v.EarliestDate != "" && value < v.EarliestDate {
+			return nil, "must be on or after " + v.EarliestDate
+		}
+		if v.LatestDate != "" && value > v.LatestDate {
+			return nil, "must be on or before " + v.LatestDate
+		}
+		answer.Value = d.Format(answerDateLayout)
+
+	case QuestionFile:
+		if in.File == nil || len(in.File.Data) == 0 {
+			return nil, "needs a file"
+		}
+		limit := MaxAnswerFileBytes
+		if v.MaxFileBytes > 0 {
+			limit = v.MaxFileBytes
+		}
+		if int64(len(in.File.Data)) > limit {
+			return nil, fmt.Sprintf("must be at most %d KB", limit/1024)
+		}
+		contentType := http.DetectContentType(in.File.Data)
+		allowed := v.AllowedFileTypes
+		if len(allowed) == 0 {
+			allowed = defaultAnswerFileTypes
+		}
+		if !contentTypeAllowed(contentType, allowed) {
+			return nil, "is not an accepted file type"
+		}
+		name := strings.TrimSpace(in.File.Name)
+		if name == "" {
+			name = "upload"
+		}
+		answer.Value = name
+		answer.File = &AnswerFile{Name: name, ContentType: contentType, Size: int64(len(in.File.Data))}
+		answer.upload = &FileUpload{Name: name, ContentType: contentType, Data: in.File.Data}
+	}
+	return answer, ""
+}
+
+// contentTypeAllowed matches a sniffed content type against a list that may
+// contain family wildcards such as image/*
+func contentTypeAllowed(contentType string, allowed []string) bool {
+	base := strings.ToLower(strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]))
+	for _, a := range allowed {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == base || (strings.HasSuffix(a, "/*") && strings.HasPrefix(base, strings.TrimSuffix(a, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatAnswerCell(a *Answer) string {
+	if a == nil {
+		return ""
+	}
+	if len(a.Choices) > 0 {
+		return strings.Join(a.Choices, "; ")
+	}
+	return a.Value
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package registration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(n int) *int { return &n }
+
+func floatPtr(f float64) *float64 { return &f }
+
+func TestValidateQuestions(t *testing.T) {
+	valid := []*Question{
+		{Label: " T-shirt size ", Type: QuestionSingleChoice, Options: []string{"S", " M ", "L"}},
+		{Label: "Age", Type: QuestionNumber, Validation: QuestionValidation{Min: floatPtr(16)}},
+	}
+	require.NoError(t, validateQuestions(valid))
+	assert.Equal(t, "T-shirt size", valid[0].Label)
+	assert.Equal(t, []string{"S", "M", "L"}, valid[0].Options)
+
+	tests := []struct {
+		name     string
+		question *Question
+	}{
+		{"missing label", &Question{Type: QuestionText}},
+		{"unknown type", &Question{Label: "x", Type: "SLIDER"}},
+		{"choice without options", &Question{Label: "x", Type: QuestionMultiChoice}},
+		{"repeated option", &Question{Label: "x", Type: QuestionSingleChoice, Options: []string{"a", "a"}}},
+		{"options on text", &Question{Label: "x", Type: QuestionText, Options: []string{"a"}}},
+		{"rule for another type", &Question{Label: "x", Type: QuestionText, Validation: QuestionValidation{Min: floatPtr(1)}}},
+		{"bad pattern", &Question{Label: "x", Type: QuestionText, Validation: QuestionValidation{Pattern: "("}}},
+		{"inverted length", &Question{Label: "x", Type: QuestionText, Validation: QuestionValidation{MinLength: intPtr(5), MaxLength: intPtr(2)}}},
+		{"bad date", &Question{Label: "x", Type: QuestionDate, Validation: QuestionValidation{EarliestDate: "01/02/2026"}}},
+		{"too many selections", &Question{Label: "x", Type: QuestionMultiChoice, Options: []string{"a"}, Validation: QuestionValidation{MaxSelections: intPtr(2)}}},
+		{"file too large", &Question{Label: "x", Type: QuestionFile, Validation: QuestionValidation{MaxFileBytes: MaxAnswerFileBytes + 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, validateQuestions([]*Question{tt.question}), ErrInvalidQuestion)
+		})
+	}
+}
+
+func TestValidateAnswers(t *testing.T) {
+	questions := []*Question{
+		{ID: "q-text", Label: "Why", Type: QuestionText, Required: true, Validation: QuestionValidation{MaxLength: intPtr(10)}},
+		{ID: "q-one", Label: "Size", Type: QuestionSingleChoice, Options: []string{"S", "M"}},
+		{ID: "q-many", Label: "Days", Type: QuestionMultiChoice, Options: []string{"Sat", "Sun"}, Validation: QuestionValidation{MinSelections: intPtr(1)}},
+		{ID: "q-num", Label: "Age", Type: QuestionNumber, Validation: QuestionValidation{Min: floatPtr(16)}},
+		{ID: "q-date", Label: "Available from", Type: QuestionDate, Validation: QuestionValidation{LatestDate: "2026-12-31"}},
+		{ID: "q-file", Label: "Waiver", Type: QuestionFile},
+	}
+	pdf := []byte("%PDF-1.4\n%fake\n")
+
+	t.Run("valid answers are normalized", func(t *testing.T) {
+		answers, err := validateAnswers(questions, []AnswerInput{
+			{QuestionID: "q-text", Value: "  to help "},
+			{QuestionID: "q-many", Choices: []string{"Sun", "Sun", " Sat"}},
+			{QuestionID: "q-num", Value: "18.0"},
+			{QuestionID: "q-date", Value: "2026-06-01"},
+			{QuestionID: "q-file", File: &FileUpload{Name: "waiver.pdf", Data: pdf}},
+		})
+		require.NoError(t, err)
+		require.Len(t, answers, 5, "the blank optional question is left out")
+		assert.Equal(t, "to help", answers[0].Value)
+		assert.Equal(t, []string{"Sun", "Sat"}, answers[1].Choices)
+		assert.Equal(t, "18", answers[2].Value)
+		require.NotNil(t, answers[4].File)
+		assert.Equal(t, "application/pdf", answers[4].File.ContentType)
+		assert.NotNil(t, answers[4].upload)
+	})
+
+	t.Run("all problems are reported together", func(t *testing.T) {
+		_, err := validateAnswers(questions, []AnswerInput{
+			{QuestionID: "q-one", Value: "XL"},
+			{QuestionID: "q-many", Choices: []string{"Mon"}},
+			{QuestionID: "q-num", Value: "12"},
+			{QuestionID: "q-date", Value: "2027-01-01"},
+			{QuestionID: "q-file", File: &FileUpload{Name: "notes.txt", Data: []byte("plain text")}},
+			{QuestionID: "q-other", Value: "?"},
+		})
+		require.ErrorIs(t, err, ErrInvalidAnswer)
+		var answerErr *AnswerError
+		require.True(t, errors.As(err, &answerErr))
+		failed := map[string]bool{}
+		for _, p := range answerErr.Problems {
+			failed[p.QuestionID] = true
+		}
+		assert.Equal(t, map[string]bool{"q-other": true, "q-text": true, "q-one": true, "q-many": true, "q-num": true, "q-date": true, "q-file": true}, failed)
+	})
+}
+
+func TestContentTypeAllowed(t *testing.T) {
+	assert.True(t, contentTypeAllowed("image/png", []string{"image/*"}))
+	assert.True(t, contentTypeAllowed("text/plain; charset=utf-8", []string{"text/plain"}))
+	assert.False(t, contentTypeAllowed("application/pdf", []string{"image/*"}))
+}
+
+func TestFormatAnswerCell(t *testing.T) {
+	assert.Equal(t, "", formatAnswerCell(nil))
+	assert.Equal(t, "Sat; Sun", formatAnswerCell(&Answer{Choices: []string{"Sat", "Sun"}}))
+	assert.Equal(t, "waiver.pdf", formatAnswerCell(&Answer{Value: "waiver.pdf", File: &AnswerFile{Name: "waiver.pdf"}}))
+}
@@ -0,0 +1,165 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/mail"
+)
+
+// ReconfirmationNotice is how long before the reconfirmation deadline
+// volunteers are asked to reconfirm
+const ReconfirmationNotice = 48 * time.Hour
+
+// reconfirmationLookahead covers every event whose reconfirmation notice
+// could already have gone out
+const reconfirmationLookahead = event.MaxReconfirmationHours*time.Hour + ReconfirmationNotice
+
+const releasedReason = "not reconfirmed before the deadline"
+
+// RunReconfirmations processes reconfirmations every interval until ctx is
+// done. A non-positive interval disables it.
+func (s *Service) RunReconfirmations(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.ProcessReconfirmations(ctx, now); err != nil {
+				s.logger.Error("failed to process reconfirmations", "error", err)
+			}
+		}
+	}
+}
+
+// ProcessReconfirmations handles events that require confirmation: it asks
+// confirmed volunteers to reconfirm once the deadline is near, and releases
+// the places of those who haven't by the deadline to the waitlist.
+func (s *Service) ProcessReconfirmations(ctx context.Context, now time.Time) error {
+	eventIDs, err := s.repo.GetConfirmationRequiredEventIDs(ctx, now, now.Add(reconfirmationLookahead))
+	if err != nil {
+		return fmt.Errorf("failed to load events awaiting reconfirmation: %w", err)
+	}
+
+	for _, eventID := range eventIDs {
+		if err := s.processEventReconfirmations(ctx, eventID, now); err != nil {
+			s.logger.Error("failed to process event reconfirmations", "eventID", eventID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) processEventReconfirmations(ctx context.Context, eventID string, now time.Time) error {
+	evt, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load event: %w", err)
+	}
+	regs, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load registrations: %w", err)
+	}
+
+	request, release := reconfirmationActions(evt, regs, now)
+	if len(request) == 0 && len(release) == 0 {
+		return nil
+	}
+	emails := s.registrantEmails(ctx, eventID)
+
+	for _, reg := range request {
+		reg.ReconfirmationRequestedAt = &now
+		reg.UpdatedAt = now
+		if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+			s.logger.Error("failed to request reconfirmation", "registrationID", reg.ID, "error", err)
+			continue
+		}
+		if to := emails[reg.UserID]; to != "" {
+			s.sendRegistrantEmail(ctx, reg, reconfirmationEmail(to, evt))
+		}
+	}
+
+	if len(release) == 0 {
+		return nil
+	}
+	changes := make([]*CapacityChange, 0, len(release))
+	for _, reg := range release {
+		reg.Status = StatusCancelled
+		reg.CancellationReason = releasedReason
+		reg.CancelledAt = &now
+		reg.UpdatedAt = now
+		changes = append(changes, &CapacityChange{
+			Registration: reg,
+			StatusChange: capacityStatusChange(reg, StatusConfirmed, "", releasedReason, now),
+		})
+	}
+	teamRegs, err := s.repo.GetEventTeamRegistrations(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load team registrations: %w", err)
+	}
+	individual, places := individualPlaces(regs, teamRegs, evt.Capacity.Maximum)
+	for _, c := range rebalanceCapacity(individual, places, "", now) {
+		if c.StatusChange != nil {
+			c.StatusChange.Reason = "place released by a volunteer who did not reconfirm"
+		}
+		changes = append(changes, c)
+	}
+
+	if err := s.repo.ApplyCapacityChanges(ctx, changes); err != nil {
+		return fmt.Errorf("failed to release unconfirmed places: %w", err)
+	}
+	for _, reg := range release {
+		s.resolveConflicts(ctx, reg.UserID, eventID, releasedReason)
+	}
+	s.afterCapacityChanges(ctx, evt, changes)
+	return nil
+}
+
+// reconfirmationActions picks the confirmed registrations to ask to reconfirm
+// and those whose place is released at now. A volunteer who has reconfirmed,
+// or was only confirmed after the deadline, keeps their place; one who was
+// never asked is asked before their place can be released.
+func reconfirmationActions(evt *event.Event, regs []*Registration, now time.Time) (request, release []*Registration) {
+	if !evt.RegistrationSettings.ConfirmationRequired || !now.Before(evt.StartTime) {
+		return nil, nil
+	}
+	deadline := evt.RegistrationSettings.ReconfirmationDeadline(evt.StartTime)
+
+	for _, reg := range regs {
+		if reg.Status != StatusConfirmed {
+			continue
+		}
+		if reg.ReconfirmedAt != nil && reg.ReconfirmationRequestedAt == nil {
+			continue
+		}
+		if !confirmedSince(reg).Before(deadline) {
+			continue
+		}
+		switch {
+		case reg.ReconfirmationRequestedAt == nil:
+			// Nobody loses their place without having been asked first
+			if !now.Before(deadline.Add(-ReconfirmationNotice)) {
+				request = append(request, reg)
+			}
+		case !now.Before(deadline):
+			release = append(release, reg)
+		}
+	}
+	return request, release
+}
+
+func reconfirmationEmail(to string, evt *event.Event) mail.Message {
+	deadline := evt.RegistrationSettings.ReconfirmationDeadline(evt.StartTime)
+	return mail.Message{
+		To:      to,
+		Subject: fmt.Sprintf("Please reconfirm your place at %s", evt.Title),
+		Body: fmt.Sprintf("%s starts on %s. Please sign in and reconfirm your registration by %s, "+
+			"or your place will be offered to someone on the waitlist.\n",
+			evt.Title, evt.InLocalZone(evt.StartTime).Format("Monday 2 January 2006 at 15:04 MST"), evt.InLocalZone(deadline).Format("Monday 2 January 2006 at 15:04 MST")),
+	}
+}
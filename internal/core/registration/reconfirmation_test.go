@@ -0,0 +1,67 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func TestReconfirmationActions(t *testing.T) {
+	start := time.Date(2026, 6, 6, 10, 0, 0, 0, time.UTC)
+	deadline := start.Add(-24 * time.Hour)
+	evt := &event.Event{
+		StartTime:            start,
+		RegistrationSettings: event.RegistrationSettings{ConfirmationRequired: true},
+	}
+	at := func(t time.Time) *time.Time { return &t }
+	early := at(start.Add(-10 * 24 * time.Hour))
+
+	regs := func() map[string]*Registration {
+		return map[string]*Registration{
+			"new":         {ID: "new", Status: StatusConfirmed, ConfirmedAt: early},
+			"asked":       {ID: "asked", Status: StatusConfirmed, ConfirmedAt: early, ReconfirmationRequestedAt: at(deadline.Add(-40 * time.Hour))},
+			"reconfirmed": {ID: "reconfirmed", Status: StatusConfirmed, ConfirmedAt: early, ReconfirmedAt: at(deadline.Add(-30 * time.Hour))},
+			"promoted":    {ID: "promoted", Status: StatusConfirmed, ConfirmedAt: at(deadline.Add(time.Hour))},
+			"waitlisted":  {ID: "waitlisted", Status: StatusWaitlisted},
+		}
+	}
+	ids := func(list []*Registration) []string {
+		var out []string
+		for _, r := range list {
+			out = append(out, r.ID)
+		}
+		return out
+	}
+	list := func(m map[string]*Registration) []*Registration {
+		return []*Registration{m["new"], m["asked"], m["reconfirmed"], m["promoted"], m["waitlisted"]}
+	}
+
+	t.Run("nothing before the notice period", func(t *testing.T) {
+		request, release := reconfirmationActions(evt, list(regs()), deadline.Add(-ReconfirmationNotice-time.Hour))
+		assert.Empty(t, request)
+		assert.Empty(t, release)
+	})
+
+	t.Run("asks volunteers who haven't been asked yet", func(t *testing.T) {
+		request, release := reconfirmationActions(evt, list(regs()), deadline.Add(-time.Hour))
+		assert.Equal(t, []string{"new"}, ids(request))
+		assert.Empty(t, release)
+	})
+
+	t.Run("releases places at the deadline", func(t *testing.T) {
+		request, release := reconfirmationActions(evt, list(regs()), deadline)
+		assert.Equal(t, []string{"new"}, ids(request), "never released without being asked")
+		assert.Equal(t, []string{"asked"}, ids(release))
+	})
+
+	t.Run("only for events that require confirmation", func(t *testing.T) {
+		optional := *evt
+		optional.RegistrationSettings.ConfirmationRequired = false
+		request, release := reconfirmationActions(&optional, list(regs()), deadline)
+		assert.Empty(t, request)
+		assert.Empty(t, release)
+	})
+}
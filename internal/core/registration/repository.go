@@ -1,17 +1,72 @@
 package registration
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // RegistrationStore defines the interface for interacting with the registration data layer.
 
 type Repository interface {
+	// Transaction runs fn in a transaction that the writes given its context join
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
 	// Registration methods
+	// CreateRegistration returns ErrNoFreeSeat when the registration fills a
+	// team seat and every seat the team reserved is already taken
 	CreateRegistration(ctx context.Context, arg *Registration) (*Registration, error)
 	GetRegistrationByID(ctx context.Context, id string) (*Registration, error)
 	GetRegistrationsByEventID(ctx context.Context, eventID string) ([]*Registration, error)
 	GetRegistrationsByUserID(ctx context.Context, userID string) ([]*Registration, error)
 	UpdateRegistration(ctx context.Context, arg *Registration) error
 	DeleteRegistration(ctx context.Context, id string) error
+	// ApplyCapacityChanges writes every change in one transaction, or none of them
+	ApplyCapacityChanges(ctx context.Context, changes []*CapacityChange) error
+	// RequestReconfirmation flags the event's registrations that still hold or await a place
+	RequestReconfirmation(ctx context.Context, eventID string, at time.Time) error
+	// GetConfirmationRequiredEventIDs returns published events that require
+	// confirmation and start after from, up to and including to
+	GetConfirmationRequiredEventIDs(ctx context.Context, from, to time.Time) ([]string, error)
+	// ApplyTransfer writes a transfer in one transaction. It returns
+	// ErrTransferSeatTaken when a target about to be confirmed, or a shift
+	// assignment about to be confirmed, no longer fits the event or shift,
+	// and ErrAlreadyTransferred when the source was transferred before.
+	ApplyTransfer(ctx context.Context, changes *TransferChanges) error
+	// GetRegistrationTransfer returns the transfer out of a registration, or ErrTransferNotFound
+	GetRegistrationTransfer(ctx context.Context, sourceRegistrationID string) (*RegistrationTransfer, error)
+
+	// Shift assignment methods
+	GetShiftAssignments(ctx context.Context, registrationID string) ([]*ShiftAssignment, error)
+	// GetEventShiftAssignments returns every assignment on the event's shifts
+	GetEventShiftAssignments(ctx context.Context, eventID string) ([]*ShiftAssignment, error)
+	// GetShiftCompletionIDs returns confirmed registrations that checked in to
+	// a shift and have no confirmed shift left open after endedBefore
+	GetShiftCompletionIDs(ctx context.Context, endedBefore time.Time) ([]string, error)
+	// SaveShiftAssignments upserts the assignments in one transaction
+	SaveShiftAssignments(ctx context.Context, assignments []*ShiftAssignment) error
+
+	// Team methods
+	// CreateTeam stores the team with its leader as the first member
+	CreateTeam(ctx context.Context, team *Team) error
+	// GetTeam returns the team, or ErrTeamNotFound
+	GetTeam(ctx context.Context, id string) (*Team, error)
+	GetUserTeams(ctx context.Context, userID string) ([]*Team, error)
+	// AddTeamMember ignores users already on the team
+	AddTeamMember(ctx context.Context, member *TeamMember) error
+	RemoveTeamMember(ctx context.Context, teamID, userID string) error
+	GetTeamMembers(ctx context.Context, teamID string) ([]*TeamMember, error)
+	// CreateTeamRegistration returns ErrTeamSeatsUnavailable when a team about
+	// to be confirmed no longer fits the event
+	CreateTeamRegistration(ctx context.Context, tr *TeamRegistration) error
+	UpdateTeamRegistration(ctx context.Context, tr *TeamRegistration) error
+	// GetTeamRegistration returns the team registration, or ErrTeamRegistrationNotFound
+	GetTeamRegistration(ctx context.Context, id string) (*TeamRegistration, error)
+	GetTeamRegistrations(ctx context.Context, teamID string) ([]*TeamRegistration, error)
+	GetEventTeamRegistrations(ctx context.Context, eventID string) ([]*TeamRegistration, error)
+	// GetTeamSeatEventIDs returns events starting after now with waitlisted
+	// teams or confirmed teams whose unassigned seats are due for release
+	GetTeamSeatEventIDs(ctx context.Context, now time.Time) ([]string, error)
+	GetTeamStats(ctx context.Context, teamID string) (*TeamStats, error)
 
 	// Waitlist methods
 	AddWaitlistEntry(ctx context.Context, arg *WaitlistEntry) (*WaitlistEntry, error)
@@ -29,4 +84,58 @@ type Repository interface {
 	CreateAttendanceRecord(ctx context.Context, arg *AttendanceRecord) (*AttendanceRecord, error)
 	GetAttendanceRecordsByRegistrationID(ctx context.Context, registrationID string) ([]*AttendanceRecord, error)
 	UpdateAttendanceRecord(ctx context.Context, arg *AttendanceRecord) error
+
+	// Ticket scan methods
+	CreateTicketScan(ctx context.Context, arg *TicketScan) (*TicketScan, error)
+	// GetTicketScanByClientID returns nil when the desk has not submitted that scan before
+	GetTicketScanByClientID(ctx context.Context, scannedBy, clientScanID string) (*TicketScan, error)
+
+	// Bulk attendance methods
+	// GetRegistrantEmails maps the user ID of each of the event's registrants to their email
+	GetRegistrantEmails(ctx context.Context, eventID string) (map[string]string, error)
+	// ApplyAttendanceChanges writes every change in one transaction, or none of them
+	ApplyAttendanceChanges(ctx context.Context, changes []*AttendanceChange) error
+
+	// Registration question methods
+	// GetEventQuestions returns the event's questions ordered by position
+	GetEventQuestions(ctx context.Context, eventID string) ([]*Question, error)
+	// ReplaceEventQuestions upserts questions and deletes the event's others, in one transaction
+	ReplaceEventQuestions(ctx context.Context, eventID string, questions []*Question) error
+	// GetTemplateQuestions returns an event template's questions ordered by position
+	GetTemplateQuestions(ctx context.Context, templateID string) ([]*Question, error)
+	// ReplaceTemplateQuestions swaps the template's questions for these, in one transaction
+	ReplaceTemplateQuestions(ctx context.Context, templateID string, questions []*Question) error
+	SaveRegistrationAnswers(ctx context.Context, registrationID string, answers []*Answer) error
+	GetRegistrationAnswers(ctx context.Context, registrationID string) ([]*Answer, error)
+	GetAnswersByEventID(ctx context.Context, eventID string) ([]*Answer, error)
+
+	// Waiver methods
+	// CreateWaiver stores the waiver and its current version
+	CreateWaiver(ctx context.Context, w *Waiver) error
+	// UpdateWaiver saves title, required and retired, adding version if it is not nil
+	UpdateWaiver(ctx context.Context, w *Waiver, version *WaiverVersion) error
+	// GetWaiver returns the waiver with its latest version, or ErrWaiverNotFound
+	GetWaiver(ctx context.Context, id string) (*Waiver, error)
+	// GetEventWaivers returns all of the event's waivers, retired ones included
+	GetEventWaivers(ctx context.Context, eventID string) ([]*Waiver, error)
+	// GetWaiverVersion returns a version, or ErrWaiverNotFound
+	GetWaiverVersion(ctx context.Context, id string) (*WaiverVersion, error)
+	// CreateWaiverSignatures ignores versions the user has already signed
+	CreateWaiverSignatures(ctx context.Context, signatures []*WaiverSignature) error
+	// LinkWaiverSignatures attaches the user's unlinked signatures for the event to a registration
+	LinkWaiverSignatures(ctx context.Context, userID, eventID, registrationID string) error
+	GetUserWaiverSignatures(ctx context.Context, userID, eventID string) ([]*WaiverSignature, error)
+	GetEventWaiverSignatures(ctx context.Context, eventID string) ([]*WaiverSignature, error)
+
+	// Guardian consent methods
+	// CreateGuardianConsent stores the request and supersedes the registration's other pending ones
+	CreateGuardianConsent(ctx context.Context, c *GuardianConsent) error
+	// GetGuardianConsentByTokenHash returns the request, or ErrGuardianConsentNotFound
+	GetGuardianConsentByTokenHash(ctx context.Context, tokenHash string) (*GuardianConsent, error)
+	// GetGuardianConsentsByRegistrationID returns newest first
+	GetGuardianConsentsByRegistrationID(ctx context.Context, registrationID string) ([]*GuardianConsent, error)
+	GetEventGuardianConsents(ctx context.Context, eventID string) ([]*GuardianConsent, error)
+	// RecordGuardianConsent saves the guardian's response and the registration's
+	// new status together, or returns ErrGuardianConsentClosed if it was already answered
+	RecordGuardianConsent(ctx context.Context, c *GuardianConsent, reg *Registration) error
 }
@@ -0,0 +1,58 @@
+package registration
+
+import (
+	"errors"
+	"time"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+var (
+	ErrRegistrationNotOpen        = errors.New("registration has not opened yet")
+	ErrRegistrationClosed         = errors.New("registration deadline has passed")
+	ErrNotCancellable             = errors.New("registration can no longer be cancelled")
+	ErrCancellationDeadlinePassed = errors.New("the cancellation deadline has passed; contact the organizer to withdraw")
+)
+
+// checkRegistrationWindow reports whether an event is taking registrations at
+// now, between its opening time and its deadline
+func checkRegistrationWindow(evt *event.Event, now time.Time) error {
+	settings := evt.RegistrationSettings
+	if settings.OpensAt != nil && now.Before(*settings.OpensAt) {
+		return ErrRegistrationNotOpen
+	}
+	if now.After(settings.ClosesAt) {
+		return ErrRegistrationClosed
+	}
+	return nil
+}
+
+// cancellationCheck decides whether a registration in status can be cancelled
+// at now. Cancelling after the event's cancellation deadline is late, and is
+// refused outright when the event blocks late cancellations.
+func cancellationCheck(status RegistrationStatus, evt *event.Event, now time.Time) (late bool, err error) {
+	switch status {
+	case StatusConfirmed, StatusWaitlisted, StatusPendingApproval, StatusPendingGuardianConsent:
+	default:
+		return false, ErrNotCancellable
+	}
+	if !now.Before(evt.StartTime) {
+		return false, ErrNotCancellable
+	}
+
+	deadline := evt.RegistrationSettings.CancellationDeadline
+	if deadline == nil || !now.After(*deadline) {
+		return false, nil
+	}
+	if evt.RegistrationSettings.BlockLateCancellation {
+		return true, ErrCancellationDeadlinePassed
+	}
+	return true, nil
+}
+
+// CanCancel reports whether the volunteer can still cancel a registration
+// in status for evt
+func CanCancel(status RegistrationStatus, evt *event.Event, now time.Time) bool {
+	_, err := cancellationCheck(status, evt, now)
+	return err == nil
+}
@@ -0,0 +1,60 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func TestCheckRegistrationWindow(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	opens := now.Add(time.Hour)
+	evt := &event.Event{RegistrationSettings: event.RegistrationSettings{OpensAt: &opens, ClosesAt: now.Add(48 * time.Hour)}}
+
+	assert.ErrorIs(t, checkRegistrationWindow(evt, now), ErrRegistrationNotOpen)
+	assert.NoError(t, checkRegistrationWindow(evt, opens))
+	assert.ErrorIs(t, checkRegistrationWindow(evt, now.Add(49*time.Hour)), ErrRegistrationClosed)
+
+	evt.RegistrationSettings.OpensAt = nil
+	assert.NoError(t, checkRegistrationWindow(evt, now), "no opening time means open on publish")
+}
+
+func TestCancellationCheck(t *testing.T) {
+	start := time.Date(2026, 6, 6, 10, 0, 0, 0, time.UTC)
+	deadline := start.Add(-24 * time.Hour)
+	evt := &event.Event{StartTime: start, RegistrationSettings: event.RegistrationSettings{CancellationDeadline: &deadline}}
+
+	t.Run("before the deadline", func(t *testing.T) {
+		late, err := cancellationCheck(StatusConfirmed, evt, deadline.Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.False(t, late)
+	})
+
+	t.Run("after the deadline is recorded as late", func(t *testing.T) {
+		late, err := cancellationCheck(StatusWaitlisted, evt, deadline.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.True(t, late)
+	})
+
+	t.Run("after the deadline can be blocked", func(t *testing.T) {
+		blocking := *evt
+		blocking.RegistrationSettings.BlockLateCancellation = true
+		_, err := cancellationCheck(StatusConfirmed, &blocking, deadline.Add(time.Hour))
+		assert.ErrorIs(t, err, ErrCancellationDeadlinePassed)
+		assert.False(t, CanCancel(StatusConfirmed, &blocking, deadline.Add(time.Hour)))
+	})
+
+	t.Run("not once the event has started", func(t *testing.T) {
+		_, err := cancellationCheck(StatusConfirmed, evt, start)
+		assert.ErrorIs(t, err, ErrNotCancellable)
+	})
+
+	t.Run("only active registrations", func(t *testing.T) {
+		assert.False(t, CanCancel(StatusCancelled, evt, deadline.Add(-time.Hour)))
+		assert.False(t, CanCancel(StatusCompleted, evt, deadline.Add(-time.Hour)))
+		assert.True(t, CanCancel(StatusPendingApproval, evt, deadline.Add(-time.Hour)))
+	})
+}
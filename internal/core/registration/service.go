@@ -17,11 +17,17 @@ type Service struct {
 	repo         Repository
 	eventService *event.EventService
 	userService  *user.Service
+	hours        HoursRecorder
+	files        AnswerFileStore
+	consent      GuardianConsentConfig
 	logger       *slog.Logger
 }
 
-// NewService creates a new registration service.
-func NewService(repo Repository, eventService *event.EventService, userService *user.Service, logger *slog.Logger) *Service {
+// NewService creates a new registration service. hours may be nil, in which
+// case completed attendance is not credited to the hours ledger. files may be
+// nil, in which case FILE registration questions can't be answered. Minors
+// can only register when consent has a Mailer to reach their guardian.
+func NewService(repo Repository, eventService *event.EventService, userService *user.Service, hours HoursRecorder, files AnswerFileStore, consent GuardianConsentConfig, logger *slog.Logger) *Service {
 	if repo == nil {
 		panic("registration repository is required")
 	}
@@ -39,6 +45,9 @@ func NewService(repo Repository, eventService *event.EventService, userService *
 		repo:         repo,
 		eventService: eventService,
 		userService:  userService,
+		hours:        hours,
+		files:        files,
+		consent:      consent,
 		logger:       logger,
 	}
 }
@@ -56,8 +65,11 @@ func (s *Service) ApproveRegistration(ctx context.Context, organizerID, registra
 		return nil, fmt.Errorf("event not found: %w", err)
 	}
 
-	if evt.OrganizerID != organizerID {
-		return nil, fmt.Errorf("user is not the organizer of this event")
+	if err := s.eventService.Authorize(ctx, evt, organizerID, event.StaffActionApprove); err != nil {
+		return nil, err
+	}
+	if reg.Status == StatusPendingGuardianConsent {
+		return nil, ErrGuardianConsentPending
 	}
 
 	// Update registration status
@@ -66,27 +78,46 @@ func (s *Service) ApproveRegistration(ctx context.Context, organizerID, registra
 			return nil, err
 		}
 	} else {
+		if err := s.loadShifts(ctx, reg); err != nil {
+			return nil, err
+		}
 		reg.Status = StatusDeclined
 		reg.ApprovalNotes = notes
 		reg.UpdatedAt = time.Now()
+		cancelShifts(reg, reg.UpdatedAt)
 	}
 
 	if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
 		return nil, fmt.Errorf("failed to update registration: %w", err)
 	}
+	if err := s.saveShifts(ctx, reg); err != nil {
+		return nil, err
+	}
 
 	return reg, nil
 }
 
 // approveRegistration handles the approval logic including capacity checks
 func (s *Service) approveRegistration(ctx context.Context, reg *Registration, evt *event.Event, notes string) error {
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return err
+	}
+	if len(reg.Shifts) > 0 {
+		if err := s.seatShifts(ctx, reg, evt); err != nil {
+			return err
+		}
+		reg.ApprovalNotes = notes
+		reg.UpdatedAt = time.Now()
+		return nil
+	}
+
 	// Check if there's still capacity
-	confirmedRegs, err := s.getConfirmedRegistrations(ctx, evt.ID)
+	taken, err := s.placesTaken(ctx, evt.ID)
 	if err != nil {
 		return fmt.Errorf("failed to check capacity: %w", err)
 	}
 
-	if len(confirmedRegs) >= evt.Capacity.Maximum {
+	if reg.TeamRegistrationID == nil && taken >= evt.Capacity.Maximum {
 		// No capacity, add to waitlist
 		reg.Status = StatusWaitlisted
 		waitlistPos, err := s.getNextWaitlistPosition(ctx, evt.ID)
@@ -112,15 +143,37 @@ func (s *Service) CancelRegistration(ctx context.Context, userID, registrationID
 	if err != nil {
 		return nil, fmt.Errorf("registration not found: %w", err)
 	}
+	if reg == nil {
+		return nil, fmt.Errorf("registration not found")
+	}
 
 	if reg.UserID != userID {
 		return nil, fmt.Errorf("user does not have permission to cancel this registration")
 	}
 
+	evt, err := s.eventService.GetEventByID(ctx, reg.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if evt == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+	now := time.Now()
+	late, err := cancellationCheck(reg.Status, evt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return nil, err
+	}
+
 	// Update registration status
+	wasConfirmed := reg.Status == StatusConfirmed
+	freedShifts := cancelShifts(reg, now)
 	reg.Status = StatusCancelled
 	reg.CancellationReason = reason
-	now := time.Now()
+	reg.LateCancellation = late
 	reg.CancelledAt = &now
 	reg.UpdatedAt = now
 
@@ -128,14 +181,31 @@ func (s *Service) CancelRegistration(ctx context.Context, userID, registrationID
 		return nil, fmt.Errorf("failed to cancel registration: %w", err)
 	}
 
-	// Try to promote someone from waitlist if this was a confirmed registration
-	if reg.Status == StatusConfirmed {
-		go s.promoteFromWaitlist(context.Background(), reg.EventID)
+	if err := s.saveShifts(ctx, reg); err != nil {
+		return nil, err
 	}
 
+	s.resolveConflicts(ctx, reg.UserID, reg.EventID, "registration cancelled")
+
+	s.handOnPlace(ctx, reg, wasConfirmed, freedShifts)
+
 	return reg, nil
 }
 
+// handOnPlace gives the place a cancelled registration held to whoever is
+// waiting for it: the team it came from, the waitlists of its freed shifts,
+// or the event's waitlist
+func (s *Service) handOnPlace(ctx context.Context, reg *Registration, wasConfirmed bool, freedShifts []string) {
+	switch {
+	case reg.TeamRegistrationID != nil:
+		s.returnTeamSeat(ctx, reg)
+	case len(freedShifts) > 0:
+		go s.promoteShiftWaitlists(context.Background(), reg.EventID, freedShifts)
+	case wasConfirmed && len(reg.Shifts) == 0:
+		go s.promoteFromWaitlist(context.Background(), reg.EventID)
+	}
+}
+
 // promoteFromWaitlist promotes the next person from waitlist when a spot opens
 func (s *Service) promoteFromWaitlist(ctx context.Context, eventID string) {
 	waitlistEntries, err := s.repo.GetWaitlistEntriesByEventID(ctx, eventID)
@@ -190,6 +260,20 @@ func (s *Service) GetRegistrationsByEventID(ctx context.Context, eventID string)
 	return s.repo.GetRegistrationsByEventID(ctx, eventID)
 }
 
+// ListEventRegistrations returns an event's registrations to its organizer or staff
+func (s *Service) ListEventRegistrations(ctx context.Context, eventID, requesterID string) ([]*Registration, error) {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+
+	if err := s.eventService.Authorize(ctx, evt, requesterID, event.StaffActionView); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetRegistrationsByEventID(ctx, eventID)
+}
+
 // GetRegistrationByID returns a specific registration by ID
 func (s *Service) GetRegistrationByID(ctx context.Context, id string) (*Registration, error) {
 	return s.repo.GetRegistrationByID(ctx, id)
@@ -202,26 +286,151 @@ func (s *Service) GetRegistrationsByUserID(ctx context.Context, userID string) (
 
 // CheckInVolunteer handles volunteer check-in for an event
 func (s *Service) CheckInVolunteer(ctx context.Context, registrationID, checkedInBy string) (*Registration, error) {
-	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	reg, _, err := s.checkIn(ctx, registrationID, checkedInBy, time.Now(), "")
+	return reg, err
+}
+
+// CheckInVolunteerAt checks a volunteer in as of an earlier moment, for
+// ticket scans a desk queued while it was offline
+func (s *Service) CheckInVolunteerAt(ctx context.Context, registrationID, checkedInBy string, at time.Time) (*Registration, error) {
+	reg, _, err := s.checkIn(ctx, registrationID, checkedInBy, at, "")
+	return reg, err
+}
+
+func (s *Service) checkIn(ctx context.Context, registrationID, checkedInBy string, at time.Time, notes string) (*Registration, *AttendanceRecord, error) {
+	reg, evt, err := s.loadForAttendance(ctx, registrationID, checkedInBy)
 	if err != nil {
-		return nil, fmt.Errorf("registration not found: %w", err)
+		return nil, nil, err
+	}
+	if err := applyAttendance(reg, AttendanceCheckedIn, checkedInBy, &at, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+		return nil, nil, fmt.Errorf("failed to check in volunteer: %w", err)
+	}
+	s.checkInShift(ctx, reg, at)
+
+	s.logger.Info("volunteer checked in", "registrationID", reg.ID, "eventID", evt.ID, "by", checkedInBy)
+	return reg, s.recordAttendance(ctx, reg, AttendanceCheckedIn, checkedInBy, notes), nil
+}
+
+// MarkAttendance lets event staff record whether a volunteer turned up
+func (s *Service) MarkAttendance(ctx context.Context, registrationID, staffID string, status AttendanceStatus, notes string, at *time.Time) (*Registration, *AttendanceRecord, error) {
+	switch status {
+	case AttendanceCheckedIn:
+		when := time.Now()
+		if at != nil {
+			when = *at
+		}
+		return s.checkIn(ctx, registrationID, staffID, when, notes)
+	case AttendanceCompleted, AttendanceNoShow:
+	default:
+		return nil, nil, fmt.Errorf("attendance cannot be marked as %s", status)
 	}
 
-	if reg.Status != StatusConfirmed {
-		return nil, fmt.Errorf("registration is not confirmed")
+	reg, evt, err := s.loadForAttendance(ctx, registrationID, staffID)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	now := time.Now()
-	reg.CheckedInAt = &now
-	reg.CheckedInBy = &checkedInBy
-	reg.AttendanceStatus = AttendanceCheckedIn
+	wasCompleted := reg.Status == StatusCompleted
+	if err := applyAttendance(reg, status, staffID, at, now); err != nil {
+		return nil, nil, err
+	}
+
+	err = s.repo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+			return fmt.Errorf("failed to mark attendance: %w", err)
+		}
+		if wasCompleted && status == AttendanceNoShow {
+			return s.reverseHours(ctx, reg, staffID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if status == AttendanceCompleted {
+		s.creditStaffMarked(ctx, reg, evt, now)
+	}
+	return reg, s.recordAttendance(ctx, reg, status, staffID, notes), nil
+}
+
+// applyAttendance moves reg to an attendance status in memory. at is when a
+// volunteer checked in; when completing it backfills a missing check-in time.
+func applyAttendance(reg *Registration, status AttendanceStatus, by string, at *time.Time, now time.Time) error {
+	switch status {
+	case AttendanceCheckedIn:
+		if reg.Status != StatusConfirmed {
+			return ErrNotConfirmed
+		}
+		if at == nil {
+			at = &now
+		}
+		reg.CheckedInAt = at
+		reg.CheckedInBy = &by
+	case AttendanceCompleted, AttendanceNoShow:
+		switch reg.Status {
+		case StatusConfirmed, StatusCompleted, StatusNoShow:
+		default:
+			return ErrNotConfirmed
+		}
+		if status == AttendanceCompleted {
+			if reg.CheckedInAt == nil && at != nil {
+				reg.CheckedInAt = at
+				reg.CheckedInBy = &by
+			}
+			reg.Status = StatusCompleted
+			reg.CompletedAt = &now
+		} else {
+			reg.Status = StatusNoShow
+			reg.CompletedAt = nil
+		}
+	default:
+		return fmt.Errorf("attendance cannot be marked as %s", status)
+	}
+	reg.AttendanceStatus = status
 	reg.UpdatedAt = now
+	return nil
+}
 
-	if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
-		return nil, fmt.Errorf("failed to check in volunteer: %w", err)
+// loadForAttendance fetches a registration and its event, and checks the
+// caller may take attendance for it
+func (s *Service) loadForAttendance(ctx context.Context, registrationID, staffID string) (*Registration, *event.Event, error) {
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("registration not found: %w", err)
 	}
 
-	return reg, nil
+	evt, err := s.eventService.GetEvent(ctx, reg.EventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("event not found: %w", err)
+	}
+
+	if err := s.eventService.Authorize(ctx, evt, staffID, event.StaffActionCheckIn); err != nil {
+		return nil, nil, err
+	}
+	return reg, evt, nil
+}
+
+// recordAttendance appends to the attendance history. The registration row is
+// already the source of truth, so a failure here is logged rather than returned.
+func (s *Service) recordAttendance(ctx context.Context, reg *Registration, status AttendanceStatus, by, notes string) *AttendanceRecord {
+	record := &AttendanceRecord{
+		ID:             uuid.New().String(),
+		RegistrationID: reg.ID,
+		Status:         string(status),
+		CheckedInAt:    reg.CheckedInAt,
+		CheckedInBy:    &by,
+		Notes:          notes,
+		CreatedAt:      time.Now(),
+	}
+	if _, err := s.repo.CreateAttendanceRecord(ctx, record); err != nil {
+		s.logger.Error("failed to record attendance", "registrationID", reg.ID, "status", status, "error", err)
+	}
+	return record
 }
 
 // MarkEventCompleted marks a registration as completed after the event
@@ -254,13 +463,30 @@ func (s *Service) GetWaitlistByEventID(ctx context.Context, eventID string) ([]*
 	return s.repo.GetWaitlistEntriesByEventID(ctx, eventID)
 }
 
-// BulkRegister handles registration for multiple events
+// BulkRegister handles registration for multiple events. Each event is also
+// checked against the ones registered earlier in the batch.
+//
+// With skipConflicts, events that fail or have a HIGH or CRITICAL conflict are
+// skipped and the rest are registered. Without it, a CRITICAL conflict anywhere
+// in the batch refuses the whole batch before anything is written.
 func (s *Service) BulkRegister(ctx context.Context, userID string, eventIDs []string, personalMessage string, skipConflicts bool) ([]*Registration, error) {
+	commitments, err := s.confirmedCommitments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blockAt := SeverityCritical
+	if skipConflicts {
+		blockAt = SeverityHigh
+	} else if err := s.preflightBulkConflicts(ctx, userID, eventIDs, commitments); err != nil {
+		return nil, err
+	}
+
 	var registrations []*Registration
 	var errors []error
 
 	for _, eventID := range eventIDs {
-		registration, err := s.RegisterForEvent(ctx, userID, eventID, personalMessage)
+		registration, evt, err := s.registerWithConflictCheck(ctx, userID, eventID, RegistrationDetails{PersonalMessage: personalMessage}, commitments, blockAt)
 		if err != nil {
 			if skipConflicts {
 				// Log error but continue with other registrations
@@ -271,6 +497,9 @@ func (s *Service) BulkRegister(ctx context.Context, userID string, eventIDs []st
 			return nil, fmt.Errorf("failed to register for event %s: %w", eventID, err)
 		}
 		registrations = append(registrations, registration)
+		if registration.Status == StatusConfirmed {
+			commitments = append(commitments, evt)
+		}
 	}
 
 	if len(errors) > 0 && len(registrations) == 0 {
@@ -280,6 +509,23 @@ func (s *Service) BulkRegister(ctx context.Context, userID string, eventIDs []st
 	return registrations, nil
 }
 
+// preflightBulkConflicts rejects a batch containing a CRITICAL conflict,
+// either with existing commitments or between events in the batch
+func (s *Service) preflightBulkConflicts(ctx context.Context, userID string, eventIDs []string, commitments []*event.Event) error {
+	planned := append([]*event.Event(nil), commitments...)
+	for _, eventID := range eventIDs {
+		evt, err := s.eventService.GetEvent(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("failed to register for event %s: %w", eventID, err)
+		}
+		if blocking := blockingConflicts(detectConflicts(userID, evt, planned), SeverityCritical); len(blocking) > 0 {
+			return &ConflictError{EventID: eventID, Conflicts: blocking}
+		}
+		planned = append(planned, evt)
+	}
+	return nil
+}
+
 // PromoteFromWaitlist manually promotes a specific registration from waitlist
 func (s *Service) PromoteFromWaitlist(ctx context.Context, registrationID string) (*Registration, error) {
 	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
@@ -383,43 +629,171 @@ type RegistrationStats struct {
 }
 
 // RegisterForEvent handles the registration of a user for an event.
-func (s *Service) RegisterForEvent(ctx context.Context, userID, eventID, personalMessage string) (*Registration, error) {
-	// Validate inputs
-	if err := s.validateRegistrationInputs(ctx, userID, eventID); err != nil {
+// Conflicts with the user's confirmed events are recorded; a CRITICAL one
+// (the events largely overlap) refuses the registration with a *ConflictError.
+// Answers to the event's registration questions are validated first; problems
+// come back together as an *AnswerError. Every required waiver must be signed,
+// beforehand or in details, or ErrWaiverUnsigned is returned. Volunteers who
+// don't meet the event's requirements are refused with an *EligibilityError.
+// Minors must give details.GuardianEmail; their registration waits in
+// StatusPendingGuardianConsent until the guardian answers the emailed link.
+func (s *Service) RegisterForEvent(ctx context.Context, userID, eventID string, details RegistrationDetails) (*Registration, error) {
+	commitments, err := s.confirmedCommitments(ctx, userID)
+	if err != nil {
 		return nil, err
 	}
 
+	registration, _, err := s.registerWithConflictCheck(ctx, userID, eventID, details, commitments, SeverityCritical)
+	return registration, err
+}
+
+// registerWithConflictCheck registers the user unless a conflict at or above
+// blockAt is found against commitments, and records the conflicts it let through
+func (s *Service) registerWithConflictCheck(ctx context.Context, userID, eventID string, details RegistrationDetails, commitments []*event.Event, blockAt ConflictSeverity) (*Registration, *event.Event, error) {
+	// Validate inputs
+	profile, err := s.validateRegistrationInputs(ctx, userID, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	minor := isMinor(profile, evt)
+	var guardianEmail string
+	if minor {
+		if s.consent.Mailer == nil {
+			return nil, evt, ErrGuardianConsentUnavailable
+		}
+		if guardianEmail, err = normalizeGuardianEmail(details.GuardianEmail, profile); err != nil {
+			return nil, evt, err
+		}
+	}
+
+	shifts, err := s.eventService.GetEventShifts(ctx, eventID)
+	if err != nil {
+		return nil, evt, err
+	}
+	chosen, err := chooseShifts(shifts, details.ShiftIDs)
+	if err != nil {
+		return nil, evt, err
+	}
+	// Shifts can ask for skills of their own
+	for _, shift := range chosen {
+		if err := checkEligibility(evt.ForShift(shift), profile); err != nil {
+			return nil, evt, err
+		}
+	}
+
+	conflicts := detectShiftConflicts(userID, shiftViews(evt, chosen), commitments)
+	if blocking := blockingConflicts(conflicts, blockAt); len(blocking) > 0 {
+		return nil, evt, &ConflictError{EventID: eventID, Conflicts: blocking}
+	}
+
+	signatures, err := s.checkWaivers(ctx, userID, eventID, details.Waivers, details.Evidence)
+	if err != nil {
+		return nil, evt, err
+	}
+
+	questions, err := s.repo.GetEventQuestions(ctx, eventID)
+	if err != nil {
+		return nil, evt, fmt.Errorf("failed to load registration questions: %w", err)
+	}
+	answers, err := validateAnswers(questions, details.Answers)
+	if err != nil {
+		return nil, evt, err
+	}
+	for _, a := range answers {
+		if a.upload != nil && s.files == nil {
+			return nil, evt, ErrFileUploadDisabled
+		}
+	}
+
 	// Create base registration
 	registration := &Registration{
-		ID:               uuid.New().String(),
-		UserID:           userID,
-		EventID:          eventID,
-		PersonalMessage:  personalMessage,
-		AttendanceStatus: AttendanceRegistered,
-		AppliedAt:        time.Now(),
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+		ID:                    uuid.New().String(),
+		UserID:                userID,
+		EventID:               eventID,
+		PersonalMessage:       details.PersonalMessage,
+		EmergencyContactName:  details.EmergencyContactName,
+		EmergencyContactPhone: details.EmergencyContactPhone,
+		DietaryRestrictions:   details.DietaryRestrictions,
+		AccessibilityNeeds:    details.AccessibilityNeeds,
+		AttendanceStatus:      AttendanceRegistered,
+		AppliedAt:             time.Now(),
+		CreatedAt:             time.Now(),
+		UpdatedAt:             time.Now(),
+	}
+	registration.Shifts = newShiftAssignments(registration.ID, chosen, registration.CreatedAt)
+
+	// Set registration status and save; a guardian has to answer before a
+	// minor's registration goes through approval and capacity checks. The
+	// registration is saved together with everything that came with it.
+	var saved *Registration
+	var consent *GuardianConsent
+	var consentToken string
+	err = s.repo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if minor {
+			registration.Status = StatusPendingGuardianConsent
+			saved, err = s.repo.CreateRegistration(ctx, registration)
+		} else {
+			saved, err = s.processRegistration(ctx, registration)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.saveShifts(ctx, registration); err != nil {
+			return err
+		}
+		saved.Shifts = registration.Shifts
+
+		if err := s.recordWaiverSignatures(ctx, saved, signatures); err != nil {
+			return err
+		}
+		if minor {
+			if consent, consentToken, err = s.recordGuardianConsentRequest(ctx, saved, evt, guardianEmail); err != nil {
+				return err
+			}
+		}
+		// Answers go last: they store files, which a rollback can't take back
+		return s.saveAnswers(ctx, saved.ID, answers)
+	})
+	if err != nil {
+		return nil, evt, err
+	}
+	if consent != nil {
+		s.emailGuardianConsent(ctx, consent, consentToken, evt, profile)
 	}
 
-	// Set registration status and save
-	return s.processRegistration(ctx, registration)
+	s.recordConflicts(ctx, conflicts)
+	return saved, evt, nil
 }
 
-// validateRegistrationInputs performs all validation checks
-func (s *Service) validateRegistrationInputs(ctx context.Context, userID, eventID string) error {
-	if err := s.validateUser(ctx, userID); err != nil {
-		return fmt.Errorf("user validation failed: %w", err)
+// validateRegistrationInputs performs all validation checks and returns the
+// volunteer's profile
+func (s *Service) validateRegistrationInputs(ctx context.Context, userID, eventID string) (*user.UserProfile, error) {
+	profile, err := s.userService.GetProfileWithDetails(ctx, userID, userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("user validation failed: user not found: %w", err)
 	}
 
-	if _, err := s.validateEvent(ctx, eventID); err != nil {
-		return fmt.Errorf("event validation failed: %w", err)
+	evt, err := s.validateEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event validation failed: %w", err)
 	}
 
 	if err := s.checkDuplicateRegistration(ctx, userID, eventID); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	if err := checkEligibility(evt, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
 }
 
 // processRegistration determines status and saves the registration
@@ -436,18 +810,6 @@ func (s *Service) processRegistration(ctx context.Context, registration *Registr
 	return s.repo.CreateRegistration(ctx, registration)
 }
 
-// validateUser checks if the user exists and is active
-func (s *Service) validateUser(ctx context.Context, userID string) error {
-	profile, err := s.userService.GetProfile(ctx, userID, userID, nil)
-	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
-	}
-	if profile == nil {
-		return fmt.Errorf("user not found")
-	}
-	return nil
-}
-
 // validateEvent checks if the event exists and is available for registration
 func (s *Service) validateEvent(ctx context.Context, eventID string) (*event.Event, error) {
 	evt, err := s.eventService.GetEvent(ctx, eventID)
@@ -472,11 +834,7 @@ func (s *Service) checkEventAvailability(evt *event.Event) error {
 		return fmt.Errorf("event is not available for registration")
 	}
 
-	if time.Now().After(evt.RegistrationSettings.ClosesAt) {
-		return fmt.Errorf("registration deadline has passed")
-	}
-
-	return nil
+	return checkRegistrationWindow(evt, time.Now())
 }
 
 // checkDuplicateRegistration ensures user hasn't already registered for this event
@@ -509,6 +867,19 @@ func (s *Service) setRegistrationStatus(ctx context.Context, registration *Regis
 
 // setStatusByCapacity sets registration status based on event capacity
 func (s *Service) setStatusByCapacity(ctx context.Context, registration *Registration, evt *event.Event) error {
+	// Events with shifts fill each shift on its own
+	if len(registration.Shifts) > 0 {
+		return s.seatShifts(ctx, registration, evt)
+	}
+
+	// A team member's place is held by the team
+	if registration.TeamRegistrationID != nil {
+		registration.Status = StatusConfirmed
+		now := time.Now()
+		registration.ConfirmedAt = &now
+		return nil
+	}
+
 	confirmedCount, err := s.getConfirmedRegistrationCount(ctx, evt.ID)
 	if err != nil {
 		return fmt.Errorf("failed to get current registrations: %w", err)
@@ -525,13 +896,10 @@ func (s *Service) setStatusByCapacity(ctx context.Context, registration *Registr
 	return nil
 }
 
-// getConfirmedRegistrationCount returns count of confirmed registrations
+// getConfirmedRegistrationCount returns how many places are taken, counting
+// the seats confirmed teams hold
 func (s *Service) getConfirmedRegistrationCount(ctx context.Context, eventID string) (int, error) {
-	confirmedRegs, err := s.getConfirmedRegistrations(ctx, eventID)
-	if err != nil {
-		return 0, err
-	}
-	return len(confirmedRegs), nil
+	return s.placesTaken(ctx, eventID)
 }
 
 // setWaitlistPosition sets the waitlist position for a registration
@@ -544,23 +912,6 @@ func (s *Service) setWaitlistPosition(ctx context.Context, registration *Registr
 	return nil
 }
 
-// getConfirmedRegistrations returns all confirmed registrations for an event
-func (s *Service) getConfirmedRegistrations(ctx context.Context, eventID string) ([]*Registration, error) {
-	allRegs, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
-	if err != nil {
-		return nil, err
-	}
-
-	var confirmed []*Registration
-	for _, reg := range allRegs {
-		if reg.Status == StatusConfirmed {
-			confirmed = append(confirmed, reg)
-		}
-	}
-
-	return confirmed, nil
-}
-
 // getNextWaitlistPosition calculates the next position in the waitlist
 func (s *Service) getNextWaitlistPosition(ctx context.Context, eventID string) (int, error) {
 	allRegs, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
@@ -0,0 +1,440 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+var (
+	ErrShiftRequired     = errors.New("this event has shifts; choose at least one")
+	ErrUnknownShift      = errors.New("shift does not belong to this event")
+	ErrOverlappingShifts = errors.New("chosen shifts overlap")
+	ErrShiftFull         = errors.New("shift is full")
+)
+
+// ShiftAssignmentStatus is a registration's standing on one shift
+type ShiftAssignmentStatus string
+
+const (
+	// ShiftPending holds the shift while the registration awaits approval or
+	// guardian consent; it doesn't take a place yet
+	ShiftPending    ShiftAssignmentStatus = "PENDING"
+	ShiftConfirmed  ShiftAssignmentStatus = "CONFIRMED"
+	ShiftWaitlisted ShiftAssignmentStatus = "WAITLISTED"
+	ShiftCancelled  ShiftAssignmentStatus = "CANCELLED"
+)
+
+// ShiftAssignment is a registration's place on one of the event's shifts.
+// Capacity, waitlist and attendance are tracked here for events with shifts.
+type ShiftAssignment struct {
+	ID               string                `json:"id"`
+	RegistrationID   string                `json:"registrationId"`
+	ShiftID          string                `json:"shiftId"`
+	Status           ShiftAssignmentStatus `json:"status"`
+	WaitlistPosition *int                  `json:"waitlistPosition,omitempty"`
+	ConfirmedAt      *time.Time            `json:"confirmedAt,omitempty"`
+	CheckedInAt      *time.Time            `json:"checkedInAt,omitempty"`
+	CheckedOutAt     *time.Time            `json:"checkedOutAt,omitempty"`
+	CreatedAt        time.Time             `json:"createdAt"`
+	UpdatedAt        time.Time             `json:"updatedAt"`
+}
+
+// active reports whether the assignment holds or is queued for a place
+func (a *ShiftAssignment) active() bool {
+	return a.Status == ShiftConfirmed || a.Status == ShiftWaitlisted
+}
+
+// GetShiftAssignments returns a registration's shifts
+func (s *Service) GetShiftAssignments(ctx context.Context, registrationID string) ([]*ShiftAssignment, error) {
+	return s.repo.GetShiftAssignments(ctx, registrationID)
+}
+
+// chooseShifts resolves the shifts a volunteer picked. Events without shifts
+// take none; events with shifts need at least one, and a volunteer can't be
+// on two shifts at once.
+func chooseShifts(shifts []*event.EventShift, ids []string) ([]*event.EventShift, error) {
+	if len(shifts) == 0 {
+		if len(ids) > 0 {
+			return nil, ErrUnknownShift
+		}
+		return nil, nil
+	}
+	if len(ids) == 0 {
+		return nil, ErrShiftRequired
+	}
+
+	byID := make(map[string]*event.EventShift, len(shifts))
+	for _, shift := range shifts {
+		byID[shift.ID] = shift
+	}
+	var chosen []*event.EventShift
+	seen := map[string]bool{}
+	for _, id := range ids {
+		shift, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownShift, id)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		chosen = append(chosen, shift)
+	}
+
+	slices.SortFunc(chosen, func(a, b *event.EventShift) int { return a.StartTime.Compare(b.StartTime) })
+	for i := 1; i < len(chosen); i++ {
+		if chosen[i].StartTime.Before(chosen[i-1].EndTime) {
+			return nil, fmt.Errorf("%w: %s and %s", ErrOverlappingShifts, chosen[i-1].Name, chosen[i].Name)
+		}
+	}
+	return chosen, nil
+}
+
+// shiftViews narrows the event to each chosen shift, or returns the whole
+// event when there are none
+func shiftViews(evt *event.Event, shifts []*event.EventShift) []*event.Event {
+	if len(shifts) == 0 {
+		return []*event.Event{evt}
+	}
+	views := make([]*event.Event, 0, len(shifts))
+	for _, shift := range shifts {
+		views = append(views, evt.ForShift(shift))
+	}
+	return views
+}
+
+func newShiftAssignments(registrationID string, shifts []*event.EventShift, now time.Time) []*ShiftAssignment {
+	assignments := make([]*ShiftAssignment, 0, len(shifts))
+	for _, shift := range shifts {
+		assignments = append(assignments, &ShiftAssignment{
+			ID:             uuid.New().String(),
+			RegistrationID: registrationID,
+			ShiftID:        shift.ID,
+			Status:         ShiftPending,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+	return assignments
+}
+
+// loadShifts fills reg.Shifts from the store unless they're already known
+func (s *Service) loadShifts(ctx context.Context, reg *Registration) error {
+	if reg.Shifts != nil {
+		return nil
+	}
+	assignments, err := s.repo.GetShiftAssignments(ctx, reg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load shifts: %w", err)
+	}
+	if assignments == nil {
+		assignments = []*ShiftAssignment{}
+	}
+	reg.Shifts = assignments
+	return nil
+}
+
+// saveShifts writes reg.Shifts, if it has any
+func (s *Service) saveShifts(ctx context.Context, reg *Registration) error {
+	if len(reg.Shifts) == 0 {
+		return nil
+	}
+	if err := s.repo.SaveShiftAssignments(ctx, reg.Shifts); err != nil {
+		return fmt.Errorf("failed to save shifts: %w", err)
+	}
+	return nil
+}
+
+// seatShifts gives each of the registration's pending shifts a place or a
+// waitlist spot, and sets the registration's status from the result
+func (s *Service) seatShifts(ctx context.Context, reg *Registration, evt *event.Event) error {
+	shifts, err := s.eventService.GetEventShifts(ctx, evt.ID)
+	if err != nil {
+		return err
+	}
+	taken, err := s.repo.GetEventShiftAssignments(ctx, evt.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load shift sign-ups: %w", err)
+	}
+
+	now := time.Now()
+	if err := seatShiftAssignments(reg.Shifts, shiftsByID(shifts), taken, now); err != nil {
+		return err
+	}
+	applyShiftStatus(reg, now)
+	return nil
+}
+
+// seatShiftAssignments confirms each pending assignment while its shift has
+// room and waitlists it behind the others when it doesn't. A full shift
+// without a waitlist refuses the registration.
+func seatShiftAssignments(pending []*ShiftAssignment, shifts map[string]*event.EventShift, taken []*ShiftAssignment, now time.Time) error {
+	for _, a := range pending {
+		if a.Status != ShiftPending {
+			continue
+		}
+		shift, ok := shifts[a.ShiftID]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownShift, a.ShiftID)
+		}
+
+		confirmed, lastPosition := 0, 0
+		for _, t := range taken {
+			if t.ShiftID != a.ShiftID || t.ID == a.ID {
+				continue
+			}
+			switch t.Status {
+			case ShiftConfirmed:
+				confirmed++
+			case ShiftWaitlisted:
+				if t.WaitlistPosition != nil && *t.WaitlistPosition > lastPosition {
+					lastPosition = *t.WaitlistPosition
+				}
+			}
+		}
+
+		switch {
+		case confirmed < shift.Capacity:
+			a.Status = ShiftConfirmed
+			a.ConfirmedAt = &now
+		case shift.WaitlistEnabled:
+			position := lastPosition + 1
+			a.Status = ShiftWaitlisted
+			a.WaitlistPosition = &position
+		default:
+			return fmt.Errorf("%w: %s", ErrShiftFull, shift.Name)
+		}
+		a.UpdatedAt = now
+	}
+	return nil
+}
+
+// applyShiftStatus confirms a registration holding at least one shift, and
+// otherwise waitlists it at its best shift waitlist position
+func applyShiftStatus(reg *Registration, now time.Time) {
+	var best *int
+	for _, a := range reg.Shifts {
+		switch a.Status {
+		case ShiftConfirmed:
+			reg.Status = StatusConfirmed
+			reg.ConfirmedAt = &now
+			reg.WaitlistPosition = nil
+			return
+		case ShiftWaitlisted:
+			if best == nil || (a.WaitlistPosition != nil && *a.WaitlistPosition < *best) {
+				best = a.WaitlistPosition
+			}
+		}
+	}
+	reg.Status = StatusWaitlisted
+	reg.WaitlistPosition = best
+}
+
+// cancelShifts gives up the registration's shifts and returns the IDs of
+// those that had a place to hand on
+func cancelShifts(reg *Registration, now time.Time) []string {
+	var freed []string
+	for _, a := range reg.Shifts {
+		if a.Status == ShiftCancelled {
+			continue
+		}
+		if a.Status == ShiftConfirmed {
+			freed = append(freed, a.ShiftID)
+		}
+		a.Status = ShiftCancelled
+		a.WaitlistPosition = nil
+		a.UpdatedAt = now
+	}
+	return freed
+}
+
+// promoteShiftWaitlists fills freed shift places from each shift's waitlist
+// and confirms the registrations that were only waitlisted
+func (s *Service) promoteShiftWaitlists(ctx context.Context, eventID string, shiftIDs []string) {
+	shifts, err := s.eventService.GetEventShifts(ctx, eventID)
+	if err != nil {
+		s.logger.Error("failed to load shifts for promotion", "eventID", eventID, "error", err)
+		return
+	}
+	taken, err := s.repo.GetEventShiftAssignments(ctx, eventID)
+	if err != nil {
+		s.logger.Error("failed to load shift sign-ups for promotion", "eventID", eventID, "error", err)
+		return
+	}
+
+	now := time.Now()
+	promoted := promoteShifts(shiftsByID(shifts), taken, shiftIDs, now)
+	if len(promoted) == 0 {
+		return
+	}
+	if err := s.repo.SaveShiftAssignments(ctx, promoted); err != nil {
+		s.logger.Error("failed to promote shift waitlist", "eventID", eventID, "error", err)
+		return
+	}
+
+	for _, a := range promoted {
+		reg, err := s.repo.GetRegistrationByID(ctx, a.RegistrationID)
+		if err != nil || reg == nil || reg.Status != StatusWaitlisted {
+			continue
+		}
+		reg.Status = StatusConfirmed
+		reg.ConfirmedAt = &now
+		reg.WaitlistPromotedAt = &now
+		reg.WaitlistPosition = nil
+		reg.UpdatedAt = now
+		if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+			s.logger.Error("failed to promote registration", "registrationID", reg.ID, "error", err)
+		}
+	}
+}
+
+// promoteShifts confirms waitlisted assignments, in waitlist order, on each
+// of shiftIDs that has room
+func promoteShifts(shifts map[string]*event.EventShift, taken []*ShiftAssignment, shiftIDs []string, now time.Time) []*ShiftAssignment {
+	var promoted []*ShiftAssignment
+	for _, id := range shiftIDs {
+		shift, ok := shifts[id]
+		if !ok {
+			continue
+		}
+		confirmed := 0
+		var waiting []*ShiftAssignment
+		for _, a := range taken {
+			if a.ShiftID != id {
+				continue
+			}
+			switch a.Status {
+			case ShiftConfirmed:
+				confirmed++
+			case ShiftWaitlisted:
+				waiting = append(waiting, a)
+			}
+		}
+		slices.SortStableFunc(waiting, func(a, b *ShiftAssignment) int {
+			return positionOrLast(a.WaitlistPosition) - positionOrLast(b.WaitlistPosition)
+		})
+
+		for _, a := range waiting[:min(max(shift.Capacity-confirmed, 0), len(waiting))] {
+			a.Status = ShiftConfirmed
+			a.ConfirmedAt = &now
+			a.WaitlistPosition = nil
+			a.UpdatedAt = now
+			promoted = append(promoted, a)
+		}
+	}
+	return promoted
+}
+
+func positionOrLast(p *int) int {
+	if p == nil {
+		return int(^uint(0) >> 1)
+	}
+	return *p
+}
+
+// nextShift is the earliest confirmed shift the volunteer hasn't finished
+// that is still running at, allowing grace after it ends
+func nextShift(assignments []*ShiftAssignment, shifts map[string]*event.EventShift, at time.Time, grace time.Duration) (*ShiftAssignment, *event.EventShift) {
+	var next *ShiftAssignment
+	var nextShift *event.EventShift
+	for _, a := range assignments {
+		shift, ok := shifts[a.ShiftID]
+		if !ok || a.Status != ShiftConfirmed || a.CheckedOutAt != nil || at.After(shift.EndTime.Add(grace)) {
+			continue
+		}
+		if nextShift == nil || shift.StartTime.Before(nextShift.StartTime) {
+			next, nextShift = a, shift
+		}
+	}
+	return next, nextShift
+}
+
+// attendanceShift finds the shift a volunteer on shifts is checking in to or
+// out of at at. Registrations without shifts get nil; those with shifts but
+// none still running get ErrOutsideCheckInWindow.
+func (s *Service) attendanceShift(ctx context.Context, reg *Registration, at time.Time, grace time.Duration) (*ShiftAssignment, *event.EventShift, error) {
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return nil, nil, err
+	}
+	if len(reg.Shifts) == 0 {
+		return nil, nil, nil
+	}
+	shifts, err := s.eventService.GetEventShifts(ctx, reg.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	assignment, shift := nextShift(reg.Shifts, shiftsByID(shifts), at, grace)
+	if assignment == nil {
+		return nil, nil, ErrOutsideCheckInWindow
+	}
+	return assignment, shift, nil
+}
+
+// checkInShift marks the shift a volunteer checked in by staff is arriving
+// for. The registration is already checked in, so failures are only logged.
+func (s *Service) checkInShift(ctx context.Context, reg *Registration, at time.Time) {
+	assignment, _, err := s.attendanceShift(ctx, reg, at, 0)
+	if err != nil || assignment == nil || assignment.CheckedInAt != nil {
+		return
+	}
+	assignment.CheckedInAt = &at
+	assignment.UpdatedAt = time.Now()
+	if err := s.saveShifts(ctx, reg); err != nil {
+		s.logger.Error("failed to check in to shift", "registrationID", reg.ID, "error", err)
+	}
+}
+
+// shiftsRemaining reports whether the volunteer holds a confirmed shift they
+// haven't checked out of and could still work: one they are checked in to, or
+// one that hasn't ended by endedBy. Shifts that ended without them are over.
+func shiftsRemaining(assignments []*ShiftAssignment, shifts map[string]*event.EventShift, endedBy time.Time) bool {
+	for _, a := range assignments {
+		if a.Status != ShiftConfirmed || a.CheckedOutAt != nil {
+			continue
+		}
+		if shift, ok := shifts[a.ShiftID]; a.CheckedInAt != nil || (ok && shift.EndTime.After(endedBy)) {
+			return true
+		}
+	}
+	return false
+}
+
+// closeEndedShifts checks the volunteer out of shifts they are still checked
+// in to at the shift's end
+func closeEndedShifts(assignments []*ShiftAssignment, shifts map[string]*event.EventShift, now time.Time) {
+	for _, a := range assignments {
+		shift, ok := shifts[a.ShiftID]
+		if !ok || a.Status != ShiftConfirmed || a.CheckedInAt == nil || a.CheckedOutAt != nil {
+			continue
+		}
+		end := shift.EndTime
+		a.CheckedOutAt = &end
+		a.UpdatedAt = now
+	}
+}
+
+// shiftHours totals the time between check-in and check-out across shifts
+func shiftHours(assignments []*ShiftAssignment) float64 {
+	var total float64
+	for _, a := range assignments {
+		if a.CheckedInAt != nil && a.CheckedOutAt != nil && a.CheckedOutAt.After(*a.CheckedInAt) {
+			total += a.CheckedOutAt.Sub(*a.CheckedInAt).Hours()
+		}
+	}
+	return total
+}
+
+func shiftsByID(shifts []*event.EventShift) map[string]*event.EventShift {
+	byID := make(map[string]*event.EventShift, len(shifts))
+	for _, shift := range shifts {
+		byID[shift.ID] = shift
+	}
+	return byID
+}
@@ -0,0 +1,194 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func testShifts() []*event.EventShift {
+	start := time.Date(2026, 7, 4, 8, 0, 0, 0, time.UTC)
+	return []*event.EventShift{
+		{ID: "morning", Name: "Morning", StartTime: start, EndTime: start.Add(4 * time.Hour), Capacity: 1, WaitlistEnabled: true},
+		{ID: "afternoon", Name: "Afternoon", StartTime: start.Add(4 * time.Hour), EndTime: start.Add(8 * time.Hour), Capacity: 1},
+		{ID: "setup", Name: "Setup", StartTime: start.Add(3 * time.Hour), EndTime: start.Add(5 * time.Hour), Capacity: 2},
+	}
+}
+
+func TestChooseShifts(t *testing.T) {
+	shifts := testShifts()
+
+	chosen, err := chooseShifts(shifts, []string{"afternoon", "morning", "morning"})
+	require.NoError(t, err)
+	require.Len(t, chosen, 2)
+	assert.Equal(t, "morning", chosen[0].ID, "ordered by start time and deduplicated")
+
+	_, err = chooseShifts(shifts, nil)
+	assert.ErrorIs(t, err, ErrShiftRequired)
+
+	_, err = chooseShifts(shifts, []string{"night"})
+	assert.ErrorIs(t, err, ErrUnknownShift)
+
+	_, err = chooseShifts(shifts, []string{"morning", "setup"})
+	assert.ErrorIs(t, err, ErrOverlappingShifts)
+
+	_, err = chooseShifts(nil, []string{"morning"})
+	assert.ErrorIs(t, err, ErrUnknownShift, "events without shifts take none")
+
+	chosen, err = chooseShifts(nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, chosen)
+}
+
+func TestSeatShiftAssignments(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	byID := shiftsByID(testShifts())
+	position := 1
+	taken := []*ShiftAssignment{
+		{ID: "a1", ShiftID: "morning", Status: ShiftConfirmed},
+		{ID: "a2", ShiftID: "morning", Status: ShiftWaitlisted, WaitlistPosition: &position},
+		{ID: "a3", ShiftID: "afternoon", Status: ShiftCancelled},
+	}
+
+	t.Run("confirms where there is room and waitlists where there isn't", func(t *testing.T) {
+		reg := &Registration{ID: "reg1"}
+		reg.Shifts = newShiftAssignments(reg.ID, []*event.EventShift{byID["morning"], byID["afternoon"]}, now)
+
+		require.NoError(t, seatShiftAssignments(reg.Shifts, byID, taken, now))
+		assert.Equal(t, ShiftWaitlisted, reg.Shifts[0].Status)
+		assert.Equal(t, 2, *reg.Shifts[0].WaitlistPosition)
+		assert.Equal(t, ShiftConfirmed, reg.Shifts[1].Status, "cancelled sign-ups free their place")
+
+		applyShiftStatus(reg, now)
+		assert.Equal(t, StatusConfirmed, reg.Status, "one confirmed shift confirms the registration")
+	})
+
+	t.Run("waitlisted on every shift", func(t *testing.T) {
+		reg := &Registration{ID: "reg2"}
+		reg.Shifts = newShiftAssignments(reg.ID, []*event.EventShift{byID["morning"]}, now)
+		require.NoError(t, seatShiftAssignments(reg.Shifts, byID, taken, now))
+
+		applyShiftStatus(reg, now)
+		assert.Equal(t, StatusWaitlisted, reg.Status)
+		assert.Equal(t, 2, *reg.WaitlistPosition)
+	})
+
+	t.Run("full shift without a waitlist", func(t *testing.T) {
+		full := append(taken, &ShiftAssignment{ID: "a4", ShiftID: "afternoon", Status: ShiftConfirmed})
+		pending := newShiftAssignments("reg3", []*event.EventShift{byID["afternoon"]}, now)
+		assert.ErrorIs(t, seatShiftAssignments(pending, byID, full, now), ErrShiftFull)
+	})
+}
+
+func TestPromoteShifts(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	byID := shiftsByID(testShifts())
+	first, second := 1, 2
+	taken := []*ShiftAssignment{
+		{ID: "late", ShiftID: "morning", Status: ShiftWaitlisted, WaitlistPosition: &second},
+		{ID: "early", ShiftID: "morning", Status: ShiftWaitlisted, WaitlistPosition: &first},
+		{ID: "gone", ShiftID: "morning", Status: ShiftCancelled},
+	}
+
+	promoted := promoteShifts(byID, taken, []string{"morning"}, now)
+	require.Len(t, promoted, 1)
+	assert.Equal(t, "early", promoted[0].ID)
+	assert.Equal(t, ShiftConfirmed, promoted[0].Status)
+	assert.Nil(t, promoted[0].WaitlistPosition)
+
+	assert.Empty(t, promoteShifts(byID, taken, []string{"morning"}, now), "no room left")
+}
+
+func TestCancelShifts(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	position := 3
+	reg := &Registration{Shifts: []*ShiftAssignment{
+		{ShiftID: "morning", Status: ShiftConfirmed},
+		{ShiftID: "afternoon", Status: ShiftWaitlisted, WaitlistPosition: &position},
+	}}
+
+	assert.Equal(t, []string{"morning"}, cancelShifts(reg, now))
+	for _, a := range reg.Shifts {
+		assert.Equal(t, ShiftCancelled, a.Status)
+		assert.Nil(t, a.WaitlistPosition)
+	}
+}
+
+func TestNextShift(t *testing.T) {
+	shifts := testShifts()
+	byID := shiftsByID(shifts)
+	morning, afternoon := shifts[0], shifts[1]
+	checkedOut := morning.EndTime
+	assignments := []*ShiftAssignment{
+		{ID: "pm", ShiftID: "afternoon", Status: ShiftConfirmed},
+		{ID: "am", ShiftID: "morning", Status: ShiftConfirmed},
+	}
+
+	a, shift := nextShift(assignments, byID, morning.StartTime.Add(-10*time.Minute), 0)
+	require.NotNil(t, a)
+	assert.Equal(t, "am", a.ID, "the earliest unfinished shift")
+	assert.Equal(t, morning, shift)
+
+	assignments[1].CheckedOutAt = &checkedOut
+	a, _ = nextShift(assignments, byID, morning.EndTime, 0)
+	assert.Equal(t, "pm", a.ID)
+
+	a, _ = nextShift(assignments, byID, afternoon.EndTime.Add(20*time.Minute), 30*time.Minute)
+	assert.Equal(t, "pm", a.ID, "grace after the end")
+	a, _ = nextShift(assignments, byID, afternoon.EndTime.Add(time.Hour), 30*time.Minute)
+	assert.Nil(t, a)
+}
+
+func TestShiftsRemaining(t *testing.T) {
+	shifts := testShifts()
+	byID := shiftsByID(shifts)
+	morning, afternoon := shifts[0], shifts[1]
+	checkedIn, checkedOut := morning.StartTime, morning.EndTime
+	assignments := []*ShiftAssignment{
+		{ID: "am", ShiftID: "morning", Status: ShiftConfirmed, CheckedInAt: &checkedIn, CheckedOutAt: &checkedOut},
+		{ID: "pm", ShiftID: "afternoon", Status: ShiftConfirmed},
+	}
+
+	assert.True(t, shiftsRemaining(assignments, byID, morning.EndTime), "the afternoon is still to come")
+	assert.False(t, shiftsRemaining(assignments, byID, afternoon.EndTime), "a skipped shift is over once it ends")
+
+	arrived := afternoon.StartTime
+	assignments[1].CheckedInAt = &arrived
+	assert.True(t, shiftsRemaining(assignments, byID, afternoon.EndTime.Add(time.Hour)), "still checked in")
+
+	closeEndedShifts(assignments, byID, afternoon.EndTime.Add(time.Hour))
+	assert.Equal(t, afternoon.EndTime, *assignments[1].CheckedOutAt, "closed at the shift's end")
+	assert.Equal(t, checkedOut, *assignments[0].CheckedOutAt)
+	assert.False(t, shiftsRemaining(assignments, byID, afternoon.EndTime.Add(time.Hour)))
+	assert.InDelta(t, 8.0, shiftHours(assignments), 0.001)
+}
+
+func TestShiftHours(t *testing.T) {
+	start := time.Date(2026, 7, 4, 8, 0, 0, 0, time.UTC)
+	in1, out1 := start, start.Add(3*time.Hour)
+	in2, out2 := start.Add(4*time.Hour), start.Add(5*time.Hour+30*time.Minute)
+	in3 := start.Add(6 * time.Hour)
+
+	assert.InDelta(t, 4.5, shiftHours([]*ShiftAssignment{
+		{CheckedInAt: &in1, CheckedOutAt: &out1},
+		{CheckedInAt: &in2, CheckedOutAt: &out2},
+		{CheckedInAt: &in3},
+	}), 0.001)
+}
+
+func TestDetectShiftConflicts(t *testing.T) {
+	shifts := testShifts()
+	evt := &event.Event{ID: "festival", StartTime: shifts[0].StartTime, EndTime: shifts[1].EndTime, Location: event.EventLocation{IsRemote: true}}
+	other := &event.Event{ID: "other", StartTime: shifts[1].StartTime.Add(time.Hour), EndTime: shifts[1].EndTime.Add(time.Hour), Location: event.EventLocation{IsRemote: true}}
+
+	conflicts := detectShiftConflicts("user1", shiftViews(evt, shifts[:1]), []*event.Event{other})
+	assert.Empty(t, conflicts, "the morning shift is clear of the other event")
+
+	conflicts = detectShiftConflicts("user1", shiftViews(evt, shifts[:2]), []*event.Event{other})
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, ConflictTimeOverlap, conflicts[0].ConflictType)
+}
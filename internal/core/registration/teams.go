@@ -0,0 +1,730 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+const (
+	// maxTeamSeats caps one team's block of seats at an event
+	maxTeamSeats = 200
+	// TeamAssignmentNotice is how long before the event seats nobody was
+	// assigned to are released, unless the leader picks another deadline
+	TeamAssignmentNotice = 48 * time.Hour
+)
+
+var (
+	ErrTeamNotFound             = errors.New("team not found")
+	ErrTeamRegistrationNotFound = errors.New("team registration not found")
+	ErrInvalidTeam              = errors.New("invalid team")
+	ErrNotTeamLeader            = errors.New("only the team leader can do that")
+	ErrNotTeamMember            = errors.New("not a member of the team")
+	ErrTeamAlreadyRegistered    = errors.New("team is already registered for this event")
+	ErrTeamSeatsUnavailable     = errors.New("not enough places left for the whole team")
+	ErrTeamWaitlisted           = errors.New("team is on the waitlist; seats can be assigned once it has places")
+	ErrNoFreeSeat               = errors.New("every seat the team reserved is assigned")
+	ErrSeatAssignmentClosed     = errors.New("the deadline for assigning seats has passed")
+)
+
+// Team is a group, such as a company or a school club, that registers for
+// events together under its leader
+type Team struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	LeaderID    string    `json:"leaderId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// TeamMember links a user to a team; the leader is a member too
+type TeamMember struct {
+	TeamID   string    `json:"teamId"`
+	UserID   string    `json:"userId"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+// TeamRegistration is a block of seats a team holds at an event. A
+// confirmed block counts against capacity in full; members fill its seats
+// with registrations of their own. Seats still empty at AssignmentDeadline
+// are released.
+type TeamRegistration struct {
+	ID                 string             `json:"id"`
+	TeamID             string             `json:"teamId"`
+	EventID            string             `json:"eventId"`
+	Seats              int                `json:"seats"`
+	Status             RegistrationStatus `json:"status"`
+	WaitlistPosition   *int               `json:"waitlistPosition,omitempty"`
+	AssignmentDeadline time.Time          `json:"assignmentDeadline"`
+	RegisteredBy       string             `json:"registeredBy"`
+	ConfirmedAt        *time.Time         `json:"confirmedAt,omitempty"`
+	SeatsReleasedAt    *time.Time         `json:"seatsReleasedAt,omitempty"`
+	CancelledAt        *time.Time         `json:"cancelledAt,omitempty"`
+	CreatedAt          time.Time          `json:"createdAt"`
+	UpdatedAt          time.Time          `json:"updatedAt"`
+}
+
+// TeamRegistrationInput reserves seats for a team
+type TeamRegistrationInput struct {
+	TeamID  string
+	EventID string
+	Seats   int
+	// AssignmentDeadline defaults to TeamAssignmentNotice before the event
+	AssignmentDeadline *time.Time
+}
+
+// TeamStats rolls up a team's registrations across events
+type TeamStats struct {
+	Events        int     `json:"events"`
+	SeatsReserved int     `json:"seatsReserved"`
+	SeatsAssigned int     `json:"seatsAssigned"`
+	Attended      int     `json:"attended"`
+	NoShows       int     `json:"noShows"`
+	Hours         float64 `json:"hours"`
+}
+
+// CreateTeam creates a team led by leaderID
+func (s *Service) CreateTeam(ctx context.Context, leaderID, name string, description *string) (*Team, error) {
+	name = strings.TrimSpace(name)
+	if len(name) < 2 || len(name) > 200 {
+		return nil, fmt.Errorf("%w: name must be between 2 and 200 characters", ErrInvalidTeam)
+	}
+	now := time.Now().UTC()
+	team := &Team{
+		ID:          uuid.New().String(),
+		Name:        name,
+		Description: description,
+		LeaderID:    leaderID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.repo.CreateTeam(ctx, team); err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+	return team, nil
+}
+
+// GetTeam returns a team, or ErrTeamNotFound
+func (s *Service) GetTeam(ctx context.Context, id string) (*Team, error) {
+	return s.repo.GetTeam(ctx, id)
+}
+
+// GetUserTeams returns the teams a user leads or belongs to
+func (s *Service) GetUserTeams(ctx context.Context, userID string) ([]*Team, error) {
+	return s.repo.GetUserTeams(ctx, userID)
+}
+
+// GetTeamMembers lists a team's members for one of them
+func (s *Service) GetTeamMembers(ctx context.Context, userID, teamID string) ([]*TeamMember, error) {
+	members, err := s.repo.GetTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !isTeamMember(members, userID) {
+		return nil, ErrNotTeamMember
+	}
+	return members, nil
+}
+
+// AddTeamMember adds a user to the leader's team
+func (s *Service) AddTeamMember(ctx context.Context, leaderID, teamID, userID string) (*TeamMember, error) {
+	if _, err := s.requireTeamLeader(ctx, teamID, leaderID); err != nil {
+		return nil, err
+	}
+	if _, err := s.userService.GetProfileWithDetails(ctx, userID, userID, nil); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	member := &TeamMember{TeamID: teamID, UserID: userID, JoinedAt: time.Now().UTC()}
+	if err := s.repo.AddTeamMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add team member: %w", err)
+	}
+	return member, nil
+}
+
+// RemoveTeamMember takes a user off the leader's team. Seats they already
+// hold are registrations of their own and stay until cancelled.
+func (s *Service) RemoveTeamMember(ctx context.Context, leaderID, teamID, userID string) error {
+	team, err := s.requireTeamLeader(ctx, teamID, leaderID)
+	if err != nil {
+		return err
+	}
+	if userID == team.LeaderID {
+		return fmt.Errorf("%w: the leader can't leave their own team", ErrInvalidTeam)
+	}
+	return s.repo.RemoveTeamMember(ctx, teamID, userID)
+}
+
+// GetTeamRegistrations lists a team's event registrations for one of its members
+func (s *Service) GetTeamRegistrations(ctx context.Context, userID, teamID string) ([]*TeamRegistration, error) {
+	if _, err := s.GetTeamMembers(ctx, userID, teamID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetTeamRegistrations(ctx, teamID)
+}
+
+// GetTeamSeats returns the registrations filling a team registration's seats
+func (s *Service) GetTeamSeats(ctx context.Context, tr *TeamRegistration) ([]*Registration, error) {
+	regs, err := s.repo.GetRegistrationsByEventID(ctx, tr.EventID)
+	if err != nil {
+		return nil, err
+	}
+	var seats []*Registration
+	for _, reg := range regs {
+		if reg.TeamRegistrationID != nil && *reg.TeamRegistrationID == tr.ID && activeSeat(reg) {
+			seats = append(seats, reg)
+		}
+	}
+	return seats, nil
+}
+
+// GetTeamStats rolls up attendance and hours for one of the team's members
+func (s *Service) GetTeamStats(ctx context.Context, userID, teamID string) (*TeamStats, error) {
+	if _, err := s.GetTeamMembers(ctx, userID, teamID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetTeamStats(ctx, teamID)
+}
+
+// RegisterTeamForEvent reserves input.Seats places for the leader's team, all
+// or nothing: the team is confirmed if every seat fits, otherwise the whole
+// team goes on the waitlist, or is refused when the event has none. Seats are
+// then assigned to members with AssignTeamSeat.
+func (s *Service) RegisterTeamForEvent(ctx context.Context, leaderID string, input TeamRegistrationInput) (*TeamRegistration, error) {
+	if _, err := s.requireTeamLeader(ctx, input.TeamID, leaderID); err != nil {
+		return nil, err
+	}
+	if input.Seats < 1 || input.Seats > maxTeamSeats {
+		return nil, fmt.Errorf("%w: a team can reserve between 1 and %d seats", ErrInvalidTeam, maxTeamSeats)
+	}
+
+	evt, err := s.validateEvent(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	shifts, err := s.eventService.GetEventShifts(ctx, evt.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shifts) > 0 {
+		return nil, fmt.Errorf("%w: events with shifts take individual sign-ups", ErrInvalidTeam)
+	}
+
+	now := time.Now().UTC()
+	deadline, err := teamAssignmentDeadline(evt, input.AssignmentDeadline, now)
+	if err != nil {
+		return nil, err
+	}
+
+	teamRegs, err := s.repo.GetEventTeamRegistrations(ctx, evt.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load team registrations: %w", err)
+	}
+	for _, tr := range teamRegs {
+		if tr.TeamID == input.TeamID && tr.Status != StatusCancelled {
+			return nil, ErrTeamAlreadyRegistered
+		}
+	}
+	taken, err := s.placesTaken(ctx, evt.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check capacity: %w", err)
+	}
+	status, err := seatTeam(evt.Capacity.Maximum-taken, input.Seats, evt.Capacity.WaitlistEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	tr := &TeamRegistration{
+		ID:                 uuid.New().String(),
+		TeamID:             input.TeamID,
+		EventID:            evt.ID,
+		Seats:              input.Seats,
+		Status:             status,
+		AssignmentDeadline: deadline,
+		RegisteredBy:       leaderID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if status == StatusConfirmed {
+		tr.ConfirmedAt = &now
+	} else {
+		position := nextTeamWaitlistPosition(teamRegs)
+		tr.WaitlistPosition = &position
+	}
+	err = s.repo.CreateTeamRegistration(ctx, tr)
+	if errors.Is(err, ErrTeamSeatsUnavailable) && evt.Capacity.WaitlistEnabled {
+		// Another sign-up took the places between the count and the insert
+		position := nextTeamWaitlistPosition(teamRegs)
+		tr.Status = StatusWaitlisted
+		tr.ConfirmedAt = nil
+		tr.WaitlistPosition = &position
+		err = s.repo.CreateTeamRegistration(ctx, tr)
+	}
+	if errors.Is(err, ErrTeamSeatsUnavailable) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to register team: %w", err)
+	}
+	return tr, nil
+}
+
+// AssignTeamSeat gives one of a confirmed team's seats to a member. The
+// member must be eligible and free of critical conflicts; minors need
+// guardianEmail and wait for consent, and events needing approval still
+// need it, but in every case the seat stays held for them.
+func (s *Service) AssignTeamSeat(ctx context.Context, leaderID, teamRegistrationID, memberID, guardianEmail string) (*Registration, error) {
+	tr, err := s.repo.GetTeamRegistration(ctx, teamRegistrationID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireTeamLeader(ctx, tr.TeamID, leaderID); err != nil {
+		return nil, err
+	}
+	members, err := s.repo.GetTeamMembers(ctx, tr.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !isTeamMember(members, memberID) {
+		return nil, ErrNotTeamMember
+	}
+
+	now := time.Now()
+	switch {
+	case tr.Status == StatusWaitlisted:
+		return nil, ErrTeamWaitlisted
+	case tr.Status != StatusConfirmed:
+		return nil, fmt.Errorf("%w: the team registration is %s", ErrInvalidTeam, strings.ToLower(string(tr.Status)))
+	case now.After(tr.AssignmentDeadline):
+		return nil, ErrSeatAssignmentClosed
+	}
+	seats, err := s.GetTeamSeats(ctx, tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load team seats: %w", err)
+	}
+	if len(seats) >= tr.Seats {
+		return nil, ErrNoFreeSeat
+	}
+
+	evt, err := s.eventService.GetEvent(ctx, tr.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	profile, err := s.userService.GetProfileWithDetails(ctx, memberID, memberID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if err := s.checkDuplicateRegistration(ctx, memberID, evt.ID); err != nil {
+		return nil, err
+	}
+	if err := checkEligibility(evt, profile); err != nil {
+		return nil, err
+	}
+	minor := isMinor(profile, evt)
+	if minor {
+		if s.consent.Mailer == nil {
+			return nil, ErrGuardianConsentUnavailable
+		}
+		if guardianEmail, err = normalizeGuardianEmail(guardianEmail, profile); err != nil {
+			return nil, err
+		}
+	}
+
+	commitments, err := s.confirmedCommitments(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	conflicts := detectConflicts(memberID, evt, commitments)
+	if blocking := blockingConflicts(conflicts, SeverityCritical); len(blocking) > 0 {
+		return nil, &ConflictError{EventID: evt.ID, Conflicts: blocking}
+	}
+
+	reg := &Registration{
+		ID:                 uuid.New().String(),
+		UserID:             memberID,
+		EventID:            evt.ID,
+		TeamRegistrationID: &tr.ID,
+		AttendanceStatus:   AttendanceRegistered,
+		AppliedAt:          now,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		Shifts:             []*ShiftAssignment{},
+	}
+	switch {
+	case minor:
+		reg.Status = StatusPendingGuardianConsent
+	case evt.RegistrationSettings.RequiresApproval:
+		reg.Status = StatusPendingApproval
+	default:
+		reg.Status = StatusConfirmed
+		reg.ConfirmedAt = &now
+	}
+	var saved *Registration
+	var consent *GuardianConsent
+	var consentToken string
+	err = s.repo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if saved, err = s.repo.CreateRegistration(ctx, reg); err != nil {
+			if errors.Is(err, ErrNoFreeSeat) {
+				return err
+			}
+			return fmt.Errorf("failed to assign seat: %w", err)
+		}
+		if minor {
+			consent, consentToken, err = s.recordGuardianConsentRequest(ctx, saved, evt, guardianEmail)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if consent != nil {
+		s.emailGuardianConsent(ctx, consent, consentToken, evt, profile)
+	}
+
+	s.recordConflicts(ctx, conflicts)
+	return saved, nil
+}
+
+// CancelTeamRegistration gives up the team's seats, cancelling the
+// registrations of the members assigned to them
+func (s *Service) CancelTeamRegistration(ctx context.Context, leaderID, teamRegistrationID, reason string) (*TeamRegistration, error) {
+	tr, err := s.repo.GetTeamRegistration(ctx, teamRegistrationID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireTeamLeader(ctx, tr.TeamID, leaderID); err != nil {
+		return nil, err
+	}
+	if tr.Status == StatusCancelled {
+		return nil, ErrNotCancellable
+	}
+	evt, err := s.eventService.GetEventByID(ctx, tr.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	now := time.Now()
+	if !now.Before(evt.StartTime) {
+		return nil, ErrNotCancellable
+	}
+
+	seats, err := s.GetTeamSeats(ctx, tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load team seats: %w", err)
+	}
+	for _, reg := range seats {
+		reg.Status = StatusCancelled
+		reg.CancellationReason = reason
+		reg.CancelledAt = &now
+		reg.UpdatedAt = now
+		if err := s.repo.UpdateRegistration(ctx, reg); err != nil {
+			return nil, fmt.Errorf("failed to cancel seat: %w", err)
+		}
+		s.resolveConflicts(ctx, reg.UserID, reg.EventID, "team registration cancelled")
+	}
+
+	tr.Status = StatusCancelled
+	tr.WaitlistPosition = nil
+	tr.CancelledAt = &now
+	tr.UpdatedAt = now
+	if err := s.repo.UpdateTeamRegistration(ctx, tr); err != nil {
+		return nil, fmt.Errorf("failed to cancel team registration: %w", err)
+	}
+
+	go func() {
+		if err := s.processEventTeamSeats(context.Background(), tr.EventID, time.Now()); err != nil {
+			s.logger.Error("failed to fill places released by a team", "eventID", tr.EventID, "error", err)
+		}
+	}()
+	return tr, nil
+}
+
+// RunTeamSeatReleases processes team seats every interval until ctx is done.
+// A non-positive interval disables it.
+func (s *Service) RunTeamSeatReleases(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.ProcessTeamSeats(ctx, now); err != nil {
+				s.logger.Error("failed to process team seats", "error", err)
+			}
+		}
+	}
+}
+
+// ProcessTeamSeats releases seats left unassigned at their team's deadline
+// and fills free places: the individual waitlist first, then waitlisted
+// teams in order, each only once all of its seats fit.
+func (s *Service) ProcessTeamSeats(ctx context.Context, now time.Time) error {
+	eventIDs, err := s.repo.GetTeamSeatEventIDs(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to load events with team seats: %w", err)
+	}
+	for _, eventID := range eventIDs {
+		if err := s.processEventTeamSeats(ctx, eventID, now); err != nil {
+			s.logger.Error("failed to process event team seats", "eventID", eventID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) processEventTeamSeats(ctx context.Context, eventID string, now time.Time) error {
+	evt, err := s.eventService.GetEventByID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load event: %w", err)
+	}
+	regs, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load registrations: %w", err)
+	}
+	teamRegs, err := s.repo.GetEventTeamRegistrations(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load team registrations: %w", err)
+	}
+
+	for _, tr := range releaseTeamSeats(teamRegs, regs, now) {
+		if err := s.repo.UpdateTeamRegistration(ctx, tr); err != nil {
+			return fmt.Errorf("failed to release team seats: %w", err)
+		}
+	}
+	if !now.Before(evt.StartTime) {
+		return nil
+	}
+
+	individual, places := individualPlaces(regs, teamRegs, evt.Capacity.Maximum)
+	changes := rebalanceCapacity(individual, places, "", now)
+	if len(changes) > 0 {
+		if err := s.repo.ApplyCapacityChanges(ctx, changes); err != nil {
+			return fmt.Errorf("failed to promote waitlist: %w", err)
+		}
+		s.afterCapacityChanges(ctx, evt, changes)
+	}
+
+	free := places
+	for _, reg := range individual {
+		if reg.Status == StatusConfirmed {
+			free--
+		}
+	}
+	for _, tr := range promoteTeams(teamRegs, free, evt.StartTime, now) {
+		if err := s.repo.UpdateTeamRegistration(ctx, tr); err != nil {
+			return fmt.Errorf("failed to promote team: %w", err)
+		}
+	}
+	return nil
+}
+
+// returnTeamSeat hands a cancelled member's seat back. Before the deadline
+// the team keeps it for someone else; after it, the place goes back to the
+// event.
+func (s *Service) returnTeamSeat(ctx context.Context, reg *Registration) {
+	tr, err := s.repo.GetTeamRegistration(ctx, *reg.TeamRegistrationID)
+	if err != nil {
+		s.logger.Error("failed to load team registration", "teamRegistrationID", *reg.TeamRegistrationID, "error", err)
+		return
+	}
+	if tr.Status != StatusConfirmed || tr.SeatsReleasedAt == nil {
+		return
+	}
+	now := time.Now()
+	tr.Seats = max(tr.Seats-1, 0)
+	if tr.Seats == 0 {
+		tr.Status = StatusCancelled
+		tr.CancelledAt = &now
+	}
+	tr.UpdatedAt = now
+	if err := s.repo.UpdateTeamRegistration(ctx, tr); err != nil {
+		s.logger.Error("failed to return team seat", "teamRegistrationID", tr.ID, "error", err)
+		return
+	}
+	go func() {
+		if err := s.processEventTeamSeats(context.Background(), tr.EventID, time.Now()); err != nil {
+			s.logger.Error("failed to fill a returned team seat", "eventID", tr.EventID, "error", err)
+		}
+	}()
+}
+
+// placesTaken counts the event's confirmed volunteers, with each confirmed
+// team holding every seat it reserved
+func (s *Service) placesTaken(ctx context.Context, eventID string) (int, error) {
+	regs, err := s.repo.GetRegistrationsByEventID(ctx, eventID)
+	if err != nil {
+		return 0, err
+	}
+	teamRegs, err := s.repo.GetEventTeamRegistrations(ctx, eventID)
+	if err != nil {
+		return 0, err
+	}
+	return countPlacesTaken(regs, teamRegs), nil
+}
+
+func (s *Service) requireTeamLeader(ctx context.Context, teamID, userID string) (*Team, error) {
+	team, err := s.repo.GetTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if team.LeaderID != userID {
+		return nil, ErrNotTeamLeader
+	}
+	return team, nil
+}
+
+func isTeamMember(members []*TeamMember, userID string) bool {
+	return slices.ContainsFunc(members, func(m *TeamMember) bool { return m.UserID == userID })
+}
+
+// teamAssignmentDeadline validates the leader's deadline for assigning seats,
+// defaulting to TeamAssignmentNotice before the event, or its start when
+// that has already passed
+func teamAssignmentDeadline(evt *event.Event, requested *time.Time, now time.Time) (time.Time, error) {
+	if requested == nil {
+		deadline := evt.StartTime.Add(-TeamAssignmentNotice)
+		if deadline.Before(now) {
+			deadline = evt.StartTime
+		}
+		return deadline, nil
+	}
+	if !requested.After(now) || requested.After(evt.StartTime) {
+		return time.Time{}, fmt.Errorf("%w: the assignment deadline must be before the event starts", ErrInvalidTeam)
+	}
+	return *requested, nil
+}
+
+// seatTeam confirms a team when all its seats fit in free places and
+// otherwise waitlists the whole team, if the event has a waitlist
+func seatTeam(free, seats int, waitlistEnabled bool) (RegistrationStatus, error) {
+	switch {
+	case seats <= free:
+		return StatusConfirmed, nil
+	case waitlistEnabled:
+		return StatusWaitlisted, nil
+	default:
+		return "", ErrTeamSeatsUnavailable
+	}
+}
+
+// activeSeat reports whether a member's registration still fills its seat
+func activeSeat(reg *Registration) bool {
+	return reg.Status != StatusCancelled && reg.Status != StatusDeclined
+}
+
+// countPlacesTaken counts confirmed volunteers outside teams plus the seats
+// of confirmed teams
+func countPlacesTaken(regs []*Registration, teamRegs []*TeamRegistration) int {
+	taken := 0
+	for _, tr := range teamRegs {
+		if tr.Status == StatusConfirmed {
+			taken += tr.Seats
+		}
+	}
+	for _, reg := range regs {
+		if reg.TeamRegistrationID == nil && reg.Status == StatusConfirmed {
+			taken++
+		}
+	}
+	return taken
+}
+
+// individualPlaces separates the registrations holding places of their own
+// from team members, whose places are part of their team's block, and
+// returns how many of maximum places are left once confirmed teams' seats
+// are set aside
+func individualPlaces(regs []*Registration, teamRegs []*TeamRegistration, maximum int) ([]*Registration, int) {
+	places := maximum
+	for _, tr := range teamRegs {
+		if tr.Status == StatusConfirmed {
+			places -= tr.Seats
+		}
+	}
+	var individual []*Registration
+	for _, reg := range regs {
+		if reg.TeamRegistrationID == nil {
+			individual = append(individual, reg)
+		}
+	}
+	return individual, places
+}
+
+// releaseTeamSeats shrinks each confirmed team whose deadline has passed to
+// the seats its members fill, cancelling teams that filled none. It returns
+// the team registrations it changed.
+func releaseTeamSeats(teamRegs []*TeamRegistration, regs []*Registration, now time.Time) []*TeamRegistration {
+	assigned := map[string]int{}
+	for _, reg := range regs {
+		if reg.TeamRegistrationID != nil && activeSeat(reg) {
+			assigned[*reg.TeamRegistrationID]++
+		}
+	}
+
+	var released []*TeamRegistration
+	for _, tr := range teamRegs {
+		if tr.Status != StatusConfirmed || tr.SeatsReleasedAt != nil || now.Before(tr.AssignmentDeadline) {
+			continue
+		}
+		tr.Seats = min(tr.Seats, assigned[tr.ID])
+		tr.SeatsReleasedAt = &now
+		if tr.Seats == 0 {
+			tr.Status = StatusCancelled
+			tr.CancelledAt = &now
+		}
+		tr.UpdatedAt = now
+		released = append(released, tr)
+	}
+	return released
+}
+
+// promoteTeams confirms waitlisted teams in order while all of the next
+// team's seats fit in free places; a team is never split. Teams promoted
+// after their assignment deadline have until the event starts.
+func promoteTeams(teamRegs []*TeamRegistration, free int, start, now time.Time) []*TeamRegistration {
+	var waiting []*TeamRegistration
+	for _, tr := range teamRegs {
+		if tr.Status == StatusWaitlisted {
+			waiting = append(waiting, tr)
+		}
+	}
+	slices.SortStableFunc(waiting, func(a, b *TeamRegistration) int {
+		return positionOrLast(a.WaitlistPosition) - positionOrLast(b.WaitlistPosition)
+	})
+
+	var promoted []*TeamRegistration
+	for _, tr := range waiting {
+		if tr.Seats > free {
+			break
+		}
+		free -= tr.Seats
+		tr.Status = StatusConfirmed
+		tr.ConfirmedAt = &now
+		tr.WaitlistPosition = nil
+		tr.UpdatedAt = now
+		if tr.AssignmentDeadline.Before(now) {
+			tr.AssignmentDeadline = start
+		}
+		promoted = append(promoted, tr)
+	}
+	return promoted
+}
+
+func nextTeamWaitlistPosition(teamRegs []*TeamRegistration) int {
+	last := 0
+	for _, tr := range teamRegs {
+		if tr.Status == StatusWaitlisted && tr.WaitlistPosition != nil && *tr.WaitlistPosition > last {
+			last = *tr.WaitlistPosition
+		}
+	}
+	return last + 1
+}
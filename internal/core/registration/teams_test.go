@@ -0,0 +1,128 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func TestSeatTeam(t *testing.T) {
+	status, err := seatTeam(5, 5, false)
+	require.NoError(t, err)
+	assert.Equal(t, StatusConfirmed, status)
+
+	status, err = seatTeam(4, 5, true)
+	require.NoError(t, err)
+	assert.Equal(t, StatusWaitlisted, status, "the whole team waits rather than splitting")
+
+	_, err = seatTeam(4, 5, false)
+	assert.ErrorIs(t, err, ErrTeamSeatsUnavailable)
+}
+
+func TestTeamAssignmentDeadline(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	evt := &event.Event{StartTime: now.Add(7 * 24 * time.Hour)}
+
+	deadline, err := teamAssignmentDeadline(evt, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, evt.StartTime.Add(-TeamAssignmentNotice), deadline)
+
+	soon := &event.Event{StartTime: now.Add(time.Hour)}
+	deadline, err = teamAssignmentDeadline(soon, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, soon.StartTime, deadline, "falls back to the start when the notice has passed")
+
+	requested := now.Add(24 * time.Hour)
+	deadline, err = teamAssignmentDeadline(evt, &requested, now)
+	require.NoError(t, err)
+	assert.Equal(t, requested, deadline)
+
+	late := evt.StartTime.Add(time.Minute)
+	_, err = teamAssignmentDeadline(evt, &late, now)
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+
+	past := now.Add(-time.Minute)
+	_, err = teamAssignmentDeadline(evt, &past, now)
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+}
+
+func TestCountPlacesTaken(t *testing.T) {
+	team := "tr-1"
+	regs := []*Registration{
+		{ID: "solo", Status: StatusConfirmed},
+		{ID: "waiting", Status: StatusWaitlisted},
+		{ID: "member", Status: StatusConfirmed, TeamRegistrationID: &team},
+	}
+	teamRegs := []*TeamRegistration{
+		{ID: team, Seats: 4, Status: StatusConfirmed},
+		{ID: "tr-2", Seats: 3, Status: StatusWaitlisted},
+	}
+
+	assert.Equal(t, 5, countPlacesTaken(regs, teamRegs), "members sit inside their team's block")
+
+	individual, places := individualPlaces(regs, teamRegs, 10)
+	assert.Equal(t, 6, places)
+	require.Len(t, individual, 2)
+	assert.Equal(t, "solo", individual[0].ID)
+}
+
+func TestReleaseTeamSeats(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	partial, empty, open := "partial", "empty", "open"
+	teamRegs := []*TeamRegistration{
+		{ID: partial, Seats: 5, Status: StatusConfirmed, AssignmentDeadline: now.Add(-time.Hour)},
+		{ID: empty, Seats: 2, Status: StatusConfirmed, AssignmentDeadline: now},
+		{ID: open, Seats: 3, Status: StatusConfirmed, AssignmentDeadline: now.Add(time.Hour)},
+	}
+	regs := []*Registration{
+		{Status: StatusConfirmed, TeamRegistrationID: &partial},
+		{Status: StatusPendingApproval, TeamRegistrationID: &partial},
+		{Status: StatusCancelled, TeamRegistrationID: &partial},
+		{Status: StatusConfirmed, TeamRegistrationID: &open},
+	}
+
+	released := releaseTeamSeats(teamRegs, regs, now)
+	require.Len(t, released, 2)
+
+	assert.Equal(t, 2, teamRegs[0].Seats, "keeps only the seats members fill")
+	assert.Equal(t, StatusConfirmed, teamRegs[0].Status)
+	assert.NotNil(t, teamRegs[0].SeatsReleasedAt)
+
+	assert.Equal(t, StatusCancelled, teamRegs[1].Status, "a team that assigned nobody is cancelled")
+	assert.Equal(t, 0, teamRegs[1].Seats)
+
+	assert.Equal(t, 3, teamRegs[2].Seats, "seats stay held until the deadline")
+	assert.Nil(t, teamRegs[2].SeatsReleasedAt)
+
+	assert.Empty(t, releaseTeamSeats(teamRegs, regs, now), "released teams aren't released again")
+}
+
+func TestPromoteTeams(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	start := now.Add(24 * time.Hour)
+	pos := func(p int) *int { return &p }
+	teamRegs := []*TeamRegistration{
+		{ID: "second", Seats: 2, Status: StatusWaitlisted, WaitlistPosition: pos(2)},
+		{ID: "first", Seats: 3, Status: StatusWaitlisted, WaitlistPosition: pos(1), AssignmentDeadline: now.Add(-time.Hour)},
+		{ID: "third", Seats: 1, Status: StatusWaitlisted, WaitlistPosition: pos(3)},
+	}
+
+	promoted := promoteTeams(teamRegs, 4, start, now)
+	require.Len(t, promoted, 1, "the second team doesn't fit and nobody jumps the queue")
+	assert.Equal(t, "first", promoted[0].ID)
+	assert.Equal(t, StatusConfirmed, promoted[0].Status)
+	assert.Nil(t, promoted[0].WaitlistPosition)
+	assert.Equal(t, start, promoted[0].AssignmentDeadline, "a passed deadline moves to the start")
+
+	assert.Equal(t, StatusWaitlisted, teamRegs[2].Status)
+	assert.Equal(t, 4, nextTeamWaitlistPosition(teamRegs))
+
+	promoted = promoteTeams(teamRegs, 3, start, now)
+	require.Len(t, promoted, 2)
+	assert.Equal(t, "second", promoted[0].ID)
+	assert.Equal(t, "third", promoted[1].ID)
+}
@@ -0,0 +1,48 @@
+package registration
+
+import (
+	"fmt"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// ticketQRLevel trades density for resilience to scuffed or dimmed phone screens
+const ticketQRLevel = qrcode.Medium
+
+// TicketQRPath is where the HTTP API serves a registration's QR code; format is "png" or "svg"
+func TicketQRPath(registrationID, format string) string {
+	return "/tickets/" + registrationID + "/qr." + format
+}
+
+// RenderTicketPNG encodes a ticket token as a square PNG QR code
+func RenderTicketPNG(token string, size int) ([]byte, error) {
+	png, err := qrcode.Encode(token, ticketQRLevel, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render ticket QR code: %w", err)
+	}
+	return png, nil
+}
+
+// RenderTicketSVG encodes a ticket token as an SVG QR code with one unit per module
+func RenderTicketSVG(token string) ([]byte, error) {
+	code, err := qrcode.New(token, ticketQRLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render ticket QR code: %w", err)
+	}
+	bitmap := code.Bitmap()
+	size := len(bitmap)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, size, size)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&b, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	b.WriteString(`"/></svg>`)
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,249 @@
+package registration
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+const (
+	ticketTokenPrefix = "vst1"
+	// checkInOpensBefore is how long before the start a ticket can be scanned
+	checkInOpensBefore = 2 * time.Hour
+	// checkInClosesAfter keeps the desk open for late arrivals
+	checkInClosesAfter = time.Hour
+	// maxOfflineScanAge bounds how long a desk may hold queued scans before syncing
+	maxOfflineScanAge = 2 * time.Hour
+	// maxClockSkew tolerates desk clocks running slightly ahead of the server
+	maxClockSkew = 2 * time.Minute
+)
+
+var (
+	ErrInvalidTicket        = errors.New("invalid ticket")
+	ErrTicketUnavailable    = errors.New("tickets are only issued for confirmed registrations")
+	ErrOutsideCheckInWindow = errors.New("ticket scanned outside the event check-in window")
+	ErrStaleScan            = errors.New("scan time is outside the accepted offline window")
+)
+
+// TicketClaims is the signed content of a ticket token
+type TicketClaims struct {
+	RegistrationID string `json:"r"`
+	EventID        string `json:"e"`
+	UserID         string `json:"u"`
+	IssuedAt       int64  `json:"i"`
+}
+
+// Ticket is a volunteer's entry pass for one confirmed registration
+type Ticket struct {
+	Registration *Registration
+	Token        string
+}
+
+// ScanTicketInput is one scan from a check-in desk. ScannedAt and
+// ClientScanID are set when the desk replays scans it queued while offline.
+type ScanTicketInput struct {
+	Token        string
+	ScannedAt    *time.Time
+	ClientScanID string
+}
+
+// ScanResult reports what a scan did
+type ScanResult struct {
+	Outcome      ScanOutcome
+	Registration *Registration
+	Scan         *TicketScan
+}
+
+// TicketService issues signed check-in tickets and validates them at the door.
+// Tokens are stateless HMACs, so a desk only needs the server to record the
+// check-in, not to look the ticket up.
+type TicketService struct {
+	registrations *Service
+	secret        []byte
+	now           func() time.Time
+}
+
+// NewTicketService creates a ticket service signing with secret
+func NewTicketService(registrations *Service, secret string) *TicketService {
+	if registrations == nil {
+		panic("registration service is required")
+	}
+	if secret == "" {
+		panic("ticket signing secret is required")
+	}
+	return &TicketService{
+		registrations: registrations,
+		secret:        []byte(secret),
+		now:           time.Now,
+	}
+}
+
+// IssueTicket returns the ticket for the caller's own confirmed registration.
+// The token is derived from the registration, so reissuing gives the same QR code.
+func (t *TicketService) IssueTicket(ctx context.Context, userID, registrationID string) (*Ticket, error) {
+	reg, err := t.registrations.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.UserID != userID {
+		return nil, fmt.Errorf("unauthorized: registration belongs to another volunteer")
+	}
+	if reg.Status != StatusConfirmed {
+		return nil, ErrTicketUnavailable
+	}
+
+	issuedAt := reg.AppliedAt
+	if reg.ConfirmedAt != nil {
+		issuedAt = *reg.ConfirmedAt
+	}
+	token, err := t.sign(TicketClaims{
+		RegistrationID: reg.ID,
+		EventID:        reg.EventID,
+		UserID:         reg.UserID,
+		IssuedAt:       issuedAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Ticket{Registration: reg, Token: token}, nil
+}
+
+// ScanTicket validates a ticket presented at the door and checks the holder in.
+// Scanning an already checked-in ticket is reported rather than rejected so a
+// desk can tell a duplicate pass from a bad one, and resubmitting a scan with
+// the same ClientScanID returns the original result.
+func (t *TicketService) ScanTicket(ctx context.Context, staffID string, input ScanTicketInput) (*ScanResult, error) {
+	claims, err := t.parse(input.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	receivedAt := t.now()
+	scannedAt := receivedAt
+	if input.ScannedAt != nil {
+		scannedAt = *input.ScannedAt
+		if err := checkScanTime(scannedAt, receivedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	repo := t.registrations.repo
+	if input.ClientScanID != "" {
+		prior, err := repo.GetTicketScanByClientID(ctx, staffID, input.ClientScanID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up scan: %w", err)
+		}
+		if prior != nil {
+			if prior.RegistrationID != claims.RegistrationID {
+				return nil, fmt.Errorf("scan id %s was already used for another ticket", input.ClientScanID)
+			}
+			reg, err := repo.GetRegistrationByID(ctx, prior.RegistrationID)
+			if err != nil {
+				return nil, fmt.Errorf("registration not found: %w", err)
+			}
+			return &ScanResult{Outcome: prior.Outcome, Registration: reg, Scan: prior}, nil
+		}
+	}
+
+	reg, evt, err := t.registrations.loadForAttendance(ctx, claims.RegistrationID, staffID)
+	if err != nil {
+		return nil, err
+	}
+	// A transferred or reassigned registration invalidates earlier tickets
+	if reg.EventID != claims.EventID || reg.UserID != claims.UserID {
+		return nil, ErrInvalidTicket
+	}
+	if err := checkInWindow(evt, scannedAt); err != nil {
+		return nil, err
+	}
+
+	outcome := ScanAlreadyCheckedIn
+	if reg.CheckedInAt == nil {
+		outcome = ScanCheckedIn
+		if reg, err = t.registrations.CheckInVolunteerAt(ctx, reg.ID, staffID, scannedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	scan := &TicketScan{
+		ID:             uuid.New().String(),
+		RegistrationID: reg.ID,
+		EventID:        reg.EventID,
+		ScannedBy:      staffID,
+		Outcome:        outcome,
+		ScannedAt:      scannedAt,
+		ReceivedAt:     receivedAt,
+	}
+	if input.ClientScanID != "" {
+		scan.ClientScanID = &input.ClientScanID
+	}
+	if _, err := repo.CreateTicketScan(ctx, scan); err != nil {
+		// The check-in itself succeeded; a resubmission will report it as a duplicate
+		t.registrations.logger.Error("failed to record ticket scan", "registrationID", reg.ID, "error", err)
+	}
+
+	return &ScanResult{Outcome: outcome, Registration: reg, Scan: scan}, nil
+}
+
+func (t *TicketService) sign(claims TicketClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode ticket: %w", err)
+	}
+	body := ticketTokenPrefix + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + base64.RawURLEncoding.EncodeToString(t.mac(body)), nil
+}
+
+func (t *TicketService) parse(token string) (*TicketClaims, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 || parts[0] != ticketTokenPrefix {
+		return nil, ErrInvalidTicket
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sig, t.mac(parts[0]+"."+parts[1])) {
+		return nil, ErrInvalidTicket
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidTicket
+	}
+	var claims TicketClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.RegistrationID == "" {
+		return nil, ErrInvalidTicket
+	}
+	return &claims, nil
+}
+
+func (t *TicketService) mac(body string) []byte {
+	h := hmac.New(sha256.New, t.secret)
+	h.Write([]byte(body))
+	return h.Sum(nil)
+}
+
+// checkScanTime rejects desk timestamps from the future or from an outage
+// longer than we are willing to trust
+func checkScanTime(scannedAt, receivedAt time.Time) error {
+	if scannedAt.After(receivedAt.Add(maxClockSkew)) || receivedAt.Sub(scannedAt) > maxOfflineScanAge {
+		return ErrStaleScan
+	}
+	return nil
+}
+
+// checkInWindow reports whether a scan falls between the desk opening before
+// the event and closing after it
+func checkInWindow(evt *event.Event, scannedAt time.Time) error {
+	if scannedAt.Before(evt.StartTime.Add(-checkInOpensBefore)) || scannedAt.After(evt.EndTime.Add(checkInClosesAfter)) {
+		return ErrOutsideCheckInWindow
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package registration
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketTokens(t *testing.T) {
+	tickets := &TicketService{secret: []byte("test-secret"), now: time.Now}
+	claims := TicketClaims{RegistrationID: "reg1", EventID: "event1", UserID: "user1", IssuedAt: 1700000000}
+
+	token, err := tickets.sign(claims)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, ticketTokenPrefix+"."))
+
+	t.Run("round trip", func(t *testing.T) {
+		parsed, err := tickets.parse(token)
+		require.NoError(t, err)
+		assert.Equal(t, claims, *parsed)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		forged, err := (&TicketService{secret: []byte("other")}).sign(TicketClaims{RegistrationID: "reg2"})
+		require.NoError(t, err)
+		parts := strings.Split(token, ".")
+		forgedParts := strings.Split(forged, ".")
+
+		_, err = tickets.parse(parts[0] + "." + forgedParts[1] + "." + parts[2])
+		assert.ErrorIs(t, err, ErrInvalidTicket)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := (&TicketService{secret: []byte("other")}).parse(token)
+		assert.ErrorIs(t, err, ErrInvalidTicket)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		for _, bad := range []string{"", "vst1", "vst1.a.b", "jwt." + strings.SplitN(token, ".", 2)[1]} {
+			_, err := tickets.parse(bad)
+			assert.ErrorIs(t, err, ErrInvalidTicket, bad)
+		}
+	})
+}
+
+func TestCheckScanTime(t *testing.T) {
+	received := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, checkScanTime(received, received))
+	assert.NoError(t, checkScanTime(received.Add(-90*time.Minute), received), "queued during a short outage")
+	assert.NoError(t, checkScanTime(received.Add(time.Minute), received), "desk clock slightly ahead")
+	assert.ErrorIs(t, checkScanTime(received.Add(-3*time.Hour), received), ErrStaleScan)
+	assert.ErrorIs(t, checkScanTime(received.Add(10*time.Minute), received), ErrStaleScan)
+}
+
+func TestCheckInWindow(t *testing.T) {
+	start := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	evt := testEvent("event1", start, 3, nil)
+
+	assert.NoError(t, checkInWindow(evt, start.Add(-time.Hour)))
+	assert.NoError(t, checkInWindow(evt, start.Add(3*time.Hour+30*time.Minute)))
+	assert.ErrorIs(t, checkInWindow(evt, start.Add(-3*time.Hour)), ErrOutsideCheckInWindow)
+	assert.ErrorIs(t, checkInWindow(evt, start.Add(5*time.Hour)), ErrOutsideCheckInWindow)
+}
+
+func TestRenderTicketQR(t *testing.T) {
+	png, err := RenderTicketPNG("vst1.payload.sig", 256)
+	require.NoError(t, err)
+	assert.Equal(t, "\x89PNG", string(png[:4]))
+
+	svg, err := RenderTicketSVG("vst1.payload.sig")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(svg), "<svg"))
+	assert.Contains(t, string(svg), "h1v1h-1z")
+}
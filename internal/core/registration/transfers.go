@@ -0,0 +1,427 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+// TransferReason is the cancellation reason and status history reason
+// recorded for a transfer
+const TransferReason = "transferred"
+
+// transferAttempts bounds how often a transfer is retried when the place it
+// was about to take is filled by someone else first
+const transferAttempts = 3
+
+var (
+	ErrTransferNotRelated   = errors.New("registrations can only move to another date of the same event series")
+	ErrTransferTeamSeat     = errors.New("team seats can't be transferred; ask your team leader")
+	ErrAlreadyTransferred   = errors.New("registration has already been transferred to another event")
+	ErrTransferNotFound     = errors.New("transfer not found")
+	ErrTransferSeatTaken    = errors.New("the place on the new event was taken before the transfer completed")
+	ErrTransferNeedsConsent = errors.New("no guardian is on record to consent to the new date; register for it instead")
+)
+
+// RegistrationTransfer records that a volunteer moved from one event of a
+// series to another. The source registration is cancelled and a new one
+// takes its place.
+type RegistrationTransfer struct {
+	ID                   string    `json:"id"`
+	SourceRegistrationID string    `json:"sourceRegistrationId"`
+	TargetRegistrationID string    `json:"targetRegistrationId"`
+	FromEventID          string    `json:"fromEventId"`
+	ToEventID            string    `json:"toEventId"`
+	TransferredBy        string    `json:"transferredBy"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
+
+// TransferChanges is everything a transfer writes, applied in one
+// transaction. Target and Transfer are nil when the volunteer only moves
+// between shifts of the same event, which updates Source in place.
+type TransferChanges struct {
+	Source        *Registration
+	Target        *Registration
+	Transfer      *RegistrationTransfer
+	StatusChanges []*RegistrationStatusChange
+}
+
+// TransferRegistration moves the volunteer's registration to another date of
+// the same series, or to other shifts of the same event. The new place is
+// seated like a fresh registration, confirmed or waitlisted, and the old one
+// is given up only if that succeeds; its place then goes to the waitlist.
+// Retrying a completed transfer returns the registration it produced.
+func (s *Service) TransferRegistration(ctx context.Context, userID, registrationID, newEventID string, shiftIDs []string) (*Registration, error) {
+	reg, err := s.repo.GetRegistrationByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("registration not found: %w", err)
+	}
+	if reg.UserID != userID {
+		return nil, fmt.Errorf("user does not have permission to transfer this registration")
+	}
+	if reg.Status == StatusCancelled && reg.CancellationReason == TransferReason {
+		return s.completedTransfer(ctx, reg, newEventID)
+	}
+	if reg.TeamRegistrationID != nil {
+		return nil, ErrTransferTeamSeat
+	}
+
+	from, err := s.eventService.GetEvent(ctx, reg.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	now := time.Now()
+	late, err := cancellationCheck(reg.Status, from, now)
+	if err != nil {
+		return nil, err
+	}
+
+	to := from
+	if newEventID != reg.EventID {
+		if to, err = s.validateEvent(ctx, newEventID); err != nil {
+			return nil, err
+		}
+		if !sameSeries(from, to) {
+			return nil, ErrTransferNotRelated
+		}
+		if err := s.checkDuplicateRegistration(ctx, userID, newEventID); err != nil {
+			return nil, err
+		}
+	} else if err := checkRegistrationWindow(to, now); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.userService.GetProfileWithDetails(ctx, userID, userID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if err := checkEligibility(to, profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.loadShifts(ctx, reg); err != nil {
+		return nil, err
+	}
+	shifts, err := s.eventService.GetEventShifts(ctx, to.ID)
+	if err != nil {
+		return nil, err
+	}
+	chosen, err := chooseShifts(shifts, shiftIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, shift := range chosen {
+		if err := checkEligibility(to.ForShift(shift), profile); err != nil {
+			return nil, err
+		}
+	}
+
+	if to.ID == reg.EventID {
+		if sameShifts(reg.Shifts, chosen) {
+			return reg, nil
+		}
+		return s.transferShifts(ctx, reg, to, chosen, userID, now)
+	}
+
+	commitments, err := s.confirmedCommitments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	commitments = slices.DeleteFunc(commitments, func(e *event.Event) bool { return e.ID == reg.EventID })
+	conflicts := detectShiftConflicts(userID, shiftViews(to, chosen), commitments)
+	if blocking := blockingConflicts(conflicts, SeverityCritical); len(blocking) > 0 {
+		return nil, &ConflictError{EventID: to.ID, Conflicts: blocking}
+	}
+
+	// Waivers and questions on the new date have to be dealt with up front;
+	// a transfer carries no new signatures or answers
+	if _, err := s.checkWaivers(ctx, userID, to.ID, nil, SignatureEvidence{}); err != nil {
+		return nil, err
+	}
+	questions, err := s.repo.GetEventQuestions(ctx, to.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registration questions: %w", err)
+	}
+	if _, err := validateAnswers(questions, nil); err != nil {
+		return nil, err
+	}
+
+	var guardianEmail string
+	if isMinor(profile, to) {
+		if s.consent.Mailer == nil {
+			return nil, ErrGuardianConsentUnavailable
+		}
+		previous, err := s.repo.GetGuardianConsentsByRegistrationID(ctx, reg.ID)
+		if err != nil || len(previous) == 0 {
+			return nil, ErrTransferNeedsConsent
+		}
+		guardianEmail = previous[0].RequestedEmail
+	}
+
+	wasConfirmed := reg.Status == StatusConfirmed
+	sourceStatus := reg.Status
+	freedShifts := cancelShifts(reg, now)
+	reg.Status = StatusCancelled
+	reg.CancellationReason = TransferReason
+	reg.LateCancellation = late
+	reg.CancelledAt = &now
+	reg.WaitlistPosition = nil
+	reg.UpdatedAt = now
+
+	var target *Registration
+	for attempt := 1; ; attempt++ {
+		target = transferTarget(reg, to.ID, chosen, now)
+		if guardianEmail != "" {
+			target.Status = StatusPendingGuardianConsent
+		} else if err := s.setRegistrationStatus(ctx, target, to); err != nil {
+			return nil, fmt.Errorf("failed to set registration status: %w", err)
+		}
+
+		err = s.repo.ApplyTransfer(ctx, planTransfer(reg, sourceStatus, target, userID, now))
+		if errors.Is(err, ErrTransferSeatTaken) && attempt < transferAttempts {
+			continue
+		}
+		break
+	}
+	if errors.Is(err, ErrAlreadyTransferred) {
+		// A concurrent retry got there first
+		return s.completedTransfer(ctx, reg, newEventID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer registration: %w", err)
+	}
+
+	if err := s.recordWaiverSignatures(ctx, target, nil); err != nil {
+		s.logger.Error("failed to link waiver signatures after transfer", "registrationID", target.ID, "error", err)
+	}
+	if guardianEmail != "" {
+		if _, err := s.requestGuardianConsent(ctx, target, to, profile, guardianEmail); err != nil {
+			s.logger.Error("failed to request guardian consent after transfer", "registrationID", target.ID, "error", err)
+		}
+	}
+	s.resolveConflicts(ctx, userID, reg.EventID, "registration transferred")
+	s.recordConflicts(ctx, conflicts)
+	s.handOnPlace(ctx, reg, wasConfirmed, freedShifts)
+
+	s.logger.Info("registration transferred", "from", reg.ID, "to", target.ID, "eventID", to.ID, "status", target.Status)
+	return target, nil
+}
+
+// transferShifts moves a registration onto other shifts of its own event.
+// The registration keeps its status where the new shifts allow: places it
+// held are released only once the new shifts are seated.
+func (s *Service) transferShifts(ctx context.Context, reg *Registration, evt *event.Event, chosen []*event.EventShift, userID string, now time.Time) (*Registration, error) {
+	commitments, err := s.confirmedCommitments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	commitments = slices.DeleteFunc(commitments, func(e *event.Event) bool { return e.ID == reg.EventID })
+	conflicts := detectShiftConflicts(userID, shiftViews(evt, chosen), commitments)
+	if blocking := blockingConflicts(conflicts, SeverityCritical); len(blocking) > 0 {
+		return nil, &ConflictError{EventID: evt.ID, Conflicts: blocking}
+	}
+
+	all, err := s.eventService.GetEventShifts(ctx, evt.ID)
+	if err != nil {
+		return nil, err
+	}
+	taken, err := s.repo.GetEventShiftAssignments(ctx, evt.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shift sign-ups: %w", err)
+	}
+
+	sourceStatus := reg.Status
+	kept, added := moveShifts(reg, chosen, now)
+	// Registrations still awaiting approval or consent are seated later; the
+	// rest take a place on the new shifts now. Shifts the volunteer is
+	// leaving don't count against the ones they join.
+	if reg.Status == StatusConfirmed || reg.Status == StatusWaitlisted {
+		taken = slices.DeleteFunc(taken, func(a *ShiftAssignment) bool { return a.RegistrationID == reg.ID })
+		if err := seatShiftAssignments(added, shiftsByID(all), taken, now); err != nil {
+			return nil, err
+		}
+	}
+	freedShifts := cancelShiftsExcept(reg, kept, added, now)
+	if reg.Status == StatusConfirmed || reg.Status == StatusWaitlisted {
+		confirmedAt := reg.ConfirmedAt
+		applyShiftStatus(reg, now)
+		if sourceStatus == StatusConfirmed && reg.Status == StatusConfirmed {
+			reg.ConfirmedAt = confirmedAt
+		}
+	}
+	reg.UpdatedAt = now
+
+	if err := s.repo.ApplyTransfer(ctx, planShiftTransfer(reg, sourceStatus, chosen, userID, now)); err != nil {
+		return nil, fmt.Errorf("failed to transfer registration: %w", err)
+	}
+
+	s.recordConflicts(ctx, conflicts)
+	if len(freedShifts) > 0 {
+		go s.promoteShiftWaitlists(context.Background(), reg.EventID, freedShifts)
+	}
+	return reg, nil
+}
+
+// completedTransfer answers a retried transfer with the registration the
+// first attempt produced
+func (s *Service) completedTransfer(ctx context.Context, reg *Registration, newEventID string) (*Registration, error) {
+	transfer, err := s.repo.GetRegistrationTransfer(ctx, reg.ID)
+	if errors.Is(err, ErrTransferNotFound) {
+		return nil, ErrNotCancellable
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transfer: %w", err)
+	}
+	if transfer.ToEventID != newEventID {
+		return nil, ErrAlreadyTransferred
+	}
+	return s.repo.GetRegistrationByID(ctx, transfer.TargetRegistrationID)
+}
+
+// sameSeries reports whether two events are dates of one recurring series:
+// either is the other's parent, or they share a parent
+func sameSeries(a, b *event.Event) bool {
+	return seriesRoot(a) == seriesRoot(b)
+}
+
+func seriesRoot(e *event.Event) string {
+	if e.ParentEventID != nil && *e.ParentEventID != "" {
+		return *e.ParentEventID
+	}
+	return e.ID
+}
+
+// sameShifts reports whether the registration already holds exactly the
+// chosen shifts
+func sameShifts(held []*ShiftAssignment, chosen []*event.EventShift) bool {
+	active := map[string]bool{}
+	for _, a := range held {
+		if a.Status != ShiftCancelled {
+			active[a.ShiftID] = true
+		}
+	}
+	if len(active) != len(chosen) {
+		return false
+	}
+	for _, shift := range chosen {
+		if !active[shift.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// moveShifts keeps the registration's assignments to chosen shifts it
+// already holds and adds pending assignments for the rest. It returns the
+// kept and added assignments; reg.Shifts gains the added ones.
+func moveShifts(reg *Registration, chosen []*event.EventShift, now time.Time) (kept, added []*ShiftAssignment) {
+	held := map[string]*ShiftAssignment{}
+	for _, a := range reg.Shifts {
+		if a.Status != ShiftCancelled {
+			held[a.ShiftID] = a
+		}
+	}
+	var joining []*event.EventShift
+	for _, shift := range chosen {
+		if a, ok := held[shift.ID]; ok {
+			kept = append(kept, a)
+		} else {
+			joining = append(joining, shift)
+		}
+	}
+	added = newShiftAssignments(reg.ID, joining, now)
+	reg.Shifts = append(reg.Shifts, added...)
+	return kept, added
+}
+
+// cancelShiftsExcept gives up the registration's assignments other than keep
+// and added, returning the IDs of shifts whose place was freed
+func cancelShiftsExcept(reg *Registration, keep, added []*ShiftAssignment, now time.Time) []string {
+	staying := map[string]bool{}
+	for _, a := range append(slices.Clone(keep), added...) {
+		staying[a.ID] = true
+	}
+	var freed []string
+	for _, a := range reg.Shifts {
+		if staying[a.ID] || a.Status == ShiftCancelled {
+			continue
+		}
+		if a.Status == ShiftConfirmed {
+			freed = append(freed, a.ShiftID)
+		}
+		a.Status = ShiftCancelled
+		a.WaitlistPosition = nil
+		a.UpdatedAt = now
+	}
+	return freed
+}
+
+// transferTarget is the new registration a transfer creates, carrying over
+// the volunteer's details from source
+func transferTarget(source *Registration, eventID string, shifts []*event.EventShift, now time.Time) *Registration {
+	target := &Registration{
+		ID:                    uuid.New().String(),
+		UserID:                source.UserID,
+		EventID:               eventID,
+		PersonalMessage:       source.PersonalMessage,
+		EmergencyContactName:  source.EmergencyContactName,
+		EmergencyContactPhone: source.EmergencyContactPhone,
+		DietaryRestrictions:   source.DietaryRestrictions,
+		AccessibilityNeeds:    source.AccessibilityNeeds,
+		AttendanceStatus:      AttendanceRegistered,
+		AppliedAt:             now,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	target.Shifts = newShiftAssignments(target.ID, shifts, now)
+	return target
+}
+
+// planTransfer records a transfer from source, already cancelled, to target
+// and the status history of both
+func planTransfer(source *Registration, sourceStatus RegistrationStatus, target *Registration, userID string, now time.Time) *TransferChanges {
+	cancelled := capacityStatusChange(source, sourceStatus, userID, TransferReason, now)
+	cancelled.Notes = "to registration " + target.ID
+	created := &RegistrationStatusChange{
+		ID:             uuid.New().String(),
+		RegistrationID: target.ID,
+		NewStatus:      string(target.Status),
+		ChangedBy:      &userID,
+		Reason:         TransferReason,
+		Notes:          "from registration " + source.ID,
+		CreatedAt:      now,
+	}
+	return &TransferChanges{
+		Source: source,
+		Target: target,
+		Transfer: &RegistrationTransfer{
+			ID:                   uuid.New().String(),
+			SourceRegistrationID: source.ID,
+			TargetRegistrationID: target.ID,
+			FromEventID:          source.EventID,
+			ToEventID:            target.EventID,
+			TransferredBy:        userID,
+			CreatedAt:            now,
+		},
+		StatusChanges: []*RegistrationStatusChange{cancelled, created},
+	}
+}
+
+// planShiftTransfer records a move between shifts of reg's event in its
+// status history
+func planShiftTransfer(reg *Registration, previous RegistrationStatus, chosen []*event.EventShift, userID string, now time.Time) *TransferChanges {
+	names := make([]string, 0, len(chosen))
+	for _, shift := range chosen {
+		names = append(names, shift.Name)
+	}
+	change := capacityStatusChange(reg, previous, userID, TransferReason, now)
+	change.Notes = "moved to " + strings.Join(names, ", ")
+	return &TransferChanges{Source: reg, StatusChanges: []*RegistrationStatusChange{change}}
+}
@@ -0,0 +1,104 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func TestSameSeries(t *testing.T) {
+	parent := "series"
+	root := &event.Event{ID: parent}
+	first := &event.Event{ID: "first", ParentEventID: &parent}
+	second := &event.Event{ID: "second", ParentEventID: &parent}
+	other := &event.Event{ID: "other"}
+
+	assert.True(t, sameSeries(first, second))
+	assert.True(t, sameSeries(root, first), "the series' first event is its parent")
+	assert.False(t, sameSeries(first, other))
+	assert.False(t, sameSeries(root, other))
+}
+
+func TestSameShifts(t *testing.T) {
+	shifts := testShifts()
+	held := []*ShiftAssignment{
+		{ShiftID: "morning", Status: ShiftConfirmed},
+		{ShiftID: "afternoon", Status: ShiftCancelled},
+	}
+
+	assert.True(t, sameShifts(held, shifts[:1]))
+	assert.False(t, sameShifts(held, shifts[:2]), "cancelled assignments aren't held")
+	assert.False(t, sameShifts(held, shifts[1:2]))
+	assert.True(t, sameShifts(nil, nil), "events without shifts have nothing to move")
+}
+
+func TestMoveShifts(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	shifts := testShifts()
+	morning := &ShiftAssignment{ID: "a-morning", ShiftID: "morning", Status: ShiftConfirmed}
+	setup := &ShiftAssignment{ID: "a-setup", ShiftID: "setup", Status: ShiftWaitlisted}
+	reg := &Registration{ID: "reg", Shifts: []*ShiftAssignment{morning, setup}}
+
+	kept, added := moveShifts(reg, []*event.EventShift{shifts[0], shifts[1]}, now)
+	require.Len(t, kept, 1)
+	assert.Same(t, morning, kept[0])
+	require.Len(t, added, 1)
+	assert.Equal(t, "afternoon", added[0].ShiftID)
+	assert.Equal(t, ShiftPending, added[0].Status)
+	assert.Len(t, reg.Shifts, 3)
+
+	freed := cancelShiftsExcept(reg, kept, added, now)
+	assert.Empty(t, freed, "the setup place was only waitlisted")
+	assert.Equal(t, ShiftCancelled, setup.Status)
+	assert.Equal(t, ShiftConfirmed, morning.Status)
+
+	kept, added = moveShifts(reg, []*event.EventShift{shifts[2]}, now)
+	assert.Empty(t, kept)
+	freed = cancelShiftsExcept(reg, kept, added, now)
+	assert.Equal(t, []string{"morning"}, freed)
+}
+
+func TestPlanTransfer(t *testing.T) {
+	now := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	source := &Registration{
+		ID:                  "source",
+		UserID:              "volunteer",
+		EventID:             "first",
+		Status:              StatusCancelled,
+		PersonalMessage:     "see you there",
+		DietaryRestrictions: "vegetarian",
+	}
+	target := transferTarget(source, "second", nil, now)
+	target.Status = StatusWaitlisted
+
+	assert.Equal(t, "volunteer", target.UserID)
+	assert.Equal(t, "second", target.EventID)
+	assert.Equal(t, "vegetarian", target.DietaryRestrictions)
+	assert.Equal(t, AttendanceRegistered, target.AttendanceStatus)
+
+	changes := planTransfer(source, StatusConfirmed, target, "volunteer", now)
+	require.NotNil(t, changes.Transfer)
+	assert.Equal(t, "source", changes.Transfer.SourceRegistrationID)
+	assert.Equal(t, target.ID, changes.Transfer.TargetRegistrationID)
+	assert.Equal(t, "second", changes.Transfer.ToEventID)
+
+	require.Len(t, changes.StatusChanges, 2)
+	cancelled, created := changes.StatusChanges[0], changes.StatusChanges[1]
+	assert.Equal(t, "CONFIRMED", *cancelled.OldStatus)
+	assert.Equal(t, "CANCELLED", cancelled.NewStatus)
+	assert.Equal(t, TransferReason, cancelled.Reason)
+	assert.Nil(t, created.OldStatus)
+	assert.Equal(t, "WAITLISTED", created.NewStatus)
+	assert.Equal(t, target.ID, created.RegistrationID)
+
+	reg := &Registration{ID: "reg", Status: StatusConfirmed}
+	moved := planShiftTransfer(reg, StatusConfirmed, testShifts()[1:2], "volunteer", now)
+	assert.Nil(t, moved.Target)
+	assert.Nil(t, moved.Transfer)
+	require.Len(t, moved.StatusChanges, 1)
+	assert.Equal(t, "moved to Afternoon", moved.StatusChanges[0].Notes)
+}
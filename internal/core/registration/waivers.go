@@ -0,0 +1,401 @@
+package registration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+var (
+	ErrWaiverNotFound    = errors.New("waiver not found")
+	ErrInvalidWaiver     = errors.New("invalid waiver")
+	ErrWaiverUnsigned    = errors.New("required waivers are not signed")
+	ErrInvalidSignature  = errors.New("invalid waiver signature")
+	ErrWaiverOutdated    = errors.New("waiver has been updated since it was shown; review and sign the current version")
+	ErrWaiverRetired     = errors.New("waiver has been retired")
+	errWaiverVersionGone = errors.New("waiver version is not current for this event")
+)
+
+// Waiver is a liability waiver or consent form attached to an event. Its
+// wording is versioned; CurrentVersion is the one volunteers sign now.
+type Waiver struct {
+	ID             string         `json:"id"`
+	EventID        string         `json:"eventId"`
+	Title          string         `json:"title"`
+	Required       bool           `json:"required"`
+	CurrentVersion *WaiverVersion `json:"currentVersion"`
+	CreatedBy      string         `json:"createdBy"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+	RetiredAt      *time.Time     `json:"retiredAt,omitempty"`
+}
+
+// WaiverVersion is one revision of a waiver's text
+type WaiverVersion struct {
+	ID          string    `json:"id"`
+	WaiverID    string    `json:"waiverId"`
+	Version     int       `json:"version"`
+	Body        string    `json:"body"`
+	ContentHash string    `json:"contentHash"`
+	CreatedBy   string    `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// WaiverSignature records a volunteer signing one waiver version
+type WaiverSignature struct {
+	ID              string    `json:"id"`
+	WaiverVersionID string    `json:"waiverVersionId"`
+	WaiverID        string    `json:"waiverId"`
+	WaiverTitle     string    `json:"waiverTitle"`
+	Version         int       `json:"version"`
+	EventID         string    `json:"eventId"`
+	UserID          string    `json:"userId"`
+	RegistrationID  *string   `json:"registrationId,omitempty"`
+	SignedName      string    `json:"signedName"`
+	IPAddress       string    `json:"ipAddress"`
+	UserAgent       string    `json:"userAgent"`
+	DocumentHash    string    `json:"documentHash"`
+	SignedAt        time.Time `json:"signedAt"`
+}
+
+// WaiverInput publishes a waiver. With WaiverID set, changed text becomes a
+// new version of that waiver.
+type WaiverInput struct {
+	WaiverID string
+	Title    string
+	Body     string
+	Required bool
+}
+
+// WaiverSignatureInput is a volunteer signing a waiver version. DocumentHash,
+// when given, must match the version's hash so volunteers sign what they saw.
+type WaiverSignatureInput struct {
+	WaiverVersionID string
+	SignedName      string
+	DocumentHash    string
+}
+
+// SignatureEvidence is where a signature came from
+type SignatureEvidence struct {
+	IPAddress string
+	UserAgent string
+}
+
+// HashWaiverBody returns the hex SHA-256 of a waiver's text
+func HashWaiverBody(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetEventWaivers returns the event's active waivers with their current text
+func (s *Service) GetEventWaivers(ctx context.Context, eventID string) ([]*Waiver, error) {
+	waivers, err := s.repo.GetEventWaivers(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	active := make([]*Waiver, 0, len(waivers))
+	for _, w := range waivers {
+		if w.RetiredAt == nil {
+			active = append(active, w)
+		}
+	}
+	return active, nil
+}
+
+// PublishWaiver attaches a new waiver to an event, or updates an existing
+// one. Changing the text adds a version; volunteers who signed an earlier
+// version must sign again before registering.
+func (s *Service) PublishWaiver(ctx context.Context, userID, eventID string, input WaiverInput) (*Waiver, error) {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, userID, event.StaffActionManage); err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(input.Title)
+	body := strings.TrimSpace(input.Body)
+	if title == "" || body == "" {
+		return nil, fmt.Errorf("%w: a waiver needs a title and text", ErrInvalidWaiver)
+	}
+	now := time.Now()
+
+	if input.WaiverID == "" {
+		w := &Waiver{
+			ID:        uuid.New().String(),
+			EventID:   eventID,
+			Title:     title,
+			Required:  input.Required,
+			CreatedBy: userID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		w.CurrentVersion = newWaiverVersion(w.ID, 1, body, userID, now)
+		if err := s.repo.CreateWaiver(ctx, w); err != nil {
+			return nil, fmt.Errorf("failed to create waiver: %w", err)
+		}
+		s.logger.Info("waiver published", "eventID", eventID, "waiverID", w.ID, "by", userID)
+		return w, nil
+	}
+
+	w, err := s.repo.GetWaiver(ctx, input.WaiverID)
+	if err != nil {
+		return nil, err
+	}
+	if w.EventID != eventID {
+		return nil, ErrWaiverNotFound
+	}
+	if w.RetiredAt != nil {
+		return nil, ErrWaiverRetired
+	}
+	w.Title = title
+	w.Required = input.Required
+	w.UpdatedAt = now
+	var version *WaiverVersion
+	if w.CurrentVersion == nil || w.CurrentVersion.ContentHash != HashWaiverBody(body) {
+		next := 1
+		if w.CurrentVersion != nil {
+			next = w.CurrentVersion.Version + 1
+		}
+		version = newWaiverVersion(w.ID, next, body, userID, now)
+		w.CurrentVersion = version
+	}
+	if err := s.repo.UpdateWaiver(ctx, w, version); err != nil {
+		return nil, fmt.Errorf("failed to update waiver: %w", err)
+	}
+	s.logger.Info("waiver updated", "eventID", eventID, "waiverID", w.ID, "by", userID, "newVersion", version != nil)
+	return w, nil
+}
+
+// RetireWaiver stops a waiver from being shown or required. Its signatures
+// are kept.
+func (s *Service) RetireWaiver(ctx context.Context, userID, waiverID string) (*Waiver, error) {
+	w, err := s.repo.GetWaiver(ctx, waiverID)
+	if err != nil {
+		return nil, err
+	}
+	evt, err := s.eventService.GetEvent(ctx, w.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, userID, event.StaffActionManage); err != nil {
+		return nil, err
+	}
+	if w.RetiredAt != nil {
+		return w, nil
+	}
+
+	now := time.Now()
+	w.RetiredAt = &now
+	w.UpdatedAt = now
+	if err := s.repo.UpdateWaiver(ctx, w, nil); err != nil {
+		return nil, fmt.Errorf("failed to retire waiver: %w", err)
+	}
+	return w, nil
+}
+
+// SignWaiver records the volunteer signing a waiver ahead of registering. If
+// they are already registered the signature is linked to the registration.
+func (s *Service) SignWaiver(ctx context.Context, userID string, input WaiverSignatureInput, evidence SignatureEvidence) (*WaiverSignature, error) {
+	version, err := s.repo.GetWaiverVersion(ctx, input.WaiverVersionID)
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.repo.GetWaiver(ctx, version.WaiverID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetUserWaiverSignatures(ctx, userID, w.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load waiver signatures: %w", err)
+	}
+	signatures, err := planWaiverSignatures([]*Waiver{w}, existing, []WaiverSignatureInput{input}, userID, w.EventID, evidence, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(signatures) == 0 {
+		// Already signed; return the original signature
+		for _, sig := range existing {
+			if sig.WaiverVersionID == input.WaiverVersionID {
+				return sig, nil
+			}
+		}
+	}
+	sig := signatures[0]
+
+	regs, err := s.repo.GetRegistrationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registrations: %w", err)
+	}
+	for _, reg := range regs {
+		if reg.EventID == w.EventID && reg.Status != StatusCancelled && reg.Status != StatusDeclined {
+			id := reg.ID
+			sig.RegistrationID = &id
+			break
+		}
+	}
+
+	if err := s.repo.CreateWaiverSignatures(ctx, signatures); err != nil {
+		return nil, fmt.Errorf("failed to record signature: %w", err)
+	}
+	return sig, nil
+}
+
+// GetEventWaiverSignatures lists every signature collected for the event's
+// waivers, for the event's staff
+func (s *Service) GetEventWaiverSignatures(ctx context.Context, staffID, eventID string) ([]*WaiverSignature, error) {
+	evt, err := s.eventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err := s.eventService.Authorize(ctx, evt, staffID, event.StaffActionView); err != nil {
+		return nil, err
+	}
+	return s.repo.GetEventWaiverSignatures(ctx, eventID)
+}
+
+// GetMyWaiverSignatures returns the volunteer's signatures for an event
+func (s *Service) GetMyWaiverSignatures(ctx context.Context, userID, eventID string) ([]*WaiverSignature, error) {
+	return s.repo.GetUserWaiverSignatures(ctx, userID, eventID)
+}
+
+// checkWaivers returns the new signatures a registration needs to record, or
+// an error naming the required waivers still unsigned
+func (s *Service) checkWaivers(ctx context.Context, userID, eventID string, inputs []WaiverSignatureInput, evidence SignatureEvidence) ([]*WaiverSignature, error) {
+	waivers, err := s.GetEventWaivers(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load waivers: %w", err)
+	}
+	if len(waivers) == 0 && len(inputs) == 0 {
+		return nil, nil
+	}
+	existing, err := s.repo.GetUserWaiverSignatures(ctx, userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load waiver signatures: %w", err)
+	}
+
+	signatures, err := planWaiverSignatures(waivers, existing, inputs, userID, eventID, evidence, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if missing := unsignedWaivers(waivers, existing, signatures); len(missing) > 0 {
+		titles := make([]string, 0, len(missing))
+		for _, w := range missing {
+			titles = append(titles, w.Title)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrWaiverUnsigned, strings.Join(titles, ", "))
+	}
+	return signatures, nil
+}
+
+// recordWaiverSignatures stores signatures made while registering and links
+// ones made beforehand to the new registration
+func (s *Service) recordWaiverSignatures(ctx context.Context, reg *Registration, signatures []*WaiverSignature) error {
+	for _, sig := range signatures {
+		id := reg.ID
+		sig.RegistrationID = &id
+	}
+	if len(signatures) > 0 {
+		if err := s.repo.CreateWaiverSignatures(ctx, signatures); err != nil {
+			return fmt.Errorf("failed to record waiver signatures: %w", err)
+		}
+	}
+	if err := s.repo.LinkWaiverSignatures(ctx, reg.UserID, reg.EventID, reg.ID); err != nil {
+		return fmt.Errorf("failed to link waiver signatures: %w", err)
+	}
+	return nil
+}
+
+// planWaiverSignatures turns signature inputs into signatures against the
+// given waivers' current versions. Versions the user already signed are
+// skipped.
+func planWaiverSignatures(waivers []*Waiver, existing []*WaiverSignature, inputs []WaiverSignatureInput, userID, eventID string, evidence SignatureEvidence, now time.Time) ([]*WaiverSignature, error) {
+	byVersion := make(map[string]*Waiver, len(waivers))
+	for _, w := range waivers {
+		if w.CurrentVersion != nil {
+			byVersion[w.CurrentVersion.ID] = w
+		}
+	}
+	signed := make(map[string]bool, len(existing))
+	for _, sig := range existing {
+		signed[sig.WaiverVersionID] = true
+	}
+
+	var out []*WaiverSignature
+	for _, in := range inputs {
+		w, ok := byVersion[in.WaiverVersionID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSignature, errWaiverVersionGone)
+		}
+		if w.RetiredAt != nil {
+			return nil, ErrWaiverRetired
+		}
+		name := strings.Join(strings.Fields(in.SignedName), " ")
+		if name == "" {
+			return nil, fmt.Errorf("%w: type your full name to sign %q", ErrInvalidSignature, w.Title)
+		}
+		if in.DocumentHash != "" && !strings.EqualFold(in.DocumentHash, w.CurrentVersion.ContentHash) {
+			return nil, ErrWaiverOutdated
+		}
+		if signed[in.WaiverVersionID] {
+			continue
+		}
+		signed[in.WaiverVersionID] = true
+		out = append(out, &WaiverSignature{
+			ID:              uuid.New().String(),
+			WaiverVersionID: w.CurrentVersion.ID,
+			WaiverID:        w.ID,
+			WaiverTitle:     w.Title,
+			Version:         w.CurrentVersion.Version,
+			EventID:         eventID,
+			UserID:          userID,
+			SignedName:      name,
+			IPAddress:       evidence.IPAddress,
+			UserAgent:       evidence.UserAgent,
+			DocumentHash:    w.CurrentVersion.ContentHash,
+			SignedAt:        now,
+		})
+	}
+	return out, nil
+}
+
+// unsignedWaivers returns the required waivers whose current version has no
+// signature among existing or planned
+func unsignedWaivers(waivers []*Waiver, existing, planned []*WaiverSignature) []*Waiver {
+	signed := map[string]bool{}
+	for _, sig := range existing {
+		signed[sig.WaiverVersionID] = true
+	}
+	for _, sig := range planned {
+		signed[sig.WaiverVersionID] = true
+	}
+	var missing []*Waiver
+	for _, w := range waivers {
+		if w.Required && w.RetiredAt == nil && w.CurrentVersion != nil && !signed[w.CurrentVersion.ID] {
+			missing = append(missing, w)
+		}
+	}
+	return missing
+}
+
+func newWaiverVersion(waiverID string, version int, body, createdBy string, now time.Time) *WaiverVersion {
+	return &WaiverVersion{
+		ID:          uuid.New().String(),
+		WaiverID:    waiverID,
+		Version:     version,
+		Body:        body,
+		ContentHash: HashWaiverBody(body),
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+	}
+}
@@ -0,0 +1,61 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanWaiverSignatures(t *testing.T) {
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	liability := &Waiver{ID: "w1", Title: "Liability", Required: true, CurrentVersion: newWaiverVersion("w1", 2, "Current text", "org", now)}
+	photo := &Waiver{ID: "w2", Title: "Photo release", CurrentVersion: newWaiverVersion("w2", 1, "Photos", "org", now)}
+	waivers := []*Waiver{liability, photo}
+	evidence := SignatureEvidence{IPAddress: "203.0.113.7", UserAgent: "test"}
+
+	t.Run("required waivers block until signed", func(t *testing.T) {
+		missing := unsignedWaivers(waivers, nil, nil)
+		require.Len(t, missing, 1)
+		assert.Equal(t, "Liability", missing[0].Title, "optional waivers don't block")
+
+		oldVersion := []*WaiverSignature{{WaiverVersionID: "an-earlier-version"}}
+		assert.Len(t, unsignedWaivers(waivers, oldVersion, nil), 1, "signing an earlier version doesn't count")
+	})
+
+	t.Run("signatures capture evidence and the document hash", func(t *testing.T) {
+		sigs, err := planWaiverSignatures(waivers, nil, []WaiverSignatureInput{
+			{WaiverVersionID: liability.CurrentVersion.ID, SignedName: "  Ana   Müller ", DocumentHash: HashWaiverBody("Current text")},
+		}, "u1", "e1", evidence, now)
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		assert.Equal(t, "Ana Müller", sigs[0].SignedName)
+		assert.Equal(t, 2, sigs[0].Version)
+		assert.Equal(t, liability.CurrentVersion.ContentHash, sigs[0].DocumentHash)
+		assert.Equal(t, "203.0.113.7", sigs[0].IPAddress)
+		assert.Empty(t, unsignedWaivers(waivers, nil, sigs))
+	})
+
+	t.Run("already signed versions are skipped", func(t *testing.T) {
+		existing := []*WaiverSignature{{WaiverVersionID: liability.CurrentVersion.ID}}
+		sigs, err := planWaiverSignatures(waivers, existing, []WaiverSignatureInput{
+			{WaiverVersionID: liability.CurrentVersion.ID, SignedName: "Ana"},
+		}, "u1", "e1", evidence, now)
+		require.NoError(t, err)
+		assert.Empty(t, sigs)
+	})
+
+	t.Run("invalid signatures", func(t *testing.T) {
+		_, err := planWaiverSignatures(waivers, nil, []WaiverSignatureInput{{WaiverVersionID: liability.CurrentVersion.ID, SignedName: " "}}, "u1", "e1", evidence, now)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+
+		_, err = planWaiverSignatures(waivers, nil, []WaiverSignatureInput{{WaiverVersionID: "stale", SignedName: "Ana"}}, "u1", "e1", evidence, now)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+
+		_, err = planWaiverSignatures(waivers, nil, []WaiverSignatureInput{
+			{WaiverVersionID: liability.CurrentVersion.ID, SignedName: "Ana", DocumentHash: HashWaiverBody("Old text")},
+		}, "u1", "e1", evidence, now)
+		assert.ErrorIs(t, err, ErrWaiverOutdated)
+	})
+}
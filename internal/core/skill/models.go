@@ -0,0 +1,50 @@
+package skill
+
+import "strings"
+
+// Skill is an entry in the skills catalogue. Profiles and event
+// requirements that name a skill by any of its synonyms are filed under it,
+// so "First Aid", "first-aid" and "CPR/First Aid" all match.
+type Skill struct {
+	ID       string `json:"id" db:"id"`
+	Slug     string `json:"slug" db:"slug"`
+	Name     string `json:"name" db:"name"`
+	Category string `json:"category" db:"category"`
+	// Synonyms are the other names the skill is known by
+	Synonyms []string `json:"synonyms"`
+}
+
+// Normalize reduces a skill name to the key synonyms are matched on:
+// lowercase ASCII letters and digits in words separated by single spaces.
+// It mirrors the normalize_skill_name SQL function.
+func Normalize(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		space = true
+	}
+	return b.String()
+}
+
+// MatchKeys returns the keys a skill matches on: its catalogue entry when it
+// has one, and its normalized name. Two skills match when they share a key,
+// so an entry matches whatever it was called and a name the catalogue
+// doesn't know still matches itself.
+func MatchKeys(id *string, name string) []string {
+	keys := make([]string, 0, 2)
+	if id != nil && *id != "" {
+		keys = append(keys, "id:"+*id)
+	}
+	if n := Normalize(name); n != "" {
+		keys = append(keys, "name:"+n)
+	}
+	return keys
+}
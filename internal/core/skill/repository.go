@@ -0,0 +1,14 @@
+package skill
+
+import "context"
+
+// Repository defines the data operations behind the skills catalogue
+type Repository interface {
+	// FindBySynonyms returns the entries that have a synonym with each of
+	// the normalized keys, by key; keys matching nothing are left out
+	FindBySynonyms(ctx context.Context, keys []string) (map[string]*Skill, error)
+	// Search returns entries with a synonym that has a word starting with
+	// the normalized prefix, those whose name starts with it first. An empty
+	// prefix matches every entry; an empty category matches every category.
+	Search(ctx context.Context, prefix, category string, limit int) ([]*Skill, error)
+}
@@ -0,0 +1,63 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	defaultSuggestions = 10
+	maxSuggestions     = 50
+)
+
+// Service looks skills up in the catalogue
+type Service struct {
+	repo Repository
+}
+
+// NewService creates a new skills catalogue service.
+func NewService(repo Repository) *Service {
+	if repo == nil {
+		panic("skill repository is required")
+	}
+	return &Service{repo: repo}
+}
+
+// Resolve maps free-text skill names onto catalogue entries, keyed by
+// Normalize(name). Names the catalogue doesn't know are left out.
+func (s *Service) Resolve(ctx context.Context, names []string) (map[string]*Skill, error) {
+	keys := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if key := Normalize(name); key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return map[string]*Skill{}, nil
+	}
+	found, err := s.repo.FindBySynonyms(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve skills: %w", err)
+	}
+	return found, nil
+}
+
+// Autocomplete suggests catalogue entries for what a user has typed so far
+func (s *Service) Autocomplete(ctx context.Context, query string, category *string, limit int) ([]*Skill, error) {
+	if limit <= 0 {
+		limit = defaultSuggestions
+	}
+	limit = min(limit, maxSuggestions)
+	cat := ""
+	if category != nil {
+		cat = strings.TrimSpace(*category)
+	}
+	skills, err := s.repo.Search(ctx, Normalize(query), cat, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search skills: %w", err)
+	}
+	return skills, nil
+}
@@ -0,0 +1,115 @@
+package skill
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepo is an in-memory Repository for service tests
+type fakeRepo struct {
+	skills  []*Skill
+	lookups [][]string
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{skills: []*Skill{
+		{ID: "first-aid", Slug: "first-aid", Name: "First Aid", Category: "medical", Synonyms: []string{"CPR/First Aid", "Basic First Aid"}},
+		{ID: "cpr", Slug: "cpr", Name: "CPR", Category: "medical"},
+		{ID: "forklift", Slug: "forklift", Name: "Forklift Operation", Category: "logistics", Synonyms: []string{"Forklift"}},
+	}}
+}
+
+func (f *fakeRepo) FindBySynonyms(ctx context.Context, keys []string) (map[string]*Skill, error) {
+	f.lookups = append(f.lookups, keys)
+	found := map[string]*Skill{}
+	for _, key := range keys {
+		for _, s := range f.skills {
+			for _, name := range append([]string{s.Name}, s.Synonyms...) {
+				if Normalize(name) == key {
+					found[key] = s
+				}
+			}
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeRepo) Search(ctx context.Context, prefix, category string, limit int) ([]*Skill, error) {
+	var out []*Skill
+	for _, s := range f.skills {
+		if category != "" && s.Category != category {
+			continue
+		}
+		if prefix == "" || strings.HasPrefix(Normalize(s.Name), prefix) {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func TestNormalize(t *testing.T) {
+	for in, want := range map[string]string{
+		"First Aid":        "first aid",
+		"first-aid":        "first aid",
+		"  FIRST   aid ":   "first aid",
+		"CPR/First Aid":    "cpr first aid",
+		"C++":              "c",
+		"Café":             "caf",
+		"":                 "",
+		"--":               "",
+		"Grade 2 Teaching": "grade 2 teaching",
+	} {
+		assert.Equal(t, want, Normalize(in), in)
+	}
+}
+
+func TestMatchKeys(t *testing.T) {
+	id := "first-aid"
+	empty := ""
+	assert.Equal(t, []string{"id:first-aid", "name:cpr first aid"}, MatchKeys(&id, "CPR/First Aid"))
+	assert.Equal(t, []string{"name:first aid"}, MatchKeys(&empty, "first-aid"))
+	assert.Empty(t, MatchKeys(nil, " / "))
+}
+
+func TestService_Resolve(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeRepo()
+	service := NewService(repo)
+
+	found, err := service.Resolve(ctx, []string{"First Aid", "first-aid", "CPR/First Aid", "Juggling", "  "})
+	require.NoError(t, err)
+	assert.Equal(t, "first-aid", found["first aid"].ID)
+	assert.Equal(t, "first-aid", found["cpr first aid"].ID)
+	assert.NotContains(t, found, "juggling")
+	assert.Equal(t, [][]string{{"first aid", "cpr first aid", "juggling"}}, repo.lookups, "keys are deduplicated")
+
+	found, err = service.Resolve(ctx, []string{"", "!!"})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	assert.Len(t, repo.lookups, 1, "nothing to look up")
+}
+
+func TestService_Autocomplete(t *testing.T) {
+	ctx := context.Background()
+	service := NewService(newFakeRepo())
+
+	skills, err := service.Autocomplete(ctx, "  FORK", nil, 0)
+	require.NoError(t, err)
+	require.Len(t, skills, 1)
+	assert.Equal(t, "forklift", skills[0].Slug)
+
+	medical := "medical"
+	skills, err = service.Autocomplete(ctx, "", &medical, 1)
+	require.NoError(t, err)
+	require.Len(t, skills, 1)
+	assert.Equal(t, "cpr", skills[0].Slug)
+}
@@ -12,6 +12,7 @@ var (
 	ErrPermissionDenied   = errors.New("permission denied")
 	ErrSkillAlreadyExists = errors.New("skill already exists")
 	ErrSkillNotFound      = errors.New("skill not found")
+	ErrTrainingNotFound   = errors.New("training not found")
 )
 
 // Location represents user's location data.
@@ -52,7 +53,8 @@ type Interest struct {
 type Skill struct {
 	ID          string
 	Name        string
-	Proficiency string // BEGINNER|INTERMEDIATE|ADVANCED|EXPERT
+	SkillID     *string // skills catalogue entry, if the name resolved to one
+	Proficiency string  // BEGINNER|INTERMEDIATE|ADVANCED|EXPERT
 	Verified    bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -75,6 +77,12 @@ type UserProfile struct {
 	UpdatedAt         time.Time
 	LastActiveAt      *time.Time
 	IsVerified        bool
+	// DateOfBirth is only ever shown to the user themselves
+	DateOfBirth *time.Time
+	// BackgroundCheckVerifiedAt is set by an admin once a check has cleared
+	BackgroundCheckVerifiedAt *time.Time
+	BackgroundCheckExpiresAt  *time.Time
+	Trainings                 []Training
 }
 
 // ActivityLog represents a user activity record.
@@ -88,15 +96,33 @@ type ActivityLog struct {
 	CreatedAt time.Time
 }
 
+// Training is a course the volunteer has completed.
+type Training struct {
+	ID          string
+	Name        string
+	CompletedAt time.Time
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+}
+
 // UpdateProfileInput represents editable fields of a profile.
 type UpdateProfileInput struct {
-	Name     *string
-	Bio      *string
-	Location *Location
+	Name        *string
+	Bio         *string
+	Location    *Location
+	DateOfBirth *time.Time
+}
+
+// TrainingInput represents input to record a completed training.
+type TrainingInput struct {
+	Name        string
+	CompletedAt time.Time
+	ExpiresAt   *time.Time
 }
 
 // SkillInput represents input to add a skill.
 type SkillInput struct {
 	Name        string
+	SkillID     *string
 	Proficiency string
 }
@@ -3,6 +3,12 @@ package user
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/volunteersync/backend/internal/core/auth"
+	"github.com/volunteersync/backend/internal/core/skill"
 )
 
 // UserStore abstracts persistence for user domain.
@@ -19,6 +25,10 @@ type UserStore interface {
 	RemoveSkill(ctx context.Context, userID, skillID string) error
 	ListSkills(ctx context.Context, userID string) ([]Skill, error)
 
+	AddTraining(ctx context.Context, userID string, in TrainingInput) (*Training, error)
+	RemoveTraining(ctx context.Context, userID, trainingID string) error
+	ListTrainings(ctx context.Context, userID string) ([]Training, error)
+
 	UpdatePrivacy(ctx context.Context, userID string, in PrivacySettings) (PrivacySettings, error)
 	UpdateNotifications(ctx context.Context, userID string, in NotificationPreferences) (NotificationPreferences, error)
 
@@ -50,6 +60,12 @@ type AuditLogger interface {
 	Warn(ctx context.Context, action string, details map[string]any)
 }
 
+// SkillResolver maps free-text skill names onto the skills catalogue. The
+// skill service implements it.
+type SkillResolver interface {
+	Resolve(ctx context.Context, names []string) (map[string]*skill.Skill, error)
+}
+
 // UserSearchFilter mirrors GraphQL input for service layer.
 type UserSearchFilter struct {
 	Skills       []string
@@ -65,6 +81,7 @@ type Service struct {
 	files    FileService
 	notifier NotificationService
 	audit    AuditLogger
+	skills   SkillResolver
 }
 
 // NewService constructs a user Service.
@@ -88,19 +105,31 @@ func (s *Service) GetProfileWithDetails(ctx context.Context, userID, requesterID
 	if err != nil {
 		return nil, err
 	}
-	// Load interests and skills
+	// Load interests, skills and roles
 	if ints, err := s.store.ListUserInterests(ctx, userID); err == nil {
 		prof.Interests = ints
 	}
 	if skills, err := s.store.ListSkills(ctx, userID); err == nil {
 		prof.Skills = skills
 	}
+	if trainings, err := s.store.ListTrainings(ctx, userID); err == nil {
+		prof.Trainings = trainings
+	}
+	if roles, err := s.store.GetUserRoles(ctx, userID); err == nil {
+		prof.Roles = roles
+	}
 	filtered := filterProfileByPrivacy(*prof, requesterID, requesterRoles)
 	return &filtered, nil
 }
 
 // UpdateProfile updates editable fields of the current user.
 func (s *Service) UpdateProfile(ctx context.Context, userID string, input UpdateProfileInput) (*UserProfile, error) {
+	if dob := input.DateOfBirth; dob != nil {
+		now := time.Now()
+		if dob.After(now) || dob.Before(now.AddDate(-120, 0, 0)) {
+			return nil, fmt.Errorf("%w: date of birth is out of range", ErrInvalidInput)
+		}
+	}
 	prof, err := s.store.UpdateProfile(ctx, userID, input)
 	if err != nil {
 		return nil, err
@@ -131,8 +160,25 @@ func (s *Service) UpdateInterests(ctx context.Context, userID string, interestID
 	return prof, nil
 }
 
-// AddSkill adds a new skill.
+// SetSkillResolver connects the skills catalogue. Without one, skills keep
+// the names they were added with.
+func (s *Service) SetSkillResolver(r SkillResolver) {
+	s.skills = r
+}
+
+// AddSkill adds a new skill. Names the catalogue knows are filed under its
+// entry and take its canonical name.
 func (s *Service) AddSkill(ctx context.Context, userID string, in SkillInput) (*UserProfile, error) {
+	if s.skills != nil {
+		found, err := s.skills.Resolve(ctx, []string{in.Name})
+		if err != nil {
+			return nil, err
+		}
+		if sk, ok := found[skill.Normalize(in.Name)]; ok {
+			in.Name = sk.Name
+			in.SkillID = &sk.ID
+		}
+	}
 	if _, err := s.store.AddSkill(ctx, userID, in); err != nil {
 		return nil, err
 	}
@@ -163,6 +209,99 @@ func (s *Service) RemoveSkill(ctx context.Context, userID, skillID string) (*Use
 	return prof, nil
 }
 
+// AddTraining records a training the user has completed.
+func (s *Service) AddTraining(ctx context.Context, userID string, in TrainingInput) (*UserProfile, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return nil, fmt.Errorf("%w: training name is required", ErrInvalidInput)
+	}
+	if in.CompletedAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: training can't be completed in the future", ErrInvalidInput)
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(in.CompletedAt) {
+		return nil, fmt.Errorf("%w: training must expire after it was completed", ErrInvalidInput)
+	}
+	if _, err := s.store.AddTraining(ctx, userID, in); err != nil {
+		return nil, err
+	}
+	prof, err := s.store.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	prof.Trainings, _ = s.store.ListTrainings(ctx, userID)
+	if s.audit != nil {
+		s.audit.Info(ctx, "user.training.add", map[string]any{"user_id": userID, "name": in.Name})
+	}
+	return prof, nil
+}
+
+// RemoveTraining removes a recorded training by ID.
+func (s *Service) RemoveTraining(ctx context.Context, userID, trainingID string) (*UserProfile, error) {
+	if err := s.store.RemoveTraining(ctx, userID, trainingID); err != nil {
+		return nil, err
+	}
+	prof, err := s.store.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	prof.Trainings, _ = s.store.ListTrainings(ctx, userID)
+	if s.audit != nil {
+		s.audit.Info(ctx, "user.training.remove", map[string]any{"user_id": userID, "training_id": trainingID})
+	}
+	return prof, nil
+}
+
+// ListTrainings returns the user's completed trainings.
+func (s *Service) ListTrainings(ctx context.Context, userID string) ([]Training, error) {
+	return s.store.ListTrainings(ctx, userID)
+}
+
+// GrantRole adds a role to a user. Callers must hold the user.manage permission.
+func (s *Service) GrantRole(ctx context.Context, adminID, userID, role string) (*UserProfile, error) {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if !auth.IsValidRole(role) {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidInput, role)
+	}
+	roles, err := s.store.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, role) {
+		if err := s.store.SetUserRoles(ctx, userID, append(roles, role), adminID); err != nil {
+			return nil, err
+		}
+	}
+	if s.audit != nil {
+		s.audit.Info(ctx, "user.role.grant", map[string]any{"user_id": userID, "role": role, "by": adminID})
+	}
+	return s.GetProfileWithDetails(ctx, userID, adminID, nil)
+}
+
+// RevokeRole removes a role from a user. Admins cannot revoke their own ADMIN role.
+func (s *Service) RevokeRole(ctx context.Context, adminID, userID, role string) (*UserProfile, error) {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if !auth.IsValidRole(role) {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidInput, role)
+	}
+	if userID == adminID && role == auth.RoleAdmin {
+		return nil, fmt.Errorf("%w: cannot revoke your own admin role", ErrPermissionDenied)
+	}
+	roles, err := s.store.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(roles, role) {
+		remaining := slices.DeleteFunc(slices.Clone(roles), func(r string) bool { return r == role })
+		if err := s.store.SetUserRoles(ctx, userID, remaining, adminID); err != nil {
+			return nil, err
+		}
+	}
+	if s.audit != nil {
+		s.audit.Warn(ctx, "user.role.revoke", map[string]any{"user_id": userID, "role": role, "by": adminID})
+	}
+	return s.GetProfileWithDetails(ctx, userID, adminID, nil)
+}
+
 // UpdatePrivacySettings updates privacy settings.
 func (s *Service) UpdatePrivacySettings(ctx context.Context, userID string, in PrivacySettings) (*UserProfile, error) {
 	_, err := s.store.UpdatePrivacy(ctx, userID, in)
@@ -243,6 +382,11 @@ func filterProfileByPrivacy(p UserProfile, requesterID string, requesterRoles []
 	if p.ID == requesterID {
 		return p
 	}
+	// Eligibility facts are private regardless of profile visibility
+	p.DateOfBirth = nil
+	p.BackgroundCheckVerifiedAt = nil
+	p.BackgroundCheckExpiresAt = nil
+	p.Trainings = nil
 	// Non-owner filtering
 	switch p.Privacy.ProfileVisibility {
 	case "PRIVATE":
@@ -3,10 +3,13 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/skill"
 )
 
 // Mock implementations for testing
@@ -80,6 +83,27 @@ func (m *mockUserStore) ListSkills(ctx context.Context, userID string) ([]Skill,
 	return nil, args.Error(1)
 }
 
+func (m *mockUserStore) AddTraining(ctx context.Context, userID string, in TrainingInput) (*Training, error) {
+	args := m.Called(ctx, userID, in)
+	if tr := args.Get(0); tr != nil {
+		return tr.(*Training), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserStore) RemoveTraining(ctx context.Context, userID, trainingID string) error {
+	args := m.Called(ctx, userID, trainingID)
+	return args.Error(0)
+}
+
+func (m *mockUserStore) ListTrainings(ctx context.Context, userID string) ([]Training, error) {
+	args := m.Called(ctx, userID)
+	if trainings := args.Get(0); trainings != nil {
+		return trainings.([]Training), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *mockUserStore) UpdatePrivacy(ctx context.Context, userID string, in PrivacySettings) (PrivacySettings, error) {
 	args := m.Called(ctx, userID, in)
 	return args.Get(0).(PrivacySettings), args.Error(1)
@@ -305,6 +329,65 @@ func TestService_AddSkill(t *testing.T) {
 		store.AssertExpectations(t)
 		audit.AssertExpectations(t)
 	})
+
+	t.Run("catalogue skills take their canonical name", func(t *testing.T) {
+		service, store, _, _, audit := createTestService()
+		service.SetSkillResolver(stubSkillResolver{"cpr first aid": {ID: "first-aid", Name: "First Aid"}})
+		firstAid := "first-aid"
+		canonical := SkillInput{Name: "First Aid", SkillID: &firstAid, Proficiency: "ADVANCED"}
+		store.On("AddSkill", ctx, "user1", canonical).Return(&Skill{ID: "skill2", Name: "First Aid", SkillID: &firstAid}, nil).Once()
+		store.On("GetProfile", ctx, "user1").Return(&UserProfile{ID: "user1"}, nil).Once()
+		store.On("ListSkills", ctx, "user1").Return([]Skill{}, nil).Once()
+		audit.On("Info", ctx, "user.skill.add", map[string]any{"user_id": "user1", "name": "First Aid"}).Once()
+
+		_, err := service.AddSkill(ctx, "user1", SkillInput{Name: "CPR/First Aid", Proficiency: "ADVANCED"})
+
+		require.NoError(t, err)
+		store.AssertExpectations(t)
+	})
+}
+
+// stubSkillResolver resolves the normalized names it holds
+type stubSkillResolver map[string]*skill.Skill
+
+func (r stubSkillResolver) Resolve(ctx context.Context, names []string) (map[string]*skill.Skill, error) {
+	return r, nil
+}
+
+func TestService_AddTraining(t *testing.T) {
+	service, store, _, _, audit := createTestService()
+	ctx := context.Background()
+
+	completed := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	expires := completed.AddDate(2, 0, 0)
+	input := TrainingInput{Name: "First Aid", CompletedAt: completed, ExpiresAt: &expires}
+	training := &Training{ID: "tr1", Name: "First Aid", CompletedAt: completed, ExpiresAt: &expires}
+
+	t.Run("successful training addition", func(t *testing.T) {
+		store.On("AddTraining", ctx, "user1", input).Return(training, nil).Once()
+		store.On("GetProfile", ctx, "user1").Return(&UserProfile{ID: "user1"}, nil).Once()
+		store.On("ListTrainings", ctx, "user1").Return([]Training{*training}, nil).Once()
+		audit.On("Info", ctx, "user.training.add", map[string]any{"user_id": "user1", "name": "First Aid"}).Once()
+
+		result, err := service.AddTraining(ctx, "user1", TrainingInput{Name: " First Aid ", CompletedAt: completed, ExpiresAt: &expires})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Trainings, 1)
+		store.AssertExpectations(t)
+		audit.AssertExpectations(t)
+	})
+
+	t.Run("invalid training input", func(t *testing.T) {
+		before := completed.AddDate(0, 0, -1)
+		for _, in := range []TrainingInput{
+			{Name: " ", CompletedAt: completed},
+			{Name: "CPR", CompletedAt: time.Now().Add(48 * time.Hour)},
+			{Name: "CPR", CompletedAt: completed, ExpiresAt: &before},
+		} {
+			_, err := service.AddTraining(ctx, "user1", in)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		}
+	})
 }
 
 func TestService_RemoveSkill(t *testing.T) {
@@ -470,4 +553,61 @@ func TestService_SearchUsers(t *testing.T) {
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
-}
\ No newline at end of file
+}
+func TestService_GrantRole(t *testing.T) {
+	service, store, _, _, audit := createTestService()
+	ctx := context.Background()
+
+	t.Run("adds missing role", func(t *testing.T) {
+		store.On("GetUserRoles", ctx, "user1").Return([]string{"VOLUNTEER"}, nil).Once()
+		store.On("SetUserRoles", ctx, "user1", []string{"VOLUNTEER", "ORGANIZER"}, "admin1").Return(nil).Once()
+		audit.On("Info", ctx, "user.role.grant", map[string]any{"user_id": "user1", "role": "ORGANIZER", "by": "admin1"}).Once()
+		store.On("GetProfile", ctx, "user1").Return(&UserProfile{ID: "user1"}, nil).Once()
+		store.On("ListUserInterests", ctx, "user1").Return([]Interest{}, nil).Once()
+		store.On("ListSkills", ctx, "user1").Return([]Skill{}, nil).Once()
+		store.On("ListTrainings", ctx, "user1").Return([]Training{}, nil).Once()
+		store.On("GetUserRoles", ctx, "user1").Return([]string{"VOLUNTEER", "ORGANIZER"}, nil).Once()
+
+		result, err := service.GrantRole(ctx, "admin1", "user1", "organizer")
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"VOLUNTEER", "ORGANIZER"}, result.Roles)
+		store.AssertExpectations(t)
+		audit.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown role", func(t *testing.T) {
+		_, err := service.GrantRole(ctx, "admin1", "user1", "superuser")
+
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	})
+}
+
+func TestService_RevokeRole(t *testing.T) {
+	service, store, _, _, audit := createTestService()
+	ctx := context.Background()
+
+	t.Run("removes role", func(t *testing.T) {
+		store.On("GetUserRoles", ctx, "user1").Return([]string{"VOLUNTEER", "ORGANIZER"}, nil).Once()
+		store.On("SetUserRoles", ctx, "user1", []string{"VOLUNTEER"}, "admin1").Return(nil).Once()
+		audit.On("Warn", ctx, "user.role.revoke", map[string]any{"user_id": "user1", "role": "ORGANIZER", "by": "admin1"}).Once()
+		store.On("GetProfile", ctx, "user1").Return(&UserProfile{ID: "user1"}, nil).Once()
+		store.On("ListUserInterests", ctx, "user1").Return([]Interest{}, nil).Once()
+		store.On("ListSkills", ctx, "user1").Return([]Skill{}, nil).Once()
+		store.On("ListTrainings", ctx, "user1").Return([]Training{}, nil).Once()
+		store.On("GetUserRoles", ctx, "user1").Return([]string{"VOLUNTEER"}, nil).Once()
+
+		result, err := service.RevokeRole(ctx, "admin1", "user1", "ORGANIZER")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"VOLUNTEER"}, result.Roles)
+		store.AssertExpectations(t)
+		audit.AssertExpectations(t)
+	})
+
+	t.Run("admin cannot revoke own admin role", func(t *testing.T) {
+		_, err := service.RevokeRole(ctx, "admin1", "admin1", "ADMIN")
+
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
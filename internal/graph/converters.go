@@ -1,9 +1,19 @@
 package graph
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/volunteersync/backend/internal/core/admin"
 	"github.com/volunteersync/backend/internal/core/auth"
 	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/hours"
+	"github.com/volunteersync/backend/internal/core/organization"
 	"github.com/volunteersync/backend/internal/core/registration"
+	"github.com/volunteersync/backend/internal/core/skill"
 	usercore "github.com/volunteersync/backend/internal/core/user"
 	"github.com/volunteersync/backend/internal/graph/model"
 )
@@ -26,12 +36,23 @@ func toGraphRegistration(r *registration.Registration) *model.Registration {
 		ApprovalNotes:      &r.ApprovalNotes,
 		CancellationReason: &r.CancellationReason,
 		AttendanceStatus:   model.AttendanceStatus(r.AttendanceStatus),
-		CanCancel:          r.Status == registration.StatusConfirmed, // Example logic
-		CanCheckIn:         r.Status == registration.StatusConfirmed, // Example logic
+		LateCancellation:   r.LateCancellation,
+		TeamRegistrationID: r.TeamRegistrationID,
 		CreatedAt:          r.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:          r.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		Skills:             []*model.UserSkill{}, // Will be resolved by field resolver if needed
 		Interests:          []*model.Interest{},  // Will be resolved by field resolver if needed
+		Answers:            []*model.RegistrationAnswer{},
+	}
+
+	if r.EmergencyContactName != "" || r.EmergencyContactPhone != "" {
+		modelReg.EmergencyContact = &model.EmergencyContact{Name: r.EmergencyContactName, Phone: r.EmergencyContactPhone}
+	}
+	if r.DietaryRestrictions != "" {
+		modelReg.DietaryRestrictions = &r.DietaryRestrictions
+	}
+	if r.AccessibilityNeeds != "" {
+		modelReg.AccessibilityNeeds = &r.AccessibilityNeeds
 	}
 
 	if r.ConfirmedAt != nil {
@@ -50,6 +71,14 @@ func toGraphRegistration(r *registration.Registration) *model.Registration {
 		s := r.CompletedAt.Format("2006-01-02T15:04:05Z07:00")
 		modelReg.CompletedAt = &s
 	}
+	if r.ReconfirmationRequestedAt != nil {
+		s := r.ReconfirmationRequestedAt.Format("2006-01-02T15:04:05Z07:00")
+		modelReg.ReconfirmationRequestedAt = &s
+	}
+	if r.ReconfirmedAt != nil {
+		s := r.ReconfirmedAt.Format("2006-01-02T15:04:05Z07:00")
+		modelReg.ReconfirmedAt = &s
+	}
 
 	return modelReg
 }
@@ -210,11 +239,26 @@ func toGraphUser(profile *usercore.UserProfile) *model.User {
 		user.Skills[i] = &model.Skill{
 			ID:          skill.ID,
 			Name:        skill.Name,
+			SkillID:     skill.SkillID,
 			Proficiency: model.SkillProficiency(skill.Proficiency),
 			Verified:    skill.Verified,
 		}
 	}
 
+	// Convert trainings
+	user.Trainings = make([]*model.Training, len(profile.Trainings))
+	for i, tr := range profile.Trainings {
+		user.Trainings[i] = &model.Training{
+			ID:          tr.ID,
+			Name:        tr.Name,
+			CompletedAt: tr.CompletedAt.Format(dateLayout),
+			ExpiresAt:   formatDate(tr.ExpiresAt),
+		}
+	}
+	user.DateOfBirth = formatDate(profile.DateOfBirth)
+	user.BackgroundCheckVerifiedAt = profile.BackgroundCheckVerifiedAt
+	user.BackgroundCheckExpiresAt = profile.BackgroundCheckExpiresAt
+
 	// Create public profile
 	user.PublicProfile = toGraphPublicProfile(profile)
 
@@ -265,6 +309,7 @@ func toGraphPublicProfile(profile *usercore.UserProfile) *model.PublicProfile {
 		publicProfile.Skills[i] = &model.Skill{
 			ID:          skill.ID,
 			Name:        skill.Name,
+			SkillID:     skill.SkillID,
 			Proficiency: model.SkillProficiency(skill.Proficiency),
 			Verified:    skill.Verified,
 		}
@@ -298,23 +343,27 @@ func toDomainCreateEventInput(input model.CreateEventInput) event.CreateEventInp
 			ZipCode:      input.Location.ZipCode,
 			Instructions: input.Location.Instructions,
 			IsRemote:     input.Location.IsRemote,
+			TimeZone:     input.Location.TimeZone,
 		},
 		Capacity: event.EventCapacityInput{
 			Minimum:         input.Capacity.Minimum,
 			Maximum:         input.Capacity.Maximum,
 			WaitlistEnabled: input.Capacity.WaitlistEnabled,
 		},
-		Category:       convertGraphQLEventCategory(input.Category),
-		TimeCommitment: convertGraphQLTimeCommitmentType(input.TimeCommitment),
+		Category:       event.EventCategory(input.Category),
+		TimeCommitment: event.TimeCommitmentType(input.TimeCommitment),
 		Tags:           input.Tags,
 		RegistrationSettings: event.RegistrationSettingsInput{
-			OpensAt:              input.RegistrationSettings.OpensAt,
-			ClosesAt:             input.RegistrationSettings.ClosesAt,
-			RequiresApproval:     input.RegistrationSettings.RequiresApproval,
-			AllowWaitlist:        input.RegistrationSettings.AllowWaitlist,
-			ConfirmationRequired: input.RegistrationSettings.ConfirmationRequired,
-			CancellationDeadline: input.RegistrationSettings.CancellationDeadline,
+			OpensAt:               input.RegistrationSettings.OpensAt,
+			ClosesAt:              input.RegistrationSettings.ClosesAt,
+			RequiresApproval:      input.RegistrationSettings.RequiresApproval,
+			AllowWaitlist:         input.RegistrationSettings.AllowWaitlist,
+			ConfirmationRequired:  input.RegistrationSettings.ConfirmationRequired,
+			CancellationDeadline:  input.RegistrationSettings.CancellationDeadline,
+			BlockLateCancellation: input.RegistrationSettings.BlockLateCancellation,
+			ReconfirmationHours:   input.RegistrationSettings.ReconfirmationHours,
 		},
+		OrganizationID: input.OrganizationID,
 	}
 
 	// Handle coordinates
@@ -375,13 +424,44 @@ func toDomainUpdateEventInput(input model.UpdateEventInput) event.UpdateEventInp
 		Title:            input.Title,
 		Description:      input.Description,
 		ShortDescription: input.ShortDescription,
+		StartTime:        input.StartTime,
+		EndTime:          input.EndTime,
 		Tags:             input.Tags,
 	}
 
+	if input.Capacity != nil {
+		result.Capacity = &event.EventCapacityInput{
+			Minimum:         input.Capacity.Minimum,
+			Maximum:         input.Capacity.Maximum,
+			WaitlistEnabled: input.Capacity.WaitlistEnabled,
+		}
+	}
+
+	if input.RegistrationSettings != nil {
+		result.RegistrationSettings = &event.RegistrationSettingsInput{
+			OpensAt:               input.RegistrationSettings.OpensAt,
+			ClosesAt:              input.RegistrationSettings.ClosesAt,
+			RequiresApproval:      input.RegistrationSettings.RequiresApproval,
+			AllowWaitlist:         input.RegistrationSettings.AllowWaitlist,
+			ConfirmationRequired:  input.RegistrationSettings.ConfirmationRequired,
+			CancellationDeadline:  input.RegistrationSettings.CancellationDeadline,
+			BlockLateCancellation: input.RegistrationSettings.BlockLateCancellation,
+			ReconfirmationHours:   input.RegistrationSettings.ReconfirmationHours,
+		}
+	}
+
+	if input.CapacityReduction != nil {
+		result.CapacityReduction = event.CapacityReductionPolicy(*input.CapacityReduction)
+	}
+
 	if input.Category != nil {
-		category := convertGraphQLEventCategory(*input.Category)
+		category := event.EventCategory(*input.Category)
 		result.Category = &category
 	}
+	if input.TimeCommitment != nil {
+		timeCommitment := event.TimeCommitmentType(*input.TimeCommitment)
+		result.TimeCommitment = &timeCommitment
+	}
 
 	if input.Location != nil {
 		result.Location = &event.EventLocationInput{
@@ -393,6 +473,7 @@ func toDomainUpdateEventInput(input model.UpdateEventInput) event.UpdateEventInp
 			ZipCode:      input.Location.ZipCode,
 			Instructions: input.Location.Instructions,
 			IsRemote:     input.Location.IsRemote,
+			TimeZone:     input.Location.TimeZone,
 		}
 
 		if input.Location.Coordinates != nil {
@@ -440,6 +521,7 @@ func toGraphQLEvent(e *event.Event) *model.Event {
 		Description:      e.Description,
 		ShortDescription: e.ShortDescription,
 		OrganizerID:      e.OrganizerID,
+		OrganizationID:   e.OrganizationID,
 		Status:           convertDomainEventStatus(e.Status),
 		StartTime:        e.StartTime,
 		EndTime:          e.EndTime,
@@ -452,7 +534,10 @@ func toGraphQLEvent(e *event.Event) *model.Event {
 			ZipCode:      e.Location.ZipCode,
 			Instructions: e.Location.Instructions,
 			IsRemote:     e.Location.IsRemote,
+			TimeZone:     optionalString(e.Location.TimeZone),
 		},
+		LocalStartTime: localTimeString(e.StartTime, e.LocalZone()),
+		LocalEndTime:   localTimeString(e.EndTime, e.LocalZone()),
 		Capacity: &model.EventCapacity{
 			Minimum:         e.Capacity.Minimum,
 			Maximum:         e.Capacity.Maximum,
@@ -465,18 +550,20 @@ func toGraphQLEvent(e *event.Event) *model.Event {
 			PhysicalRequirements: e.Requirements.PhysicalRequirements,
 			Interests:            e.Requirements.Interests,
 		},
-		Category:       convertDomainEventCategory(e.Category),
-		TimeCommitment: convertDomainTimeCommitmentType(e.TimeCommitment),
+		Category:       string(e.Category),
+		TimeCommitment: string(e.TimeCommitment),
 		Tags:           e.Tags,
 		Slug:           e.Slug,
 		ShareURL:       e.ShareURL,
 		RegistrationSettings: &model.RegistrationSettings{
-			OpensAt:              e.RegistrationSettings.OpensAt,
-			ClosesAt:             e.RegistrationSettings.ClosesAt,
-			RequiresApproval:     e.RegistrationSettings.RequiresApproval,
-			AllowWaitlist:        e.RegistrationSettings.AllowWaitlist,
-			ConfirmationRequired: e.RegistrationSettings.ConfirmationRequired,
-			CancellationDeadline: e.RegistrationSettings.CancellationDeadline,
+			OpensAt:               e.RegistrationSettings.OpensAt,
+			ClosesAt:              e.RegistrationSettings.ClosesAt,
+			RequiresApproval:      e.RegistrationSettings.RequiresApproval,
+			AllowWaitlist:         e.RegistrationSettings.AllowWaitlist,
+			ConfirmationRequired:  e.RegistrationSettings.ConfirmationRequired,
+			CancellationDeadline:  e.RegistrationSettings.CancellationDeadline,
+			BlockLateCancellation: e.RegistrationSettings.BlockLateCancellation,
+			ReconfirmationHours:   e.RegistrationSettings.ReconfirmationHours,
 		},
 		Images:               []*model.EventImage{},
 		Announcements:        []*model.EventAnnouncement{},
@@ -501,6 +588,7 @@ func toGraphQLEvent(e *event.Event) *model.Event {
 		result.Requirements.Skills = append(result.Requirements.Skills, &model.SkillRequirement{
 			ID:          skill.ID,
 			Skill:       skill.Skill,
+			SkillID:     skill.SkillID,
 			Proficiency: convertDomainSkillProficiency(skill.Proficiency),
 			Required:    skill.Required,
 		})
@@ -534,108 +622,6 @@ func toGraphQLEvent(e *event.Event) *model.Event {
 
 // Enum converters
 
-func convertGraphQLEventCategory(category model.EventCategory) event.EventCategory {
-	switch category {
-	case model.EventCategoryCommunityService:
-		return event.EventCategoryCommunityService
-	case model.EventCategoryEnvironmental:
-		return event.EventCategoryEnvironment
-	case model.EventCategoryEducation:
-		return event.EventCategoryEducation
-	case model.EventCategoryHealthWellness:
-		return event.EventCategoryHealth
-	case model.EventCategoryDisasterRelief:
-		return event.EventCategoryDisasterRelief
-	case model.EventCategoryAnimalWelfare:
-		return event.EventCategoryAnimalWelfare
-	case model.EventCategoryArtsCulture:
-		return event.EventCategoryArtsCulture
-	case model.EventCategoryTechnology:
-		return event.EventCategoryTechnology
-	case model.EventCategorySportsRecreation:
-		return event.EventCategorySportsRecreation
-	case model.EventCategoryFoodHunger:
-		return event.EventCategoryFoodSecurity
-	case model.EventCategoryYouthDevelopment:
-		return event.EventCategoryYouthMentoring
-	case model.EventCategorySeniorCare:
-		return event.EventCategorySeniorCare
-	case model.EventCategoryHomelessServices:
-		return event.EventCategoryCommunityService // Map to community service
-	case model.EventCategoryFundraising:
-		return event.EventCategoryCommunityService // Map to community service
-	case model.EventCategoryAdvocacy:
-		return event.EventCategoryCommunityService // Map to community service
-	default:
-		return event.EventCategoryCommunityService
-	}
-}
-
-func convertDomainEventCategory(category event.EventCategory) model.EventCategory {
-	switch category {
-	case event.EventCategoryCommunityService:
-		return model.EventCategoryCommunityService
-	case event.EventCategoryEnvironment:
-		return model.EventCategoryEnvironmental
-	case event.EventCategoryEducation:
-		return model.EventCategoryEducation
-	case event.EventCategoryHealth:
-		return model.EventCategoryHealthWellness
-	case event.EventCategoryDisasterRelief:
-		return model.EventCategoryDisasterRelief
-	case event.EventCategoryAnimalWelfare:
-		return model.EventCategoryAnimalWelfare
-	case event.EventCategoryArtsCulture:
-		return model.EventCategoryArtsCulture
-	case event.EventCategoryTechnology:
-		return model.EventCategoryTechnology
-	case event.EventCategorySportsRecreation:
-		return model.EventCategorySportsRecreation
-	case event.EventCategoryFoodSecurity:
-		return model.EventCategoryFoodHunger
-	case event.EventCategoryYouthMentoring:
-		return model.EventCategoryYouthDevelopment
-	case event.EventCategorySeniorCare:
-		return model.EventCategorySeniorCare
-	default:
-		return model.EventCategoryCommunityService
-	}
-}
-
-func convertGraphQLTimeCommitmentType(timeCommitment model.TimeCommitmentType) event.TimeCommitmentType {
-	switch timeCommitment {
-	case model.TimeCommitmentTypeOneTime:
-		return event.TimeCommitmentOneTime
-	case model.TimeCommitmentTypeWeekly:
-		return event.TimeCommitmentShortTerm // Map weekly to short term
-	case model.TimeCommitmentTypeMonthly:
-		return event.TimeCommitmentMediumTerm // Map monthly to medium term
-	case model.TimeCommitmentTypeSeasonal:
-		return event.TimeCommitmentLongTerm // Map seasonal to long term
-	case model.TimeCommitmentTypeOngoing:
-		return event.TimeCommitmentOngoing
-	default:
-		return event.TimeCommitmentOneTime
-	}
-}
-
-func convertDomainTimeCommitmentType(timeCommitment event.TimeCommitmentType) model.TimeCommitmentType {
-	switch timeCommitment {
-	case event.TimeCommitmentOneTime:
-		return model.TimeCommitmentTypeOneTime
-	case event.TimeCommitmentShortTerm:
-		return model.TimeCommitmentTypeWeekly
-	case event.TimeCommitmentMediumTerm:
-		return model.TimeCommitmentTypeMonthly
-	case event.TimeCommitmentLongTerm:
-		return model.TimeCommitmentTypeSeasonal
-	case event.TimeCommitmentOngoing:
-		return model.TimeCommitmentTypeOngoing
-	default:
-		return model.TimeCommitmentTypeOneTime
-	}
-}
-
 func convertGraphQLSkillProficiency(proficiency model.SkillProficiency) event.SkillProficiency {
 	switch proficiency {
 	case model.SkillProficiencyBeginner:
@@ -739,10 +725,11 @@ func convertGraphQLDaysOfWeek(days []model.DayOfWeek) []event.DayOfWeek {
 // toDomainEventSearchFilter converts GraphQL search filter to domain search filter
 func toDomainEventSearchFilter(filter model.EventSearchFilter) event.EventSearchFilter {
 	result := event.EventSearchFilter{
-		Query:     filter.Query,
-		Skills:    filter.Skills,
-		Interests: filter.Interests,
-		Tags:      filter.Tags,
+		Query:          filter.Query,
+		Skills:         filter.Skills,
+		Interests:      filter.Interests,
+		Tags:           filter.Tags,
+		OrganizationID: filter.OrganizationID,
 	}
 
 	// Convert status enums
@@ -757,7 +744,7 @@ func toDomainEventSearchFilter(filter model.EventSearchFilter) event.EventSearch
 	if filter.Category != nil {
 		result.Categories = make([]event.EventCategory, len(filter.Category))
 		for i, category := range filter.Category {
-			result.Categories[i] = convertGraphQLEventCategory(category)
+			result.Categories[i] = event.EventCategory(category)
 		}
 	}
 
@@ -765,7 +752,7 @@ func toDomainEventSearchFilter(filter model.EventSearchFilter) event.EventSearch
 	if filter.TimeCommitment != nil {
 		result.TimeCommitment = make([]event.TimeCommitmentType, len(filter.TimeCommitment))
 		for i, tc := range filter.TimeCommitment {
-			result.TimeCommitment[i] = convertGraphQLTimeCommitmentType(tc)
+			result.TimeCommitment[i] = event.TimeCommitmentType(tc)
 		}
 	}
 
@@ -923,3 +910,992 @@ func authUserToUserProfile(u *auth.User) *usercore.UserProfile {
 		LastActiveAt:      u.LastLogin,                        // Use last login as last active
 	}
 }
+
+// toGraphPasskeyCeremony converts a passkey ceremony to its GraphQL model
+func toGraphPasskeyCeremony(c *auth.PasskeyCeremony) *model.PasskeyCeremony {
+	if c == nil {
+		return nil
+	}
+	return &model.PasskeyCeremony{
+		SessionID: c.SessionID,
+		Options:   string(c.Options),
+	}
+}
+
+// toGraphPasskey converts a stored passkey credential to its GraphQL model
+func toGraphPasskey(c *auth.PasskeyCredential) *model.Passkey {
+	if c == nil {
+		return nil
+	}
+	transports := c.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+	return &model.Passkey{
+		ID:             c.ID,
+		Name:           c.Name,
+		Transports:     transports,
+		BackupEligible: c.BackupEligible,
+		CreatedAt:      c.CreatedAt,
+		LastUsedAt:     c.LastUsedAt,
+	}
+}
+
+// toGraphEventStaff converts an event staff entry to its GraphQL model.
+// Event and user are stubs filled in by field resolvers.
+func toGraphEventStaff(s *event.EventStaff) *model.EventStaff {
+	if s == nil {
+		return nil
+	}
+	staff := &model.EventStaff{
+		ID:         s.ID,
+		Event:      &model.Event{ID: s.EventID},
+		Email:      s.Email,
+		Role:       model.EventStaffRole(s.Role),
+		Status:     model.EventStaffStatus(s.Status),
+		InvitedAt:  s.InvitedAt,
+		AcceptedAt: s.AcceptedAt,
+	}
+	if s.UserID != nil {
+		staff.User = &model.User{ID: *s.UserID}
+	}
+	return staff
+}
+
+// toGraphEventUpdate converts a change log entry to its GraphQL model.
+// updatedBy is filled in by a field resolver.
+func toGraphEventUpdate(u *event.EventUpdate) *model.EventUpdate {
+	if u == nil {
+		return nil
+	}
+	return &model.EventUpdate{
+		ID:         u.ID,
+		UpdatedBy:  &model.User{ID: u.UpdatedBy},
+		FieldName:  u.FieldName,
+		OldValue:   u.OldValue,
+		NewValue:   u.NewValue,
+		UpdateType: toGraphUpdateType(u),
+		CreatedAt:  u.CreatedAt,
+	}
+}
+
+// toGraphEventUpdateConnection converts a page of change history to its
+// GraphQL connection.
+func toGraphEventUpdateConnection(page *event.EventUpdateConnection) *model.EventUpdateConnection {
+	edges := make([]*model.EventUpdateEdge, len(page.Edges))
+	for i, edge := range page.Edges {
+		edges[i] = &model.EventUpdateEdge{
+			Node:   toGraphEventUpdate(edge.Node),
+			Cursor: edge.Cursor,
+		}
+	}
+	return &model.EventUpdateConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			HasNextPage:     page.PageInfo.HasNextPage,
+			HasPreviousPage: page.PageInfo.HasPreviousPage,
+			StartCursor:     page.PageInfo.StartCursor,
+			EndCursor:       page.PageInfo.EndCursor,
+		},
+	}
+}
+
+// toGraphUpdateType maps the stored change kind onto the API's coarser
+// enum, naming status changes by the status the event moved to.
+func toGraphUpdateType(u *event.EventUpdate) model.UpdateType {
+	if u.UpdateType == event.UpdateTypeStatusChange && u.NewValue != nil {
+		switch event.EventStatus(*u.NewValue) {
+		case event.EventStatusPublished:
+			return model.UpdateTypePublished
+		case event.EventStatusCancelled:
+			return model.UpdateTypeCancelled
+		case event.EventStatusCompleted:
+			return model.UpdateTypeCompleted
+		}
+	}
+	return model.UpdateTypeUpdated
+}
+
+// toGraphOrganization converts an organization to its GraphQL model.
+// Members and myRole are filled in by field resolvers.
+func toGraphOrganization(o *organization.Organization) *model.Organization {
+	if o == nil {
+		return nil
+	}
+	return &model.Organization{
+		ID:                 o.ID,
+		Name:               o.Name,
+		Slug:               o.Slug,
+		Description:        o.Description,
+		Website:            o.Website,
+		ContactEmail:       o.ContactEmail,
+		LogoURL:            o.LogoURL,
+		VerificationStatus: model.OrganizationVerificationStatus(o.VerificationStatus),
+		VerifiedAt:         o.VerifiedAt,
+		CreatedAt:          o.CreatedAt,
+		UpdatedAt:          o.UpdatedAt,
+	}
+}
+
+// toGraphOrganizationMember converts a membership; the user is a stub for the field resolver
+func toGraphOrganizationMember(m *organization.Member) *model.OrganizationMember {
+	if m == nil {
+		return nil
+	}
+	return &model.OrganizationMember{
+		User:     &model.User{ID: m.UserID},
+		Role:     model.OrganizationRole(m.Role),
+		JoinedAt: m.JoinedAt,
+	}
+}
+
+func toDomainCreateOrganizationInput(input model.CreateOrganizationInput) organization.CreateOrganizationInput {
+	return organization.CreateOrganizationInput{
+		Name:         input.Name,
+		Description:  input.Description,
+		Website:      input.Website,
+		ContactEmail: input.ContactEmail,
+		LogoURL:      input.LogoURL,
+	}
+}
+
+func toDomainUpdateOrganizationInput(input model.UpdateOrganizationInput) organization.UpdateOrganizationInput {
+	return organization.UpdateOrganizationInput{
+		Name:         input.Name,
+		Description:  input.Description,
+		Website:      input.Website,
+		ContactEmail: input.ContactEmail,
+		LogoURL:      input.LogoURL,
+	}
+}
+
+// toGraphRegistrationConflict converts a conflict as seen from the queried event
+func toGraphRegistrationConflict(d *registration.ConflictDetail) *model.RegistrationConflict {
+	if d == nil {
+		return nil
+	}
+	suggestions := make([]*model.Event, 0, len(d.Suggestions))
+	for _, evt := range d.Suggestions {
+		suggestions = append(suggestions, toGraphQLEvent(evt))
+	}
+	return &model.RegistrationConflict{
+		ID:               d.Conflict.ID,
+		ConflictingEvent: toGraphQLEvent(d.ConflictingEvent),
+		ConflictType:     model.ConflictType(d.Conflict.ConflictType),
+		Severity:         model.ConflictSeverity(d.Conflict.Severity),
+		Resolved:         d.Conflict.Resolved,
+		Suggestions:      suggestions,
+	}
+}
+
+// toGraphAttendanceRecord pairs an attendance record with its registration
+func toGraphAttendanceRecord(reg *registration.Registration, rec *registration.AttendanceRecord) *model.AttendanceRecord {
+	if reg == nil || rec == nil {
+		return nil
+	}
+	out := &model.AttendanceRecord{Registration: toGraphRegistration(reg)}
+	if rec.CheckedInAt != nil {
+		s := rec.CheckedInAt.Format("2006-01-02T15:04:05Z07:00")
+		out.CheckedInAt = &s
+	}
+	if rec.CheckedInBy != nil {
+		out.CheckedInBy = &model.User{ID: *rec.CheckedInBy} // Only ID, resolver will fetch full data
+	}
+	if rec.CheckedOutAt != nil {
+		s := rec.CheckedOutAt.Format("2006-01-02T15:04:05Z07:00")
+		out.CheckedOutAt = &s
+		hours := rec.HoursWorked()
+		out.HoursWorked = &hours
+	}
+	out.LocationVerified = rec.LocationVerified
+	if rec.Notes != "" {
+		out.Notes = &rec.Notes
+	}
+	return out
+}
+
+// toDomainBulkAttendanceEntries converts bulk attendance rows
+func toDomainBulkAttendanceEntries(entries []*model.BulkAttendanceEntryInput) ([]registration.BulkAttendanceEntry, error) {
+	out := make([]registration.BulkAttendanceEntry, 0, len(entries))
+	for i, e := range entries {
+		checkedInAt, err := parseDateTime(e.CheckedInAt)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		out = append(out, registration.BulkAttendanceEntry{
+			RegistrationID: derefString(e.RegistrationID),
+			Email:          derefString(e.Email),
+			Status:         registration.AttendanceStatus(e.Status),
+			CheckedInAt:    checkedInAt,
+			Notes:          derefString(e.Notes),
+		})
+	}
+	return out, nil
+}
+
+func toGraphBulkAttendanceReport(r *registration.BulkAttendanceResult) *model.BulkAttendanceReport {
+	if r == nil {
+		return nil
+	}
+	out := &model.BulkAttendanceReport{
+		Applied:   r.Applied,
+		Updated:   r.Updated,
+		Unchanged: r.Unchanged,
+		Failed:    r.Failed,
+		Rows:      make([]*model.BulkAttendanceRowResult, 0, len(r.Rows)),
+	}
+	for _, row := range r.Rows {
+		res := &model.BulkAttendanceRowResult{
+			Row:          row.Row,
+			Outcome:      model.BulkRowOutcome(row.Outcome),
+			Registration: toGraphRegistration(row.Registration),
+		}
+		if row.RegistrationID != "" {
+			res.RegistrationID = &row.RegistrationID
+		}
+		if row.Email != "" {
+			res.Email = &row.Email
+		}
+		if row.Error != "" {
+			res.Error = &row.Error
+		}
+		out.Rows = append(out.Rows, res)
+	}
+	return out
+}
+
+// toDomainDeviceLocation converts an optional device position
+func toDomainDeviceLocation(c *model.CoordinatesInput) *event.Coordinates {
+	if c == nil {
+		return nil
+	}
+	return &event.Coordinates{Latitude: c.Lat, Longitude: c.Lng}
+}
+
+func toGraphRegistrationTicket(t *registration.Ticket) *model.RegistrationTicket {
+	if t == nil {
+		return nil
+	}
+	return &model.RegistrationTicket{
+		Registration: toGraphRegistration(t.Registration),
+		Token:        t.Token,
+		QRPngURL:     registration.TicketQRPath(t.Registration.ID, "png"),
+		QRSVGURL:     registration.TicketQRPath(t.Registration.ID, "svg"),
+	}
+}
+
+func toGraphTicketScanResult(r *registration.ScanResult) *model.TicketScanResult {
+	if r == nil {
+		return nil
+	}
+	return &model.TicketScanResult{
+		Outcome:      model.TicketScanOutcome(r.Outcome),
+		Registration: toGraphRegistration(r.Registration),
+		ScannedAt:    r.Scan.ScannedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// toGraphHoursEntry converts a ledger entry; user, event and organization are resolved by field resolvers
+func toGraphHoursEntry(e *hours.Entry) *model.VolunteerHoursEntry {
+	if e == nil {
+		return nil
+	}
+	out := &model.VolunteerHoursEntry{
+		ID:             e.ID,
+		User:           &model.User{ID: e.UserID},
+		AdjustsEntryID: e.AdjustsEntryID,
+		Source:         model.HoursSource(e.Source),
+		Status:         model.HoursStatus(e.Status),
+		Hours:          e.Hours,
+		Category:       e.Category,
+		Description:    e.Description,
+		Reason:         e.Reason,
+		ActivityDate:   e.ActivityDate.Format("2006-01-02T15:04:05Z07:00"),
+		ReviewNotes:    e.ReviewNotes,
+		CreatedAt:      e.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if e.EventID != nil {
+		out.Event = &model.Event{ID: *e.EventID}
+	}
+	if e.OrganizationID != nil {
+		out.Organization = &model.Organization{ID: *e.OrganizationID}
+	}
+	if e.ReviewedAt != nil {
+		s := e.ReviewedAt.Format("2006-01-02T15:04:05Z07:00")
+		out.ReviewedAt = &s
+	}
+	return out
+}
+
+func toGraphHoursSummary(summary *hours.Summary, entries []*hours.Entry) *model.HoursSummary {
+	out := &model.HoursSummary{
+		TotalHours:         summary.TotalHours,
+		PendingHours:       summary.PendingHours,
+		EventsParticipated: summary.EventsParticipated,
+		ByEvent:            make([]*model.EventHoursBreakdown, 0, len(summary.ByEvent)),
+		ByCategory:         make([]*model.CategoryHoursBreakdown, 0, len(summary.ByCategory)),
+		Entries:            make([]*model.VolunteerHoursEntry, 0, len(entries)),
+	}
+	for _, eh := range summary.ByEvent {
+		out.ByEvent = append(out.ByEvent, &model.EventHoursBreakdown{Event: &model.Event{ID: eh.EventID}, Hours: eh.Hours})
+	}
+	for _, ch := range summary.ByCategory {
+		out.ByCategory = append(out.ByCategory, &model.CategoryHoursBreakdown{Category: ch.Category, Hours: ch.Hours})
+	}
+	for _, e := range entries {
+		out.Entries = append(out.Entries, toGraphHoursEntry(e))
+	}
+	return out
+}
+
+// toDomainDateRange converts an optional date range argument
+func toDomainDateRange(r *model.DateRangeInput) (hours.DateRange, error) {
+	var rng hours.DateRange
+	if r == nil {
+		return rng, nil
+	}
+	var err error
+	if rng.Start, err = parseDateTime(&r.Start); err != nil {
+		return rng, err
+	}
+	if rng.End, err = parseDateTime(&r.End); err != nil {
+		return rng, err
+	}
+	return rng, nil
+}
+
+// toGraphHoursCertificate converts a certificate; publicURL prefixes the verification link
+func toGraphHoursCertificate(c *hours.Certificate, publicURL string) *model.HoursCertificate {
+	if c == nil {
+		return nil
+	}
+	out := &model.HoursCertificate{
+		ID:            c.ID,
+		Code:          hours.FormatCertificateCode(c.Code),
+		Scope:         model.HoursCertificateScope(c.Scope),
+		VolunteerName: c.VolunteerName,
+		TotalHours:    c.TotalHours,
+		Lines:         make([]*model.HoursCertificateLine, 0, len(c.Lines)),
+		IssuedAt:      c.IssuedAt.Format("2006-01-02T15:04:05Z07:00"),
+		PDFURL:        hours.CertificatePDFPath(c.Code),
+		VerifyURL:     strings.TrimRight(publicURL, "/") + hours.CertificateVerifyPath(c.Code),
+	}
+	if c.RangeStart != nil {
+		s := c.RangeStart.Format("2006-01-02T15:04:05Z07:00")
+		out.RangeStart = &s
+	}
+	if c.RangeEnd != nil {
+		s := c.RangeEnd.Format("2006-01-02T15:04:05Z07:00")
+		out.RangeEnd = &s
+	}
+	for _, l := range c.Lines {
+		line := &model.HoursCertificateLine{
+			Title:        l.Title,
+			Organization: l.Organization,
+			Date:         l.Date.Format("2006-01-02T15:04:05Z07:00"),
+			Hours:        l.Hours,
+		}
+		if l.EventID != nil {
+			line.Event = &model.Event{ID: *l.EventID}
+		}
+		if l.Location != "" {
+			location := l.Location
+			line.Location = &location
+		}
+		out.Lines = append(out.Lines, line)
+	}
+	return out
+}
+
+// toGraphAdminUser converts an account for the moderation console
+func toGraphAdminUser(u *admin.UserAccount) *model.AdminUser {
+	if u == nil {
+		return nil
+	}
+	roles := make([]model.UserRole, 0, len(u.Roles))
+	for _, role := range u.Roles {
+		roles = append(roles, model.UserRole(role))
+	}
+	return &model.AdminUser{
+		ID:                  u.ID,
+		Email:               u.Email,
+		Name:                u.Name,
+		IsVerified:          u.IsVerified,
+		EmailVerified:       u.EmailVerified,
+		FailedLoginAttempts: u.FailedLoginAttempts,
+		LockedUntil:         u.LockedUntil,
+		IsLocked:            u.IsLocked(time.Now()),
+		LastLogin:           u.LastLogin,
+		Roles:               roles,
+		CreatedAt:           u.CreatedAt,
+	}
+}
+
+// toGraphAdminAuditEntry converts an audit entry; details are returned as a JSON object string
+func toGraphAdminAuditEntry(e *admin.AuditEntry) *model.AdminAuditEntry {
+	if e == nil {
+		return nil
+	}
+	details, _ := json.Marshal(e.Details)
+	return &model.AdminAuditEntry{
+		ID:         e.ID,
+		Sequence:   int(e.Sequence),
+		ActorID:    e.ActorID,
+		Action:     string(e.Action),
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Reason:     e.Reason,
+		Details:    string(details),
+		CreatedAt:  e.CreatedAt,
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}
+}
+
+func toDomainAdminUserFilter(f *model.AdminUserFilter) admin.UserFilter {
+	var filter admin.UserFilter
+	if f == nil {
+		return filter
+	}
+	filter.Query = f.Query
+	filter.Verified = f.Verified
+	if f.LockedOnly != nil {
+		filter.LockedOnly = *f.LockedOnly
+	}
+	if f.Role != nil {
+		role := string(*f.Role)
+		filter.Role = &role
+	}
+	return filter
+}
+
+func toDomainAdminAuditFilter(f *model.AdminAuditFilter) admin.AuditFilter {
+	var filter admin.AuditFilter
+	if f == nil {
+		return filter
+	}
+	filter.ActorID = f.ActorID
+	filter.TargetType = f.TargetType
+	filter.TargetID = f.TargetID
+	if f.Action != nil {
+		action := admin.Action(*f.Action)
+		filter.Action = &action
+	}
+	return filter
+}
+
+// toDomainRegistrationQuestions converts a registration form definition
+func toDomainRegistrationQuestions(questions []*model.RegistrationQuestionInput) []*registration.Question {
+	out := make([]*registration.Question, 0, len(questions))
+	for _, q := range questions {
+		dq := &registration.Question{
+			ID:       derefString(q.ID),
+			Label:    q.Label,
+			HelpText: derefString(q.HelpText),
+			Type:     registration.QuestionType(q.Type),
+			Required: q.Required != nil && *q.Required,
+			Options:  q.Options,
+		}
+		if v := q.Validation; v != nil {
+			dq.Validation = registration.QuestionValidation{
+				MinLength:        v.MinLength,
+				MaxLength:        v.MaxLength,
+				Pattern:          derefString(v.Pattern),
+				Min:              v.Min,
+				Max:              v.Max,
+				MinSelections:    v.MinSelections,
+				MaxSelections:    v.MaxSelections,
+				EarliestDate:     derefString(v.EarliestDate),
+				LatestDate:       derefString(v.LatestDate),
+				AllowedFileTypes: v.AllowedFileTypes,
+				MaxFileBytes:     int64(derefInt(v.MaxFileBytes)),
+			}
+		}
+		out = append(out, dq)
+	}
+	return out
+}
+
+func toGraphRegistrationQuestion(q *registration.Question) *model.RegistrationQuestion {
+	v := q.Validation
+	validation := &model.RegistrationQuestionValidation{
+		MinLength:        v.MinLength,
+		MaxLength:        v.MaxLength,
+		Min:              v.Min,
+		Max:              v.Max,
+		MinSelections:    v.MinSelections,
+		MaxSelections:    v.MaxSelections,
+		AllowedFileTypes: v.AllowedFileTypes,
+	}
+	if v.Pattern != "" {
+		validation.Pattern = &v.Pattern
+	}
+	if v.EarliestDate != "" {
+		validation.EarliestDate = &v.EarliestDate
+	}
+	if v.LatestDate != "" {
+		validation.LatestDate = &v.LatestDate
+	}
+	if v.MaxFileBytes > 0 {
+		n := int(v.MaxFileBytes)
+		validation.MaxFileBytes = &n
+	}
+
+	out := &model.RegistrationQuestion{
+		ID:         q.ID,
+		Position:   q.Position,
+		Label:      q.Label,
+		Type:       model.RegistrationQuestionType(q.Type),
+		Required:   q.Required,
+		Options:    q.Options,
+		Validation: validation,
+	}
+	if out.Options == nil {
+		out.Options = []string{}
+	}
+	if q.HelpText != "" {
+		out.HelpText = &q.HelpText
+	}
+	return out
+}
+
+// toDomainRegistrationDetails reads a registration form, including any
+// uploaded answer files
+func toDomainRegistrationDetails(input model.RegisterForEventInput) (registration.RegistrationDetails, error) {
+	details := registration.RegistrationDetails{
+		PersonalMessage:     derefString(input.PersonalMessage),
+		DietaryRestrictions: derefString(input.DietaryRestrictions),
+		AccessibilityNeeds:  derefString(input.AccessibilityNeeds),
+	}
+	if input.EmergencyContact != nil {
+		details.EmergencyContactName = input.EmergencyContact.Name
+		details.EmergencyContactPhone = input.EmergencyContact.Phone
+	}
+	for _, a := range input.Answers {
+		answer := registration.AnswerInput{
+			QuestionID: a.QuestionID,
+			Value:      derefString(a.Value),
+			Choices:    a.Values,
+		}
+		if a.File != nil {
+			if a.File.Size > registration.MaxAnswerFileBytes {
+				return details, fmt.Errorf("%w: %s is larger than %d MB", registration.ErrInvalidAnswer, a.File.Filename, registration.MaxAnswerFileBytes/(1024*1024))
+			}
+			data, err := io.ReadAll(io.LimitReader(a.File.File, registration.MaxAnswerFileBytes+1))
+			if err != nil {
+				return details, fmt.Errorf("failed to read %s: %w", a.File.Filename, err)
+			}
+			answer.File = &registration.FileUpload{Name: a.File.Filename, ContentType: a.File.ContentType, Data: data}
+		}
+		details.Answers = append(details.Answers, answer)
+	}
+	for _, sig := range input.WaiverSignatures {
+		details.Waivers = append(details.Waivers, toDomainWaiverSignature(sig))
+	}
+	details.GuardianEmail = derefString(input.GuardianEmail)
+	details.ShiftIDs = input.ShiftIds
+	return details, nil
+}
+
+// toGraphRegistrationAnswers pairs answers with their questions, in form order
+func toGraphRegistrationAnswers(answers []*registration.Answer, questions []*registration.Question, publicURL string) []*model.RegistrationAnswer {
+	byQuestion := make(map[string]*registration.Answer, len(answers))
+	for _, a := range answers {
+		byQuestion[a.QuestionID] = a
+	}
+	out := make([]*model.RegistrationAnswer, 0, len(answers))
+	for _, q := range questions {
+		a, ok := byQuestion[q.ID]
+		if !ok {
+			continue
+		}
+		ga := &model.RegistrationAnswer{
+			QuestionID: q.ID,
+			Label:      q.Label,
+			Type:       model.RegistrationQuestionType(q.Type),
+			Values:     a.Choices,
+		}
+		if ga.Values == nil {
+			ga.Values = []string{}
+		}
+		if a.Value != "" {
+			ga.Value = &a.Value
+		}
+		if a.File != nil {
+			ga.FileName = &a.File.Name
+			url := strings.TrimRight(publicURL, "/") + registration.AnswerFilePath(a.RegistrationID, a.QuestionID)
+			ga.FileURL = &url
+		}
+		out = append(out, ga)
+	}
+	return out
+}
+
+func toGraphEventWaiver(w *registration.Waiver) *model.EventWaiver {
+	out := &model.EventWaiver{
+		ID:        w.ID,
+		Title:     w.Title,
+		Required:  w.Required,
+		CreatedAt: w.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: w.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if v := w.CurrentVersion; v != nil {
+		out.CurrentVersion = &model.WaiverVersion{
+			ID:          v.ID,
+			Version:     v.Version,
+			Body:        v.Body,
+			ContentHash: v.ContentHash,
+			CreatedAt:   v.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	if w.RetiredAt != nil {
+		s := w.RetiredAt.Format("2006-01-02T15:04:05Z07:00")
+		out.RetiredAt = &s
+	}
+	return out
+}
+
+func toGraphEventShift(a *event.ShiftAvailability) *model.EventShift {
+	shift := a.Shift
+	out := &model.EventShift{
+		ID:              shift.ID,
+		Name:            shift.Name,
+		Role:            shift.Role,
+		Description:     shift.Description,
+		StartTime:       shift.StartTime,
+		EndTime:         shift.EndTime,
+		Capacity:        shift.Capacity,
+		WaitlistEnabled: shift.WaitlistEnabled,
+		Skills:          make([]*model.SkillRequirement, 0, len(shift.Skills)),
+		ConfirmedCount:  a.Confirmed,
+		WaitlistCount:   a.Waitlisted,
+		SpotsAvailable:  a.SpotsAvailable(),
+		IsFull:          a.SpotsAvailable() == 0,
+	}
+	for _, skill := range shift.Skills {
+		out.Skills = append(out.Skills, &model.SkillRequirement{
+			ID:          skill.ID,
+			Skill:       skill.Skill,
+			SkillID:     skill.SkillID,
+			Proficiency: convertDomainSkillProficiency(skill.Proficiency),
+			Required:    skill.Required,
+		})
+	}
+	return out
+}
+
+func toGraphEventTemplate(t *event.EventTemplate) *model.EventTemplate {
+	// The snapshot converts like any event; the template only shows the
+	// parts that don't depend on a date
+	snapshot := toGraphQLEvent(&t.Event)
+	return &model.EventTemplate{
+		ID:              t.ID,
+		Name:            t.Name,
+		OrganizationID:  t.OrganizationID,
+		SourceEventID:   t.SourceEventID,
+		Title:           t.Event.Title,
+		Category:        snapshot.Category,
+		DurationMinutes: int(t.Event.EndTime.Sub(t.Event.StartTime).Minutes()),
+		Capacity:        snapshot.Capacity,
+		Requirements:    snapshot.Requirements,
+		ShiftCount:      len(t.Shifts),
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+func toGraphSkillCatalogEntry(s *skill.Skill) *model.SkillCatalogEntry {
+	return &model.SkillCatalogEntry{
+		ID:       s.ID,
+		Slug:     s.Slug,
+		Name:     s.Name,
+		Category: s.Category,
+		Synonyms: append([]string{}, s.Synonyms...),
+	}
+}
+
+func toGraphTaxonomyTerm(t *event.TaxonomyTerm) *model.TaxonomyTerm {
+	return &model.TaxonomyTerm{
+		ID:          t.ID,
+		Taxonomy:    model.EventTaxonomy(t.Taxonomy),
+		Slug:        t.Slug,
+		Name:        t.Name,
+		Description: t.Description,
+		Icon:        t.Icon,
+		ParentID:    t.ParentID,
+		SortOrder:   t.SortOrder,
+		IsActive:    t.IsActive,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func toDomainTaxonomyTermInput(in model.TaxonomyTermInput) event.TaxonomyTermInput {
+	return event.TaxonomyTermInput{
+		Slug:        in.Slug,
+		Name:        in.Name,
+		Description: in.Description,
+		Icon:        in.Icon,
+		ParentID:    in.ParentID,
+		SortOrder:   in.SortOrder,
+		IsActive:    in.IsActive,
+	}
+}
+
+func toDomainEventShiftInputs(inputs []*model.EventShiftInput) []event.EventShiftInput {
+	out := make([]event.EventShiftInput, 0, len(inputs))
+	for _, in := range inputs {
+		shift := event.EventShiftInput{
+			ID:              in.ID,
+			Name:            in.Name,
+			Role:            in.Role,
+			Description:     in.Description,
+			StartTime:       in.StartTime,
+			EndTime:         in.EndTime,
+			Capacity:        in.Capacity,
+			WaitlistEnabled: in.WaitlistEnabled,
+		}
+		for _, skill := range in.Skills {
+			shift.Skills = append(shift.Skills, event.SkillRequirementInput{
+				Skill:       skill.Skill,
+				Proficiency: convertGraphQLSkillProficiency(skill.Proficiency),
+				Required:    skill.Required,
+			})
+		}
+		out = append(out, shift)
+	}
+	return out
+}
+
+func toGraphRegistrationShift(a *registration.ShiftAssignment, shift *model.EventShift) *model.RegistrationShift {
+	out := &model.RegistrationShift{
+		ID:               a.ID,
+		Shift:            shift,
+		Status:           model.ShiftAssignmentStatus(a.Status),
+		WaitlistPosition: a.WaitlistPosition,
+	}
+	if a.ConfirmedAt != nil {
+		s := a.ConfirmedAt.Format("2006-01-02T15:04:05Z07:00")
+		out.ConfirmedAt = &s
+	}
+	if a.CheckedInAt != nil {
+		s := a.CheckedInAt.Format("2006-01-02T15:04:05Z07:00")
+		out.CheckedInAt = &s
+	}
+	if a.CheckedOutAt != nil {
+		s := a.CheckedOutAt.Format("2006-01-02T15:04:05Z07:00")
+		out.CheckedOutAt = &s
+	}
+	return out
+}
+
+func toGraphWaiverSignature(sig *registration.WaiverSignature) *model.WaiverSignature {
+	out := &model.WaiverSignature{
+		ID:              sig.ID,
+		WaiverID:        sig.WaiverID,
+		WaiverTitle:     sig.WaiverTitle,
+		WaiverVersionID: sig.WaiverVersionID,
+		Version:         sig.Version,
+		User:            &model.User{ID: sig.UserID},
+		RegistrationID:  sig.RegistrationID,
+		SignedName:      sig.SignedName,
+		DocumentHash:    sig.DocumentHash,
+		SignedAt:        sig.SignedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if sig.IPAddress != "" {
+		out.IPAddress = &sig.IPAddress
+	}
+	if sig.UserAgent != "" {
+		out.UserAgent = &sig.UserAgent
+	}
+	return out
+}
+
+func toGraphWaiverSignatures(signatures []*registration.WaiverSignature) []*model.WaiverSignature {
+	out := make([]*model.WaiverSignature, 0, len(signatures))
+	for _, sig := range signatures {
+		out = append(out, toGraphWaiverSignature(sig))
+	}
+	return out
+}
+
+func toDomainWaiverSignature(input *model.WaiverSignatureInput) registration.WaiverSignatureInput {
+	return registration.WaiverSignatureInput{
+		WaiverVersionID: input.WaiverVersionID,
+		SignedName:      input.SignedName,
+		DocumentHash:    derefString(input.DocumentHash),
+	}
+}
+
+func toGraphGuardianConsent(c *registration.GuardianConsent) *model.GuardianConsent {
+	out := &model.GuardianConsent{
+		ID:             c.ID,
+		RegistrationID: c.RegistrationID,
+		User:           &model.User{ID: c.UserID},
+		EventID:        c.EventID,
+		RequestedEmail: c.RequestedEmail,
+		Status:         model.GuardianConsentStatus(c.Status),
+		RequestedAt:    c.RequestedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:      c.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		GuardianName:   optionalString(c.GuardianName),
+		GuardianEmail:  optionalString(c.GuardianEmail),
+		GuardianPhone:  optionalString(c.GuardianPhone),
+		Relationship:   optionalString(c.Relationship),
+		IPAddress:      optionalString(c.IPAddress),
+		UserAgent:      optionalString(c.UserAgent),
+	}
+	if c.RespondedAt != nil {
+		respondedAt := c.RespondedAt.Format("2006-01-02T15:04:05Z07:00")
+		out.RespondedAt = &respondedAt
+	}
+	return out
+}
+
+func toGraphTeam(t *registration.Team) *model.Team {
+	return &model.Team{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Leader:      &model.User{ID: t.LeaderID},
+		CreatedAt:   t.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   t.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func toGraphTeamMember(m *registration.TeamMember) *model.TeamMember {
+	return &model.TeamMember{
+		User:     &model.User{ID: m.UserID},
+		JoinedAt: m.JoinedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func toGraphTeamRegistration(tr *registration.TeamRegistration) *model.TeamRegistration {
+	out := &model.TeamRegistration{
+		ID:                 tr.ID,
+		Team:               &model.Team{ID: tr.TeamID},
+		Event:              &model.Event{ID: tr.EventID},
+		Seats:              tr.Seats,
+		Status:             model.RegistrationStatus(tr.Status),
+		WaitlistPosition:   tr.WaitlistPosition,
+		AssignmentDeadline: tr.AssignmentDeadline.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:          tr.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if tr.ConfirmedAt != nil {
+		s := tr.ConfirmedAt.Format("2006-01-02T15:04:05Z07:00")
+		out.ConfirmedAt = &s
+	}
+	if tr.SeatsReleasedAt != nil {
+		s := tr.SeatsReleasedAt.Format("2006-01-02T15:04:05Z07:00")
+		out.SeatsReleasedAt = &s
+	}
+	if tr.CancelledAt != nil {
+		s := tr.CancelledAt.Format("2006-01-02T15:04:05Z07:00")
+		out.CancelledAt = &s
+	}
+	return out
+}
+
+// derefString returns the pointed-to string, or "" for nil
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optionalString returns nil for "", so empty values read as null
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// localTimeString formats t as wall-clock time in loc, with its UTC offset,
+// or returns nil when the zone isn't known
+func localTimeString(t time.Time, loc *time.Location) *string {
+	if loc == nil {
+		return nil
+	}
+	s := t.In(loc).Format(time.RFC3339)
+	return &s
+}
+
+// toGraphEventOccurrence converts one date of an event for GraphQL
+func toGraphEventOccurrence(o event.Occurrence, loc *time.Location) *model.EventOccurrence {
+	return &model.EventOccurrence{
+		StartTime:      o.StartTime.UTC(),
+		EndTime:        o.EndTime.UTC(),
+		LocalStartTime: localTimeString(o.StartTime, loc),
+		LocalEndTime:   localTimeString(o.EndTime, loc),
+	}
+}
+
+// derefInt returns the pointed-to int, or 0 for nil
+func derefInt(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// dateLayout is the YYYY-MM-DD form used for calendar dates such as birthdays
+const dateLayout = "2006-01-02"
+
+// parseDate parses an optional YYYY-MM-DD argument
+func parseDate(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(dateLayout, *s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", *s)
+	}
+	return &t, nil
+}
+
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(dateLayout)
+	return &s
+}
+
+// toDomainTrainingInput converts GraphQL TrainingInput to domain TrainingInput
+func toDomainTrainingInput(input model.TrainingInput) (usercore.TrainingInput, error) {
+	completed, err := parseDate(&input.CompletedAt)
+	if err != nil {
+		return usercore.TrainingInput{}, err
+	}
+	expires, err := parseDate(input.ExpiresAt)
+	if err != nil {
+		return usercore.TrainingInput{}, err
+	}
+	return usercore.TrainingInput{Name: input.Name, CompletedAt: *completed, ExpiresAt: expires}, nil
+}
+
+func toGraphEligibility(e *registration.Eligibility) *model.Eligibility {
+	out := &model.Eligibility{EventID: e.EventID, Eligible: e.Eligible, Reasons: make([]*model.EligibilityReason, 0, len(e.Reasons))}
+	for _, r := range e.Reasons {
+		out.Reasons = append(out.Reasons, &model.EligibilityReason{
+			Code:        model.EligibilityCode(r.Code),
+			Requirement: r.Requirement,
+			Message:     r.Message,
+			Blocking:    r.Blocking,
+		})
+	}
+	return out
+}
+
+// parseDateTime parses an optional DateTime argument
+func parseDateTime(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := UnmarshalTime(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -171,8 +171,12 @@ func TestToGraphUser(t *testing.T) {
 					{ID: "1", Name: "Technology", Category: "TECHNOLOGY"},
 				},
 				Skills: []usercore.Skill{
-					{ID: "1", Name: "Go", Proficiency: "ADVANCED", Verified: true},
+					{ID: "1", Name: "Go", SkillID: stringPtr("skill-go"), Proficiency: "ADVANCED", Verified: true},
+				},
+				Trainings: []usercore.Training{
+					{ID: "t1", Name: "First Aid", CompletedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
 				},
+				DateOfBirth: timePtr(time.Date(1990, 4, 12, 0, 0, 0, 0, time.UTC)),
 				Privacy: usercore.PrivacySettings{
 					ProfileVisibility: "PUBLIC",
 					ShowLocation:      true,
@@ -202,8 +206,12 @@ func TestToGraphUser(t *testing.T) {
 					{ID: "1", Name: "Technology", Category: model.InterestCategoryTechnology},
 				},
 				Skills: []*model.Skill{
-					{ID: "1", Name: "Go", Proficiency: model.SkillProficiencyAdvanced, Verified: true},
+					{ID: "1", Name: "Go", SkillID: stringPtr("skill-go"), Proficiency: model.SkillProficiencyAdvanced, Verified: true},
 				},
+				Trainings: []*model.Training{
+					{ID: "t1", Name: "First Aid", CompletedAt: "2025-03-01"},
+				},
+				DateOfBirth:   stringPtr("1990-04-12"),
 				Roles:         []string{"user"},
 				IsVerified:    true,
 				EmailVerified: true,
@@ -229,7 +237,7 @@ func TestToGraphUser(t *testing.T) {
 						{ID: "1", Name: "Technology", Category: model.InterestCategoryTechnology},
 					},
 					Skills: []*model.Skill{
-						{ID: "1", Name: "Go", Proficiency: model.SkillProficiencyAdvanced, Verified: true},
+						{ID: "1", Name: "Go", SkillID: stringPtr("skill-go"), Proficiency: model.SkillProficiencyAdvanced, Verified: true},
 					},
 					VolunteerStats: &model.VolunteerStats{
 						Hours:              0,
@@ -351,3 +359,7 @@ func stringPtr(s string) *string {
 func float64Ptr(f float64) *float64 {
 	return &f
 }
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,23 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/volunteersync/backend/internal/core/auth"
+	mw "github.com/volunteersync/backend/internal/middleware"
+)
+
+// HasPermission implements the @hasPermission directive. The field resolves only
+// when the roles carried in the caller's access token grant the permission.
+func HasPermission(ctx context.Context, obj any, next graphql.Resolver, permission string) (any, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if !mw.HasPermission(ctx, auth.Permission(permission)) {
+		return nil, fmt.Errorf("forbidden: missing permission %s", permission)
+	}
+	return next(ctx)
+}
@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/auth"
+	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/graph/generated"
+	mw "github.com/volunteersync/backend/internal/middleware"
+)
+
+func withRoles(ctx context.Context, userID string, roles ...string) context.Context {
+	return context.WithValue(ctx, mw.UserClaimsContextKey, &auth.UserClaims{UserID: userID, Roles: roles})
+}
+
+func TestHasPermissionDirective(t *testing.T) {
+	next := func(ctx context.Context) (any, error) { return "ok", nil }
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := HasPermission(context.Background(), nil, next, string(auth.PermEventCreate))
+		assert.EqualError(t, err, "authentication required")
+	})
+
+	t.Run("volunteer cannot create events", func(t *testing.T) {
+		ctx := withRoles(context.Background(), "u1", auth.RoleVolunteer)
+		_, err := HasPermission(ctx, nil, next, string(auth.PermEventCreate))
+		assert.ErrorContains(t, err, "forbidden")
+	})
+
+	t.Run("organizer can create events", func(t *testing.T) {
+		ctx := withRoles(context.Background(), "u1", auth.RoleOrganizer)
+		res, err := HasPermission(ctx, nil, next, string(auth.PermEventCreate))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+}
+
+// TestHasPermissionDirective_Schema checks the directive is enforced by the generated schema
+func TestHasPermissionDirective_Schema(t *testing.T) {
+	repo := newFakeEventRepo()
+	resolver := &Resolver{EventService: event.NewEventService(repo)}
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  resolver,
+		Directives: generated.DirectiveRoot{HasPermission: HasPermission},
+	}))
+
+	t.Run("volunteer rejected", func(t *testing.T) {
+		c := client.New(srv, func(r *client.Request) {
+			r.HTTP = r.HTTP.WithContext(withRoles(r.HTTP.Context(), "u1", auth.RoleVolunteer))
+		})
+		var resp map[string]any
+		err := c.Post(`mutation { deleteEventImage(id: "i1") }`, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing permission event.manage")
+	})
+
+	// deleteEvent carries no directive; the event's own staff decide
+	const mutation = `mutation { deleteEvent(id: "e1") }`
+	repo.events["e1"] = &event.Event{ID: "e1", OrganizerID: "u1", Status: event.EventStatusDraft}
+	repo.staff["e1/u2"] = &event.EventStaff{EventID: "e1", Role: event.StaffRoleCoOrganizer, Status: event.StaffStatusActive}
+
+	t.Run("organizer of another event rejected", func(t *testing.T) {
+		c := client.New(srv, func(r *client.Request) {
+			r.HTTP = r.HTTP.WithContext(withRoles(r.HTTP.Context(), "u3", auth.RoleOrganizer))
+		})
+		var resp map[string]any
+		err := c.Post(mutation, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+
+	t.Run("co-organizer reaches resolver", func(t *testing.T) {
+		c := client.New(srv, func(r *client.Request) {
+			r.HTTP = r.HTTP.WithContext(withRoles(r.HTTP.Context(), "u2", auth.RoleVolunteer))
+		})
+		var resp struct{ DeleteEvent bool }
+		require.NoError(t, c.Post(mutation, &resp))
+		assert.True(t, resp.DeleteEvent)
+	})
+}
@@ -16,9 +16,13 @@ import (
 // fakeEventRepo is a minimal in-memory implementation of event.Repository for tests
 type fakeEventRepo struct {
 	events map[string]*event.Event
+	// staff is keyed by event ID and user ID joined with a slash
+	staff map[string]*event.EventStaff
 }
 
-func newFakeEventRepo() *fakeEventRepo { return &fakeEventRepo{events: map[string]*event.Event{}} }
+func newFakeEventRepo() *fakeEventRepo {
+	return &fakeEventRepo{events: map[string]*event.Event{}, staff: map[string]*event.EventStaff{}}
+}
 
 // CRUD
 func (f *fakeEventRepo) Create(ctx context.Context, e *event.Event) error {
@@ -38,6 +42,10 @@ func (f *fakeEventRepo) Update(ctx context.Context, e *event.Event) error {
 	f.events[e.ID] = e
 	return nil
 }
+func (f *fakeEventRepo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func (f *fakeEventRepo) Delete(ctx context.Context, id string) error {
 	if _, ok := f.events[id]; ok {
 		delete(f.events, id)
@@ -149,9 +157,31 @@ func (f *fakeEventRepo) DeleteAnnouncement(ctx context.Context, announcementID s
 	return nil
 }
 
+// Staff
+func (f *fakeEventRepo) CreateStaffInvitation(ctx context.Context, staff *event.EventStaff) error {
+	return nil
+}
+func (f *fakeEventRepo) GetStaffByID(ctx context.Context, staffID string) (*event.EventStaff, error) {
+	return nil, assert.AnError
+}
+func (f *fakeEventRepo) GetEventStaff(ctx context.Context, eventID string) ([]*event.EventStaff, error) {
+	return nil, nil
+}
+func (f *fakeEventRepo) GetStaffMember(ctx context.Context, eventID, userID string) (*event.EventStaff, error) {
+	return f.staff[eventID+"/"+userID], nil
+}
+func (f *fakeEventRepo) GetPendingStaffInvitations(ctx context.Context, email string) ([]*event.EventStaff, error) {
+	return nil, nil
+}
+func (f *fakeEventRepo) UpdateStaff(ctx context.Context, staff *event.EventStaff) error { return nil }
+
+func (f *fakeEventRepo) GetOrganizationRole(ctx context.Context, organizationID, userID string) (string, error) {
+	return "", nil
+}
+
 // Updates
 func (f *fakeEventRepo) LogUpdate(ctx context.Context, update *event.EventUpdate) error { return nil }
-func (f *fakeEventRepo) GetUpdateHistory(ctx context.Context, eventID string, limit, offset int) ([]*event.EventUpdate, error) {
+func (f *fakeEventRepo) GetUpdateHistory(ctx context.Context, eventID string, limit int, after string) ([]*event.EventUpdate, error) {
 	return nil, nil
 }
 
@@ -170,6 +200,47 @@ func (f *fakeEventRepo) GetCurrentCapacity(ctx context.Context, eventID string)
 func (f *fakeEventRepo) IsAtCapacity(ctx context.Context, eventID string) (bool, error) {
 	return false, nil
 }
+func (f *fakeEventRepo) GetShifts(ctx context.Context, eventID string) ([]*event.EventShift, error) {
+	return nil, nil
+}
+func (f *fakeEventRepo) ReplaceShifts(ctx context.Context, eventID string, shifts []*event.EventShift) error {
+	return nil
+}
+func (f *fakeEventRepo) GetShiftSignups(ctx context.Context, eventID string) (map[string]event.ShiftSignups, error) {
+	return nil, nil
+}
+
+// Templates
+func (f *fakeEventRepo) CreateTemplate(ctx context.Context, template *event.EventTemplate) error {
+	return nil
+}
+func (f *fakeEventRepo) GetTemplate(ctx context.Context, id string) (*event.EventTemplate, error) {
+	return nil, event.ErrTemplateNotFound
+}
+func (f *fakeEventRepo) GetTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*event.EventTemplate, error) {
+	return nil, nil
+}
+func (f *fakeEventRepo) GetTemplatesByOrganization(ctx context.Context, organizationID string) ([]*event.EventTemplate, error) {
+	return nil, nil
+}
+func (f *fakeEventRepo) DeleteTemplate(ctx context.Context, id string) error { return nil }
+
+// Taxonomies; every slug names an active term
+func (f *fakeEventRepo) ListTaxonomyTerms(ctx context.Context, taxonomy event.Taxonomy) ([]*event.TaxonomyTerm, error) {
+	return nil, nil
+}
+func (f *fakeEventRepo) GetTaxonomyTerm(ctx context.Context, taxonomy event.Taxonomy, slug string) (*event.TaxonomyTerm, error) {
+	return &event.TaxonomyTerm{Taxonomy: taxonomy, Slug: slug, IsActive: true}, nil
+}
+func (f *fakeEventRepo) CreateTaxonomyTerm(ctx context.Context, term *event.TaxonomyTerm) error {
+	return nil
+}
+func (f *fakeEventRepo) UpdateTaxonomyTerm(ctx context.Context, term *event.TaxonomyTerm, previousSlug string) error {
+	return nil
+}
+func (f *fakeEventRepo) DeleteTaxonomyTerm(ctx context.Context, taxonomy event.Taxonomy, id string) error {
+	return nil
+}
 
 // Utils
 func (f *fakeEventRepo) EventExists(ctx context.Context, id string) (bool, error) {
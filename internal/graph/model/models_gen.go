@@ -8,6 +8,8 @@ import (
 	"io"
 	"strconv"
 	"time"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
 type ActivityLog struct {
@@ -18,6 +20,53 @@ type ActivityLog struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+type AdminAuditEntry struct {
+	ID         string    `json:"id"`
+	Sequence   int       `json:"sequence"`
+	ActorID    string    `json:"actorId"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"targetType"`
+	TargetID   string    `json:"targetId"`
+	Reason     *string   `json:"reason,omitempty"`
+	Details    string    `json:"details"`
+	CreatedAt  time.Time `json:"createdAt"`
+	PrevHash   string    `json:"prevHash"`
+	Hash       string    `json:"hash"`
+}
+
+type AdminAuditFilter struct {
+	ActorID    *string `json:"actorId,omitempty"`
+	Action     *string `json:"action,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+	TargetID   *string `json:"targetId,omitempty"`
+}
+
+type AdminUser struct {
+	ID                  string     `json:"id"`
+	Email               string     `json:"email"`
+	Name                string     `json:"name"`
+	IsVerified          bool       `json:"isVerified"`
+	EmailVerified       bool       `json:"emailVerified"`
+	FailedLoginAttempts int        `json:"failedLoginAttempts"`
+	LockedUntil         *time.Time `json:"lockedUntil,omitempty"`
+	IsLocked            bool       `json:"isLocked"`
+	LastLogin           *time.Time `json:"lastLogin,omitempty"`
+	Roles               []UserRole `json:"roles"`
+	CreatedAt           time.Time  `json:"createdAt"`
+}
+
+type AdminUserConnection struct {
+	Users      []*AdminUser `json:"users"`
+	TotalCount int          `json:"totalCount"`
+}
+
+type AdminUserFilter struct {
+	Query      *string   `json:"query,omitempty"`
+	LockedOnly *bool     `json:"lockedOnly,omitempty"`
+	Verified   *bool     `json:"verified,omitempty"`
+	Role       *UserRole `json:"role,omitempty"`
+}
+
 type ApprovalDecisionInput struct {
 	RegistrationID string   `json:"registrationId"`
 	Approved       bool     `json:"approved"`
@@ -33,10 +82,19 @@ type AttendanceInput struct {
 }
 
 type AttendanceRecord struct {
-	Registration *Registration `json:"registration"`
-	CheckedInAt  *string       `json:"checkedInAt,omitempty"`
-	CheckedInBy  *User         `json:"checkedInBy,omitempty"`
-	Notes        *string       `json:"notes,omitempty"`
+	Registration     *Registration `json:"registration"`
+	CheckedInAt      *string       `json:"checkedInAt,omitempty"`
+	CheckedInBy      *User         `json:"checkedInBy,omitempty"`
+	CheckedOutAt     *string       `json:"checkedOutAt,omitempty"`
+	LocationVerified bool          `json:"locationVerified"`
+	HoursWorked      *float64      `json:"hoursWorked,omitempty"`
+	Notes            *string       `json:"notes,omitempty"`
+}
+
+type AuditTrailVerification struct {
+	Valid            bool `json:"valid"`
+	CheckedEntries   int  `json:"checkedEntries"`
+	BrokenAtSequence *int `json:"brokenAtSequence,omitempty"`
 }
 
 type AuthPayload struct {
@@ -45,12 +103,42 @@ type AuthPayload struct {
 	User         *User  `json:"user"`
 }
 
+type BulkAttendanceEntryInput struct {
+	RegistrationID *string          `json:"registrationId,omitempty"`
+	Email          *string          `json:"email,omitempty"`
+	Status         AttendanceStatus `json:"status"`
+	CheckedInAt    *string          `json:"checkedInAt,omitempty"`
+	Notes          *string          `json:"notes,omitempty"`
+}
+
+type BulkAttendanceReport struct {
+	Applied   bool                       `json:"applied"`
+	Updated   int                        `json:"updated"`
+	Unchanged int                        `json:"unchanged"`
+	Failed    int                        `json:"failed"`
+	Rows      []*BulkAttendanceRowResult `json:"rows"`
+}
+
+type BulkAttendanceRowResult struct {
+	Row            int            `json:"row"`
+	RegistrationID *string        `json:"registrationId,omitempty"`
+	Email          *string        `json:"email,omitempty"`
+	Outcome        BulkRowOutcome `json:"outcome"`
+	Error          *string        `json:"error,omitempty"`
+	Registration   *Registration  `json:"registration,omitempty"`
+}
+
 type BulkRegistrationInput struct {
 	EventIds        []string `json:"eventIds"`
 	PersonalMessage *string  `json:"personalMessage,omitempty"`
 	SkipConflicts   *bool    `json:"skipConflicts,omitempty"`
 }
 
+type CategoryHoursBreakdown struct {
+	Category string  `json:"category"`
+	Hours    float64 `json:"hours"`
+}
+
 type Coordinates struct {
 	Lat float64 `json:"lat"`
 	Lng float64 `json:"lng"`
@@ -71,10 +159,19 @@ type CreateEventInput struct {
 	Capacity             *EventCapacityInput        `json:"capacity"`
 	Requirements         *EventRequirementsInput    `json:"requirements,omitempty"`
 	Tags                 []string                   `json:"tags,omitempty"`
-	Category             EventCategory              `json:"category"`
-	TimeCommitment       TimeCommitmentType         `json:"timeCommitment"`
+	Category             string                     `json:"category"`
+	TimeCommitment       string                     `json:"timeCommitment"`
 	RecurrenceRule       *RecurrenceRuleInput       `json:"recurrenceRule,omitempty"`
 	RegistrationSettings *RegistrationSettingsInput `json:"registrationSettings"`
+	OrganizationID       *string                    `json:"organizationId,omitempty"`
+}
+
+type CreateOrganizationInput struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description,omitempty"`
+	Website      *string `json:"website,omitempty"`
+	ContactEmail *string `json:"contactEmail,omitempty"`
+	LogoURL      *string `json:"logoUrl,omitempty"`
 }
 
 type DateRangeInput struct {
@@ -82,39 +179,65 @@ type DateRangeInput struct {
 	End   string `json:"end"`
 }
 
+type Eligibility struct {
+	EventID  string               `json:"eventId"`
+	Eligible bool                 `json:"eligible"`
+	Reasons  []*EligibilityReason `json:"reasons"`
+}
+
+type EligibilityReason struct {
+	Code        EligibilityCode `json:"code"`
+	Requirement string          `json:"requirement"`
+	Message     string          `json:"message"`
+	Blocking    bool            `json:"blocking"`
+}
+
+type EmergencyContact struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+}
+
 type EmergencyContactInput struct {
 	Name  string `json:"name"`
 	Phone string `json:"phone"`
 }
 
 type Event struct {
-	ID                   string                `json:"id"`
-	Title                string                `json:"title"`
-	Description          string                `json:"description"`
-	ShortDescription     *string               `json:"shortDescription,omitempty"`
-	Organizer            *User                 `json:"organizer"`
-	OrganizerID          string                `json:"organizerId"`
-	Status               EventStatus           `json:"status"`
-	StartTime            time.Time             `json:"startTime"`
-	EndTime              time.Time             `json:"endTime"`
-	Location             *EventLocation        `json:"location"`
-	Capacity             *EventCapacity        `json:"capacity"`
-	Requirements         *EventRequirements    `json:"requirements"`
-	Category             EventCategory         `json:"category"`
-	TimeCommitment       TimeCommitmentType    `json:"timeCommitment"`
-	Tags                 []string              `json:"tags"`
-	Slug                 *string               `json:"slug,omitempty"`
-	ShareURL             *string               `json:"shareURL,omitempty"`
-	RecurrenceRule       *RecurrenceRule       `json:"recurrenceRule,omitempty"`
-	RegistrationSettings *RegistrationSettings `json:"registrationSettings"`
-	Images               []*EventImage         `json:"images"`
-	Announcements        []*EventAnnouncement  `json:"announcements"`
-	CreatedAt            time.Time             `json:"createdAt"`
-	UpdatedAt            time.Time             `json:"updatedAt"`
-	CurrentRegistrations int                   `json:"currentRegistrations"`
-	AvailableSpots       int                   `json:"availableSpots"`
-	IsAtCapacity         bool                  `json:"isAtCapacity"`
-	CanRegister          bool                  `json:"canRegister"`
+	ID                    string                  `json:"id"`
+	Title                 string                  `json:"title"`
+	Description           string                  `json:"description"`
+	ShortDescription      *string                 `json:"shortDescription,omitempty"`
+	Organizer             *User                   `json:"organizer"`
+	OrganizerID           string                  `json:"organizerId"`
+	Organization          *Organization           `json:"organization,omitempty"`
+	OrganizationID        *string                 `json:"organizationId,omitempty"`
+	Status                EventStatus             `json:"status"`
+	StartTime             time.Time               `json:"startTime"`
+	EndTime               time.Time               `json:"endTime"`
+	LocalStartTime        *string                 `json:"localStartTime,omitempty"`
+	LocalEndTime          *string                 `json:"localEndTime,omitempty"`
+	Location              *EventLocation          `json:"location"`
+	Capacity              *EventCapacity          `json:"capacity"`
+	Requirements          *EventRequirements      `json:"requirements"`
+	Category              string                  `json:"category"`
+	TimeCommitment        string                  `json:"timeCommitment"`
+	Tags                  []string                `json:"tags"`
+	Slug                  *string                 `json:"slug,omitempty"`
+	ShareURL              *string                 `json:"shareURL,omitempty"`
+	RecurrenceRule        *RecurrenceRule         `json:"recurrenceRule,omitempty"`
+	RegistrationSettings  *RegistrationSettings   `json:"registrationSettings"`
+	Images                []*EventImage           `json:"images"`
+	Announcements         []*EventAnnouncement    `json:"announcements"`
+	RegistrationQuestions []*RegistrationQuestion `json:"registrationQuestions"`
+	Waivers               []*EventWaiver          `json:"waivers"`
+	Shifts                []*EventShift           `json:"shifts"`
+	Occurrences           []*EventOccurrence      `json:"occurrences"`
+	CreatedAt             time.Time               `json:"createdAt"`
+	UpdatedAt             time.Time               `json:"updatedAt"`
+	CurrentRegistrations  int                     `json:"currentRegistrations"`
+	AvailableSpots        int                     `json:"availableSpots"`
+	IsAtCapacity          bool                    `json:"isAtCapacity"`
+	CanRegister           bool                    `json:"canRegister"`
 }
 
 type EventAnnouncement struct {
@@ -149,6 +272,11 @@ type EventEdge struct {
 	Cursor string `json:"cursor"`
 }
 
+type EventHoursBreakdown struct {
+	Event *Event  `json:"event"`
+	Hours float64 `json:"hours"`
+}
+
 type EventImage struct {
 	ID           string  `json:"id"`
 	URL          string  `json:"url"`
@@ -167,6 +295,7 @@ type EventLocation struct {
 	Coordinates  *Coordinates `json:"coordinates,omitempty"`
 	Instructions *string      `json:"instructions,omitempty"`
 	IsRemote     bool         `json:"isRemote"`
+	TimeZone     *string      `json:"timeZone,omitempty"`
 }
 
 type EventLocationInput struct {
@@ -179,6 +308,14 @@ type EventLocationInput struct {
 	Coordinates  *CoordinatesInput `json:"coordinates,omitempty"`
 	Instructions *string           `json:"instructions,omitempty"`
 	IsRemote     bool              `json:"isRemote"`
+	TimeZone     *string           `json:"timeZone,omitempty"`
+}
+
+type EventOccurrence struct {
+	StartTime      time.Time `json:"startTime"`
+	EndTime        time.Time `json:"endTime"`
+	LocalStartTime *string   `json:"localStartTime,omitempty"`
+	LocalEndTime   *string   `json:"localEndTime,omitempty"`
 }
 
 type EventRequirements struct {
@@ -202,9 +339,10 @@ type EventRequirementsInput struct {
 type EventSearchFilter struct {
 	Query                   *string              `json:"query,omitempty"`
 	Status                  []EventStatus        `json:"status,omitempty"`
-	Category                []EventCategory      `json:"category,omitempty"`
-	TimeCommitment          []TimeCommitmentType `json:"timeCommitment,omitempty"`
+	Category                []string             `json:"category,omitempty"`
+	TimeCommitment          []string             `json:"timeCommitment,omitempty"`
 	OrganizerID             *string              `json:"organizerId,omitempty"`
+	OrganizationID          *string              `json:"organizationId,omitempty"`
 	Tags                    []string             `json:"tags,omitempty"`
 	StartDate               *time.Time           `json:"startDate,omitempty"`
 	EndDate                 *time.Time           `json:"endDate,omitempty"`
@@ -215,11 +353,65 @@ type EventSearchFilter struct {
 	MinimumAge              *int                 `json:"minimumAge,omitempty"`
 }
 
+type EventShift struct {
+	ID              string              `json:"id"`
+	Name            string              `json:"name"`
+	Role            *string             `json:"role,omitempty"`
+	Description     *string             `json:"description,omitempty"`
+	StartTime       time.Time           `json:"startTime"`
+	EndTime         time.Time           `json:"endTime"`
+	Capacity        int                 `json:"capacity"`
+	WaitlistEnabled bool                `json:"waitlistEnabled"`
+	Skills          []*SkillRequirement `json:"skills"`
+	ConfirmedCount  int                 `json:"confirmedCount"`
+	WaitlistCount   int                 `json:"waitlistCount"`
+	SpotsAvailable  int                 `json:"spotsAvailable"`
+	IsFull          bool                `json:"isFull"`
+}
+
+type EventShiftInput struct {
+	ID              *string                  `json:"id,omitempty"`
+	Name            string                   `json:"name"`
+	Role            *string                  `json:"role,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	StartTime       time.Time                `json:"startTime"`
+	EndTime         time.Time                `json:"endTime"`
+	Capacity        int                      `json:"capacity"`
+	WaitlistEnabled bool                     `json:"waitlistEnabled"`
+	Skills          []*SkillRequirementInput `json:"skills,omitempty"`
+}
+
 type EventSortInput struct {
 	Field     EventSortField `json:"field"`
 	Direction SortDirection  `json:"direction"`
 }
 
+type EventStaff struct {
+	ID         string           `json:"id"`
+	Event      *Event           `json:"event"`
+	User       *User            `json:"user,omitempty"`
+	Email      string           `json:"email"`
+	Role       EventStaffRole   `json:"role"`
+	Status     EventStaffStatus `json:"status"`
+	InvitedAt  time.Time        `json:"invitedAt"`
+	AcceptedAt *time.Time       `json:"acceptedAt,omitempty"`
+}
+
+type EventTemplate struct {
+	ID              string             `json:"id"`
+	Name            string             `json:"name"`
+	OrganizationID  *string            `json:"organizationId,omitempty"`
+	SourceEventID   *string            `json:"sourceEventId,omitempty"`
+	Title           string             `json:"title"`
+	Category        string             `json:"category"`
+	DurationMinutes int                `json:"durationMinutes"`
+	Capacity        *EventCapacity     `json:"capacity"`
+	Requirements    *EventRequirements `json:"requirements"`
+	ShiftCount      int                `json:"shiftCount"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
 type EventUpdate struct {
 	ID         string     `json:"id"`
 	UpdatedBy  *User      `json:"updatedBy"`
@@ -230,11 +422,89 @@ type EventUpdate struct {
 	CreatedAt  time.Time  `json:"createdAt"`
 }
 
+type EventUpdateConnection struct {
+	Edges    []*EventUpdateEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type EventUpdateEdge struct {
+	Node   *EventUpdate `json:"node"`
+	Cursor string       `json:"cursor"`
+}
+
+type EventWaiver struct {
+	ID             string         `json:"id"`
+	Title          string         `json:"title"`
+	Required       bool           `json:"required"`
+	CurrentVersion *WaiverVersion `json:"currentVersion"`
+	RetiredAt      *string        `json:"retiredAt,omitempty"`
+	CreatedAt      string         `json:"createdAt"`
+	UpdatedAt      string         `json:"updatedAt"`
+}
+
+type ExternalHoursInput struct {
+	OrganizationID string  `json:"organizationId"`
+	Hours          float64 `json:"hours"`
+	Date           string  `json:"date"`
+	Category       string  `json:"category"`
+	Description    string  `json:"description"`
+}
+
+type GuardianConsent struct {
+	ID             string                `json:"id"`
+	RegistrationID *string               `json:"registrationId,omitempty"`
+	User           *User                 `json:"user"`
+	EventID        string                `json:"eventId"`
+	RequestedEmail string                `json:"requestedEmail"`
+	Status         GuardianConsentStatus `json:"status"`
+	RequestedAt    string                `json:"requestedAt"`
+	ExpiresAt      string                `json:"expiresAt"`
+	RespondedAt    *string               `json:"respondedAt,omitempty"`
+	GuardianName   *string               `json:"guardianName,omitempty"`
+	GuardianEmail  *string               `json:"guardianEmail,omitempty"`
+	GuardianPhone  *string               `json:"guardianPhone,omitempty"`
+	Relationship   *string               `json:"relationship,omitempty"`
+	IPAddress      *string               `json:"ipAddress,omitempty"`
+	UserAgent      *string               `json:"userAgent,omitempty"`
+}
+
 type Health struct {
 	Status string    `json:"status"`
 	Time   time.Time `json:"time"`
 }
 
+type HoursCertificate struct {
+	ID            string                  `json:"id"`
+	Code          string                  `json:"code"`
+	Scope         HoursCertificateScope   `json:"scope"`
+	VolunteerName string                  `json:"volunteerName"`
+	TotalHours    float64                 `json:"totalHours"`
+	RangeStart    *string                 `json:"rangeStart,omitempty"`
+	RangeEnd      *string                 `json:"rangeEnd,omitempty"`
+	Lines         []*HoursCertificateLine `json:"lines"`
+	IssuedAt      string                  `json:"issuedAt"`
+	PDFURL        string                  `json:"pdfUrl"`
+	VerifyURL     string                  `json:"verifyUrl"`
+}
+
+type HoursCertificateLine struct {
+	Event        *Event  `json:"event,omitempty"`
+	Title        string  `json:"title"`
+	Organization string  `json:"organization"`
+	Location     *string `json:"location,omitempty"`
+	Date         string  `json:"date"`
+	Hours        float64 `json:"hours"`
+}
+
+type HoursSummary struct {
+	TotalHours         float64                   `json:"totalHours"`
+	PendingHours       float64                   `json:"pendingHours"`
+	EventsParticipated int                       `json:"eventsParticipated"`
+	ByEvent            []*EventHoursBreakdown    `json:"byEvent"`
+	ByCategory         []*CategoryHoursBreakdown `json:"byCategory"`
+	Entries            []*VolunteerHoursEntry    `json:"entries"`
+}
+
 type Interest struct {
 	ID       string           `json:"id"`
 	Name     string           `json:"name"`
@@ -294,6 +564,28 @@ type NotificationPreferencesInput struct {
 	NewsletterSubscription *bool `json:"newsletterSubscription,omitempty"`
 }
 
+type Organization struct {
+	ID                 string                         `json:"id"`
+	Name               string                         `json:"name"`
+	Slug               string                         `json:"slug"`
+	Description        *string                        `json:"description,omitempty"`
+	Website            *string                        `json:"website,omitempty"`
+	ContactEmail       *string                        `json:"contactEmail,omitempty"`
+	LogoURL            *string                        `json:"logoUrl,omitempty"`
+	VerificationStatus OrganizationVerificationStatus `json:"verificationStatus"`
+	VerifiedAt         *time.Time                     `json:"verifiedAt,omitempty"`
+	Members            []*OrganizationMember          `json:"members"`
+	MyRole             *OrganizationRole              `json:"myRole,omitempty"`
+	CreatedAt          time.Time                      `json:"createdAt"`
+	UpdatedAt          time.Time                      `json:"updatedAt"`
+}
+
+type OrganizationMember struct {
+	User     *User            `json:"user"`
+	Role     OrganizationRole `json:"role"`
+	JoinedAt time.Time        `json:"joinedAt"`
+}
+
 type PageInfo struct {
 	HasNextPage     bool    `json:"hasNextPage"`
 	HasPreviousPage bool    `json:"hasPreviousPage"`
@@ -301,6 +593,20 @@ type PageInfo struct {
 	EndCursor       *string `json:"endCursor,omitempty"`
 }
 
+type Passkey struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Transports     []string   `json:"transports"`
+	BackupEligible bool       `json:"backupEligible"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	LastUsedAt     *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+type PasskeyCeremony struct {
+	SessionID string `json:"sessionId"`
+	Options   string `json:"options"`
+}
+
 type PrivacySettings struct {
 	ProfileVisibility ProfileVisibility `json:"profileVisibility"`
 	ShowEmail         bool              `json:"showEmail"`
@@ -352,11 +658,15 @@ type RefreshTokenInput struct {
 }
 
 type RegisterForEventInput struct {
-	EventID             string                 `json:"eventId"`
-	PersonalMessage     *string                `json:"personalMessage,omitempty"`
-	EmergencyContact    *EmergencyContactInput `json:"emergencyContact,omitempty"`
-	DietaryRestrictions *string                `json:"dietaryRestrictions,omitempty"`
-	AccessibilityNeeds  *string                `json:"accessibilityNeeds,omitempty"`
+	EventID             string                     `json:"eventId"`
+	PersonalMessage     *string                    `json:"personalMessage,omitempty"`
+	EmergencyContact    *EmergencyContactInput     `json:"emergencyContact,omitempty"`
+	DietaryRestrictions *string                    `json:"dietaryRestrictions,omitempty"`
+	AccessibilityNeeds  *string                    `json:"accessibilityNeeds,omitempty"`
+	Answers             []*RegistrationAnswerInput `json:"answers,omitempty"`
+	WaiverSignatures    []*WaiverSignatureInput    `json:"waiverSignatures,omitempty"`
+	GuardianEmail       *string                    `json:"guardianEmail,omitempty"`
+	ShiftIds            []string                   `json:"shiftIds,omitempty"`
 }
 
 type RegisterInput struct {
@@ -365,33 +675,69 @@ type RegisterInput struct {
 	Password string `json:"password"`
 }
 
+type RegisterTeamInput struct {
+	TeamID             string  `json:"teamId"`
+	EventID            string  `json:"eventId"`
+	Seats              int     `json:"seats"`
+	AssignmentDeadline *string `json:"assignmentDeadline,omitempty"`
+}
+
 type Registration struct {
-	ID                 string             `json:"id"`
-	User               *User              `json:"user"`
-	Event              *Event             `json:"event"`
-	Status             RegistrationStatus `json:"status"`
-	PersonalMessage    *string            `json:"personalMessage,omitempty"`
-	Skills             []*UserSkill       `json:"skills"`
-	Interests          []*Interest        `json:"interests"`
-	AppliedAt          string             `json:"appliedAt"`
-	ConfirmedAt        *string            `json:"confirmedAt,omitempty"`
-	CancelledAt        *string            `json:"cancelledAt,omitempty"`
-	CheckedInAt        *string            `json:"checkedInAt,omitempty"`
-	CompletedAt        *string            `json:"completedAt,omitempty"`
-	WaitlistPosition   *int               `json:"waitlistPosition,omitempty"`
-	ApprovalNotes      *string            `json:"approvalNotes,omitempty"`
-	CancellationReason *string            `json:"cancellationReason,omitempty"`
-	AttendanceStatus   AttendanceStatus   `json:"attendanceStatus"`
-	CanCancel          bool               `json:"canCancel"`
-	CanCheckIn         bool               `json:"canCheckIn"`
-	CreatedAt          string             `json:"createdAt"`
-	UpdatedAt          string             `json:"updatedAt"`
+	ID                        string                `json:"id"`
+	User                      *User                 `json:"user"`
+	Event                     *Event                `json:"event"`
+	Status                    RegistrationStatus    `json:"status"`
+	PersonalMessage           *string               `json:"personalMessage,omitempty"`
+	Skills                    []*UserSkill          `json:"skills"`
+	Interests                 []*Interest           `json:"interests"`
+	AppliedAt                 string                `json:"appliedAt"`
+	ConfirmedAt               *string               `json:"confirmedAt,omitempty"`
+	CancelledAt               *string               `json:"cancelledAt,omitempty"`
+	CheckedInAt               *string               `json:"checkedInAt,omitempty"`
+	CompletedAt               *string               `json:"completedAt,omitempty"`
+	WaitlistPosition          *int                  `json:"waitlistPosition,omitempty"`
+	ApprovalNotes             *string               `json:"approvalNotes,omitempty"`
+	CancellationReason        *string               `json:"cancellationReason,omitempty"`
+	AttendanceStatus          AttendanceStatus      `json:"attendanceStatus"`
+	EmergencyContact          *EmergencyContact     `json:"emergencyContact,omitempty"`
+	DietaryRestrictions       *string               `json:"dietaryRestrictions,omitempty"`
+	AccessibilityNeeds        *string               `json:"accessibilityNeeds,omitempty"`
+	Answers                   []*RegistrationAnswer `json:"answers"`
+	GuardianConsent           *GuardianConsent      `json:"guardianConsent,omitempty"`
+	ReconfirmationRequestedAt *string               `json:"reconfirmationRequestedAt,omitempty"`
+	ReconfirmedAt             *string               `json:"reconfirmedAt,omitempty"`
+	LateCancellation          bool                  `json:"lateCancellation"`
+	Shifts                    []*RegistrationShift  `json:"shifts"`
+	TeamRegistrationID        *string               `json:"teamRegistrationId,omitempty"`
+	CanCancel                 bool                  `json:"canCancel"`
+	CanCheckIn                bool                  `json:"canCheckIn"`
+	CreatedAt                 string                `json:"createdAt"`
+	UpdatedAt                 string                `json:"updatedAt"`
+}
+
+type RegistrationAnswer struct {
+	QuestionID string                   `json:"questionId"`
+	Label      string                   `json:"label"`
+	Type       RegistrationQuestionType `json:"type"`
+	Value      *string                  `json:"value,omitempty"`
+	Values     []string                 `json:"values"`
+	FileName   *string                  `json:"fileName,omitempty"`
+	FileURL    *string                  `json:"fileUrl,omitempty"`
+}
+
+type RegistrationAnswerInput struct {
+	QuestionID string          `json:"questionId"`
+	Value      *string         `json:"value,omitempty"`
+	Values     []string        `json:"values,omitempty"`
+	File       *graphql.Upload `json:"file,omitempty"`
 }
 
 type RegistrationConflict struct {
+	ID               string           `json:"id"`
 	ConflictingEvent *Event           `json:"conflictingEvent"`
 	ConflictType     ConflictType     `json:"conflictType"`
 	Severity         ConflictSeverity `json:"severity"`
+	Resolved         bool             `json:"resolved"`
 	Suggestions      []*Event         `json:"suggestions"`
 }
 
@@ -403,22 +749,85 @@ type RegistrationFilterInput struct {
 	AttendanceStatus []AttendanceStatus   `json:"attendanceStatus,omitempty"`
 }
 
+type RegistrationQuestion struct {
+	ID         string                          `json:"id"`
+	Position   int                             `json:"position"`
+	Label      string                          `json:"label"`
+	HelpText   *string                         `json:"helpText,omitempty"`
+	Type       RegistrationQuestionType        `json:"type"`
+	Required   bool                            `json:"required"`
+	Options    []string                        `json:"options"`
+	Validation *RegistrationQuestionValidation `json:"validation"`
+}
+
+type RegistrationQuestionInput struct {
+	ID         *string                              `json:"id,omitempty"`
+	Label      string                               `json:"label"`
+	HelpText   *string                              `json:"helpText,omitempty"`
+	Type       RegistrationQuestionType             `json:"type"`
+	Required   *bool                                `json:"required,omitempty"`
+	Options    []string                             `json:"options,omitempty"`
+	Validation *RegistrationQuestionValidationInput `json:"validation,omitempty"`
+}
+
+type RegistrationQuestionValidation struct {
+	MinLength        *int     `json:"minLength,omitempty"`
+	MaxLength        *int     `json:"maxLength,omitempty"`
+	Pattern          *string  `json:"pattern,omitempty"`
+	Min              *float64 `json:"min,omitempty"`
+	Max              *float64 `json:"max,omitempty"`
+	MinSelections    *int     `json:"minSelections,omitempty"`
+	MaxSelections    *int     `json:"maxSelections,omitempty"`
+	EarliestDate     *string  `json:"earliestDate,omitempty"`
+	LatestDate       *string  `json:"latestDate,omitempty"`
+	AllowedFileTypes []string `json:"allowedFileTypes,omitempty"`
+	MaxFileBytes     *int     `json:"maxFileBytes,omitempty"`
+}
+
+type RegistrationQuestionValidationInput struct {
+	MinLength        *int     `json:"minLength,omitempty"`
+	MaxLength        *int     `json:"maxLength,omitempty"`
+	Pattern          *string  `json:"pattern,omitempty"`
+	Min              *float64 `json:"min,omitempty"`
+	Max              *float64 `json:"max,omitempty"`
+	MinSelections    *int     `json:"minSelections,omitempty"`
+	MaxSelections    *int     `json:"maxSelections,omitempty"`
+	EarliestDate     *string  `json:"earliestDate,omitempty"`
+	LatestDate       *string  `json:"latestDate,omitempty"`
+	AllowedFileTypes []string `json:"allowedFileTypes,omitempty"`
+	MaxFileBytes     *int     `json:"maxFileBytes,omitempty"`
+}
+
 type RegistrationSettings struct {
-	OpensAt              *time.Time `json:"opensAt,omitempty"`
-	ClosesAt             time.Time  `json:"closesAt"`
-	RequiresApproval     bool       `json:"requiresApproval"`
-	AllowWaitlist        bool       `json:"allowWaitlist"`
-	ConfirmationRequired bool       `json:"confirmationRequired"`
-	CancellationDeadline *time.Time `json:"cancellationDeadline,omitempty"`
+	OpensAt               *time.Time `json:"opensAt,omitempty"`
+	ClosesAt              time.Time  `json:"closesAt"`
+	RequiresApproval      bool       `json:"requiresApproval"`
+	AllowWaitlist         bool       `json:"allowWaitlist"`
+	ConfirmationRequired  bool       `json:"confirmationRequired"`
+	CancellationDeadline  *time.Time `json:"cancellationDeadline,omitempty"`
+	BlockLateCancellation bool       `json:"blockLateCancellation"`
+	ReconfirmationHours   int        `json:"reconfirmationHours"`
 }
 
 type RegistrationSettingsInput struct {
-	OpensAt              *time.Time `json:"opensAt,omitempty"`
-	ClosesAt             time.Time  `json:"closesAt"`
-	RequiresApproval     bool       `json:"requiresApproval"`
-	AllowWaitlist        bool       `json:"allowWaitlist"`
-	ConfirmationRequired bool       `json:"confirmationRequired"`
-	CancellationDeadline *time.Time `json:"cancellationDeadline,omitempty"`
+	OpensAt               *time.Time `json:"opensAt,omitempty"`
+	ClosesAt              time.Time  `json:"closesAt"`
+	RequiresApproval      bool       `json:"requiresApproval"`
+	AllowWaitlist         bool       `json:"allowWaitlist"`
+	ConfirmationRequired  bool       `json:"confirmationRequired"`
+	CancellationDeadline  *time.Time `json:"cancellationDeadline,omitempty"`
+	BlockLateCancellation bool       `json:"blockLateCancellation"`
+	ReconfirmationHours   int        `json:"reconfirmationHours"`
+}
+
+type RegistrationShift struct {
+	ID               string                `json:"id"`
+	Shift            *EventShift           `json:"shift"`
+	Status           ShiftAssignmentStatus `json:"status"`
+	WaitlistPosition *int                  `json:"waitlistPosition,omitempty"`
+	ConfirmedAt      *string               `json:"confirmedAt,omitempty"`
+	CheckedInAt      *string               `json:"checkedInAt,omitempty"`
+	CheckedOutAt     *string               `json:"checkedOutAt,omitempty"`
 }
 
 type RegistrationStats struct {
@@ -430,13 +839,35 @@ type RegistrationStats struct {
 	CancellationRate       float64 `json:"cancellationRate"`
 }
 
+type RegistrationTicket struct {
+	Registration *Registration `json:"registration"`
+	Token        string        `json:"token"`
+	QRPngURL     string        `json:"qrPngUrl"`
+	QRSVGURL     string        `json:"qrSvgUrl"`
+}
+
+type ScanTicketInput struct {
+	Token        string  `json:"token"`
+	ScannedAt    *string `json:"scannedAt,omitempty"`
+	ClientScanID *string `json:"clientScanId,omitempty"`
+}
+
 type Skill struct {
 	ID          string           `json:"id"`
 	Name        string           `json:"name"`
+	SkillID     *string          `json:"skillId,omitempty"`
 	Proficiency SkillProficiency `json:"proficiency"`
 	Verified    bool             `json:"verified"`
 }
 
+type SkillCatalogEntry struct {
+	ID       string   `json:"id"`
+	Slug     string   `json:"slug"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Synonyms []string `json:"synonyms"`
+}
+
 type SkillInput struct {
 	Name        string           `json:"name"`
 	Proficiency SkillProficiency `json:"proficiency"`
@@ -445,6 +876,7 @@ type SkillInput struct {
 type SkillRequirement struct {
 	ID          string           `json:"id"`
 	Skill       string           `json:"skill"`
+	SkillID     *string          `json:"skillId,omitempty"`
 	Proficiency SkillProficiency `json:"proficiency"`
 	Required    bool             `json:"required"`
 }
@@ -455,6 +887,90 @@ type SkillRequirementInput struct {
 	Required    bool             `json:"required"`
 }
 
+type TaxonomyTerm struct {
+	ID          string        `json:"id"`
+	Taxonomy    EventTaxonomy `json:"taxonomy"`
+	Slug        string        `json:"slug"`
+	Name        string        `json:"name"`
+	Description *string       `json:"description,omitempty"`
+	Icon        *string       `json:"icon,omitempty"`
+	ParentID    *string       `json:"parentId,omitempty"`
+	SortOrder   int           `json:"sortOrder"`
+	IsActive    bool          `json:"isActive"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+}
+
+type TaxonomyTermInput struct {
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	ParentID    *string `json:"parentId,omitempty"`
+	SortOrder   int     `json:"sortOrder"`
+	IsActive    bool    `json:"isActive"`
+}
+
+type Team struct {
+	ID            string              `json:"id"`
+	Name          string              `json:"name"`
+	Description   *string             `json:"description,omitempty"`
+	Leader        *User               `json:"leader"`
+	Members       []*TeamMember       `json:"members"`
+	Registrations []*TeamRegistration `json:"registrations"`
+	Stats         *TeamStats          `json:"stats"`
+	CreatedAt     string              `json:"createdAt"`
+	UpdatedAt     string              `json:"updatedAt"`
+}
+
+type TeamMember struct {
+	User     *User  `json:"user"`
+	JoinedAt string `json:"joinedAt"`
+}
+
+type TeamRegistration struct {
+	ID                 string             `json:"id"`
+	Team               *Team              `json:"team"`
+	Event              *Event             `json:"event"`
+	Seats              int                `json:"seats"`
+	Status             RegistrationStatus `json:"status"`
+	WaitlistPosition   *int               `json:"waitlistPosition,omitempty"`
+	AssignmentDeadline string             `json:"assignmentDeadline"`
+	AssignedSeats      []*Registration    `json:"assignedSeats"`
+	ConfirmedAt        *string            `json:"confirmedAt,omitempty"`
+	SeatsReleasedAt    *string            `json:"seatsReleasedAt,omitempty"`
+	CancelledAt        *string            `json:"cancelledAt,omitempty"`
+	CreatedAt          string             `json:"createdAt"`
+}
+
+type TeamStats struct {
+	Events        int     `json:"events"`
+	SeatsReserved int     `json:"seatsReserved"`
+	SeatsAssigned int     `json:"seatsAssigned"`
+	Attended      int     `json:"attended"`
+	NoShows       int     `json:"noShows"`
+	Hours         float64 `json:"hours"`
+}
+
+type TicketScanResult struct {
+	Outcome      TicketScanOutcome `json:"outcome"`
+	Registration *Registration     `json:"registration"`
+	ScannedAt    string            `json:"scannedAt"`
+}
+
+type Training struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	CompletedAt string  `json:"completedAt"`
+	ExpiresAt   *string `json:"expiresAt,omitempty"`
+}
+
+type TrainingInput struct {
+	Name        string  `json:"name"`
+	CompletedAt string  `json:"completedAt"`
+	ExpiresAt   *string `json:"expiresAt,omitempty"`
+}
+
 type TrainingRequirement struct {
 	ID                  string  `json:"id"`
 	Name                string  `json:"name"`
@@ -471,40 +987,59 @@ type TrainingRequirementInput struct {
 }
 
 type UpdateEventInput struct {
-	Title            *string                 `json:"title,omitempty"`
-	Description      *string                 `json:"description,omitempty"`
-	ShortDescription *string                 `json:"shortDescription,omitempty"`
-	Location         *EventLocationInput     `json:"location,omitempty"`
-	Requirements     *EventRequirementsInput `json:"requirements,omitempty"`
-	Tags             []string                `json:"tags,omitempty"`
-	Category         *EventCategory          `json:"category,omitempty"`
+	Title                *string                    `json:"title,omitempty"`
+	Description          *string                    `json:"description,omitempty"`
+	ShortDescription     *string                    `json:"shortDescription,omitempty"`
+	StartTime            *time.Time                 `json:"startTime,omitempty"`
+	EndTime              *time.Time                 `json:"endTime,omitempty"`
+	Location             *EventLocationInput        `json:"location,omitempty"`
+	Capacity             *EventCapacityInput        `json:"capacity,omitempty"`
+	Requirements         *EventRequirementsInput    `json:"requirements,omitempty"`
+	Tags                 []string                   `json:"tags,omitempty"`
+	Category             *string                    `json:"category,omitempty"`
+	TimeCommitment       *string                    `json:"timeCommitment,omitempty"`
+	RegistrationSettings *RegistrationSettingsInput `json:"registrationSettings,omitempty"`
+	CapacityReduction    *CapacityReductionPolicy   `json:"capacityReduction,omitempty"`
+}
+
+type UpdateOrganizationInput struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Website      *string `json:"website,omitempty"`
+	ContactEmail *string `json:"contactEmail,omitempty"`
+	LogoURL      *string `json:"logoUrl,omitempty"`
 }
 
 type UpdateProfileInput struct {
-	Name     *string        `json:"name,omitempty"`
-	Bio      *string        `json:"bio,omitempty"`
-	Location *LocationInput `json:"location,omitempty"`
+	Name        *string        `json:"name,omitempty"`
+	Bio         *string        `json:"bio,omitempty"`
+	Location    *LocationInput `json:"location,omitempty"`
+	DateOfBirth *string        `json:"dateOfBirth,omitempty"`
 }
 
 type User struct {
-	ID             string         `json:"id"`
-	Email          string         `json:"email"`
-	Name           string         `json:"name"`
-	EmailVerified  bool           `json:"emailVerified"`
-	GoogleID       *string        `json:"googleId,omitempty"`
-	LastLogin      *time.Time     `json:"lastLogin,omitempty"`
-	CreatedAt      time.Time      `json:"createdAt"`
-	UpdatedAt      time.Time      `json:"updatedAt"`
-	Bio            *string        `json:"bio,omitempty"`
-	Location       *Location      `json:"location,omitempty"`
-	ProfilePicture *string        `json:"profilePicture,omitempty"`
-	Interests      []*Interest    `json:"interests"`
-	Skills         []*Skill       `json:"skills"`
-	Roles          []string       `json:"roles"`
-	IsVerified     bool           `json:"isVerified"`
-	JoinedAt       time.Time      `json:"joinedAt"`
-	LastActiveAt   *time.Time     `json:"lastActiveAt,omitempty"`
-	PublicProfile  *PublicProfile `json:"publicProfile"`
+	ID                        string         `json:"id"`
+	Email                     string         `json:"email"`
+	Name                      string         `json:"name"`
+	EmailVerified             bool           `json:"emailVerified"`
+	GoogleID                  *string        `json:"googleId,omitempty"`
+	LastLogin                 *time.Time     `json:"lastLogin,omitempty"`
+	CreatedAt                 time.Time      `json:"createdAt"`
+	UpdatedAt                 time.Time      `json:"updatedAt"`
+	Bio                       *string        `json:"bio,omitempty"`
+	Location                  *Location      `json:"location,omitempty"`
+	ProfilePicture            *string        `json:"profilePicture,omitempty"`
+	Interests                 []*Interest    `json:"interests"`
+	Skills                    []*Skill       `json:"skills"`
+	Roles                     []string       `json:"roles"`
+	IsVerified                bool           `json:"isVerified"`
+	JoinedAt                  time.Time      `json:"joinedAt"`
+	LastActiveAt              *time.Time     `json:"lastActiveAt,omitempty"`
+	PublicProfile             *PublicProfile `json:"publicProfile"`
+	DateOfBirth               *string        `json:"dateOfBirth,omitempty"`
+	Trainings                 []*Training    `json:"trainings"`
+	BackgroundCheckVerifiedAt *time.Time     `json:"backgroundCheckVerifiedAt,omitempty"`
+	BackgroundCheckExpiresAt  *time.Time     `json:"backgroundCheckExpiresAt,omitempty"`
 }
 
 type UserSearchFilter struct {
@@ -521,6 +1056,24 @@ type UserSkill struct {
 	Proficiency SkillProficiency `json:"proficiency"`
 }
 
+type VolunteerHoursEntry struct {
+	ID             string        `json:"id"`
+	User           *User         `json:"user"`
+	Event          *Event        `json:"event,omitempty"`
+	Organization   *Organization `json:"organization,omitempty"`
+	AdjustsEntryID *string       `json:"adjustsEntryId,omitempty"`
+	Source         HoursSource   `json:"source"`
+	Status         HoursStatus   `json:"status"`
+	Hours          float64       `json:"hours"`
+	Category       string        `json:"category"`
+	Description    *string       `json:"description,omitempty"`
+	Reason         *string       `json:"reason,omitempty"`
+	ActivityDate   string        `json:"activityDate"`
+	ReviewedAt     *string       `json:"reviewedAt,omitempty"`
+	ReviewNotes    *string       `json:"reviewNotes,omitempty"`
+	CreatedAt      string        `json:"createdAt"`
+}
+
 type VolunteerStats struct {
 	Hours              int `json:"hours"`
 	EventsParticipated int `json:"eventsParticipated"`
@@ -536,6 +1089,42 @@ type WaitlistEntry struct {
 	AutoPromote            bool          `json:"autoPromote"`
 }
 
+type WaiverInput struct {
+	WaiverID *string `json:"waiverId,omitempty"`
+	Title    string  `json:"title"`
+	Body     string  `json:"body"`
+	Required *bool   `json:"required,omitempty"`
+}
+
+type WaiverSignature struct {
+	ID              string  `json:"id"`
+	WaiverID        string  `json:"waiverId"`
+	WaiverTitle     string  `json:"waiverTitle"`
+	WaiverVersionID string  `json:"waiverVersionId"`
+	Version         int     `json:"version"`
+	User            *User   `json:"user"`
+	RegistrationID  *string `json:"registrationId,omitempty"`
+	SignedName      string  `json:"signedName"`
+	IPAddress       *string `json:"ipAddress,omitempty"`
+	UserAgent       *string `json:"userAgent,omitempty"`
+	DocumentHash    string  `json:"documentHash"`
+	SignedAt        string  `json:"signedAt"`
+}
+
+type WaiverSignatureInput struct {
+	WaiverVersionID string  `json:"waiverVersionId"`
+	SignedName      string  `json:"signedName"`
+	DocumentHash    *string `json:"documentHash,omitempty"`
+}
+
+type WaiverVersion struct {
+	ID          string `json:"id"`
+	Version     int    `json:"version"`
+	Body        string `json:"body"`
+	ContentHash string `json:"contentHash"`
+	CreatedAt   string `json:"createdAt"`
+}
+
 type AttendanceStatus string
 
 const (
@@ -654,6 +1243,118 @@ func (e AvailabilityStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type BulkRowOutcome string
+
+const (
+	BulkRowOutcomeUpdated   BulkRowOutcome = "UPDATED"
+	BulkRowOutcomeUnchanged BulkRowOutcome = "UNCHANGED"
+	BulkRowOutcomeFailed    BulkRowOutcome = "FAILED"
+)
+
+var AllBulkRowOutcome = []BulkRowOutcome{
+	BulkRowOutcomeUpdated,
+	BulkRowOutcomeUnchanged,
+	BulkRowOutcomeFailed,
+}
+
+func (e BulkRowOutcome) IsValid() bool {
+	switch e {
+	case BulkRowOutcomeUpdated, BulkRowOutcomeUnchanged, BulkRowOutcomeFailed:
+		return true
+	}
+	return false
+}
+
+func (e BulkRowOutcome) String() string {
+	return string(e)
+}
+
+func (e *BulkRowOutcome) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkRowOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkRowOutcome", str)
+	}
+	return nil
+}
+
+func (e BulkRowOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BulkRowOutcome) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BulkRowOutcome) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CapacityReductionPolicy string
+
+const (
+	CapacityReductionPolicyReject         CapacityReductionPolicy = "REJECT"
+	CapacityReductionPolicyWaitlistLatest CapacityReductionPolicy = "WAITLIST_LATEST"
+)
+
+var AllCapacityReductionPolicy = []CapacityReductionPolicy{
+	CapacityReductionPolicyReject,
+	CapacityReductionPolicyWaitlistLatest,
+}
+
+func (e CapacityReductionPolicy) IsValid() bool {
+	switch e {
+	case CapacityReductionPolicyReject, CapacityReductionPolicyWaitlistLatest:
+		return true
+	}
+	return false
+}
+
+func (e CapacityReductionPolicy) String() string {
+	return string(e)
+}
+
+func (e *CapacityReductionPolicy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CapacityReductionPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CapacityReductionPolicy", str)
+	}
+	return nil
+}
+
+func (e CapacityReductionPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CapacityReductionPolicy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CapacityReductionPolicy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ConflictSeverity string
 
 const (
@@ -837,76 +1538,60 @@ func (e DayOfWeek) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-type EventCategory string
+type EligibilityCode string
 
 const (
-	EventCategoryCommunityService EventCategory = "COMMUNITY_SERVICE"
-	EventCategoryEnvironmental    EventCategory = "ENVIRONMENTAL"
-	EventCategoryEducation        EventCategory = "EDUCATION"
-	EventCategoryHealthWellness   EventCategory = "HEALTH_WELLNESS"
-	EventCategoryDisasterRelief   EventCategory = "DISASTER_RELIEF"
-	EventCategoryAnimalWelfare    EventCategory = "ANIMAL_WELFARE"
-	EventCategoryArtsCulture      EventCategory = "ARTS_CULTURE"
-	EventCategoryTechnology       EventCategory = "TECHNOLOGY"
-	EventCategorySportsRecreation EventCategory = "SPORTS_RECREATION"
-	EventCategoryFoodHunger       EventCategory = "FOOD_HUNGER"
-	EventCategoryYouthDevelopment EventCategory = "YOUTH_DEVELOPMENT"
-	EventCategorySeniorCare       EventCategory = "SENIOR_CARE"
-	EventCategoryHomelessServices EventCategory = "HOMELESS_SERVICES"
-	EventCategoryFundraising      EventCategory = "FUNDRAISING"
-	EventCategoryAdvocacy         EventCategory = "ADVOCACY"
-	EventCategoryOther            EventCategory = "OTHER"
+	EligibilityCodeMinimumAge         EligibilityCode = "MINIMUM_AGE"
+	EligibilityCodeDateOfBirthMissing EligibilityCode = "DATE_OF_BIRTH_MISSING"
+	EligibilityCodeSkillMissing       EligibilityCode = "SKILL_MISSING"
+	EligibilityCodeSkillProficiency   EligibilityCode = "SKILL_PROFICIENCY"
+	EligibilityCodeTrainingMissing    EligibilityCode = "TRAINING_MISSING"
+	EligibilityCodeTrainingExpired    EligibilityCode = "TRAINING_EXPIRED"
+	EligibilityCodeBackgroundCheck    EligibilityCode = "BACKGROUND_CHECK"
+	EligibilityCodeInterestMismatch   EligibilityCode = "INTEREST_MISMATCH"
 )
 
-var AllEventCategory = []EventCategory{
-	EventCategoryCommunityService,
-	EventCategoryEnvironmental,
-	EventCategoryEducation,
-	EventCategoryHealthWellness,
-	EventCategoryDisasterRelief,
-	EventCategoryAnimalWelfare,
-	EventCategoryArtsCulture,
-	EventCategoryTechnology,
-	EventCategorySportsRecreation,
-	EventCategoryFoodHunger,
-	EventCategoryYouthDevelopment,
-	EventCategorySeniorCare,
-	EventCategoryHomelessServices,
-	EventCategoryFundraising,
-	EventCategoryAdvocacy,
-	EventCategoryOther,
-}
-
-func (e EventCategory) IsValid() bool {
+var AllEligibilityCode = []EligibilityCode{
+	EligibilityCodeMinimumAge,
+	EligibilityCodeDateOfBirthMissing,
+	EligibilityCodeSkillMissing,
+	EligibilityCodeSkillProficiency,
+	EligibilityCodeTrainingMissing,
+	EligibilityCodeTrainingExpired,
+	EligibilityCodeBackgroundCheck,
+	EligibilityCodeInterestMismatch,
+}
+
+func (e EligibilityCode) IsValid() bool {
 	switch e {
-	case EventCategoryCommunityService, EventCategoryEnvironmental, EventCategoryEducation, EventCategoryHealthWellness, EventCategoryDisasterRelief, EventCategoryAnimalWelfare, EventCategoryArtsCulture, EventCategoryTechnology, EventCategorySportsRecreation, EventCategoryFoodHunger, EventCategoryYouthDevelopment, EventCategorySeniorCare, EventCategoryHomelessServices, EventCategoryFundraising, EventCategoryAdvocacy, EventCategoryOther:
+	case EligibilityCodeMinimumAge, EligibilityCodeDateOfBirthMissing, EligibilityCodeSkillMissing, EligibilityCodeSkillProficiency, EligibilityCodeTrainingMissing, EligibilityCodeTrainingExpired, EligibilityCodeBackgroundCheck, EligibilityCodeInterestMismatch:
 		return true
 	}
 	return false
 }
 
-func (e EventCategory) String() string {
+func (e EligibilityCode) String() string {
 	return string(e)
 }
 
-func (e *EventCategory) UnmarshalGQL(v any) error {
+func (e *EligibilityCode) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EventCategory(str)
+	*e = EligibilityCode(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EventCategory", str)
+		return fmt.Errorf("%s is not a valid EligibilityCode", str)
 	}
 	return nil
 }
 
-func (e EventCategory) MarshalGQL(w io.Writer) {
+func (e EligibilityCode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *EventCategory) UnmarshalJSON(b []byte) error {
+func (e *EligibilityCode) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -914,7 +1599,7 @@ func (e *EventCategory) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e EventCategory) MarshalJSON() ([]byte, error) {
+func (e EligibilityCode) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -981,54 +1666,50 @@ func (e EventSortField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-type EventStatus string
+type EventStaffRole string
 
 const (
-	EventStatusDraft     EventStatus = "DRAFT"
-	EventStatusPublished EventStatus = "PUBLISHED"
-	EventStatusCancelled EventStatus = "CANCELLED"
-	EventStatusCompleted EventStatus = "COMPLETED"
-	EventStatusArchived  EventStatus = "ARCHIVED"
+	EventStaffRoleCoOrganizer  EventStaffRole = "CO_ORGANIZER"
+	EventStaffRoleCheckinStaff EventStaffRole = "CHECKIN_STAFF"
+	EventStaffRoleViewer       EventStaffRole = "VIEWER"
 )
 
-var AllEventStatus = []EventStatus{
-	EventStatusDraft,
-	EventStatusPublished,
-	EventStatusCancelled,
-	EventStatusCompleted,
-	EventStatusArchived,
+var AllEventStaffRole = []EventStaffRole{
+	EventStaffRoleCoOrganizer,
+	EventStaffRoleCheckinStaff,
+	EventStaffRoleViewer,
 }
 
-func (e EventStatus) IsValid() bool {
+func (e EventStaffRole) IsValid() bool {
 	switch e {
-	case EventStatusDraft, EventStatusPublished, EventStatusCancelled, EventStatusCompleted, EventStatusArchived:
+	case EventStaffRoleCoOrganizer, EventStaffRoleCheckinStaff, EventStaffRoleViewer:
 		return true
 	}
 	return false
 }
 
-func (e EventStatus) String() string {
+func (e EventStaffRole) String() string {
 	return string(e)
 }
 
-func (e *EventStatus) UnmarshalGQL(v any) error {
+func (e *EventStaffRole) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EventStatus(str)
+	*e = EventStaffRole(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EventStatus", str)
+		return fmt.Errorf("%s is not a valid EventStaffRole", str)
 	}
 	return nil
 }
 
-func (e EventStatus) MarshalGQL(w io.Writer) {
+func (e EventStaffRole) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *EventStatus) UnmarshalJSON(b []byte) error {
+func (e *EventStaffRole) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1036,56 +1717,56 @@ func (e *EventStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e EventStatus) MarshalJSON() ([]byte, error) {
+func (e EventStaffRole) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type ExperienceLevel string
+type EventStaffStatus string
 
 const (
-	ExperienceLevelJunior ExperienceLevel = "JUNIOR"
-	ExperienceLevelMid    ExperienceLevel = "MID"
-	ExperienceLevelSenior ExperienceLevel = "SENIOR"
+	EventStaffStatusInvited EventStaffStatus = "INVITED"
+	EventStaffStatusActive  EventStaffStatus = "ACTIVE"
+	EventStaffStatusRemoved EventStaffStatus = "REMOVED"
 )
 
-var AllExperienceLevel = []ExperienceLevel{
-	ExperienceLevelJunior,
-	ExperienceLevelMid,
-	ExperienceLevelSenior,
+var AllEventStaffStatus = []EventStaffStatus{
+	EventStaffStatusInvited,
+	EventStaffStatusActive,
+	EventStaffStatusRemoved,
 }
 
-func (e ExperienceLevel) IsValid() bool {
+func (e EventStaffStatus) IsValid() bool {
 	switch e {
-	case ExperienceLevelJunior, ExperienceLevelMid, ExperienceLevelSenior:
+	case EventStaffStatusInvited, EventStaffStatusActive, EventStaffStatusRemoved:
 		return true
 	}
 	return false
 }
 
-func (e ExperienceLevel) String() string {
+func (e EventStaffStatus) String() string {
 	return string(e)
 }
 
-func (e *ExperienceLevel) UnmarshalGQL(v any) error {
+func (e *EventStaffStatus) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ExperienceLevel(str)
+	*e = EventStaffStatus(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ExperienceLevel", str)
+		return fmt.Errorf("%s is not a valid EventStaffStatus", str)
 	}
 	return nil
 }
 
-func (e ExperienceLevel) MarshalGQL(w io.Writer) {
+func (e EventStaffStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ExperienceLevel) UnmarshalJSON(b []byte) error {
+func (e *EventStaffStatus) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1093,36 +1774,437 @@ func (e *ExperienceLevel) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ExperienceLevel) MarshalJSON() ([]byte, error) {
+func (e EventStaffStatus) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type InterestCategory string
+type EventStatus string
 
 const (
-	InterestCategoryEnvironment InterestCategory = "ENVIRONMENT"
-	InterestCategoryEducation   InterestCategory = "EDUCATION"
-	InterestCategoryHealth      InterestCategory = "HEALTH"
-	InterestCategoryCommunity   InterestCategory = "COMMUNITY"
-	InterestCategoryTechnology  InterestCategory = "TECHNOLOGY"
-	InterestCategoryArts        InterestCategory = "ARTS"
-	InterestCategorySports      InterestCategory = "SPORTS"
-	InterestCategoryAnimals     InterestCategory = "ANIMALS"
+	EventStatusDraft     EventStatus = "DRAFT"
+	EventStatusPublished EventStatus = "PUBLISHED"
+	EventStatusCancelled EventStatus = "CANCELLED"
+	EventStatusCompleted EventStatus = "COMPLETED"
+	EventStatusArchived  EventStatus = "ARCHIVED"
 )
 
-var AllInterestCategory = []InterestCategory{
-	InterestCategoryEnvironment,
-	InterestCategoryEducation,
-	InterestCategoryHealth,
-	InterestCategoryCommunity,
-	InterestCategoryTechnology,
-	InterestCategoryArts,
-	InterestCategorySports,
-	InterestCategoryAnimals,
-}
-
+var AllEventStatus = []EventStatus{
+	EventStatusDraft,
+	EventStatusPublished,
+	EventStatusCancelled,
+	EventStatusCompleted,
+	EventStatusArchived,
+}
+
+func (e EventStatus) IsValid() bool {
+	switch e {
+	case EventStatusDraft, EventStatusPublished, EventStatusCancelled, EventStatusCompleted, EventStatusArchived:
+		return true
+	}
+	return false
+}
+
+func (e EventStatus) String() string {
+	return string(e)
+}
+
+func (e *EventStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventStatus", str)
+	}
+	return nil
+}
+
+func (e EventStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EventTaxonomy string
+
+const (
+	EventTaxonomyCategory       EventTaxonomy = "CATEGORY"
+	EventTaxonomyTimeCommitment EventTaxonomy = "TIME_COMMITMENT"
+)
+
+var AllEventTaxonomy = []EventTaxonomy{
+	EventTaxonomyCategory,
+	EventTaxonomyTimeCommitment,
+}
+
+func (e EventTaxonomy) IsValid() bool {
+	switch e {
+	case EventTaxonomyCategory, EventTaxonomyTimeCommitment:
+		return true
+	}
+	return false
+}
+
+func (e EventTaxonomy) String() string {
+	return string(e)
+}
+
+func (e *EventTaxonomy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventTaxonomy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventTaxonomy", str)
+	}
+	return nil
+}
+
+func (e EventTaxonomy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventTaxonomy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventTaxonomy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ExperienceLevel string
+
+const (
+	ExperienceLevelJunior ExperienceLevel = "JUNIOR"
+	ExperienceLevelMid    ExperienceLevel = "MID"
+	ExperienceLevelSenior ExperienceLevel = "SENIOR"
+)
+
+var AllExperienceLevel = []ExperienceLevel{
+	ExperienceLevelJunior,
+	ExperienceLevelMid,
+	ExperienceLevelSenior,
+}
+
+func (e ExperienceLevel) IsValid() bool {
+	switch e {
+	case ExperienceLevelJunior, ExperienceLevelMid, ExperienceLevelSenior:
+		return true
+	}
+	return false
+}
+
+func (e ExperienceLevel) String() string {
+	return string(e)
+}
+
+func (e *ExperienceLevel) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExperienceLevel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExperienceLevel", str)
+	}
+	return nil
+}
+
+func (e ExperienceLevel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ExperienceLevel) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ExperienceLevel) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type GuardianConsentStatus string
+
+const (
+	GuardianConsentStatusPending    GuardianConsentStatus = "PENDING"
+	GuardianConsentStatusApproved   GuardianConsentStatus = "APPROVED"
+	GuardianConsentStatusDeclined   GuardianConsentStatus = "DECLINED"
+	GuardianConsentStatusSuperseded GuardianConsentStatus = "SUPERSEDED"
+)
+
+var AllGuardianConsentStatus = []GuardianConsentStatus{
+	GuardianConsentStatusPending,
+	GuardianConsentStatusApproved,
+	GuardianConsentStatusDeclined,
+	GuardianConsentStatusSuperseded,
+}
+
+func (e GuardianConsentStatus) IsValid() bool {
+	switch e {
+	case GuardianConsentStatusPending, GuardianConsentStatusApproved, GuardianConsentStatusDeclined, GuardianConsentStatusSuperseded:
+		return true
+	}
+	return false
+}
+
+func (e GuardianConsentStatus) String() string {
+	return string(e)
+}
+
+func (e *GuardianConsentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GuardianConsentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GuardianConsentStatus", str)
+	}
+	return nil
+}
+
+func (e GuardianConsentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GuardianConsentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GuardianConsentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type HoursCertificateScope string
+
+const (
+	HoursCertificateScopeEvent HoursCertificateScope = "EVENT"
+	HoursCertificateScopeRange HoursCertificateScope = "RANGE"
+)
+
+var AllHoursCertificateScope = []HoursCertificateScope{
+	HoursCertificateScopeEvent,
+	HoursCertificateScopeRange,
+}
+
+func (e HoursCertificateScope) IsValid() bool {
+	switch e {
+	case HoursCertificateScopeEvent, HoursCertificateScopeRange:
+		return true
+	}
+	return false
+}
+
+func (e HoursCertificateScope) String() string {
+	return string(e)
+}
+
+func (e *HoursCertificateScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HoursCertificateScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HoursCertificateScope", str)
+	}
+	return nil
+}
+
+func (e HoursCertificateScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *HoursCertificateScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e HoursCertificateScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type HoursSource string
+
+const (
+	HoursSourceAttendance HoursSource = "ATTENDANCE"
+	HoursSourceAdjustment HoursSource = "ADJUSTMENT"
+	HoursSourceExternal   HoursSource = "EXTERNAL"
+)
+
+var AllHoursSource = []HoursSource{
+	HoursSourceAttendance,
+	HoursSourceAdjustment,
+	HoursSourceExternal,
+}
+
+func (e HoursSource) IsValid() bool {
+	switch e {
+	case HoursSourceAttendance, HoursSourceAdjustment, HoursSourceExternal:
+		return true
+	}
+	return false
+}
+
+func (e HoursSource) String() string {
+	return string(e)
+}
+
+func (e *HoursSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HoursSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HoursSource", str)
+	}
+	return nil
+}
+
+func (e HoursSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *HoursSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e HoursSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type HoursStatus string
+
+const (
+	HoursStatusPending  HoursStatus = "PENDING"
+	HoursStatusApproved HoursStatus = "APPROVED"
+	HoursStatusRejected HoursStatus = "REJECTED"
+)
+
+var AllHoursStatus = []HoursStatus{
+	HoursStatusPending,
+	HoursStatusApproved,
+	HoursStatusRejected,
+}
+
+func (e HoursStatus) IsValid() bool {
+	switch e {
+	case HoursStatusPending, HoursStatusApproved, HoursStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e HoursStatus) String() string {
+	return string(e)
+}
+
+func (e *HoursStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HoursStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HoursStatus", str)
+	}
+	return nil
+}
+
+func (e HoursStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *HoursStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e HoursStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type InterestCategory string
+
+const (
+	InterestCategoryEnvironment InterestCategory = "ENVIRONMENT"
+	InterestCategoryEducation   InterestCategory = "EDUCATION"
+	InterestCategoryHealth      InterestCategory = "HEALTH"
+	InterestCategoryCommunity   InterestCategory = "COMMUNITY"
+	InterestCategoryTechnology  InterestCategory = "TECHNOLOGY"
+	InterestCategoryArts        InterestCategory = "ARTS"
+	InterestCategorySports      InterestCategory = "SPORTS"
+	InterestCategoryAnimals     InterestCategory = "ANIMALS"
+)
+
+var AllInterestCategory = []InterestCategory{
+	InterestCategoryEnvironment,
+	InterestCategoryEducation,
+	InterestCategoryHealth,
+	InterestCategoryCommunity,
+	InterestCategoryTechnology,
+	InterestCategoryArts,
+	InterestCategorySports,
+	InterestCategoryAnimals,
+}
+
 func (e InterestCategory) IsValid() bool {
 	switch e {
 	case InterestCategoryEnvironment, InterestCategoryEducation, InterestCategoryHealth, InterestCategoryCommunity, InterestCategoryTechnology, InterestCategoryArts, InterestCategorySports, InterestCategoryAnimals:
@@ -1166,6 +2248,122 @@ func (e InterestCategory) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner  OrganizationRole = "OWNER"
+	OrganizationRoleAdmin  OrganizationRole = "ADMIN"
+	OrganizationRoleMember OrganizationRole = "MEMBER"
+)
+
+var AllOrganizationRole = []OrganizationRole{
+	OrganizationRoleOwner,
+	OrganizationRoleAdmin,
+	OrganizationRoleMember,
+}
+
+func (e OrganizationRole) IsValid() bool {
+	switch e {
+	case OrganizationRoleOwner, OrganizationRoleAdmin, OrganizationRoleMember:
+		return true
+	}
+	return false
+}
+
+func (e OrganizationRole) String() string {
+	return string(e)
+}
+
+func (e *OrganizationRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrganizationRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationRole", str)
+	}
+	return nil
+}
+
+func (e OrganizationRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrganizationRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrganizationRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrganizationVerificationStatus string
+
+const (
+	OrganizationVerificationStatusUnverified OrganizationVerificationStatus = "UNVERIFIED"
+	OrganizationVerificationStatusPending    OrganizationVerificationStatus = "PENDING"
+	OrganizationVerificationStatusVerified   OrganizationVerificationStatus = "VERIFIED"
+	OrganizationVerificationStatusRejected   OrganizationVerificationStatus = "REJECTED"
+)
+
+var AllOrganizationVerificationStatus = []OrganizationVerificationStatus{
+	OrganizationVerificationStatusUnverified,
+	OrganizationVerificationStatusPending,
+	OrganizationVerificationStatusVerified,
+	OrganizationVerificationStatusRejected,
+}
+
+func (e OrganizationVerificationStatus) IsValid() bool {
+	switch e {
+	case OrganizationVerificationStatusUnverified, OrganizationVerificationStatusPending, OrganizationVerificationStatusVerified, OrganizationVerificationStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e OrganizationVerificationStatus) String() string {
+	return string(e)
+}
+
+func (e *OrganizationVerificationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrganizationVerificationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationVerificationStatus", str)
+	}
+	return nil
+}
+
+func (e OrganizationVerificationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrganizationVerificationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrganizationVerificationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ProfileVisibility string
 
 const (
@@ -1282,19 +2480,84 @@ func (e RecurrenceFrequency) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type RegistrationQuestionType string
+
+const (
+	RegistrationQuestionTypeText         RegistrationQuestionType = "TEXT"
+	RegistrationQuestionTypeSingleChoice RegistrationQuestionType = "SINGLE_CHOICE"
+	RegistrationQuestionTypeMultiChoice  RegistrationQuestionType = "MULTI_CHOICE"
+	RegistrationQuestionTypeNumber       RegistrationQuestionType = "NUMBER"
+	RegistrationQuestionTypeDate         RegistrationQuestionType = "DATE"
+	RegistrationQuestionTypeFile         RegistrationQuestionType = "FILE"
+)
+
+var AllRegistrationQuestionType = []RegistrationQuestionType{
+	RegistrationQuestionTypeText,
+	RegistrationQuestionTypeSingleChoice,
+	RegistrationQuestionTypeMultiChoice,
+	RegistrationQuestionTypeNumber,
+	RegistrationQuestionTypeDate,
+	RegistrationQuestionTypeFile,
+}
+
+func (e RegistrationQuestionType) IsValid() bool {
+	switch e {
+	case RegistrationQuestionTypeText, RegistrationQuestionTypeSingleChoice, RegistrationQuestionTypeMultiChoice, RegistrationQuestionTypeNumber, RegistrationQuestionTypeDate, RegistrationQuestionTypeFile:
+		return true
+	}
+	return false
+}
+
+func (e RegistrationQuestionType) String() string {
+	return string(e)
+}
+
+func (e *RegistrationQuestionType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RegistrationQuestionType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RegistrationQuestionType", str)
+	}
+	return nil
+}
+
+func (e RegistrationQuestionType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RegistrationQuestionType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RegistrationQuestionType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type RegistrationStatus string
 
 const (
-	RegistrationStatusPendingApproval RegistrationStatus = "PENDING_APPROVAL"
-	RegistrationStatusConfirmed       RegistrationStatus = "CONFIRMED"
-	RegistrationStatusWaitlisted      RegistrationStatus = "WAITLISTED"
-	RegistrationStatusCancelled       RegistrationStatus = "CANCELLED"
-	RegistrationStatusDeclined        RegistrationStatus = "DECLINED"
-	RegistrationStatusNoShow          RegistrationStatus = "NO_SHOW"
-	RegistrationStatusCompleted       RegistrationStatus = "COMPLETED"
+	RegistrationStatusPendingGuardianConsent RegistrationStatus = "PENDING_GUARDIAN_CONSENT"
+	RegistrationStatusPendingApproval        RegistrationStatus = "PENDING_APPROVAL"
+	RegistrationStatusConfirmed              RegistrationStatus = "CONFIRMED"
+	RegistrationStatusWaitlisted             RegistrationStatus = "WAITLISTED"
+	RegistrationStatusCancelled              RegistrationStatus = "CANCELLED"
+	RegistrationStatusDeclined               RegistrationStatus = "DECLINED"
+	RegistrationStatusNoShow                 RegistrationStatus = "NO_SHOW"
+	RegistrationStatusCompleted              RegistrationStatus = "COMPLETED"
 )
 
 var AllRegistrationStatus = []RegistrationStatus{
+	RegistrationStatusPendingGuardianConsent,
 	RegistrationStatusPendingApproval,
 	RegistrationStatusConfirmed,
 	RegistrationStatusWaitlisted,
@@ -1306,7 +2569,7 @@ var AllRegistrationStatus = []RegistrationStatus{
 
 func (e RegistrationStatus) IsValid() bool {
 	switch e {
-	case RegistrationStatusPendingApproval, RegistrationStatusConfirmed, RegistrationStatusWaitlisted, RegistrationStatusCancelled, RegistrationStatusDeclined, RegistrationStatusNoShow, RegistrationStatusCompleted:
+	case RegistrationStatusPendingGuardianConsent, RegistrationStatusPendingApproval, RegistrationStatusConfirmed, RegistrationStatusWaitlisted, RegistrationStatusCancelled, RegistrationStatusDeclined, RegistrationStatusNoShow, RegistrationStatusCompleted:
 		return true
 	}
 	return false
@@ -1347,6 +2610,65 @@ func (e RegistrationStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ShiftAssignmentStatus string
+
+const (
+	ShiftAssignmentStatusPending    ShiftAssignmentStatus = "PENDING"
+	ShiftAssignmentStatusConfirmed  ShiftAssignmentStatus = "CONFIRMED"
+	ShiftAssignmentStatusWaitlisted ShiftAssignmentStatus = "WAITLISTED"
+	ShiftAssignmentStatusCancelled  ShiftAssignmentStatus = "CANCELLED"
+)
+
+var AllShiftAssignmentStatus = []ShiftAssignmentStatus{
+	ShiftAssignmentStatusPending,
+	ShiftAssignmentStatusConfirmed,
+	ShiftAssignmentStatusWaitlisted,
+	ShiftAssignmentStatusCancelled,
+}
+
+func (e ShiftAssignmentStatus) IsValid() bool {
+	switch e {
+	case ShiftAssignmentStatusPending, ShiftAssignmentStatusConfirmed, ShiftAssignmentStatusWaitlisted, ShiftAssignmentStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e ShiftAssignmentStatus) String() string {
+	return string(e)
+}
+
+func (e *ShiftAssignmentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShiftAssignmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShiftAssignmentStatus", str)
+	}
+	return nil
+}
+
+func (e ShiftAssignmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ShiftAssignmentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ShiftAssignmentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SkillProficiency string
 
 const (
@@ -1461,54 +2783,48 @@ func (e SortDirection) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-type TimeCommitmentType string
+type TicketScanOutcome string
 
 const (
-	TimeCommitmentTypeOneTime  TimeCommitmentType = "ONE_TIME"
-	TimeCommitmentTypeWeekly   TimeCommitmentType = "WEEKLY"
-	TimeCommitmentTypeMonthly  TimeCommitmentType = "MONTHLY"
-	TimeCommitmentTypeSeasonal TimeCommitmentType = "SEASONAL"
-	TimeCommitmentTypeOngoing  TimeCommitmentType = "ONGOING"
+	TicketScanOutcomeCheckedIn        TicketScanOutcome = "CHECKED_IN"
+	TicketScanOutcomeAlreadyCheckedIn TicketScanOutcome = "ALREADY_CHECKED_IN"
 )
 
-var AllTimeCommitmentType = []TimeCommitmentType{
-	TimeCommitmentTypeOneTime,
-	TimeCommitmentTypeWeekly,
-	TimeCommitmentTypeMonthly,
-	TimeCommitmentTypeSeasonal,
-	TimeCommitmentTypeOngoing,
+var AllTicketScanOutcome = []TicketScanOutcome{
+	TicketScanOutcomeCheckedIn,
+	TicketScanOutcomeAlreadyCheckedIn,
 }
 
-func (e TimeCommitmentType) IsValid() bool {
+func (e TicketScanOutcome) IsValid() bool {
 	switch e {
-	case TimeCommitmentTypeOneTime, TimeCommitmentTypeWeekly, TimeCommitmentTypeMonthly, TimeCommitmentTypeSeasonal, TimeCommitmentTypeOngoing:
+	case TicketScanOutcomeCheckedIn, TicketScanOutcomeAlreadyCheckedIn:
 		return true
 	}
 	return false
 }
 
-func (e TimeCommitmentType) String() string {
+func (e TicketScanOutcome) String() string {
 	return string(e)
 }
 
-func (e *TimeCommitmentType) UnmarshalGQL(v any) error {
+func (e *TicketScanOutcome) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = TimeCommitmentType(str)
+	*e = TicketScanOutcome(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid TimeCommitmentType", str)
+		return fmt.Errorf("%s is not a valid TicketScanOutcome", str)
 	}
 	return nil
 }
 
-func (e TimeCommitmentType) MarshalGQL(w io.Writer) {
+func (e TicketScanOutcome) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *TimeCommitmentType) UnmarshalJSON(b []byte) error {
+func (e *TicketScanOutcome) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1516,7 +2832,7 @@ func (e *TimeCommitmentType) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e TimeCommitmentType) MarshalJSON() ([]byte, error) {
+func (e TicketScanOutcome) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -1582,3 +2898,60 @@ func (e UpdateType) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type UserRole string
+
+const (
+	UserRoleVolunteer UserRole = "VOLUNTEER"
+	UserRoleOrganizer UserRole = "ORGANIZER"
+	UserRoleAdmin     UserRole = "ADMIN"
+)
+
+var AllUserRole = []UserRole{
+	UserRoleVolunteer,
+	UserRoleOrganizer,
+	UserRoleAdmin,
+}
+
+func (e UserRole) IsValid() bool {
+	switch e {
+	case UserRoleVolunteer, UserRoleOrganizer, UserRoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e UserRole) String() string {
+	return string(e)
+}
+
+func (e *UserRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserRole", str)
+	}
+	return nil
+}
+
+func (e UserRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UserRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UserRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
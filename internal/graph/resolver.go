@@ -3,9 +3,13 @@ package graph
 import (
 	"database/sql"
 
+	"github.com/volunteersync/backend/internal/core/admin"
 	"github.com/volunteersync/backend/internal/core/auth"
 	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/hours"
+	"github.com/volunteersync/backend/internal/core/organization"
 	"github.com/volunteersync/backend/internal/core/registration"
+	"github.com/volunteersync/backend/internal/core/skill"
 	usercore "github.com/volunteersync/backend/internal/core/user"
 	"github.com/volunteersync/backend/internal/graph/generated"
 )
@@ -15,9 +19,34 @@ type Resolver struct {
 	DB                  *sql.DB
 	AuthService         *auth.AuthService
 	OAuthService        *auth.OAuthService
+	PasskeyService      *auth.PasskeyService
 	UserService         *usercore.Service
 	EventService        *event.EventService
 	RegistrationService *registration.Service
+	TicketService       *registration.TicketService
+	AttendanceService   *registration.AttendanceService
+	HoursService        *hours.Service
+	CertificateService  *hours.CertificateService
+	OrganizationService *organization.Service
+	AdminService        *admin.Service
+	SkillService        *skill.Service
+	// PublicURL prefixes links handed out for use outside the app
+	PublicURL string
+}
+
+// AttendanceRecord returns generated.AttendanceRecordResolver implementation.
+func (r *Resolver) AttendanceRecord() generated.AttendanceRecordResolver {
+	return &attendanceRecordResolver{r}
+}
+
+// EventHoursBreakdown returns generated.EventHoursBreakdownResolver implementation.
+func (r *Resolver) EventHoursBreakdown() generated.EventHoursBreakdownResolver {
+	return &eventHoursBreakdownResolver{r}
+}
+
+// HoursCertificateLine returns generated.HoursCertificateLineResolver implementation.
+func (r *Resolver) HoursCertificateLine() generated.HoursCertificateLineResolver {
+	return &hoursCertificateLineResolver{r}
 }
 
 // Mutation returns generated.MutationResolver implementation.
@@ -29,6 +58,17 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 // Event returns generated.EventResolver implementation.
 func (r *Resolver) Event() generated.EventResolver { return &eventResolver{r} }
 
+// EventStaff returns generated.EventStaffResolver implementation.
+func (r *Resolver) EventStaff() generated.EventStaffResolver { return &eventStaffResolver{r} }
+
+// Organization returns generated.OrganizationResolver implementation.
+func (r *Resolver) Organization() generated.OrganizationResolver { return &organizationResolver{r} }
+
+// OrganizationMember returns generated.OrganizationMemberResolver implementation.
+func (r *Resolver) OrganizationMember() generated.OrganizationMemberResolver {
+	return &organizationMemberResolver{r}
+}
+
 // PublicProfile returns generated.PublicProfileResolver implementation.
 func (r *Resolver) PublicProfile() generated.PublicProfileResolver { return &publicProfileResolver{r} }
 
@@ -37,3 +77,38 @@ func (r *Resolver) Registration() generated.RegistrationResolver { return &regis
 
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
+// VolunteerHoursEntry returns generated.VolunteerHoursEntryResolver implementation.
+func (r *Resolver) VolunteerHoursEntry() generated.VolunteerHoursEntryResolver {
+	return &volunteerHoursEntryResolver{r}
+}
+
+// WaiverSignature returns generated.WaiverSignatureResolver implementation.
+func (r *Resolver) WaiverSignature() generated.WaiverSignatureResolver {
+	return &waiverSignatureResolver{r}
+}
+
+// GuardianConsent returns generated.GuardianConsentResolver implementation.
+func (r *Resolver) GuardianConsent() generated.GuardianConsentResolver {
+	return &guardianConsentResolver{r}
+}
+
+// EventUpdate returns generated.EventUpdateResolver implementation.
+func (r *Resolver) EventUpdate() generated.EventUpdateResolver {
+	return &eventUpdateResolver{r}
+}
+
+// Team returns generated.TeamResolver implementation.
+func (r *Resolver) Team() generated.TeamResolver {
+	return &teamResolver{r}
+}
+
+// TeamMember returns generated.TeamMemberResolver implementation.
+func (r *Resolver) TeamMember() generated.TeamMemberResolver {
+	return &teamMemberResolver{r}
+}
+
+// TeamRegistration returns generated.TeamRegistrationResolver implementation.
+func (r *Resolver) TeamRegistration() generated.TeamRegistrationResolver {
+	return &teamRegistrationResolver{r}
+}
@@ -6,16 +6,45 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/volunteersync/backend/internal/core/auth"
 	"github.com/volunteersync/backend/internal/core/event"
+	"github.com/volunteersync/backend/internal/core/hours"
+	"github.com/volunteersync/backend/internal/core/organization"
+	"github.com/volunteersync/backend/internal/core/registration"
 	"github.com/volunteersync/backend/internal/graph/model"
 	mw "github.com/volunteersync/backend/internal/middleware"
 )
 
+// CheckedInBy is the resolver for the checkedInBy field.
+func (r *attendanceRecordResolver) CheckedInBy(ctx context.Context, obj *model.AttendanceRecord) (*model.User, error) {
+	if obj.CheckedInBy == nil {
+		return nil, nil
+	}
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.CheckedInBy.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
+}
+
 // Organizer is the resolver for the organizer field.
 func (r *eventResolver) Organizer(ctx context.Context, obj *model.Event) (*model.User, error) {
 	if r.UserService == nil {
@@ -38,6 +67,23 @@ func (r *eventResolver) Organizer(ctx context.Context, obj *model.Event) (*model
 	return toGraphUser(profile), nil
 }
 
+// Organization is the resolver for the organization field.
+func (r *eventResolver) Organization(ctx context.Context, obj *model.Event) (*model.Organization, error) {
+	if obj.OrganizationID == nil {
+		return nil, nil
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	org, err := r.OrganizationService.GetOrganization(ctx, *obj.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
+	}
+
+	return toGraphOrganization(org), nil
+}
+
 // Images is the resolver for the images field.
 func (r *eventResolver) Images(ctx context.Context, obj *model.Event) ([]*model.EventImage, error) {
 	// TODO: Implement image fetching when image management is implemented
@@ -50,6 +96,88 @@ func (r *eventResolver) Announcements(ctx context.Context, obj *model.Event) ([]
 	return []*model.EventAnnouncement{}, nil
 }
 
+// RegistrationQuestions is the resolver for the registrationQuestions field.
+func (r *eventResolver) RegistrationQuestions(ctx context.Context, obj *model.Event) ([]*model.RegistrationQuestion, error) {
+	if r.RegistrationService == nil {
+		return []*model.RegistrationQuestion{}, nil
+	}
+
+	questions, err := r.RegistrationService.GetEventQuestions(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.RegistrationQuestion, 0, len(questions))
+	for _, q := range questions {
+		out = append(out, toGraphRegistrationQuestion(q))
+	}
+	return out, nil
+}
+
+// Waivers is the resolver for the waivers field.
+func (r *eventResolver) Waivers(ctx context.Context, obj *model.Event) ([]*model.EventWaiver, error) {
+	if r.RegistrationService == nil {
+		return []*model.EventWaiver{}, nil
+	}
+
+	waivers, err := r.RegistrationService.GetEventWaivers(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.EventWaiver, 0, len(waivers))
+	for _, w := range waivers {
+		out = append(out, toGraphEventWaiver(w))
+	}
+	return out, nil
+}
+
+// Shifts is the resolver for the shifts field.
+func (r *eventResolver) Shifts(ctx context.Context, obj *model.Event) ([]*model.EventShift, error) {
+	if r.EventService == nil {
+		return []*model.EventShift{}, nil
+	}
+
+	shifts, err := r.EventService.GetShiftAvailability(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.EventShift, 0, len(shifts))
+	for _, shift := range shifts {
+		out = append(out, toGraphEventShift(shift))
+	}
+	return out, nil
+}
+
+// Occurrences is the resolver for the occurrences field.
+func (r *eventResolver) Occurrences(ctx context.Context, obj *model.Event, limit *int) ([]*model.EventOccurrence, error) {
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	evt, err := r.EventService.GetEvent(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	n := 10
+	if limit != nil {
+		n = *limit
+	}
+	if n < 1 || n > 100 {
+		return nil, fmt.Errorf("limit must be between 1 and 100")
+	}
+
+	loc := evt.LocalZone()
+	occurrences := evt.Occurrences(time.Now().UTC(), n)
+	out := make([]*model.EventOccurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		out = append(out, toGraphEventOccurrence(o, loc))
+	}
+	return out, nil
+}
+
 // CurrentRegistrations is the resolver for the currentRegistrations field.
 func (r *eventResolver) CurrentRegistrations(ctx context.Context, obj *model.Event) (int, error) {
 	if r.RegistrationService == nil {
@@ -64,6 +192,118 @@ func (r *eventResolver) CurrentRegistrations(ctx context.Context, obj *model.Eve
 	return len(registrations), nil
 }
 
+// Event is the resolver for the event field.
+func (r *eventHoursBreakdownResolver) Event(ctx context.Context, obj *model.EventHoursBreakdown) (*model.Event, error) {
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	domainEvent, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	return toGraphQLEvent(domainEvent), nil
+}
+
+// Event is the resolver for the event field.
+func (r *eventStaffResolver) Event(ctx context.Context, obj *model.EventStaff) (*model.Event, error) {
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	domainEvent, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	return toGraphQLEvent(domainEvent), nil
+}
+
+// User is the resolver for the user field.
+func (r *eventStaffResolver) User(ctx context.Context, obj *model.EventStaff) (*model.User, error) {
+	// Pending invitations have no linked user yet
+	if obj.User == nil {
+		return nil, nil
+	}
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.User.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
+}
+
+// UpdatedBy is the resolver for the updatedBy field.
+func (r *eventUpdateResolver) UpdatedBy(ctx context.Context, obj *model.EventUpdate) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.UpdatedBy.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
+}
+
+// User is the resolver for the user field.
+func (r *guardianConsentResolver) User(ctx context.Context, obj *model.GuardianConsent) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.User.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
+}
+
+// Event is the resolver for the event field.
+func (r *hoursCertificateLineResolver) Event(ctx context.Context, obj *model.HoursCertificateLine) (*model.Event, error) {
+	if obj.Event == nil {
+		return nil, nil
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	domainEvent, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	return toGraphQLEvent(domainEvent), nil
+}
+
 // Register is the resolver for the register field.
 func (r *mutationResolver) Register(ctx context.Context, input model.RegisterInput) (*model.AuthPayload, error) {
 	if r.AuthService == nil {
@@ -143,6 +383,100 @@ func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
 	panic(fmt.Errorf("not implemented: Logout - logout"))
 }
 
+// BeginPasskeyRegistration is the resolver for the beginPasskeyRegistration field.
+func (r *mutationResolver) BeginPasskeyRegistration(ctx context.Context) (*model.PasskeyCeremony, error) {
+	if r.PasskeyService == nil {
+		return nil, fmt.Errorf("passkey service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	ceremony, err := r.PasskeyService.BeginRegistration(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphPasskeyCeremony(ceremony), nil
+}
+
+// FinishPasskeyRegistration is the resolver for the finishPasskeyRegistration field.
+func (r *mutationResolver) FinishPasskeyRegistration(ctx context.Context, sessionID string, credential string, name *string) (*model.Passkey, error) {
+	if r.PasskeyService == nil {
+		return nil, fmt.Errorf("passkey service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	label := ""
+	if name != nil {
+		label = *name
+	}
+
+	passkey, err := r.PasskeyService.FinishRegistration(ctx, userID, sessionID, []byte(credential), label)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphPasskey(passkey), nil
+}
+
+// BeginPasskeyLogin is the resolver for the beginPasskeyLogin field.
+func (r *mutationResolver) BeginPasskeyLogin(ctx context.Context, email *string) (*model.PasskeyCeremony, error) {
+	if r.PasskeyService == nil {
+		return nil, fmt.Errorf("passkey service unavailable")
+	}
+
+	addr := ""
+	if email != nil {
+		addr = *email
+	}
+
+	ceremony, err := r.PasskeyService.BeginLogin(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphPasskeyCeremony(ceremony), nil
+}
+
+// FinishPasskeyLogin is the resolver for the finishPasskeyLogin field.
+func (r *mutationResolver) FinishPasskeyLogin(ctx context.Context, sessionID string, credential string) (*model.AuthPayload, error) {
+	if r.PasskeyService == nil {
+		return nil, fmt.Errorf("passkey service unavailable")
+	}
+
+	result, err := r.PasskeyService.FinishLogin(ctx, sessionID, []byte(credential))
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert auth.User to user.UserProfile for toGraphUser
+	userProfile := authUserToUserProfile(result.User)
+
+	return &model.AuthPayload{
+		Token:        result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		User:         toGraphUser(userProfile),
+	}, nil
+}
+
+// DeletePasskey is the resolver for the deletePasskey field.
+func (r *mutationResolver) DeletePasskey(ctx context.Context, id string) (bool, error) {
+	if r.PasskeyService == nil {
+		return false, fmt.Errorf("passkey service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	if err := r.PasskeyService.DeleteCredential(ctx, userID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // GoogleAuthURL is the resolver for the googleAuthURL field.
 func (r *mutationResolver) GoogleAuthURL(ctx context.Context, redirectURL string) (string, error) {
 	panic(fmt.Errorf("not implemented: GoogleAuthURL - googleAuthURL"))
@@ -163,6 +497,11 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 		return nil, fmt.Errorf("unauthorized")
 	}
 	in := toDomainUpdateProfile(input)
+	dob, err := parseDate(input.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+	in.DateOfBirth = dob
 	prof, err := r.UserService.UpdateProfile(ctx, userID, in)
 	if err != nil {
 		return nil, err
@@ -240,8 +579,8 @@ func (r *mutationResolver) RemoveSkill(ctx context.Context, skillID string) (*mo
 	return toGraphUser(prof), nil
 }
 
-// UpdatePrivacySettings is the resolver for the updatePrivacySettings field.
-func (r *mutationResolver) UpdatePrivacySettings(ctx context.Context, input model.PrivacySettingsInput) (*model.User, error) {
+// AddTraining is the resolver for the addTraining field.
+func (r *mutationResolver) AddTraining(ctx context.Context, input model.TrainingInput) (*model.User, error) {
 	if r.UserService == nil {
 		return nil, fmt.Errorf("service unavailable")
 	}
@@ -249,16 +588,19 @@ func (r *mutationResolver) UpdatePrivacySettings(ctx context.Context, input mode
 	if userID == "" {
 		return nil, fmt.Errorf("unauthorized")
 	}
-	in := toDomainPrivacyInput(input)
-	prof, err := r.UserService.UpdatePrivacySettings(ctx, userID, in)
+	in, err := toDomainTrainingInput(input)
+	if err != nil {
+		return nil, err
+	}
+	prof, err := r.UserService.AddTraining(ctx, userID, in)
 	if err != nil {
 		return nil, err
 	}
 	return toGraphUser(prof), nil
 }
 
-// UpdateNotificationPreferences is the resolver for the updateNotificationPreferences field.
-func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, input model.NotificationPreferencesInput) (*model.User, error) {
+// RemoveTraining is the resolver for the removeTraining field.
+func (r *mutationResolver) RemoveTraining(ctx context.Context, trainingID string) (*model.User, error) {
 	if r.UserService == nil {
 		return nil, fmt.Errorf("service unavailable")
 	}
@@ -266,29 +608,187 @@ func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, in
 	if userID == "" {
 		return nil, fmt.Errorf("unauthorized")
 	}
-	dom := toDomainNotifInput(input)
-	prof, err := r.UserService.UpdateNotificationPreferences(ctx, userID, dom)
+	prof, err := r.UserService.RemoveTraining(ctx, userID, trainingID)
 	if err != nil {
 		return nil, err
 	}
 	return toGraphUser(prof), nil
 }
 
-// ChangePassword is the resolver for the changePassword field.
-func (r *mutationResolver) ChangePassword(ctx context.Context, currentPassword string, newPassword string) (bool, error) {
-	panic(fmt.Errorf("not implemented: ChangePassword - changePassword"))
-}
-
-// DeactivateAccount is the resolver for the deactivateAccount field.
-func (r *mutationResolver) DeactivateAccount(ctx context.Context, confirmationCode string) (bool, error) {
-	panic(fmt.Errorf("not implemented: DeactivateAccount - deactivateAccount"))
-}
+// UpdatePrivacySettings is the resolver for the updatePrivacySettings field.
+func (r *mutationResolver) UpdatePrivacySettings(ctx context.Context, input model.PrivacySettingsInput) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	in := toDomainPrivacyInput(input)
+	prof, err := r.UserService.UpdatePrivacySettings(ctx, userID, in)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphUser(prof), nil
+}
+
+// UpdateNotificationPreferences is the resolver for the updateNotificationPreferences field.
+func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, input model.NotificationPreferencesInput) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	dom := toDomainNotifInput(input)
+	prof, err := r.UserService.UpdateNotificationPreferences(ctx, userID, dom)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphUser(prof), nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, currentPassword string, newPassword string) (bool, error) {
+	panic(fmt.Errorf("not implemented: ChangePassword - changePassword"))
+}
+
+// DeactivateAccount is the resolver for the deactivateAccount field.
+func (r *mutationResolver) DeactivateAccount(ctx context.Context, confirmationCode string) (bool, error) {
+	panic(fmt.Errorf("not implemented: DeactivateAccount - deactivateAccount"))
+}
 
 // ExportUserData is the resolver for the exportUserData field.
 func (r *mutationResolver) ExportUserData(ctx context.Context) (string, error) {
 	panic(fmt.Errorf("not implemented: ExportUserData - exportUserData"))
 }
 
+// CreateOrganization is the resolver for the createOrganization field.
+func (r *mutationResolver) CreateOrganization(ctx context.Context, input model.CreateOrganizationInput) (*model.Organization, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	org, err := r.OrganizationService.CreateOrganization(ctx, userID, toDomainCreateOrganizationInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphOrganization(org), nil
+}
+
+// UpdateOrganization is the resolver for the updateOrganization field.
+func (r *mutationResolver) UpdateOrganization(ctx context.Context, id string, input model.UpdateOrganizationInput) (*model.Organization, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	org, err := r.OrganizationService.UpdateOrganization(ctx, id, userID, toDomainUpdateOrganizationInput(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphOrganization(org), nil
+}
+
+// AddOrganizationMember is the resolver for the addOrganizationMember field.
+func (r *mutationResolver) AddOrganizationMember(ctx context.Context, organizationID string, userID string, role model.OrganizationRole) (*model.OrganizationMember, error) {
+	actorID := mw.GetUserIDFromContext(ctx)
+	if actorID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	member, err := r.OrganizationService.AddMember(ctx, organizationID, actorID, userID, organization.MemberRole(role))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphOrganizationMember(member), nil
+}
+
+// UpdateOrganizationMemberRole is the resolver for the updateOrganizationMemberRole field.
+func (r *mutationResolver) UpdateOrganizationMemberRole(ctx context.Context, organizationID string, userID string, role model.OrganizationRole) (*model.OrganizationMember, error) {
+	actorID := mw.GetUserIDFromContext(ctx)
+	if actorID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	member, err := r.OrganizationService.UpdateMemberRole(ctx, organizationID, actorID, userID, organization.MemberRole(role))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphOrganizationMember(member), nil
+}
+
+// RemoveOrganizationMember is the resolver for the removeOrganizationMember field.
+func (r *mutationResolver) RemoveOrganizationMember(ctx context.Context, organizationID string, userID string) (bool, error) {
+	actorID := mw.GetUserIDFromContext(ctx)
+	if actorID == "" {
+		return false, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return false, fmt.Errorf("organization service unavailable")
+	}
+
+	if err := r.OrganizationService.RemoveMember(ctx, organizationID, actorID, userID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RequestOrganizationVerification is the resolver for the requestOrganizationVerification field.
+func (r *mutationResolver) RequestOrganizationVerification(ctx context.Context, id string) (*model.Organization, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	org, err := r.OrganizationService.RequestVerification(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphOrganization(org), nil
+}
+
+// SetOrganizationVerification is the resolver for the setOrganizationVerification field.
+func (r *mutationResolver) SetOrganizationVerification(ctx context.Context, id string, status model.OrganizationVerificationStatus) (*model.Organization, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	org, err := r.OrganizationService.SetVerificationStatus(ctx, id, adminID, organization.VerificationStatus(status))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphOrganization(org), nil
+}
+
 // CreateEvent is the resolver for the createEvent field.
 func (r *mutationResolver) CreateEvent(ctx context.Context, input model.CreateEventInput) (*model.Event, error) {
 	// Get current user from context
@@ -414,6 +914,33 @@ func (r *mutationResolver) DeleteEvent(ctx context.Context, id string) (bool, er
 	return true, nil
 }
 
+// SetEventShifts is the resolver for the setEventShifts field.
+func (r *mutationResolver) SetEventShifts(ctx context.Context, eventID string, shifts []*model.EventShiftInput) ([]*model.EventShift, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	if _, err := r.EventService.SetEventShifts(ctx, eventID, userID, toDomainEventShiftInputs(shifts)); err != nil {
+		return nil, err
+	}
+
+	// Read back with sign-up counts
+	saved, err := r.EventService.GetShiftAvailability(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.EventShift, 0, len(saved))
+	for _, shift := range saved {
+		out = append(out, toGraphEventShift(shift))
+	}
+	return out, nil
+}
+
 // AddEventImage is the resolver for the addEventImage field.
 func (r *mutationResolver) AddEventImage(ctx context.Context, eventID string, file graphql.Upload, altText *string, isPrimary *bool) (*model.EventImage, error) {
 	panic(fmt.Errorf("not implemented: AddEventImage - addEventImage"))
@@ -444,6 +971,94 @@ func (r *mutationResolver) DeleteEventAnnouncement(ctx context.Context, id strin
 	panic(fmt.Errorf("not implemented: DeleteEventAnnouncement - deleteEventAnnouncement"))
 }
 
+// InviteEventStaff is the resolver for the inviteEventStaff field.
+func (r *mutationResolver) InviteEventStaff(ctx context.Context, eventID string, email string, role model.EventStaffRole) (*model.EventStaff, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	staff, err := r.EventService.InviteStaff(ctx, eventID, userID, email, event.StaffRole(role))
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite staff: %w", err)
+	}
+
+	return toGraphEventStaff(staff), nil
+}
+
+// AcceptStaffInvitation is the resolver for the acceptStaffInvitation field.
+func (r *mutationResolver) AcceptStaffInvitation(ctx context.Context, id string) (*model.EventStaff, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	staff, err := r.EventService.AcceptStaffInvitation(ctx, id, userID, mw.GetUserEmailFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	return toGraphEventStaff(staff), nil
+}
+
+// RemoveEventStaff is the resolver for the removeEventStaff field.
+func (r *mutationResolver) RemoveEventStaff(ctx context.Context, id string) (*model.EventStaff, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	staff, err := r.EventService.RemoveStaff(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove staff: %w", err)
+	}
+
+	return toGraphEventStaff(staff), nil
+}
+
+// GrantRole is the resolver for the grantRole field.
+func (r *mutationResolver) GrantRole(ctx context.Context, userID string, role model.UserRole) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	prof, err := r.UserService.GrantRole(ctx, adminID, userID, string(role))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphUser(prof), nil
+}
+
+// RevokeRole is the resolver for the revokeRole field.
+func (r *mutationResolver) RevokeRole(ctx context.Context, userID string, role model.UserRole) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	prof, err := r.UserService.RevokeRole(ctx, adminID, userID, string(role))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphUser(prof), nil
+}
+
 // RegisterForEvent is the resolver for the registerForEvent field.
 func (r *mutationResolver) RegisterForEvent(ctx context.Context, input model.RegisterForEventInput) (*model.Registration, error) {
 	userID := mw.GetUserIDFromContext(ctx)
@@ -451,12 +1066,16 @@ func (r *mutationResolver) RegisterForEvent(ctx context.Context, input model.Reg
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	personalMessage := ""
-	if input.PersonalMessage != nil {
-		personalMessage = *input.PersonalMessage
+	details, err := toDomainRegistrationDetails(input)
+	if err != nil {
+		return nil, err
+	}
+	details.Evidence = registration.SignatureEvidence{
+		IPAddress: mw.GetClientIPFromContext(ctx),
+		UserAgent: mw.GetUserAgentFromContext(ctx),
 	}
 
-	registration, err := r.RegistrationService.RegisterForEvent(ctx, userID, input.EventID, personalMessage)
+	registration, err := r.RegistrationService.RegisterForEvent(ctx, userID, input.EventID, details)
 	if err != nil {
 		return nil, err
 	}
@@ -466,7 +1085,27 @@ func (r *mutationResolver) RegisterForEvent(ctx context.Context, input model.Reg
 
 // BulkRegister is the resolver for the bulkRegister field.
 func (r *mutationResolver) BulkRegister(ctx context.Context, input model.BulkRegistrationInput) ([]*model.Registration, error) {
-	panic(fmt.Errorf("not implemented: BulkRegister - bulkRegister"))
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	personalMessage := ""
+	if input.PersonalMessage != nil {
+		personalMessage = *input.PersonalMessage
+	}
+	skipConflicts := input.SkipConflicts != nil && *input.SkipConflicts
+
+	registrations, err := r.RegistrationService.BulkRegister(ctx, userID, input.EventIds, personalMessage, skipConflicts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Registration, 0, len(registrations))
+	for _, reg := range registrations {
+		result = append(result, toGraphRegistration(reg))
+	}
+	return result, nil
 }
 
 // CancelRegistration is the resolver for the cancelRegistration field.
@@ -511,424 +1150,2011 @@ func (r *mutationResolver) ApproveRegistration(ctx context.Context, input model.
 
 // CheckInVolunteer is the resolver for the checkInVolunteer field.
 func (r *mutationResolver) CheckInVolunteer(ctx context.Context, input model.AttendanceInput) (*model.AttendanceRecord, error) {
-	panic(fmt.Errorf("not implemented: CheckInVolunteer - checkInVolunteer"))
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	checkedInAt, err := parseDateTime(input.CheckedInAt)
+	if err != nil {
+		return nil, err
+	}
+
+	reg, record, err := r.RegistrationService.MarkAttendance(ctx, input.RegistrationID, userID, registration.AttendanceCheckedIn, derefString(input.Notes), checkedInAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphAttendanceRecord(reg, record), nil
 }
 
 // MarkAttendance is the resolver for the markAttendance field.
 func (r *mutationResolver) MarkAttendance(ctx context.Context, input model.AttendanceInput) (*model.AttendanceRecord, error) {
-	panic(fmt.Errorf("not implemented: MarkAttendance - markAttendance"))
-}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
 
-// PromoteFromWaitlist is the resolver for the promoteFromWaitlist field.
-func (r *mutationResolver) PromoteFromWaitlist(ctx context.Context, registrationID string) (*model.Registration, error) {
-	panic(fmt.Errorf("not implemented: PromoteFromWaitlist - promoteFromWaitlist"))
-}
+	checkedInAt, err := parseDateTime(input.CheckedInAt)
+	if err != nil {
+		return nil, err
+	}
 
-// TransferRegistration is the resolver for the transferRegistration field.
-func (r *mutationResolver) TransferRegistration(ctx context.Context, registrationID string, newEventID string) (*model.Registration, error) {
-	panic(fmt.Errorf("not implemented: TransferRegistration - transferRegistration"))
+	reg, record, err := r.RegistrationService.MarkAttendance(ctx, input.RegistrationID, userID, registration.AttendanceStatus(input.Status), derefString(input.Notes), checkedInAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphAttendanceRecord(reg, record), nil
 }
 
-// UpdateRegistration is the resolver for the updateRegistration field.
-func (r *mutationResolver) UpdateRegistration(ctx context.Context, registrationID string, personalMessage *string) (*model.Registration, error) {
+// CheckIn is the resolver for the checkIn field.
+func (r *mutationResolver) CheckIn(ctx context.Context, registrationID string, location *model.CoordinatesInput) (*model.AttendanceRecord, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	if r.AttendanceService == nil {
+		return nil, fmt.Errorf("attendance service unavailable")
+	}
+
+	reg, record, err := r.AttendanceService.CheckIn(ctx, userID, registrationID, toDomainDeviceLocation(location))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphAttendanceRecord(reg, record), nil
+}
+
+// CheckOut is the resolver for the checkOut field.
+func (r *mutationResolver) CheckOut(ctx context.Context, registrationID string, location *model.CoordinatesInput) (*model.AttendanceRecord, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	if r.AttendanceService == nil {
+		return nil, fmt.Errorf("attendance service unavailable")
+	}
+
+	reg, record, err := r.AttendanceService.CheckOut(ctx, userID, registrationID, toDomainDeviceLocation(location))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphAttendanceRecord(reg, record), nil
+}
+
+// BulkMarkAttendance is the resolver for the bulkMarkAttendance field.
+func (r *mutationResolver) BulkMarkAttendance(ctx context.Context, eventID string, entries []*model.BulkAttendanceEntryInput, skipInvalid *bool) (*model.BulkAttendanceReport, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	domainEntries, err := toDomainBulkAttendanceEntries(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.RegistrationService.BulkMarkAttendance(ctx, userID, eventID, domainEntries, skipInvalid != nil && *skipInvalid)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphBulkAttendanceReport(result), nil
+}
+
+// ImportAttendanceCSV is the resolver for the importAttendanceCsv field.
+func (r *mutationResolver) ImportAttendanceCSV(ctx context.Context, eventID string, file graphql.Upload, skipInvalid *bool) (*model.BulkAttendanceReport, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	result, err := r.RegistrationService.ImportAttendanceCSV(ctx, userID, eventID, file.File, skipInvalid != nil && *skipInvalid)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphBulkAttendanceReport(result), nil
+}
+
+// SetEventRegistrationQuestions is the resolver for the setEventRegistrationQuestions field.
+func (r *mutationResolver) SetEventRegistrationQuestions(ctx context.Context, eventID string, questions []*model.RegistrationQuestionInput) ([]*model.RegistrationQuestion, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	saved, err := r.RegistrationService.SetEventRegistrationQuestions(ctx, userID, eventID, toDomainRegistrationQuestions(questions))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.RegistrationQuestion, 0, len(saved))
+	for _, q := range saved {
+		out = append(out, toGraphRegistrationQuestion(q))
+	}
+	return out, nil
+}
+
+// PublishEventWaiver is the resolver for the publishEventWaiver field.
+func (r *mutationResolver) PublishEventWaiver(ctx context.Context, eventID string, input model.WaiverInput) (*model.EventWaiver, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	waiver, err := r.RegistrationService.PublishWaiver(ctx, userID, eventID, registration.WaiverInput{
+		WaiverID: derefString(input.WaiverID),
+		Title:    input.Title,
+		Body:     input.Body,
+		Required: input.Required == nil || *input.Required,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphEventWaiver(waiver), nil
+}
+
+// RetireEventWaiver is the resolver for the retireEventWaiver field.
+func (r *mutationResolver) RetireEventWaiver(ctx context.Context, waiverID string) (*model.EventWaiver, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	waiver, err := r.RegistrationService.RetireWaiver(ctx, userID, waiverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphEventWaiver(waiver), nil
+}
+
+// SignEventWaiver is the resolver for the signEventWaiver field.
+func (r *mutationResolver) SignEventWaiver(ctx context.Context, input model.WaiverSignatureInput) (*model.WaiverSignature, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	sig, err := r.RegistrationService.SignWaiver(ctx, userID, toDomainWaiverSignature(&input), registration.SignatureEvidence{
+		IPAddress: mw.GetClientIPFromContext(ctx),
+		UserAgent: mw.GetUserAgentFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphWaiverSignature(sig), nil
+}
+
+// ResendGuardianConsent is the resolver for the resendGuardianConsent field.
+func (r *mutationResolver) ResendGuardianConsent(ctx context.Context, registrationID string, guardianEmail *string) (*model.GuardianConsent, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	consent, err := r.RegistrationService.ResendGuardianConsent(ctx, userID, registrationID, guardianEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphGuardianConsent(consent), nil
+}
+
+// ReconfirmRegistration is the resolver for the reconfirmRegistration field.
+func (r *mutationResolver) ReconfirmRegistration(ctx context.Context, registrationID string) (*model.Registration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	reg, err := r.RegistrationService.ReconfirmRegistration(ctx, userID, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphRegistration(reg), nil
+}
+
+// PromoteFromWaitlist is the resolver for the promoteFromWaitlist field.
+func (r *mutationResolver) PromoteFromWaitlist(ctx context.Context, registrationID string) (*model.Registration, error) {
+	panic(fmt.Errorf("not implemented: PromoteFromWaitlist - promoteFromWaitlist"))
+}
+
+// TransferRegistration is the resolver for the transferRegistration field.
+func (r *mutationResolver) TransferRegistration(ctx context.Context, registrationID string, newEventID string, shiftIds []string) (*model.Registration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	reg, err := r.RegistrationService.TransferRegistration(ctx, userID, registrationID, newEventID, shiftIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphRegistration(reg), nil
+}
+
+// UpdateRegistration is the resolver for the updateRegistration field.
+func (r *mutationResolver) UpdateRegistration(ctx context.Context, registrationID string, personalMessage *string) (*model.Registration, error) {
 	panic(fmt.Errorf("not implemented: UpdateRegistration - updateRegistration"))
 }
 
-// Interests is the resolver for the interests field.
-func (r *publicProfileResolver) Interests(ctx context.Context, obj *model.PublicProfile) ([]*model.Interest, error) {
-	// The interests are already populated in the PublicProfile object by the toGraphPublicProfile converter
-	return obj.Interests, nil
+// ScanTicket is the resolver for the scanTicket field.
+func (r *mutationResolver) ScanTicket(ctx context.Context, input model.ScanTicketInput) (*model.TicketScanResult, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	if r.TicketService == nil {
+		return nil, fmt.Errorf("ticket service unavailable")
+	}
+
+	scannedAt, err := parseDateTime(input.ScannedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.TicketService.ScanTicket(ctx, userID, registration.ScanTicketInput{
+		Token:        input.Token,
+		ScannedAt:    scannedAt,
+		ClientScanID: derefString(input.ClientScanID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphTicketScanResult(result), nil
+}
+
+// CreateTeam is the resolver for the createTeam field.
+func (r *mutationResolver) CreateTeam(ctx context.Context, name string, description *string) (*model.Team, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	team, err := r.RegistrationService.CreateTeam(ctx, userID, name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphTeam(team), nil
+}
+
+// AddTeamMember is the resolver for the addTeamMember field.
+func (r *mutationResolver) AddTeamMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
+	leaderID := mw.GetUserIDFromContext(ctx)
+	if leaderID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	member, err := r.RegistrationService.AddTeamMember(ctx, leaderID, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphTeamMember(member), nil
+}
+
+// RemoveTeamMember is the resolver for the removeTeamMember field.
+func (r *mutationResolver) RemoveTeamMember(ctx context.Context, teamID string, userID string) (bool, error) {
+	leaderID := mw.GetUserIDFromContext(ctx)
+	if leaderID == "" {
+		return false, fmt.Errorf("unauthorized")
+	}
+
+	if err := r.RegistrationService.RemoveTeamMember(ctx, leaderID, teamID, userID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RegisterTeamForEvent is the resolver for the registerTeamForEvent field.
+func (r *mutationResolver) RegisterTeamForEvent(ctx context.Context, input model.RegisterTeamInput) (*model.TeamRegistration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	deadline, err := parseDateTime(input.AssignmentDeadline)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := r.RegistrationService.RegisterTeamForEvent(ctx, userID, registration.TeamRegistrationInput{
+		TeamID:             input.TeamID,
+		EventID:            input.EventID,
+		Seats:              input.Seats,
+		AssignmentDeadline: deadline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphTeamRegistration(tr), nil
+}
+
+// AssignTeamSeat is the resolver for the assignTeamSeat field.
+func (r *mutationResolver) AssignTeamSeat(ctx context.Context, teamRegistrationID string, userID string, guardianEmail *string) (*model.Registration, error) {
+	leaderID := mw.GetUserIDFromContext(ctx)
+	if leaderID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	reg, err := r.RegistrationService.AssignTeamSeat(ctx, leaderID, teamRegistrationID, userID, derefString(guardianEmail))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphRegistration(reg), nil
+}
+
+// CancelTeamRegistration is the resolver for the cancelTeamRegistration field.
+func (r *mutationResolver) CancelTeamRegistration(ctx context.Context, teamRegistrationID string, reason *string) (*model.TeamRegistration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	tr, err := r.RegistrationService.CancelTeamRegistration(ctx, userID, teamRegistrationID, derefString(reason))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphTeamRegistration(tr), nil
+}
+
+// SubmitExternalHours is the resolver for the submitExternalHours field.
+func (r *mutationResolver) SubmitExternalHours(ctx context.Context, input model.ExternalHoursInput) (*model.VolunteerHoursEntry, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.HoursService == nil {
+		return nil, fmt.Errorf("hours service unavailable")
+	}
+
+	date, err := UnmarshalTime(input.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := r.HoursService.SubmitExternalHours(ctx, userID, hours.ExternalHoursInput{
+		OrganizationID: input.OrganizationID,
+		Hours:          input.Hours,
+		Date:           date,
+		Category:       input.Category,
+		Description:    input.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphHoursEntry(entry), nil
+}
+
+// ReviewExternalHours is the resolver for the reviewExternalHours field.
+func (r *mutationResolver) ReviewExternalHours(ctx context.Context, entryID string, approved bool, notes *string) (*model.VolunteerHoursEntry, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.HoursService == nil {
+		return nil, fmt.Errorf("hours service unavailable")
+	}
+
+	entry, err := r.HoursService.ReviewExternalHours(ctx, userID, entryID, approved, derefString(notes))
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphHoursEntry(entry), nil
+}
+
+// AdjustVolunteerHours is the resolver for the adjustVolunteerHours field.
+func (r *mutationResolver) AdjustVolunteerHours(ctx context.Context, entryID string, hours float64, reason string) (*model.VolunteerHoursEntry, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.HoursService == nil {
+		return nil, fmt.Errorf("hours service unavailable")
+	}
+
+	entry, err := r.HoursService.AdjustHours(ctx, userID, entryID, hours, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphHoursEntry(entry), nil
+}
+
+// IssueHoursCertificate is the resolver for the issueHoursCertificate field.
+func (r *mutationResolver) IssueHoursCertificate(ctx context.Context, eventID *string, rangeArg *model.DateRangeInput) (*model.HoursCertificate, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.CertificateService == nil {
+		return nil, fmt.Errorf("certificate service unavailable")
+	}
+	if (eventID == nil) == (rangeArg == nil) {
+		return nil, fmt.Errorf("provide exactly one of eventId or range")
+	}
+
+	var cert *hours.Certificate
+	var err error
+	if eventID != nil {
+		cert, err = r.CertificateService.IssueEventCertificate(ctx, userID, *eventID)
+	} else {
+		rng, rngErr := toDomainDateRange(rangeArg)
+		if rngErr != nil {
+			return nil, rngErr
+		}
+		cert, err = r.CertificateService.IssueRangeCertificate(ctx, userID, rng)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphHoursCertificate(cert, r.PublicURL), nil
+}
+
+// AdminUnlockUser is the resolver for the adminUnlockUser field.
+func (r *mutationResolver) AdminUnlockUser(ctx context.Context, userID string, reason *string) (*model.AdminUser, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+
+	account, err := r.AdminService.UnlockUser(ctx, adminID, userID, derefString(reason))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphAdminUser(account), nil
+}
+
+// AdminForceLogout is the resolver for the adminForceLogout field.
+func (r *mutationResolver) AdminForceLogout(ctx context.Context, userID string, reason *string) (bool, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return false, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return false, fmt.Errorf("admin service unavailable")
+	}
+
+	if err := r.AdminService.ForceLogout(ctx, adminID, userID, derefString(reason)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AdminSetUserVerified is the resolver for the adminSetUserVerified field.
+func (r *mutationResolver) AdminSetUserVerified(ctx context.Context, userID string, verified bool, reason *string) (*model.AdminUser, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+
+	account, err := r.AdminService.SetUserVerified(ctx, adminID, userID, verified, derefString(reason))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphAdminUser(account), nil
+}
+
+// AdminSetSkillVerified is the resolver for the adminSetSkillVerified field.
+func (r *mutationResolver) AdminSetSkillVerified(ctx context.Context, skillID string, verified bool, reason *string) (bool, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return false, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return false, fmt.Errorf("admin service unavailable")
+	}
+
+	if err := r.AdminService.SetSkillVerified(ctx, adminID, skillID, verified, derefString(reason)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AdminSetBackgroundCheck is the resolver for the adminSetBackgroundCheck field.
+func (r *mutationResolver) AdminSetBackgroundCheck(ctx context.Context, userID string, cleared bool, expiresAt *time.Time, reason *string) (bool, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return false, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return false, fmt.Errorf("admin service unavailable")
+	}
+
+	if err := r.AdminService.SetBackgroundCheck(ctx, adminID, userID, cleared, expiresAt, derefString(reason)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AdminUnpublishEvent is the resolver for the adminUnpublishEvent field.
+func (r *mutationResolver) AdminUnpublishEvent(ctx context.Context, eventID string, reason *string) (*model.Event, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	if err := r.AdminService.UnpublishEvent(ctx, adminID, eventID, derefString(reason)); err != nil {
+		return nil, err
+	}
+
+	evt, err := r.EventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return toGraphQLEvent(evt), nil
+}
+
+// AdminArchiveEvent is the resolver for the adminArchiveEvent field.
+func (r *mutationResolver) AdminArchiveEvent(ctx context.Context, eventID string, reason *string) (*model.Event, error) {
+	adminID := mw.GetUserIDFromContext(ctx)
+	if adminID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	if err := r.AdminService.ArchiveEvent(ctx, adminID, eventID, derefString(reason)); err != nil {
+		return nil, err
+	}
+
+	evt, err := r.EventService.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return toGraphQLEvent(evt), nil
+}
+
+// CreateEventTemplate is the resolver for the createEventTemplate field.
+func (r *mutationResolver) CreateEventTemplate(ctx context.Context, eventID string, name *string) (*model.EventTemplate, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	template, err := r.EventService.CreateEventTemplate(ctx, userID, eventID, derefString(name))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphEventTemplate(template), nil
+}
+
+// DeleteEventTemplate is the resolver for the deleteEventTemplate field.
+func (r *mutationResolver) DeleteEventTemplate(ctx context.Context, id string) (bool, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return false, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return false, fmt.Errorf("event service unavailable")
+	}
+
+	if err := r.EventService.DeleteEventTemplate(ctx, userID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateEventFromTemplate is the resolver for the createEventFromTemplate field.
+func (r *mutationResolver) CreateEventFromTemplate(ctx context.Context, templateID string, startTime time.Time) (*model.Event, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	evt, err := r.EventService.CreateEventFromTemplate(ctx, userID, templateID, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event: %w", err)
+	}
+	return toGraphQLEvent(evt), nil
+}
+
+// DuplicateEvent is the resolver for the duplicateEvent field.
+func (r *mutationResolver) DuplicateEvent(ctx context.Context, id string, startTime time.Time) (*model.Event, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	evt, err := r.EventService.DuplicateEvent(ctx, userID, id, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to duplicate event: %w", err)
+	}
+	return toGraphQLEvent(evt), nil
+}
+
+// CreateTaxonomyTerm is the resolver for the createTaxonomyTerm field.
+func (r *mutationResolver) CreateTaxonomyTerm(ctx context.Context, taxonomy model.EventTaxonomy, input model.TaxonomyTermInput) (*model.TaxonomyTerm, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	term, err := r.EventService.CreateTaxonomyTerm(ctx, event.Taxonomy(taxonomy), toDomainTaxonomyTermInput(input))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphTaxonomyTerm(term), nil
+}
+
+// UpdateTaxonomyTerm is the resolver for the updateTaxonomyTerm field.
+func (r *mutationResolver) UpdateTaxonomyTerm(ctx context.Context, taxonomy model.EventTaxonomy, id string, input model.TaxonomyTermInput) (*model.TaxonomyTerm, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	term, err := r.EventService.UpdateTaxonomyTerm(ctx, event.Taxonomy(taxonomy), id, toDomainTaxonomyTermInput(input))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphTaxonomyTerm(term), nil
+}
+
+// DeleteTaxonomyTerm is the resolver for the deleteTaxonomyTerm field.
+func (r *mutationResolver) DeleteTaxonomyTerm(ctx context.Context, taxonomy model.EventTaxonomy, id string) (bool, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return false, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return false, fmt.Errorf("event service unavailable")
+	}
+
+	if err := r.EventService.DeleteTaxonomyTerm(ctx, event.Taxonomy(taxonomy), id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Members is the resolver for the members field.
+func (r *organizationResolver) Members(ctx context.Context, obj *model.Organization) ([]*model.OrganizationMember, error) {
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	members, err := r.OrganizationService.GetMembers(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch members: %w", err)
+	}
+
+	result := make([]*model.OrganizationMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, toGraphOrganizationMember(m))
+	}
+	return result, nil
+}
+
+// MyRole is the resolver for the myRole field.
+func (r *organizationResolver) MyRole(ctx context.Context, obj *model.Organization) (*model.OrganizationRole, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" || r.OrganizationService == nil {
+		return nil, nil
+	}
+
+	member, err := r.OrganizationService.GetMembership(ctx, obj.ID, userID)
+	if errors.Is(err, organization.ErrMemberNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch membership: %w", err)
+	}
+
+	role := model.OrganizationRole(member.Role)
+	return &role, nil
+}
+
+// User is the resolver for the user field.
+func (r *organizationMemberResolver) User(ctx context.Context, obj *model.OrganizationMember) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.User.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
+}
+
+// Interests is the resolver for the interests field.
+func (r *publicProfileResolver) Interests(ctx context.Context, obj *model.PublicProfile) ([]*model.Interest, error) {
+	// The interests are already populated in the PublicProfile object by the toGraphPublicProfile converter
+	return obj.Interests, nil
+}
+
+// Skills is the resolver for the skills field.
+func (r *publicProfileResolver) Skills(ctx context.Context, obj *model.PublicProfile) ([]*model.Skill, error) {
+	// The skills are already populated in the PublicProfile object by the toGraphPublicProfile converter
+	return obj.Skills, nil
+}
+
+// VolunteerStats is the resolver for the volunteerStats field.
+func (r *publicProfileResolver) VolunteerStats(ctx context.Context, obj *model.PublicProfile) (*model.VolunteerStats, error) {
+	if r.HoursService == nil {
+		return obj.VolunteerStats, nil
+	}
+
+	stats, err := r.HoursService.GetStats(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load volunteer stats: %w", err)
+	}
+
+	return &model.VolunteerStats{
+		Hours:              int(math.Round(stats.TotalHours)),
+		EventsParticipated: stats.EventsParticipated,
+	}, nil
+}
+
+// Health is the resolver for the health field.
+func (r *queryResolver) Health(ctx context.Context) (*model.Health, error) {
+	return &model.Health{
+		Status: "OK",
+		Time:   time.Now(),
+	}, nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	prof, err := r.UserService.GetProfileWithDetails(ctx, userID, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphUser(prof), nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*model.PublicProfile, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	requesterID := mw.GetUserIDFromContext(ctx)
+	// roles from claims could be used later
+	prof, err := r.UserService.GetProfileWithDetails(ctx, id, requesterID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphPublicProfile(prof), nil
+}
+
+// SearchUsers is the resolver for the searchUsers field.
+func (r *queryResolver) SearchUsers(ctx context.Context, filter model.UserSearchFilter, limit *int, offset *int) ([]*model.PublicProfile, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	l, o := 20, 0
+	if limit != nil {
+		l = *limit
+	}
+	if offset != nil {
+		o = *offset
+	}
+	dom := toDomainSearchFilter(filter)
+	res, err := r.UserService.SearchUsers(ctx, dom, l, o)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.PublicProfile, 0, len(res))
+	for _, p := range res {
+		out = append(out, toGraphPublicProfile(&p))
+	}
+	return out, nil
+}
+
+// Interests is the resolver for the interests field.
+func (r *queryResolver) Interests(ctx context.Context) ([]*model.Interest, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	ints, err := r.UserService.ListInterests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.Interest, 0, len(ints))
+	for _, it := range ints {
+		out = append(out, &model.Interest{ID: it.ID, Name: it.Name, Category: model.InterestCategory(it.Category)})
+	}
+	return out, nil
+}
+
+// UserActivity is the resolver for the userActivity field.
+func (r *queryResolver) UserActivity(ctx context.Context) ([]*model.ActivityLog, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	logs, err := r.UserService.ListActivityLogs(ctx, userID, 50, 0)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.ActivityLog, 0, len(logs))
+	for _, l := range logs {
+		out = append(out, &model.ActivityLog{ID: l.ID, Action: l.Action, CreatedAt: l.CreatedAt})
+	}
+	return out, nil
+}
+
+// MyPasskeys is the resolver for the myPasskeys field.
+func (r *queryResolver) MyPasskeys(ctx context.Context) ([]*model.Passkey, error) {
+	if r.PasskeyService == nil {
+		return nil, fmt.Errorf("passkey service unavailable")
+	}
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	passkeys, err := r.PasskeyService.ListCredentials(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.Passkey, 0, len(passkeys))
+	for _, p := range passkeys {
+		out = append(out, toGraphPasskey(p))
+	}
+	return out, nil
+}
+
+// Organization is the resolver for the organization field.
+func (r *queryResolver) Organization(ctx context.Context, id string) (*model.Organization, error) {
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	org, err := r.OrganizationService.GetOrganization(ctx, id)
+	if errors.Is(err, organization.ErrOrganizationNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	return toGraphOrganization(org), nil
+}
+
+// MyOrganizations is the resolver for the myOrganizations field.
+func (r *queryResolver) MyOrganizations(ctx context.Context) ([]*model.Organization, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
+
+	orgs, err := r.OrganizationService.GetUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizations: %w", err)
+	}
+
+	result := make([]*model.Organization, 0, len(orgs))
+	for _, o := range orgs {
+		result = append(result, toGraphOrganization(o))
+	}
+	return result, nil
+}
+
+// OrganizationEvents is the resolver for the organizationEvents field.
+func (r *queryResolver) OrganizationEvents(ctx context.Context, organizationID string, status []model.EventStatus, first *int, after *string) (*model.EventConnection, error) {
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	limit := 20
+	offset := 0
+	if first != nil {
+		limit = *first
+	}
+	if after != nil {
+		offset = 20 // Placeholder implementation
+	}
+
+	domainStatus := make([]event.EventStatus, len(status))
+	for i, s := range status {
+		domainStatus[i] = convertGraphQLEventStatus(s)
+	}
+
+	connection, err := r.EventService.GetOrganizationEvents(ctx, organizationID, mw.GetUserIDFromContext(ctx), domainStatus, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization events: %w", err)
+	}
+
+	return toGraphQLEventConnection(connection), nil
+}
+
+// Event is the resolver for the event field.
+func (r *queryResolver) Event(ctx context.Context, id string) (*model.Event, error) {
+	// Check if EventService is available
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	// Get event by ID
+	event, err := r.EventService.GetEventByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	// Convert domain event to GraphQL event
+	return toGraphQLEvent(event), nil
+}
+
+// EventBySlug is the resolver for the eventBySlug field.
+func (r *queryResolver) EventBySlug(ctx context.Context, slug string) (*model.Event, error) {
+	// Check if EventService is available
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	// Get event by slug
+	event, err := r.EventService.GetEventBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	// Convert domain event to GraphQL event
+	return toGraphQLEvent(event), nil
+}
+
+// Events is the resolver for the events field.
+func (r *queryResolver) Events(ctx context.Context, filter *model.EventSearchFilter, sort *model.EventSortInput, first *int, after *string) (*model.EventConnection, error) {
+	// Check if EventService is available
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	// Set default values
+	limit := 20
+	offset := 0
+	if first != nil {
+		limit = *first
+	}
+
+	// For simplicity, we'll use offset-based pagination for now
+	// In production, cursor-based pagination would be preferred
+	if after != nil {
+		// Basic offset calculation from cursor - in production this would be more sophisticated
+		offset = 20 // Placeholder implementation
+	}
+
+	// Convert GraphQL filter to domain filter
+	domainFilter := event.EventSearchFilter{}
+	if filter != nil {
+		domainFilter = toDomainEventSearchFilter(*filter)
+	}
+
+	// Convert GraphQL sort to domain sort
+	var domainSort *event.EventSortInput
+	if sort != nil {
+		domainSort = toDomainEventSortInput(*sort)
+	}
+
+	// Search events
+	connection, err := r.EventService.SearchEvents(ctx, domainFilter, domainSort, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search events: %w", err)
+	}
+
+	// Convert domain connection to GraphQL connection
+	return toGraphQLEventConnection(connection), nil
+}
+
+// SearchEvents is the resolver for the searchEvents field.
+func (r *queryResolver) SearchEvents(ctx context.Context, query string, filter *model.EventSearchFilter, sort *model.EventSortInput, first *int, after *string) (*model.EventConnection, error) {
+	// Check if EventService is available
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	// Set default values
+	limit := 20
+	offset := 0
+	if first != nil {
+		limit = *first
+	}
+
+	// For simplicity, we'll use offset-based pagination for now
+	if after != nil {
+		offset = 20 // Placeholder implementation
+	}
+
+	// Convert GraphQL filter to domain filter
+	domainFilter := event.EventSearchFilter{
+		Query: &query, // Set the search query
+	}
+	if filter != nil {
+		// Merge additional filters
+		additionalFilter := toDomainEventSearchFilter(*filter)
+		domainFilter.Status = additionalFilter.Status
+		domainFilter.Categories = additionalFilter.Categories
+		domainFilter.TimeCommitment = additionalFilter.TimeCommitment
+		domainFilter.Tags = additionalFilter.Tags
+		domainFilter.Location = additionalFilter.Location
+		domainFilter.Skills = additionalFilter.Skills
+		domainFilter.Interests = additionalFilter.Interests
+	}
+
+	// Convert GraphQL sort to domain sort
+	var domainSort *event.EventSortInput
+	if sort != nil {
+		domainSort = toDomainEventSortInput(*sort)
+	}
+
+	// Search events
+	connection, err := r.EventService.SearchEvents(ctx, domainFilter, domainSort, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search events: %w", err)
+	}
+
+	// Convert domain connection to GraphQL connection
+	return toGraphQLEventConnection(connection), nil
+}
+
+// MyEvents is the resolver for the myEvents field.
+func (r *queryResolver) MyEvents(ctx context.Context, status []model.EventStatus, first *int, after *string) (*model.EventConnection, error) {
+	// Get current user from context
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+
+	// Check if EventService is available
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	// Set default values
+	limit := 20
+	offset := 0
+	if first != nil {
+		limit = *first
+	}
+
+	if after != nil {
+		offset = 20 // Placeholder implementation
+	}
+
+	// Convert GraphQL status to domain status
+	domainStatus := make([]event.EventStatus, len(status))
+	for i, s := range status {
+		domainStatus[i] = convertGraphQLEventStatus(s)
+	}
+
+	// Get user's events
+	connection, err := r.EventService.GetUserEvents(ctx, userID, domainStatus, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user events: %w", err)
+	}
+
+	// Convert domain connection to GraphQL connection
+	return toGraphQLEventConnection(connection), nil
+}
+
+// NearbyEvents is the resolver for the nearbyEvents field.
+func (r *queryResolver) NearbyEvents(ctx context.Context, coordinates model.CoordinatesInput, radius float64, filter *model.EventSearchFilter, first *int, after *string) (*model.EventConnection, error) {
+	// Check if EventService is available
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	// Set default values
+	limit := 20
+	offset := 0
+	if first != nil {
+		limit = *first
+	}
+
+	if after != nil {
+		offset = 20 // Placeholder implementation
+	}
+
+	// Convert GraphQL filter to domain filter
+	domainFilter := event.EventSearchFilter{}
+	if filter != nil {
+		domainFilter = toDomainEventSearchFilter(*filter)
+	}
+
+	// Search nearby events
+	connection, err := r.EventService.GetNearbyEvents(ctx, coordinates.Lat, coordinates.Lng, radius, domainFilter, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nearby events: %w", err)
+	}
+
+	// Convert domain connection to GraphQL connection
+	return toGraphQLEventConnection(connection), nil
+}
+
+// EventUpdates is the resolver for the eventUpdates field.
+func (r *queryResolver) EventUpdates(ctx context.Context, eventID string, first *int, after *string) (*model.EventUpdateConnection, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	page, err := r.EventService.GetEventUpdates(ctx, eventID, userID, derefInt(first), derefString(after))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event updates: %w", err)
+	}
+	return toGraphEventUpdateConnection(page), nil
+}
+
+// EventStaff is the resolver for the eventStaff field.
+func (r *queryResolver) EventStaff(ctx context.Context, eventID string) ([]*model.EventStaff, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	staff, err := r.EventService.ListEventStaff(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event staff: %w", err)
+	}
+
+	result := make([]*model.EventStaff, 0, len(staff))
+	for _, s := range staff {
+		result = append(result, toGraphEventStaff(s))
+	}
+	return result, nil
+}
+
+// MyStaffInvitations is the resolver for the myStaffInvitations field.
+func (r *queryResolver) MyStaffInvitations(ctx context.Context) ([]*model.EventStaff, error) {
+	email := mw.GetUserEmailFromContext(ctx)
+	if mw.GetUserIDFromContext(ctx) == "" || email == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	invitations, err := r.EventService.GetPendingStaffInvitations(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff invitations: %w", err)
+	}
+
+	result := make([]*model.EventStaff, 0, len(invitations))
+	for _, s := range invitations {
+		result = append(result, toGraphEventStaff(s))
+	}
+	return result, nil
+}
+
+// MyRegistrations is the resolver for the myRegistrations field.
+func (r *queryResolver) MyRegistrations(ctx context.Context, filter *model.RegistrationFilterInput) ([]*model.Registration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	registrations, err := r.RegistrationService.GetRegistrationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*model.Registration
+	for _, reg := range registrations {
+		result = append(result, toGraphRegistration(reg))
+	}
+
+	return result, nil
+}
+
+// Registration is the resolver for the registration field.
+func (r *queryResolver) Registration(ctx context.Context, id string) (*model.Registration, error) {
+	registration, err := r.RegistrationService.GetRegistrationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphRegistration(registration), nil
+}
+
+// EventRegistrations is the resolver for the eventRegistrations field.
+func (r *queryResolver) EventRegistrations(ctx context.Context, eventID string, filter *model.RegistrationFilterInput) ([]*model.Registration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	registrations, err := r.RegistrationService.ListEventRegistrations(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*model.Registration
+	for _, reg := range registrations {
+		result = append(result, toGraphRegistration(reg))
+	}
+
+	return result, nil
+}
+
+// WaitlistEntries is the resolver for the waitlistEntries field.
+func (r *queryResolver) WaitlistEntries(ctx context.Context, eventID string) ([]*model.WaitlistEntry, error) {
+	panic(fmt.Errorf("not implemented: WaitlistEntries - waitlistEntries"))
+}
+
+// RegistrationConflicts is the resolver for the registrationConflicts field.
+func (r *queryResolver) RegistrationConflicts(ctx context.Context, eventID string) ([]*model.RegistrationConflict, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	details, err := r.RegistrationService.GetEventConflicts(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.RegistrationConflict, 0, len(details))
+	for _, d := range details {
+		result = append(result, toGraphRegistrationConflict(d))
+	}
+	return result, nil
+}
+
+// Eligibility is the resolver for the eligibility field.
+func (r *queryResolver) Eligibility(ctx context.Context, eventID string) (*model.Eligibility, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	result, err := r.RegistrationService.CheckEligibility(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphEligibility(result), nil
+}
+
+// AttendanceRecords is the resolver for the attendanceRecords field.
+func (r *queryResolver) AttendanceRecords(ctx context.Context, eventID string) ([]*model.AttendanceRecord, error) {
+	panic(fmt.Errorf("not implemented: AttendanceRecords - attendanceRecords"))
+}
+
+// RegistrationStats is the resolver for the registrationStats field.
+func (r *queryResolver) RegistrationStats(ctx context.Context, eventID string) (*model.RegistrationStats, error) {
+	panic(fmt.Errorf("not implemented: RegistrationStats - registrationStats"))
+}
+
+// EventWaiverSignatures is the resolver for the eventWaiverSignatures field.
+func (r *queryResolver) EventWaiverSignatures(ctx context.Context, eventID string) ([]*model.WaiverSignature, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	signatures, err := r.RegistrationService.GetEventWaiverSignatures(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphWaiverSignatures(signatures), nil
+}
+
+// MyWaiverSignatures is the resolver for the myWaiverSignatures field.
+func (r *queryResolver) MyWaiverSignatures(ctx context.Context, eventID string) ([]*model.WaiverSignature, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	signatures, err := r.RegistrationService.GetMyWaiverSignatures(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphWaiverSignatures(signatures), nil
+}
+
+// EventGuardianConsents is the resolver for the eventGuardianConsents field.
+func (r *queryResolver) EventGuardianConsents(ctx context.Context, eventID string) ([]*model.GuardianConsent, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	consents, err := r.RegistrationService.GetEventGuardianConsents(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.GuardianConsent, 0, len(consents))
+	for _, c := range consents {
+		result = append(result, toGraphGuardianConsent(c))
+	}
+	return result, nil
+}
+
+// RegistrationTicket is the resolver for the registrationTicket field.
+func (r *queryResolver) RegistrationTicket(ctx context.Context, registrationID string) (*model.RegistrationTicket, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	if r.TicketService == nil {
+		return nil, fmt.Errorf("ticket service unavailable")
+	}
+
+	ticket, err := r.TicketService.IssueTicket(ctx, userID, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphRegistrationTicket(ticket), nil
 }
 
-// Skills is the resolver for the skills field.
-func (r *publicProfileResolver) Skills(ctx context.Context, obj *model.PublicProfile) ([]*model.Skill, error) {
-	// The skills are already populated in the PublicProfile object by the toGraphPublicProfile converter
-	return obj.Skills, nil
-}
+// MyTeams is the resolver for the myTeams field.
+func (r *queryResolver) MyTeams(ctx context.Context) ([]*model.Team, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
 
-// VolunteerStats is the resolver for the volunteerStats field.
-func (r *publicProfileResolver) VolunteerStats(ctx context.Context, obj *model.PublicProfile) (*model.VolunteerStats, error) {
-	// The volunteerStats are already populated in the PublicProfile object by the toGraphPublicProfile converter
-	return obj.VolunteerStats, nil
+	teams, err := r.RegistrationService.GetUserTeams(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Team, 0, len(teams))
+	for _, team := range teams {
+		result = append(result, toGraphTeam(team))
+	}
+
+	return result, nil
 }
 
-// Health is the resolver for the health field.
-func (r *queryResolver) Health(ctx context.Context) (*model.Health, error) {
-	return &model.Health{
-		Status: "OK",
-		Time:   time.Now(),
-	}, nil
+// Team is the resolver for the team field.
+func (r *queryResolver) Team(ctx context.Context, id string) (*model.Team, error) {
+	team, err := r.RegistrationService.GetTeam(ctx, id)
+	if errors.Is(err, registration.ErrTeamNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toGraphTeam(team), nil
 }
 
-// Me is the resolver for the me field.
-func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
-	if r.UserService == nil {
-		return nil, fmt.Errorf("service unavailable")
+// MyHours is the resolver for the myHours field.
+func (r *queryResolver) MyHours(ctx context.Context, rangeArg *model.DateRangeInput) (*model.HoursSummary, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.HoursService == nil {
+		return nil, fmt.Errorf("hours service unavailable")
+	}
+
+	rng, err := toDomainDateRange(rangeArg)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, entries, err := r.HoursService.GetSummary(ctx, userID, rng)
+	if err != nil {
+		return nil, err
 	}
+
+	return toGraphHoursSummary(summary, entries), nil
+}
+
+// PendingHoursSubmissions is the resolver for the pendingHoursSubmissions field.
+func (r *queryResolver) PendingHoursSubmissions(ctx context.Context, organizationID string) ([]*model.VolunteerHoursEntry, error) {
 	userID := mw.GetUserIDFromContext(ctx)
 	if userID == "" {
-		return nil, fmt.Errorf("unauthorized")
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.HoursService == nil {
+		return nil, fmt.Errorf("hours service unavailable")
 	}
 
-	prof, err := r.UserService.GetProfileWithDetails(ctx, userID, userID, nil)
+	entries, err := r.HoursService.PendingSubmissions(ctx, userID, organizationID)
 	if err != nil {
 		return nil, err
 	}
-	return toGraphUser(prof), nil
+
+	result := make([]*model.VolunteerHoursEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, toGraphHoursEntry(e))
+	}
+	return result, nil
 }
 
-// User is the resolver for the user field.
-func (r *queryResolver) User(ctx context.Context, id string) (*model.PublicProfile, error) {
-	if r.UserService == nil {
-		return nil, fmt.Errorf("service unavailable")
+// MyHoursCertificates is the resolver for the myHoursCertificates field.
+func (r *queryResolver) MyHoursCertificates(ctx context.Context) ([]*model.HoursCertificate, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
 	}
-	requesterID := mw.GetUserIDFromContext(ctx)
-	// roles from claims could be used later
-	prof, err := r.UserService.GetProfileWithDetails(ctx, id, requesterID, nil)
+	if r.CertificateService == nil {
+		return nil, fmt.Errorf("certificate service unavailable")
+	}
+
+	certs, err := r.CertificateService.ListCertificates(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-	return toGraphPublicProfile(prof), nil
+
+	result := make([]*model.HoursCertificate, 0, len(certs))
+	for _, c := range certs {
+		result = append(result, toGraphHoursCertificate(c, r.PublicURL))
+	}
+	return result, nil
 }
 
-// SearchUsers is the resolver for the searchUsers field.
-func (r *queryResolver) SearchUsers(ctx context.Context, filter model.UserSearchFilter, limit *int, offset *int) ([]*model.PublicProfile, error) {
-	if r.UserService == nil {
-		return nil, fmt.Errorf("service unavailable")
+// VerifyHoursCertificate is the resolver for the verifyHoursCertificate field.
+func (r *queryResolver) VerifyHoursCertificate(ctx context.Context, code string) (*model.HoursCertificate, error) {
+	if r.CertificateService == nil {
+		return nil, fmt.Errorf("certificate service unavailable")
 	}
-	l, o := 20, 0
-	if limit != nil {
-		l = *limit
+
+	cert, err := r.CertificateService.Verify(ctx, code)
+	if errors.Is(err, hours.ErrCertificateNotFound) {
+		return nil, nil
 	}
-	if offset != nil {
-		o = *offset
+	if err != nil {
+		return nil, err
 	}
-	dom := toDomainSearchFilter(filter)
-	res, err := r.UserService.SearchUsers(ctx, dom, l, o)
+
+	return toGraphHoursCertificate(cert, r.PublicURL), nil
+}
+
+// AdminUsers is the resolver for the adminUsers field.
+func (r *queryResolver) AdminUsers(ctx context.Context, filter *model.AdminUserFilter, limit *int, offset *int) (*model.AdminUserConnection, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+
+	page, err := r.AdminService.SearchUsers(ctx, toDomainAdminUserFilter(filter), derefInt(limit), derefInt(offset))
 	if err != nil {
 		return nil, err
 	}
-	out := make([]*model.PublicProfile, 0, len(res))
-	for _, p := range res {
-		out = append(out, toGraphPublicProfile(&p))
+
+	users := make([]*model.AdminUser, 0, len(page.Users))
+	for _, u := range page.Users {
+		users = append(users, toGraphAdminUser(u))
 	}
-	return out, nil
+	return &model.AdminUserConnection{Users: users, TotalCount: page.TotalCount}, nil
 }
 
-// Interests is the resolver for the interests field.
-func (r *queryResolver) Interests(ctx context.Context) ([]*model.Interest, error) {
-	if r.UserService == nil {
-		return nil, fmt.Errorf("service unavailable")
+// AdminAuditLog is the resolver for the adminAuditLog field.
+func (r *queryResolver) AdminAuditLog(ctx context.Context, filter *model.AdminAuditFilter, limit *int, offset *int) ([]*model.AdminAuditEntry, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return nil, fmt.Errorf("authentication required")
 	}
-	ints, err := r.UserService.ListInterests(ctx)
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+
+	entries, err := r.AdminService.ListAuditLog(ctx, toDomainAdminAuditFilter(filter), derefInt(limit), derefInt(offset))
 	if err != nil {
 		return nil, err
 	}
-	out := make([]*model.Interest, 0, len(ints))
-	for _, it := range ints {
-		out = append(out, &model.Interest{ID: it.ID, Name: it.Name, Category: model.InterestCategory(it.Category)})
+
+	result := make([]*model.AdminAuditEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, toGraphAdminAuditEntry(e))
 	}
-	return out, nil
+	return result, nil
 }
 
-// UserActivity is the resolver for the userActivity field.
-func (r *queryResolver) UserActivity(ctx context.Context) ([]*model.ActivityLog, error) {
-	if r.UserService == nil {
-		return nil, fmt.Errorf("service unavailable")
+// VerifyAdminAuditTrail is the resolver for the verifyAdminAuditTrail field.
+func (r *queryResolver) VerifyAdminAuditTrail(ctx context.Context) (*model.AuditTrailVerification, error) {
+	if mw.GetUserIDFromContext(ctx) == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if r.AdminService == nil {
+		return nil, fmt.Errorf("admin service unavailable")
+	}
+
+	v, err := r.AdminService.VerifyAuditTrail(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.AuditTrailVerification{Valid: v.Valid, CheckedEntries: v.CheckedEntries}
+	if v.BrokenAtSequence != nil {
+		seq := int(*v.BrokenAtSequence)
+		result.BrokenAtSequence = &seq
 	}
+	return result, nil
+}
+
+// EventTemplates is the resolver for the eventTemplates field.
+func (r *queryResolver) EventTemplates(ctx context.Context, organizationID *string) ([]*model.EventTemplate, error) {
 	userID := mw.GetUserIDFromContext(ctx)
 	if userID == "" {
-		return nil, fmt.Errorf("unauthorized")
+		return nil, fmt.Errorf("authentication required")
 	}
-	logs, err := r.UserService.ListActivityLogs(ctx, userID, 50, 0)
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	templates, err := r.EventService.ListEventTemplates(ctx, userID, organizationID)
 	if err != nil {
 		return nil, err
 	}
-	out := make([]*model.ActivityLog, 0, len(logs))
-	for _, l := range logs {
-		out = append(out, &model.ActivityLog{ID: l.ID, Action: l.Action, CreatedAt: l.CreatedAt})
+	out := make([]*model.EventTemplate, 0, len(templates))
+	for _, t := range templates {
+		out = append(out, toGraphEventTemplate(t))
 	}
 	return out, nil
 }
 
-// Event is the resolver for the event field.
-func (r *queryResolver) Event(ctx context.Context, id string) (*model.Event, error) {
-	// Check if EventService is available
+// EventTemplate is the resolver for the eventTemplate field.
+func (r *queryResolver) EventTemplate(ctx context.Context, id string) (*model.EventTemplate, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("authentication required")
+	}
 	if r.EventService == nil {
 		return nil, fmt.Errorf("event service unavailable")
 	}
 
-	// Get event by ID
-	event, err := r.EventService.GetEventByID(ctx, id)
+	template, err := r.EventService.GetEventTemplate(ctx, userID, id)
+	if errors.Is(err, event.ErrTemplateNotFound) {
+		return nil, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get event: %w", err)
+		return nil, err
 	}
-
-	// Convert domain event to GraphQL event
-	return toGraphQLEvent(event), nil
+	return toGraphEventTemplate(template), nil
 }
 
-// EventBySlug is the resolver for the eventBySlug field.
-func (r *queryResolver) EventBySlug(ctx context.Context, slug string) (*model.Event, error) {
-	// Check if EventService is available
+// TaxonomyTerms is the resolver for the taxonomyTerms field.
+func (r *queryResolver) TaxonomyTerms(ctx context.Context, taxonomy model.EventTaxonomy, includeInactive *bool) ([]*model.TaxonomyTerm, error) {
 	if r.EventService == nil {
 		return nil, fmt.Errorf("event service unavailable")
 	}
 
-	// Get event by slug
-	event, err := r.EventService.GetEventBySlug(ctx, slug)
+	includeAll := includeInactive != nil && *includeInactive
+	terms, err := r.EventService.ListTaxonomyTerms(ctx, event.Taxonomy(taxonomy), includeAll)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get event: %w", err)
+		return nil, err
+	}
+	out := make([]*model.TaxonomyTerm, 0, len(terms))
+	for _, t := range terms {
+		out = append(out, toGraphTaxonomyTerm(t))
 	}
+	return out, nil
+}
 
-	// Convert domain event to GraphQL event
-	return toGraphQLEvent(event), nil
+// SkillCatalog is the resolver for the skillCatalog field.
+func (r *queryResolver) SkillCatalog(ctx context.Context, query *string, category *string, limit *int) ([]*model.SkillCatalogEntry, error) {
+	if r.SkillService == nil {
+		return nil, fmt.Errorf("skill service unavailable")
+	}
+
+	q := ""
+	if query != nil {
+		q = *query
+	}
+	n := 0
+	if limit != nil {
+		n = *limit
+	}
+	skills, err := r.SkillService.Autocomplete(ctx, q, category, n)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.SkillCatalogEntry, 0, len(skills))
+	for _, s := range skills {
+		out = append(out, toGraphSkillCatalogEntry(s))
+	}
+	return out, nil
 }
 
-// Events is the resolver for the events field.
-func (r *queryResolver) Events(ctx context.Context, filter *model.EventSearchFilter, sort *model.EventSortInput, first *int, after *string) (*model.EventConnection, error) {
-	// Check if EventService is available
+// User is the resolver for the user field.
+func (r *registrationResolver) User(ctx context.Context, obj *model.Registration) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	// Get current user context for authorization
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.User.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
+}
+
+// Event is the resolver for the event field.
+func (r *registrationResolver) Event(ctx context.Context, obj *model.Registration) (*model.Event, error) {
 	if r.EventService == nil {
 		return nil, fmt.Errorf("event service unavailable")
 	}
 
-	// Set default values
-	limit := 20
-	offset := 0
-	if first != nil {
-		limit = *first
+	domainEvent, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %w", err)
 	}
 
-	// For simplicity, we'll use offset-based pagination for now
-	// In production, cursor-based pagination would be preferred
-	if after != nil {
-		// Basic offset calculation from cursor - in production this would be more sophisticated
-		offset = 20 // Placeholder implementation
+	return toGraphQLEvent(domainEvent), nil
+}
+
+// Skills is the resolver for the skills field.
+func (r *registrationResolver) Skills(ctx context.Context, obj *model.Registration) ([]*model.UserSkill, error) {
+	// For now, return empty slice - this would be populated from registration-specific skills
+	// if the system supports skills per registration rather than per user
+	return []*model.UserSkill{}, nil
+}
+
+// Interests is the resolver for the interests field.
+func (r *registrationResolver) Interests(ctx context.Context, obj *model.Registration) ([]*model.Interest, error) {
+	// For now, return empty slice - this would be populated from registration-specific interests
+	// if the system supports interests per registration rather than per user
+	return []*model.Interest{}, nil
+}
+
+// Answers is the resolver for the answers field.
+func (r *registrationResolver) Answers(ctx context.Context, obj *model.Registration) ([]*model.RegistrationAnswer, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
 	}
 
-	// Convert GraphQL filter to domain filter
-	domainFilter := event.EventSearchFilter{}
-	if filter != nil {
-		domainFilter = toDomainEventSearchFilter(*filter)
+	answers, err := r.RegistrationService.GetRegistrationAnswers(ctx, userID, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(answers) == 0 {
+		return []*model.RegistrationAnswer{}, nil
+	}
+	questions, err := r.RegistrationService.GetEventQuestions(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert GraphQL sort to domain sort
-	var domainSort *event.EventSortInput
-	if sort != nil {
-		domainSort = toDomainEventSortInput(*sort)
+	return toGraphRegistrationAnswers(answers, questions, r.PublicURL), nil
+}
+
+// GuardianConsent is the resolver for the guardianConsent field.
+func (r *registrationResolver) GuardianConsent(ctx context.Context, obj *model.Registration) (*model.GuardianConsent, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
 	}
 
-	// Search events
-	connection, err := r.EventService.SearchEvents(ctx, domainFilter, domainSort, limit, offset)
+	consents, err := r.RegistrationService.GetGuardianConsents(ctx, userID, obj.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search events: %w", err)
+		return nil, err
+	}
+	if len(consents) == 0 {
+		return nil, nil
 	}
 
-	// Convert domain connection to GraphQL connection
-	return toGraphQLEventConnection(connection), nil
+	return toGraphGuardianConsent(consents[0]), nil
 }
 
-// SearchEvents is the resolver for the searchEvents field.
-func (r *queryResolver) SearchEvents(ctx context.Context, query string, filter *model.EventSearchFilter, sort *model.EventSortInput, first *int, after *string) (*model.EventConnection, error) {
-	// Check if EventService is available
+// Shifts is the resolver for the shifts field.
+func (r *registrationResolver) Shifts(ctx context.Context, obj *model.Registration) ([]*model.RegistrationShift, error) {
 	if r.EventService == nil {
 		return nil, fmt.Errorf("event service unavailable")
 	}
 
-	// Set default values
-	limit := 20
-	offset := 0
-	if first != nil {
-		limit = *first
+	assignments, err := r.RegistrationService.GetShiftAssignments(ctx, obj.ID)
+	if err != nil {
+		return nil, err
 	}
-
-	// For simplicity, we'll use offset-based pagination for now
-	if after != nil {
-		offset = 20 // Placeholder implementation
+	if len(assignments) == 0 {
+		return []*model.RegistrationShift{}, nil
 	}
 
-	// Convert GraphQL filter to domain filter
-	domainFilter := event.EventSearchFilter{
-		Query: &query, // Set the search query
+	shifts, err := r.EventService.GetShiftAvailability(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, err
 	}
-	if filter != nil {
-		// Merge additional filters
-		additionalFilter := toDomainEventSearchFilter(*filter)
-		domainFilter.Status = additionalFilter.Status
-		domainFilter.Categories = additionalFilter.Categories
-		domainFilter.TimeCommitment = additionalFilter.TimeCommitment
-		domainFilter.Tags = additionalFilter.Tags
-		domainFilter.Location = additionalFilter.Location
-		domainFilter.Skills = additionalFilter.Skills
-		domainFilter.Interests = additionalFilter.Interests
+	byID := make(map[string]*model.EventShift, len(shifts))
+	for _, shift := range shifts {
+		byID[shift.Shift.ID] = toGraphEventShift(shift)
 	}
 
-	// Convert GraphQL sort to domain sort
-	var domainSort *event.EventSortInput
-	if sort != nil {
-		domainSort = toDomainEventSortInput(*sort)
+	out := make([]*model.RegistrationShift, 0, len(assignments))
+	for _, a := range assignments {
+		if shift, ok := byID[a.ShiftID]; ok {
+			out = append(out, toGraphRegistrationShift(a, shift))
+		}
 	}
+	return out, nil
+}
 
-	// Search events
-	connection, err := r.EventService.SearchEvents(ctx, domainFilter, domainSort, limit, offset)
+// CanCancel is the resolver for the canCancel field.
+func (r *registrationResolver) CanCancel(ctx context.Context, obj *model.Registration) (bool, error) {
+	if r.EventService == nil {
+		return false, fmt.Errorf("event service unavailable")
+	}
+
+	evt, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search events: %w", err)
+		return false, fmt.Errorf("failed to fetch event: %w", err)
 	}
 
-	// Convert domain connection to GraphQL connection
-	return toGraphQLEventConnection(connection), nil
+	return registration.CanCancel(registration.RegistrationStatus(obj.Status), evt, time.Now()), nil
 }
 
-// MyEvents is the resolver for the myEvents field.
-func (r *queryResolver) MyEvents(ctx context.Context, status []model.EventStatus, first *int, after *string) (*model.EventConnection, error) {
-	// Get current user from context
-	userID := mw.GetUserIDFromContext(ctx)
-	if userID == "" {
-		return nil, fmt.Errorf("authentication required")
+// CanCheckIn is the resolver for the canCheckIn field.
+func (r *registrationResolver) CanCheckIn(ctx context.Context, obj *model.Registration) (bool, error) {
+	if r.AttendanceService == nil || obj.Status != model.RegistrationStatusConfirmed {
+		return false, nil
 	}
-
-	// Check if EventService is available
 	if r.EventService == nil {
-		return nil, fmt.Errorf("event service unavailable")
+		return false, fmt.Errorf("event service unavailable")
 	}
 
-	// Set default values
-	limit := 20
-	offset := 0
-	if first != nil {
-		limit = *first
+	evt, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch event: %w", err)
 	}
 
-	if after != nil {
-		offset = 20 // Placeholder implementation
+	return r.AttendanceService.CanCheckIn(registration.RegistrationStatus(obj.Status), obj.CheckedInAt != nil, evt), nil
+}
+
+// Leader is the resolver for the leader field.
+func (r *teamResolver) Leader(ctx context.Context, obj *model.Team) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
 	}
 
-	// Convert GraphQL status to domain status
-	domainStatus := make([]event.EventStatus, len(status))
-	for i, s := range status {
-		domainStatus[i] = convertGraphQLEventStatus(s)
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
 	}
 
-	// Get user's events
-	connection, err := r.EventService.GetUserEvents(ctx, userID, domainStatus, limit, offset)
+	profile, err := r.UserService.GetProfile(ctx, obj.Leader.ID, requesterID, requesterRoles)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user events: %w", err)
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
 	}
 
-	// Convert domain connection to GraphQL connection
-	return toGraphQLEventConnection(connection), nil
+	return toGraphUser(profile), nil
 }
 
-// NearbyEvents is the resolver for the nearbyEvents field.
-func (r *queryResolver) NearbyEvents(ctx context.Context, coordinates model.CoordinatesInput, radius float64, filter *model.EventSearchFilter, first *int, after *string) (*model.EventConnection, error) {
-	// Check if EventService is available
-	if r.EventService == nil {
-		return nil, fmt.Errorf("event service unavailable")
+// Members is the resolver for the members field.
+func (r *teamResolver) Members(ctx context.Context, obj *model.Team) ([]*model.TeamMember, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
 	}
 
-	// Set default values
-	limit := 20
-	offset := 0
-	if first != nil {
-		limit = *first
+	members, err := r.RegistrationService.GetTeamMembers(ctx, userID, obj.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	if after != nil {
-		offset = 20 // Placeholder implementation
+	result := make([]*model.TeamMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, toGraphTeamMember(m))
 	}
 
-	// Convert GraphQL filter to domain filter
-	domainFilter := event.EventSearchFilter{}
-	if filter != nil {
-		domainFilter = toDomainEventSearchFilter(*filter)
+	return result, nil
+}
+
+// Registrations is the resolver for the registrations field.
+func (r *teamResolver) Registrations(ctx context.Context, obj *model.Team) ([]*model.TeamRegistration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
 	}
 
-	// Search nearby events
-	connection, err := r.EventService.GetNearbyEvents(ctx, coordinates.Lat, coordinates.Lng, radius, domainFilter, limit, offset)
+	teamRegs, err := r.RegistrationService.GetTeamRegistrations(ctx, userID, obj.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get nearby events: %w", err)
+		return nil, err
 	}
 
-	// Convert domain connection to GraphQL connection
-	return toGraphQLEventConnection(connection), nil
-}
+	result := make([]*model.TeamRegistration, 0, len(teamRegs))
+	for _, tr := range teamRegs {
+		result = append(result, toGraphTeamRegistration(tr))
+	}
 
-// EventUpdates is the resolver for the eventUpdates field.
-func (r *queryResolver) EventUpdates(ctx context.Context, eventID string, first *int, after *string) ([]*model.EventUpdate, error) {
-	panic(fmt.Errorf("not implemented: EventUpdates - eventUpdates"))
+	return result, nil
 }
 
-// MyRegistrations is the resolver for the myRegistrations field.
-func (r *queryResolver) MyRegistrations(ctx context.Context, filter *model.RegistrationFilterInput) ([]*model.Registration, error) {
+// Stats is the resolver for the stats field.
+func (r *teamResolver) Stats(ctx context.Context, obj *model.Team) (*model.TeamStats, error) {
 	userID := mw.GetUserIDFromContext(ctx)
 	if userID == "" {
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	registrations, err := r.RegistrationService.GetRegistrationsByUserID(ctx, userID)
+	stats, err := r.RegistrationService.GetTeamStats(ctx, userID, obj.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	var result []*model.Registration
-	for _, reg := range registrations {
-		result = append(result, toGraphRegistration(reg))
+	return &model.TeamStats{
+		Events:        stats.Events,
+		SeatsReserved: stats.SeatsReserved,
+		SeatsAssigned: stats.SeatsAssigned,
+		Attended:      stats.Attended,
+		NoShows:       stats.NoShows,
+		Hours:         stats.Hours,
+	}, nil
+}
+
+// User is the resolver for the user field.
+func (r *teamMemberResolver) User(ctx context.Context, obj *model.TeamMember) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
 	}
 
-	return result, nil
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.User.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
 }
 
-// Registration is the resolver for the registration field.
-func (r *queryResolver) Registration(ctx context.Context, id string) (*model.Registration, error) {
-	registration, err := r.RegistrationService.GetRegistrationByID(ctx, id)
+// Team is the resolver for the team field.
+func (r *teamRegistrationResolver) Team(ctx context.Context, obj *model.TeamRegistration) (*model.Team, error) {
+	team, err := r.RegistrationService.GetTeam(ctx, obj.Team.ID)
 	if err != nil {
 		return nil, err
 	}
-	return toGraphRegistration(registration), nil
+
+	return toGraphTeam(team), nil
 }
 
-// EventRegistrations is the resolver for the eventRegistrations field.
-func (r *queryResolver) EventRegistrations(ctx context.Context, eventID string, filter *model.RegistrationFilterInput) ([]*model.Registration, error) {
-	registrations, err := r.RegistrationService.GetRegistrationsByEventID(ctx, eventID)
+// Event is the resolver for the event field.
+func (r *teamRegistrationResolver) Event(ctx context.Context, obj *model.TeamRegistration) (*model.Event, error) {
+	if r.EventService == nil {
+		return nil, fmt.Errorf("event service unavailable")
+	}
+
+	domainEvent, err := r.EventService.GetEventByID(ctx, obj.Event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	return toGraphQLEvent(domainEvent), nil
+}
+
+// AssignedSeats is the resolver for the assignedSeats field.
+func (r *teamRegistrationResolver) AssignedSeats(ctx context.Context, obj *model.TeamRegistration) ([]*model.Registration, error) {
+	userID := mw.GetUserIDFromContext(ctx)
+	if userID == "" {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// Members' registrations are visible to the rest of the team
+	if _, err := r.RegistrationService.GetTeamMembers(ctx, userID, obj.Team.ID); err != nil {
+		return nil, err
+	}
+
+	seats, err := r.RegistrationService.GetTeamSeats(ctx, &registration.TeamRegistration{ID: obj.ID, EventID: obj.Event.ID})
 	if err != nil {
 		return nil, err
 	}
 
-	var result []*model.Registration
-	for _, reg := range registrations {
+	result := make([]*model.Registration, 0, len(seats))
+	for _, reg := range seats {
 		result = append(result, toGraphRegistration(reg))
 	}
 
 	return result, nil
 }
 
-// WaitlistEntries is the resolver for the waitlistEntries field.
-func (r *queryResolver) WaitlistEntries(ctx context.Context, eventID string) ([]*model.WaitlistEntry, error) {
-	panic(fmt.Errorf("not implemented: WaitlistEntries - waitlistEntries"))
+// Interests is the resolver for the interests field.
+func (r *userResolver) Interests(ctx context.Context, obj *model.User) ([]*model.Interest, error) {
+	// The interests are already populated in the User object by the toGraphUser converter
+	return obj.Interests, nil
 }
 
-// RegistrationConflicts is the resolver for the registrationConflicts field.
-func (r *queryResolver) RegistrationConflicts(ctx context.Context, eventID string) ([]*model.RegistrationConflict, error) {
-	panic(fmt.Errorf("not implemented: RegistrationConflicts - registrationConflicts"))
+// Skills is the resolver for the skills field.
+func (r *userResolver) Skills(ctx context.Context, obj *model.User) ([]*model.Skill, error) {
+	// The skills are already populated in the User object by the toGraphUser converter
+	return obj.Skills, nil
 }
 
-// AttendanceRecords is the resolver for the attendanceRecords field.
-func (r *queryResolver) AttendanceRecords(ctx context.Context, eventID string) ([]*model.AttendanceRecord, error) {
-	panic(fmt.Errorf("not implemented: AttendanceRecords - attendanceRecords"))
+// PublicProfile is the resolver for the publicProfile field.
+func (r *userResolver) PublicProfile(ctx context.Context, obj *model.User) (*model.PublicProfile, error) {
+	// The publicProfile is already populated in the User object by the toGraphUser converter
+	return obj.PublicProfile, nil
 }
 
-// RegistrationStats is the resolver for the registrationStats field.
-func (r *queryResolver) RegistrationStats(ctx context.Context, eventID string) (*model.RegistrationStats, error) {
-	panic(fmt.Errorf("not implemented: RegistrationStats - registrationStats"))
+// Trainings is the resolver for the trainings field.
+func (r *userResolver) Trainings(ctx context.Context, obj *model.User) ([]*model.Training, error) {
+	// The trainings are already populated in the User object by the toGraphUser converter
+	return obj.Trainings, nil
 }
 
 // User is the resolver for the user field.
-func (r *registrationResolver) User(ctx context.Context, obj *model.Registration) (*model.User, error) {
+func (r *volunteerHoursEntryResolver) User(ctx context.Context, obj *model.VolunteerHoursEntry) (*model.User, error) {
 	if r.UserService == nil {
 		return nil, fmt.Errorf("user service unavailable")
 	}
 
-	// Get current user context for authorization
 	requesterID := mw.GetUserIDFromContext(ctx)
 	claims := mw.GetUserClaimsFromContext(ctx)
 	requesterRoles := []string{}
@@ -945,7 +3171,11 @@ func (r *registrationResolver) User(ctx context.Context, obj *model.Registration
 }
 
 // Event is the resolver for the event field.
-func (r *registrationResolver) Event(ctx context.Context, obj *model.Registration) (*model.Event, error) {
+func (r *volunteerHoursEntryResolver) Event(ctx context.Context, obj *model.VolunteerHoursEntry) (*model.Event, error) {
+	// Off-platform hours have no event
+	if obj.Event == nil {
+		return nil, nil
+	}
 	if r.EventService == nil {
 		return nil, fmt.Errorf("event service unavailable")
 	}
@@ -958,41 +3188,60 @@ func (r *registrationResolver) Event(ctx context.Context, obj *model.Registratio
 	return toGraphQLEvent(domainEvent), nil
 }
 
-// Skills is the resolver for the skills field.
-func (r *registrationResolver) Skills(ctx context.Context, obj *model.Registration) ([]*model.UserSkill, error) {
-	// For now, return empty slice - this would be populated from registration-specific skills
-	// if the system supports skills per registration rather than per user
-	return []*model.UserSkill{}, nil
-}
+// Organization is the resolver for the organization field.
+func (r *volunteerHoursEntryResolver) Organization(ctx context.Context, obj *model.VolunteerHoursEntry) (*model.Organization, error) {
+	if obj.Organization == nil {
+		return nil, nil
+	}
+	if r.OrganizationService == nil {
+		return nil, fmt.Errorf("organization service unavailable")
+	}
 
-// Interests is the resolver for the interests field.
-func (r *registrationResolver) Interests(ctx context.Context, obj *model.Registration) ([]*model.Interest, error) {
-	// For now, return empty slice - this would be populated from registration-specific interests
-	// if the system supports interests per registration rather than per user
-	return []*model.Interest{}, nil
-}
+	org, err := r.OrganizationService.GetOrganization(ctx, obj.Organization.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
+	}
 
-// Interests is the resolver for the interests field.
-func (r *userResolver) Interests(ctx context.Context, obj *model.User) ([]*model.Interest, error) {
-	// The interests are already populated in the User object by the toGraphUser converter
-	return obj.Interests, nil
+	return toGraphOrganization(org), nil
 }
 
-// Skills is the resolver for the skills field.
-func (r *userResolver) Skills(ctx context.Context, obj *model.User) ([]*model.Skill, error) {
-	// The skills are already populated in the User object by the toGraphUser converter
-	return obj.Skills, nil
-}
+// User is the resolver for the user field.
+func (r *waiverSignatureResolver) User(ctx context.Context, obj *model.WaiverSignature) (*model.User, error) {
+	if r.UserService == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
 
-// PublicProfile is the resolver for the publicProfile field.
-func (r *userResolver) PublicProfile(ctx context.Context, obj *model.User) (*model.PublicProfile, error) {
-	// The publicProfile is already populated in the User object by the toGraphUser converter
-	return obj.PublicProfile, nil
+	requesterID := mw.GetUserIDFromContext(ctx)
+	claims := mw.GetUserClaimsFromContext(ctx)
+	requesterRoles := []string{}
+	if claims != nil {
+		requesterRoles = claims.Roles
+	}
+
+	profile, err := r.UserService.GetProfile(ctx, obj.User.ID, requesterID, requesterRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return toGraphUser(profile), nil
 }
 
+type attendanceRecordResolver struct{ *Resolver }
 type eventResolver struct{ *Resolver }
+type eventHoursBreakdownResolver struct{ *Resolver }
+type eventStaffResolver struct{ *Resolver }
+type eventUpdateResolver struct{ *Resolver }
+type guardianConsentResolver struct{ *Resolver }
+type hoursCertificateLineResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type organizationResolver struct{ *Resolver }
+type organizationMemberResolver struct{ *Resolver }
 type publicProfileResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type registrationResolver struct{ *Resolver }
+type teamResolver struct{ *Resolver }
+type teamMemberResolver struct{ *Resolver }
+type teamRegistrationResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
+type volunteerHoursEntryResolver struct{ *Resolver }
+type waiverSignatureResolver struct{ *Resolver }
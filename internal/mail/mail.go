@@ -0,0 +1,102 @@
+// Package mail sends transactional email such as guardian consent requests.
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Message is a plain-text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// SMTPConfig holds the outgoing mail server settings
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPSender delivers messages through an SMTP relay, authenticating with
+// PLAIN auth when a username is configured
+type SMTPSender struct {
+	cfg SMTPConfig
+}
+
+// NewSMTPSender creates a sender for the given relay
+func NewSMTPSender(cfg SMTPConfig) *SMTPSender {
+	if cfg.Host == "" {
+		panic("smtp host is required")
+	}
+	if cfg.From == "" {
+		panic("mail from address is required")
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &SMTPSender{cfg: cfg}
+}
+
+// Send delivers msg. net/smtp doesn't take a context, so ctx is only checked
+// before connecting.
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	if err := smtp.SendMail(addr, auth, s.cfg.From, []string{msg.To}, formatMessage(s.cfg.From, msg, time.Now())); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+	return nil
+}
+
+// LogSender writes messages to the log instead of sending them, for
+// development setups without a mail server
+type LogSender struct {
+	logger *slog.Logger
+}
+
+// NewLogSender creates a sender that only logs
+func NewLogSender(logger *slog.Logger) *LogSender {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LogSender{logger: logger}
+}
+
+// Send logs msg
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	s.logger.InfoContext(ctx, "email not sent (no SMTP server configured)", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
+
+// formatMessage renders msg as an RFC 5322 message. Header values are
+// stripped of line breaks so user-supplied text can't inject headers.
+func formatMessage(from string, msg Message, now time.Time) []byte {
+	clean := strings.NewReplacer("\r", "", "\n", " ")
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", clean.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", clean.Replace(msg.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", clean.Replace(msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,25 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMessage(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+	raw := string(formatMessage("noreply@example.org", Message{
+		To:      "guardian@example.org\r\nBcc: someone@example.org",
+		Subject: "Consent needed",
+		Body:    "Hello,\nPlease review.",
+	}, now))
+
+	headers, body, found := strings.Cut(raw, "\r\n\r\n")
+	assert.True(t, found)
+	assert.Contains(t, headers, "Subject: Consent needed\r\n")
+	assert.Contains(t, headers, "Date: Fri, 01 May 2026 09:30:00 +0000\r\n")
+	assert.NotContains(t, headers, "\r\nBcc:", "line breaks in header values are removed")
+	assert.Equal(t, "Hello,\r\nPlease review.", body)
+}
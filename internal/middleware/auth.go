@@ -262,3 +262,12 @@ func HasAnyRole(ctx context.Context, roles ...string) bool {
 	}
 	return false
 }
+
+// HasPermission checks if the authenticated user's roles grant a permission
+func HasPermission(ctx context.Context, perm auth.Permission) bool {
+	claims := GetUserClaimsFromContext(ctx)
+	if claims == nil {
+		return false
+	}
+	return auth.RolesHavePermission(claims.Roles, perm)
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ClientIPContextKey is the key for the caller's IP address in context
+	ClientIPContextKey ContextKey = "client_ip"
+	// UserAgentContextKey is the key for the caller's User-Agent in context
+	UserAgentContextKey ContextKey = "user_agent"
+)
+
+// RequestInfo copies the client IP and User-Agent into the request context so
+// services can record them, e.g. as evidence on a signature
+func RequestInfo() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		ctx := context.WithValue(c.Request.Context(), ClientIPContextKey, c.ClientIP())
+		ctx = context.WithValue(ctx, UserAgentContextKey, c.Request.UserAgent())
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	})
+}
+
+// GetClientIPFromContext returns the caller's IP address, or "" if unknown
+func GetClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(ClientIPContextKey).(string)
+	return ip
+}
+
+// GetUserAgentFromContext returns the caller's User-Agent, or "" if unknown
+func GetUserAgentFromContext(ctx context.Context) string {
+	ua, _ := ctx.Value(UserAgentContextKey).(string)
+	return ua
+}
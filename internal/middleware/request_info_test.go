@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestInfo(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	var ip, ua string
+	router.GET("/", RequestInfo(), func(c *gin.Context) {
+		ip = GetClientIPFromContext(c.Request.Context())
+		ua = GetUserAgentFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "203.0.113.7:51234"
+	req.Header.Set("User-Agent", "test-agent/1.0")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if ip != "203.0.113.7" {
+		t.Errorf("GetClientIPFromContext() = %q, want %q", ip, "203.0.113.7")
+	}
+	if ua != "test-agent/1.0" {
+		t.Errorf("GetUserAgentFromContext() = %q, want %q", ua, "test-agent/1.0")
+	}
+}
@@ -0,0 +1,290 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/volunteersync/backend/internal/core/admin"
+)
+
+// adminAuditLockKey is the advisory lock that serialises appends to admin_audit_log
+const adminAuditLockKey = 0x61756469 // "audi"
+
+const adminUserSelectColumns = `
+	u.id, u.email, u.name, COALESCE(u.is_verified, FALSE), u.email_verified,
+	u.failed_login_attempts, u.locked_until, u.last_login, u.created_at,
+	COALESCE((SELECT array_agg(r.role ORDER BY r.role) FROM user_roles r WHERE r.user_id = u.id), '{}')
+`
+
+const adminAuditSelectColumns = `
+	sequence, id, actor_id, action, target_type, target_id, reason, details, created_at, prev_hash, hash
+`
+
+// AdminStorePG implements the admin.Repository interface using PostgreSQL
+type AdminStorePG struct {
+	db *sql.DB
+}
+
+// NewAdminStore creates a new PostgreSQL admin store
+func NewAdminStore(db *sql.DB) *AdminStorePG {
+	return &AdminStorePG{db: db}
+}
+
+// SearchUsers lists accounts matching the filter along with the total match count
+func (s *AdminStorePG) SearchUsers(ctx context.Context, filter admin.UserFilter, limit, offset int) (*admin.UserPage, error) {
+	var where []string
+	var args []any
+	if filter.Query != nil {
+		args = append(args, "%"+*filter.Query+"%")
+		where = append(where, fmt.Sprintf("(u.name ILIKE $%d OR u.email ILIKE $%d)", len(args), len(args)))
+	}
+	if filter.LockedOnly {
+		where = append(where, "u.locked_until > NOW()")
+	}
+	if filter.Verified != nil {
+		args = append(args, *filter.Verified)
+		where = append(where, fmt.Sprintf("COALESCE(u.is_verified, FALSE) = $%d", len(args)))
+	}
+	if filter.Role != nil {
+		args = append(args, strings.ToUpper(*filter.Role))
+		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM user_roles r WHERE r.user_id = u.id AND r.role = $%d)", len(args)))
+	}
+	clause := ""
+	if len(where) > 0 {
+		clause = " WHERE " + strings.Join(where, " AND ")
+	}
+
+	page := &admin.UserPage{}
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users u"+clause, args...).Scan(&page.TotalCount); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM users u%s ORDER BY u.created_at DESC, u.id LIMIT $%d OFFSET $%d",
+		adminUserSelectColumns, clause, len(args)+1, len(args)+2)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		u, err := scanUserAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		page.Users = append(page.Users, u)
+	}
+	return page, rows.Err()
+}
+
+// GetUser returns a single account or admin.ErrUserNotFound
+func (s *AdminStorePG) GetUser(ctx context.Context, userID string) (*admin.UserAccount, error) {
+	u, err := scanUserAccount(s.db.QueryRowContext(ctx, "SELECT "+adminUserSelectColumns+" FROM users u WHERE u.id = $1", userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, admin.ErrUserNotFound
+	}
+	return u, err
+}
+
+// UnlockUser clears the lockout and records the audit entry atomically
+func (s *AdminStorePG) UnlockUser(ctx context.Context, userID string, entry *admin.AuditEntry) error {
+	return s.withAudit(ctx, entry, func(tx *sql.Tx) error {
+		return execAffectingOne(ctx, tx, admin.ErrUserNotFound,
+			"UPDATE users SET failed_login_attempts = 0, locked_until = NULL, updated_at = NOW() WHERE id = $1", userID)
+	})
+}
+
+// SetUserVerified sets users.is_verified and records the audit entry atomically
+func (s *AdminStorePG) SetUserVerified(ctx context.Context, userID string, verified bool, entry *admin.AuditEntry) error {
+	return s.withAudit(ctx, entry, func(tx *sql.Tx) error {
+		return execAffectingOne(ctx, tx, admin.ErrUserNotFound,
+			"UPDATE users SET is_verified = $2, updated_at = NOW() WHERE id = $1", userID, verified)
+	})
+}
+
+// SetBackgroundCheck sets the users.background_check_* columns and records the audit entry atomically
+func (s *AdminStorePG) SetBackgroundCheck(ctx context.Context, userID string, verifiedAt, expiresAt *time.Time, entry *admin.AuditEntry) error {
+	return s.withAudit(ctx, entry, func(tx *sql.Tx) error {
+		return execAffectingOne(ctx, tx, admin.ErrUserNotFound,
+			"UPDATE users SET background_check_verified_at = $2, background_check_expires_at = $3, updated_at = NOW() WHERE id = $1",
+			userID, verifiedAt, expiresAt)
+	})
+}
+
+// GetSkillOwner returns the user who holds the skill
+func (s *AdminStorePG) GetSkillOwner(ctx context.Context, skillID string) (string, error) {
+	var userID string
+	err := s.db.QueryRowContext(ctx, "SELECT user_id FROM user_skills WHERE id = $1", skillID).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", admin.ErrSkillNotFound
+	}
+	return userID, err
+}
+
+// SetSkillVerified sets user_skills.verified and records the audit entry atomically
+func (s *AdminStorePG) SetSkillVerified(ctx context.Context, skillID string, verified bool, entry *admin.AuditEntry) error {
+	return s.withAudit(ctx, entry, func(tx *sql.Tx) error {
+		return execAffectingOne(ctx, tx, admin.ErrSkillNotFound,
+			"UPDATE user_skills SET verified = $2, updated_at = NOW() WHERE id = $1", skillID, verified)
+	})
+}
+
+// GetEventStatus returns an event's current status
+func (s *AdminStorePG) GetEventStatus(ctx context.Context, eventID string) (string, error) {
+	var status string
+	err := s.db.QueryRowContext(ctx, "SELECT status FROM events WHERE id = $1", eventID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", admin.ErrEventNotFound
+	}
+	return status, err
+}
+
+// SetEventStatus moves an event to a new status and records the audit entry atomically
+func (s *AdminStorePG) SetEventStatus(ctx context.Context, eventID, status string, entry *admin.AuditEntry) error {
+	return s.withAudit(ctx, entry, func(tx *sql.Tx) error {
+		return execAffectingOne(ctx, tx, admin.ErrEventNotFound,
+			"UPDATE events SET status = $2, updated_at = NOW() WHERE id = $1", eventID, status)
+	})
+}
+
+// AppendAuditEntry records an audit entry on its own
+func (s *AdminStorePG) AppendAuditEntry(ctx context.Context, entry *admin.AuditEntry) error {
+	return s.withAudit(ctx, entry, func(*sql.Tx) error { return nil })
+}
+
+// ListAuditEntries returns entries matching the filter, newest first
+func (s *AdminStorePG) ListAuditEntries(ctx context.Context, filter admin.AuditFilter, limit, offset int) ([]*admin.AuditEntry, error) {
+	var where []string
+	var args []any
+	if filter.ActorID != nil {
+		args = append(args, *filter.ActorID)
+		where = append(where, fmt.Sprintf("actor_id = $%d", len(args)))
+	}
+	if filter.Action != nil {
+		args = append(args, string(*filter.Action))
+		where = append(where, fmt.Sprintf("action = $%d", len(args)))
+	}
+	if filter.TargetType != nil {
+		args = append(args, *filter.TargetType)
+		where = append(where, fmt.Sprintf("target_type = $%d", len(args)))
+	}
+	if filter.TargetID != nil {
+		args = append(args, *filter.TargetID)
+		where = append(where, fmt.Sprintf("target_id = $%d", len(args)))
+	}
+	clause := ""
+	if len(where) > 0 {
+		clause = " WHERE " + strings.Join(where, " AND ")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM admin_audit_log%s ORDER BY sequence DESC LIMIT $%d OFFSET $%d",
+		adminAuditSelectColumns, clause, len(args)+1, len(args)+2)
+	return s.queryAuditEntries(ctx, query, append(args, limit, offset)...)
+}
+
+// ListAuditEntriesAfter returns entries with a sequence above afterSequence, oldest first
+func (s *AdminStorePG) ListAuditEntriesAfter(ctx context.Context, afterSequence int64, limit int) ([]*admin.AuditEntry, error) {
+	query := "SELECT " + adminAuditSelectColumns + " FROM admin_audit_log WHERE sequence > $1 ORDER BY sequence LIMIT $2"
+	return s.queryAuditEntries(ctx, query, afterSequence, limit)
+}
+
+// withAudit runs change and appends entry to the hash chain in one transaction.
+// The advisory lock keeps concurrent writers from forking the chain.
+func (s *AdminStorePG) withAudit(ctx context.Context, entry *admin.AuditEntry, change func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := change(tx); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", adminAuditLockKey); err != nil {
+		return fmt.Errorf("lock audit log: %w", err)
+	}
+	var lastSeq int64
+	var lastHash sql.NullString
+	err = tx.QueryRowContext(ctx, "SELECT sequence, hash FROM admin_audit_log ORDER BY sequence DESC LIMIT 1").Scan(&lastSeq, &lastHash)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("read audit chain head: %w", err)
+	}
+
+	entry.Sequence = lastSeq + 1
+	entry.PrevHash = admin.GenesisHash
+	if lastHash.Valid {
+		entry.PrevHash = lastHash.String
+	}
+	entry.Hash = entry.ComputeHash()
+
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO admin_audit_log (
+			sequence, id, actor_id, action, target_type, target_id, reason, details, created_at, prev_hash, hash
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		entry.Sequence, entry.ID, entry.ActorID, string(entry.Action), entry.TargetType, entry.TargetID,
+		entry.Reason, details, entry.CreatedAt, entry.PrevHash, entry.Hash)
+	if err != nil {
+		return fmt.Errorf("insert audit entry: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (s *AdminStorePG) queryAuditEntries(ctx context.Context, query string, args ...any) ([]*admin.AuditEntry, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*admin.AuditEntry
+	for rows.Next() {
+		e := &admin.AuditEntry{}
+		var action string
+		var details []byte
+		if err := rows.Scan(&e.Sequence, &e.ID, &e.ActorID, &action, &e.TargetType, &e.TargetID,
+			&e.Reason, &details, &e.CreatedAt, &e.PrevHash, &e.Hash); err != nil {
+			return nil, err
+		}
+		e.Action = admin.Action(action)
+		if err := json.Unmarshal(details, &e.Details); err != nil {
+			return nil, fmt.Errorf("decode audit details: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func scanUserAccount(row rowScanner) (*admin.UserAccount, error) {
+	u := &admin.UserAccount{}
+	var roles pq.StringArray
+	if err := row.Scan(&u.ID, &u.Email, &u.Name, &u.IsVerified, &u.EmailVerified,
+		&u.FailedLoginAttempts, &u.LockedUntil, &u.LastLogin, &u.CreatedAt, &roles); err != nil {
+		return nil, err
+	}
+	u.Roles = roles
+	return u, nil
+}
+
+// execAffectingOne runs an UPDATE and maps "no rows changed" to notFound
+func execAffectingOne(ctx context.Context, tx *sql.Tx, notFound error, query string, args ...any) error {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return notFound
+	}
+	return nil
+}
@@ -22,7 +22,7 @@ func NewAuthUserRepository(db *sql.DB) *AuthUserRepository { return &AuthUserRep
 func (r *AuthUserRepository) CreateUser(ctx context.Context, user *auth.User) error {
 	const q = `INSERT INTO users (id, email, name, password_hash, email_verified, google_id, last_login, failed_login_attempts, locked_until, created_at, updated_at)
                VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`
-	_, err := r.db.ExecContext(ctx, q,
+	_, err := conn(ctx, r.db).ExecContext(ctx, q,
 		user.ID, user.Email, user.Name, user.PasswordHash, user.EmailVerified, user.GoogleID, user.LastLogin,
 		user.FailedLoginAttempts, user.LockedUntil, user.CreatedAt, user.UpdatedAt,
 	)
@@ -158,6 +158,37 @@ func (r *AuthUserRepository) EmailExists(ctx context.Context, email string) (boo
 	return exists, err
 }
 
+// GetUserRoles lists the roles assigned to a user
+func (r *AuthUserRepository) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT role FROM user_roles WHERE user_id=$1 ORDER BY role`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var roles []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+// AssignRole adds a role to a user, ignoring duplicates
+func (r *AuthUserRepository) AssignRole(ctx context.Context, userID, role string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `INSERT INTO user_roles (user_id, role) VALUES ($1,$2) ON CONFLICT DO NOTHING`, userID, strings.ToUpper(role))
+	return err
+}
+
+// Transaction runs fn in a transaction that CreateUser and AssignRole join
+func (r *AuthUserRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return inTx(ctx, r.db, func(ctx context.Context, _ *sql.Tx) error {
+		return fn(ctx)
+	})
+}
+
 // RefreshTokenRepository implements auth.RefreshTokenRepository using Postgres
 type RefreshTokenRepository struct {
 	db *sql.DB
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/auth"
+)
+
+func TestAuthUserRepository_Transaction(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewAuthUserRepository(db)
+	ctx := context.Background()
+	newUser := func() *auth.User {
+		id := uuid.New().String()
+		now := time.Now().UTC()
+		return &auth.User{ID: id, Email: id + "@example.com", Name: "New Volunteer", CreatedAt: now, UpdatedAt: now}
+	}
+
+	user := newUser()
+	err := repo.Transaction(ctx, func(ctx context.Context) error {
+		if err := repo.CreateUser(ctx, user); err != nil {
+			return err
+		}
+		return repo.AssignRole(ctx, user.ID, auth.RoleVolunteer)
+	})
+	require.NoError(t, err)
+	roles, err := repo.GetUserRoles(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{auth.RoleVolunteer}, roles)
+
+	t.Run("a failed role assignment leaves no user behind", func(t *testing.T) {
+		user := newUser()
+		err := repo.Transaction(ctx, func(ctx context.Context) error {
+			if err := repo.CreateUser(ctx, user); err != nil {
+				return err
+			}
+			return errors.New("role not assigned")
+		})
+		require.Error(t, err)
+
+		_, err = repo.GetUserByID(ctx, user.ID)
+		assert.Error(t, err)
+	})
+}
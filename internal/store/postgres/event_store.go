@@ -26,7 +26,9 @@ const eventSelectColumns = `
 	physical_requirements, category, time_commitment, tags,
 	registration_opens_at, registration_closes_at, requires_approval,
 	confirmation_required, cancellation_deadline, parent_event_id,
-	recurrence_rule, slug, share_url, created_at, updated_at, published_at
+	recurrence_rule, slug, share_url, created_at, updated_at, published_at,
+	organization_id, block_late_cancellation, reconfirmation_hours,
+	location_time_zone
 `
 
 // NewEventStore creates a new PostgreSQL event store
@@ -90,11 +92,13 @@ func (s *EventStorePG) insertMainEvent(ctx context.Context, tx *sql.Tx, e *event
 			physical_requirements, category, time_commitment, tags,
 			registration_opens_at, registration_closes_at, requires_approval,
 			confirmation_required, cancellation_deadline, parent_event_id,
-			recurrence_rule, slug, share_url, created_at, updated_at, published_at
+			recurrence_rule, slug, share_url, created_at, updated_at, published_at,
+			organization_id, block_late_cancellation, reconfirmation_hours,
+			location_time_zone
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
 			$17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30,
-			$31, $32, $33, $34, $35, $36, $37, $38, $39
+			$31, $32, $33, $34, $35, $36, $37, $38, $39, $40, $41, $42, NULLIF($43, '')
 		)`
 
 	_, err := tx.ExecContext(ctx, query,
@@ -109,6 +113,8 @@ func (s *EventStorePG) insertMainEvent(ctx context.Context, tx *sql.Tx, e *event
 		e.RegistrationSettings.RequiresApproval, e.RegistrationSettings.ConfirmationRequired,
 		e.RegistrationSettings.CancellationDeadline, e.ParentEventID,
 		recurrenceJSON, e.Slug, e.ShareURL, e.CreatedAt, e.UpdatedAt, e.PublishedAt,
+		e.OrganizationID, e.RegistrationSettings.BlockLateCancellation,
+		e.RegistrationSettings.ReconfirmationHours, e.Location.TimeZone,
 	)
 
 	return err
@@ -182,10 +188,13 @@ func (s *EventStorePG) Update(ctx context.Context, e *event.Event) error {
 			physical_requirements = $20, category = $21, time_commitment = $22,
 			tags = $23, registration_opens_at = $24, registration_closes_at = $25,
 			requires_approval = $26, confirmation_required = $27,
-			cancellation_deadline = $28, recurrence_rule = $29, updated_at = NOW()
+			cancellation_deadline = $28, recurrence_rule = $29,
+			start_time = $30, end_time = $31, block_late_cancellation = $32,
+			reconfirmation_hours = $33, location_time_zone = NULLIF($34, ''),
+			updated_at = NOW()
 		WHERE id = $1`
 
-	_, err := s.db.ExecContext(ctx, query, e.ID, e.Title, e.Description, e.ShortDescription,
+	_, err := conn(ctx, s.db).ExecContext(ctx, query, e.ID, e.Title, e.Description, e.ShortDescription,
 		e.Location.Name, e.Location.Address, e.Location.City, e.Location.State,
 		e.Location.Country, e.Location.ZipCode, lat, lng, e.Location.Instructions,
 		e.Location.IsRemote, e.Capacity.Minimum, e.Capacity.Maximum,
@@ -194,11 +203,21 @@ func (s *EventStorePG) Update(ctx context.Context, e *event.Event) error {
 		e.Category, e.TimeCommitment, pq.Array(e.Tags),
 		e.RegistrationSettings.OpensAt, e.RegistrationSettings.ClosesAt,
 		e.RegistrationSettings.RequiresApproval, e.RegistrationSettings.ConfirmationRequired,
-		e.RegistrationSettings.CancellationDeadline, recurrenceJSON)
+		e.RegistrationSettings.CancellationDeadline, recurrenceJSON,
+		e.StartTime, e.EndTime, e.RegistrationSettings.BlockLateCancellation,
+		e.RegistrationSettings.ReconfirmationHours, e.Location.TimeZone)
 
 	return err
 }
 
+// Transaction runs fn in a transaction that this store's, and the
+// registration store's, writes given its context join
+func (s *EventStorePG) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return inTx(ctx, s.db, func(ctx context.Context, _ *sql.Tx) error {
+		return fn(ctx)
+	})
+}
+
 // Delete soft deletes an event
 func (s *EventStorePG) Delete(ctx context.Context, id string) error {
 	_, err := s.db.ExecContext(ctx, "UPDATE events SET status = 'ARCHIVED', updated_at = NOW() WHERE id = $1", id)
@@ -219,10 +238,10 @@ func (s *EventStorePG) List(ctx context.Context, filter event.EventSearchFilter,
 		args = append(args, *filter.Query)
 	}
 
-	// Add category filter
+	// Add category filter; a category matches its narrower terms too
 	if len(filter.Categories) > 0 {
 		argCount++
-		baseQuery += fmt.Sprintf(` AND e.category = ANY($%d)`, argCount)
+		baseQuery += ` AND e.category IN (` + termSubtreeQuery(event.TaxonomyCategory, argCount) + `)`
 		categories := make([]string, len(filter.Categories))
 		for i, cat := range filter.Categories {
 			categories[i] = string(cat)
@@ -230,6 +249,45 @@ func (s *EventStorePG) List(ctx context.Context, filter event.EventSearchFilter,
 		args = append(args, pq.Array(categories))
 	}
 
+	// Add time commitment filter
+	if len(filter.TimeCommitment) > 0 {
+		argCount++
+		baseQuery += ` AND e.time_commitment IN (` + termSubtreeQuery(event.TaxonomyTimeCommitment, argCount) + `)`
+		commitments := make([]string, len(filter.TimeCommitment))
+		for i, tc := range filter.TimeCommitment {
+			commitments[i] = string(tc)
+		}
+		args = append(args, pq.Array(commitments))
+	}
+
+	// Add skills filter: events requiring any of the skills, matched on the
+	// canonical skill whatever name the filter or the event used
+	if len(filter.Skills) > 0 {
+		argCount++
+		baseQuery += ` AND EXISTS (
+			SELECT 1 FROM event_skill_requirements esr
+			WHERE esr.event_id = e.id AND ` + skillMatchCondition("esr.skill_id", "esr.skill_name", argCount) + `)`
+		args = append(args, pq.Array(filter.Skills))
+	}
+
+	// Add status filter
+	if len(filter.Status) > 0 {
+		argCount++
+		baseQuery += fmt.Sprintf(` AND e.status = ANY($%d)`, argCount)
+		statuses := make([]string, len(filter.Status))
+		for i, st := range filter.Status {
+			statuses[i] = string(st)
+		}
+		args = append(args, pq.Array(statuses))
+	}
+
+	// Add organization filter
+	if filter.OrganizationID != nil && *filter.OrganizationID != "" {
+		argCount++
+		baseQuery += fmt.Sprintf(` AND e.organization_id = $%d`, argCount)
+		args = append(args, *filter.OrganizationID)
+	}
+
 	// Get total count
 	countQuery := "SELECT COUNT(*) " + baseQuery
 	var totalCount int
@@ -261,7 +319,9 @@ func (s *EventStorePG) List(ctx context.Context, filter event.EventSearchFilter,
 			e.physical_requirements, e.category, e.time_commitment, e.tags,
 			e.registration_opens_at, e.registration_closes_at, e.requires_approval,
 			e.confirmation_required, e.cancellation_deadline, e.parent_event_id,
-			e.recurrence_rule, e.slug, e.share_url, e.created_at, e.updated_at, e.published_at
+			e.recurrence_rule, e.slug, e.share_url, e.created_at, e.updated_at, e.published_at,
+			e.organization_id, e.block_late_cancellation, e.reconfirmation_hours,
+			e.location_time_zone
 		%s %s LIMIT %d OFFSET %d`, baseQuery, orderBy, limit, offset)
 
 	rows, err := s.db.QueryContext(ctx, selectQuery, args...)
@@ -290,6 +350,7 @@ func (s *EventStorePG) scanEvent(row *sql.Row, e *event.Event) error {
 	var recurrenceJSON []byte
 	var tags pq.StringArray
 	var lat, lng sql.NullFloat64
+	var timeZone sql.NullString
 
 	err := row.Scan(
 		&e.ID, &e.Title, &e.Description, &e.ShortDescription, &e.OrganizerID, &e.Status,
@@ -302,7 +363,9 @@ func (s *EventStorePG) scanEvent(row *sql.Row, e *event.Event) error {
 		&e.RegistrationSettings.ClosesAt, &e.RegistrationSettings.RequiresApproval,
 		&e.RegistrationSettings.ConfirmationRequired, &e.RegistrationSettings.CancellationDeadline,
 		&e.ParentEventID, &recurrenceJSON, &e.Slug, &e.ShareURL,
-		&e.CreatedAt, &e.UpdatedAt, &e.PublishedAt,
+		&e.CreatedAt, &e.UpdatedAt, &e.PublishedAt, &e.OrganizationID,
+		&e.RegistrationSettings.BlockLateCancellation, &e.RegistrationSettings.ReconfirmationHours,
+		&timeZone,
 	)
 	if err != nil {
 		return err
@@ -317,6 +380,7 @@ func (s *EventStorePG) scanEvent(row *sql.Row, e *event.Event) error {
 	}
 
 	e.Tags = []string(tags)
+	e.Location.TimeZone = timeZone.String
 
 	// Parse recurrence rule
 	if len(recurrenceJSON) > 0 {
@@ -334,6 +398,7 @@ func (s *EventStorePG) scanEventFromRows(rows *sql.Rows, e *event.Event) error {
 	var recurrenceJSON []byte
 	var tags pq.StringArray
 	var lat, lng sql.NullFloat64
+	var timeZone sql.NullString
 
 	err := rows.Scan(
 		&e.ID, &e.Title, &e.Description, &e.ShortDescription, &e.OrganizerID, &e.Status,
@@ -346,7 +411,9 @@ func (s *EventStorePG) scanEventFromRows(rows *sql.Rows, e *event.Event) error {
 		&e.RegistrationSettings.ClosesAt, &e.RegistrationSettings.RequiresApproval,
 		&e.RegistrationSettings.ConfirmationRequired, &e.RegistrationSettings.CancellationDeadline,
 		&e.ParentEventID, &recurrenceJSON, &e.Slug, &e.ShareURL,
-		&e.CreatedAt, &e.UpdatedAt, &e.PublishedAt,
+		&e.CreatedAt, &e.UpdatedAt, &e.PublishedAt, &e.OrganizationID,
+		&e.RegistrationSettings.BlockLateCancellation, &e.RegistrationSettings.ReconfirmationHours,
+		&timeZone,
 	)
 	if err != nil {
 		return err
@@ -361,6 +428,7 @@ func (s *EventStorePG) scanEventFromRows(rows *sql.Rows, e *event.Event) error {
 	}
 
 	e.Tags = []string(tags)
+	e.Location.TimeZone = timeZone.String
 
 	// Parse recurrence rule
 	if len(recurrenceJSON) > 0 {
@@ -576,6 +644,7 @@ func (s *EventStorePG) GetNearby(ctx context.Context, lat, lng, radius float64,
 		var latNull, lngNull sql.NullFloat64
 		var recurrenceJSON []byte
 		var tags pq.StringArray
+		var timeZone sql.NullString
 		var distance float64
 
 		if err := rows.Scan(
@@ -590,7 +659,8 @@ func (s *EventStorePG) GetNearby(ctx context.Context, lat, lng, radius float64,
 			&e.RegistrationSettings.RequiresApproval, &e.RegistrationSettings.ConfirmationRequired,
 			&e.RegistrationSettings.CancellationDeadline, &e.ParentEventID,
 			&recurrenceJSON, &e.Slug, &e.ShareURL, &e.CreatedAt, &e.UpdatedAt, &e.PublishedAt,
-			&distance,
+			&e.OrganizationID, &e.RegistrationSettings.BlockLateCancellation,
+			&e.RegistrationSettings.ReconfirmationHours, &timeZone, &distance,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan event: %w", err)
 		}
@@ -606,6 +676,7 @@ func (s *EventStorePG) GetNearby(ctx context.Context, lat, lng, radius float64,
 		}
 
 		e.Tags = []string(tags)
+		e.Location.TimeZone = timeZone.String
 
 		// Parse recurrence rule
 		if len(recurrenceJSON) > 0 {
@@ -671,9 +742,9 @@ func (s *EventStorePG) GetByStatus(ctx context.Context, status event.EventStatus
 func (s *EventStorePG) insertSkillRequirements(ctx context.Context, tx *sql.Tx, eventID string, skills []event.SkillRequirement) error {
 	for _, skill := range skills {
 		query := `
-			INSERT INTO event_skill_requirements (id, event_id, skill_name, proficiency, required, created_at)
-			VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW())`
-		_, err := tx.ExecContext(ctx, query, eventID, skill.Skill, skill.Proficiency, skill.Required)
+			INSERT INTO event_skill_requirements (id, event_id, skill_name, skill_id, proficiency, required, created_at)
+			VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW())`
+		_, err := tx.ExecContext(ctx, query, eventID, skill.Skill, skill.SkillID, skill.Proficiency, skill.Required)
 		if err != nil {
 			return err
 		}
@@ -683,16 +754,16 @@ func (s *EventStorePG) insertSkillRequirements(ctx context.Context, tx *sql.Tx,
 
 func (s *EventStorePG) CreateSkillRequirement(ctx context.Context, req *event.SkillRequirement) error {
 	query := `
-		INSERT INTO event_skill_requirements (id, event_id, skill_name, proficiency, required, created_at)
-		VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW())
+		INSERT INTO event_skill_requirements (id, event_id, skill_name, skill_id, proficiency, required, created_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW())
 		RETURNING id, created_at`
-	return s.db.QueryRowContext(ctx, query, req.EventID, req.Skill, req.Proficiency, req.Required).
+	return s.db.QueryRowContext(ctx, query, req.EventID, req.Skill, req.SkillID, req.Proficiency, req.Required).
 		Scan(&req.ID, &req.CreatedAt)
 }
 
 func (s *EventStorePG) GetSkillRequirements(ctx context.Context, eventID string) ([]*event.SkillRequirement, error) {
 	query := `
-		SELECT id, event_id, skill_name, proficiency, required, created_at
+		SELECT id, event_id, skill_name, skill_id, proficiency, required, created_at
 		FROM event_skill_requirements
 		WHERE event_id = $1
 		ORDER BY created_at`
@@ -706,7 +777,7 @@ func (s *EventStorePG) GetSkillRequirements(ctx context.Context, eventID string)
 	var requirements []*event.SkillRequirement
 	for rows.Next() {
 		req := &event.SkillRequirement{}
-		err := rows.Scan(&req.ID, &req.EventID, &req.Skill, &req.Proficiency, &req.Required, &req.CreatedAt)
+		err := rows.Scan(&req.ID, &req.EventID, &req.Skill, &req.SkillID, &req.Proficiency, &req.Required, &req.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -715,31 +786,28 @@ func (s *EventStorePG) GetSkillRequirements(ctx context.Context, eventID string)
 	return requirements, nil
 }
 
+// UpdateSkillRequirements replaces the event's skill requirements, joining
+// the transaction ctx carries if any
 func (s *EventStorePG) UpdateSkillRequirements(ctx context.Context, eventID string, requirements []*event.SkillRequirement) error {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = tx.Rollback() }()
-
-	// Delete existing
-	_, err = tx.ExecContext(ctx, "DELETE FROM event_skill_requirements WHERE event_id = $1", eventID)
-	if err != nil {
-		return err
-	}
-
-	// Insert new
-	for _, req := range requirements {
-		query := `
-			INSERT INTO event_skill_requirements (id, event_id, skill_name, proficiency, required, created_at)
-			VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW())`
-		_, err = tx.ExecContext(ctx, query, eventID, req.Skill, req.Proficiency, req.Required)
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		// Delete existing
+		_, err := tx.ExecContext(ctx, "DELETE FROM event_skill_requirements WHERE event_id = $1", eventID)
 		if err != nil {
 			return err
 		}
-	}
 
-	return tx.Commit()
+		// Insert new
+		for _, req := range requirements {
+			query := `
+				INSERT INTO event_skill_requirements (id, event_id, skill_name, skill_id, proficiency, required, created_at)
+				VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW())`
+			_, err = tx.ExecContext(ctx, query, eventID, req.Skill, req.SkillID, req.Proficiency, req.Required)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (s *EventStorePG) DeleteSkillRequirements(ctx context.Context, eventID string) error {
@@ -795,31 +863,28 @@ func (s *EventStorePG) GetTrainingRequirements(ctx context.Context, eventID stri
 	return requirements, nil
 }
 
+// UpdateTrainingRequirements replaces the event's training requirements,
+// joining the transaction ctx carries if any
 func (s *EventStorePG) UpdateTrainingRequirements(ctx context.Context, eventID string, requirements []*event.TrainingRequirement) error {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = tx.Rollback() }()
-
-	// Delete existing
-	_, err = tx.ExecContext(ctx, "DELETE FROM event_training_requirements WHERE event_id = $1", eventID)
-	if err != nil {
-		return err
-	}
-
-	// Insert new
-	for _, req := range requirements {
-		query := `
-			INSERT INTO event_training_requirements (id, event_id, name, description, required, provided_by_organizer, created_at)
-			VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW())`
-		_, err = tx.ExecContext(ctx, query, eventID, req.Name, req.Description, req.Required, req.ProvidedByOrganizer)
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		// Delete existing
+		_, err := tx.ExecContext(ctx, "DELETE FROM event_training_requirements WHERE event_id = $1", eventID)
 		if err != nil {
 			return err
 		}
-	}
 
-	return tx.Commit()
+		// Insert new
+		for _, req := range requirements {
+			query := `
+				INSERT INTO event_training_requirements (id, event_id, name, description, required, provided_by_organizer, created_at)
+				VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW())`
+			_, err = tx.ExecContext(ctx, query, eventID, req.Name, req.Description, req.Required, req.ProvidedByOrganizer)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (s *EventStorePG) DeleteTrainingRequirements(ctx context.Context, eventID string) error {
@@ -877,31 +942,28 @@ func (s *EventStorePG) GetInterestRequirements(ctx context.Context, eventID stri
 	return interestIDs, nil
 }
 
+// UpdateInterestRequirements replaces the event's interest requirements,
+// joining the transaction ctx carries if any
 func (s *EventStorePG) UpdateInterestRequirements(ctx context.Context, eventID string, interestIDs []string) error {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = tx.Rollback() }()
-
-	// Delete existing
-	_, err = tx.ExecContext(ctx, "DELETE FROM event_interest_requirements WHERE event_id = $1", eventID)
-	if err != nil {
-		return err
-	}
-
-	// Insert new
-	if len(interestIDs) > 0 {
-		query := `
-			INSERT INTO event_interest_requirements (event_id, interest_id, created_at)
-			VALUES ($1, unnest($2::uuid[]), NOW())`
-		_, err = tx.ExecContext(ctx, query, eventID, pq.Array(interestIDs))
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		// Delete existing
+		_, err := tx.ExecContext(ctx, "DELETE FROM event_interest_requirements WHERE event_id = $1", eventID)
 		if err != nil {
 			return err
 		}
-	}
 
-	return tx.Commit()
+		// Insert new
+		if len(interestIDs) > 0 {
+			query := `
+				INSERT INTO event_interest_requirements (event_id, interest_id, created_at)
+				VALUES ($1, unnest($2::uuid[]), NOW())`
+			_, err = tx.ExecContext(ctx, query, eventID, pq.Array(interestIDs))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (s *EventStorePG) RemoveInterestRequirements(ctx context.Context, eventID string) error {
@@ -1033,25 +1095,149 @@ func (s *EventStorePG) DeleteAnnouncement(ctx context.Context, announcementID st
 	return err
 }
 
+// Event staff methods
+const eventStaffSelectColumns = `id, event_id, user_id, email, role, status, invited_by, invited_at, accepted_at, removed_at, updated_at`
+
+func (s *EventStorePG) CreateStaffInvitation(ctx context.Context, staff *event.EventStaff) error {
+	query := `
+		INSERT INTO event_staff (id, event_id, user_id, email, role, status, invited_by, invited_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, invited_at, updated_at`
+	return s.db.QueryRowContext(ctx, query, staff.EventID, staff.UserID, staff.Email, staff.Role, staff.Status, staff.InvitedBy).
+		Scan(&staff.ID, &staff.InvitedAt, &staff.UpdatedAt)
+}
+
+func (s *EventStorePG) GetStaffByID(ctx context.Context, staffID string) (*event.EventStaff, error) {
+	query := `SELECT ` + eventStaffSelectColumns + ` FROM event_staff WHERE id = $1`
+	staff, err := scanEventStaff(s.db.QueryRowContext(ctx, query, staffID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("staff member not found: %s", staffID)
+	}
+	return staff, err
+}
+
+func (s *EventStorePG) GetEventStaff(ctx context.Context, eventID string) ([]*event.EventStaff, error) {
+	query := `
+		SELECT ` + eventStaffSelectColumns + `
+		FROM event_staff
+		WHERE event_id = $1 AND status <> 'REMOVED'
+		ORDER BY invited_at`
+	return s.queryEventStaff(ctx, query, eventID)
+}
+
+func (s *EventStorePG) GetStaffMember(ctx context.Context, eventID, userID string) (*event.EventStaff, error) {
+	query := `
+		SELECT ` + eventStaffSelectColumns + `
+		FROM event_staff
+		WHERE event_id = $1 AND user_id = $2 AND status = 'ACTIVE'`
+	staff, err := scanEventStaff(s.db.QueryRowContext(ctx, query, eventID, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return staff, err
+}
+
+func (s *EventStorePG) GetPendingStaffInvitations(ctx context.Context, email string) ([]*event.EventStaff, error) {
+	query := `
+		SELECT ` + eventStaffSelectColumns + `
+		FROM event_staff
+		WHERE lower(email) = lower($1) AND status = 'INVITED'
+		ORDER BY invited_at DESC`
+	return s.queryEventStaff(ctx, query, email)
+}
+
+func (s *EventStorePG) UpdateStaff(ctx context.Context, staff *event.EventStaff) error {
+	query := `
+		UPDATE event_staff
+		SET user_id = $1, role = $2, status = $3, accepted_at = $4, removed_at = $5, updated_at = NOW()
+		WHERE id = $6
+		RETURNING updated_at`
+	return s.db.QueryRowContext(ctx, query, staff.UserID, staff.Role, staff.Status, staff.AcceptedAt, staff.RemovedAt, staff.ID).
+		Scan(&staff.UpdatedAt)
+}
+
+func (s *EventStorePG) queryEventStaff(ctx context.Context, query string, args ...interface{}) ([]*event.EventStaff, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var staff []*event.EventStaff
+	for rows.Next() {
+		member, err := scanEventStaff(rows)
+		if err != nil {
+			return nil, err
+		}
+		staff = append(staff, member)
+	}
+
+	return staff, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanEventStaff(row rowScanner) (*event.EventStaff, error) {
+	staff := &event.EventStaff{}
+	var userID sql.NullString
+	var acceptedAt, removedAt sql.NullTime
+	err := row.Scan(&staff.ID, &staff.EventID, &userID, &staff.Email, &staff.Role, &staff.Status, &staff.InvitedBy,
+		&staff.InvitedAt, &acceptedAt, &removedAt, &staff.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if userID.Valid {
+		staff.UserID = &userID.String
+	}
+	if acceptedAt.Valid {
+		staff.AcceptedAt = &acceptedAt.Time
+	}
+	if removedAt.Valid {
+		staff.RemovedAt = &removedAt.Time
+	}
+	return staff, nil
+}
+
+// GetOrganizationRole returns the user's role in an organization, or "" if they are not a member
+func (s *EventStorePG) GetOrganizationRole(ctx context.Context, organizationID, userID string) (string, error) {
+	var role string
+	err := s.db.QueryRowContext(ctx,
+		"SELECT role FROM organization_members WHERE organization_id = $1 AND user_id = $2",
+		organizationID, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return role, err
+}
+
 // Event update/audit log methods
 func (s *EventStorePG) LogUpdate(ctx context.Context, update *event.EventUpdate) error {
 	query := `
 		INSERT INTO event_updates (id, event_id, updated_by, field_name, old_value, new_value, update_type, created_at)
 		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, NOW())
 		RETURNING id, created_at`
-	return s.db.QueryRowContext(ctx, query, update.EventID, update.UpdatedBy, update.FieldName, update.OldValue, update.NewValue, update.UpdateType).
+	return conn(ctx, s.db).QueryRowContext(ctx, query, update.EventID, update.UpdatedBy, update.FieldName, update.OldValue, update.NewValue, update.UpdateType).
 		Scan(&update.ID, &update.CreatedAt)
 }
 
-func (s *EventStorePG) GetUpdateHistory(ctx context.Context, eventID string, limit, offset int) ([]*event.EventUpdate, error) {
+// GetUpdateHistory pages newest first. after is the ID of the last update on
+// the previous page; an unknown ID yields an empty page.
+func (s *EventStorePG) GetUpdateHistory(ctx context.Context, eventID string, limit int, after string) ([]*event.EventUpdate, error) {
 	query := `
 		SELECT id, event_id, updated_by, field_name, old_value, new_value, update_type, created_at
 		FROM event_updates
 		WHERE event_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3`
+			AND ($3::text = '' OR (created_at, id) < (
+				SELECT created_at, id FROM event_updates
+				WHERE id = NULLIF($3::text, '')::uuid AND event_id = $1
+			))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2`
 
-	rows, err := s.db.QueryContext(ctx, query, eventID, limit, offset)
+	rows, err := s.db.QueryContext(ctx, query, eventID, limit, after)
 	if err != nil {
 		return nil, err
 	}
@@ -1067,7 +1253,7 @@ func (s *EventStorePG) GetUpdateHistory(ctx context.Context, eventID string, lim
 		updates = append(updates, upd)
 	}
 
-	return updates, nil
+	return updates, rows.Err()
 }
 
 // Recurring event methods
@@ -1149,9 +1335,11 @@ func (s *EventStorePG) GetUpcomingInstances(ctx context.Context, parentEventID s
 // Capacity management methods
 func (s *EventStorePG) GetCurrentCapacity(ctx context.Context, eventID string) (int, error) {
 	query := `
-		SELECT COUNT(*)
-		FROM registrations
-		WHERE event_id = $1 AND status = 'CONFIRMED'`
+		SELECT
+			(SELECT COUNT(*) FROM registrations
+				WHERE event_id = $1 AND status = 'CONFIRMED' AND team_registration_id IS NULL) +
+			(SELECT COALESCE(SUM(seats), 0) FROM team_registrations
+				WHERE event_id = $1 AND status = 'CONFIRMED')`
 
 	var count int
 	err := s.db.QueryRowContext(ctx, query, eventID).Scan(&count)
@@ -1161,7 +1349,10 @@ func (s *EventStorePG) GetCurrentCapacity(ctx context.Context, eventID string) (
 func (s *EventStorePG) IsAtCapacity(ctx context.Context, eventID string) (bool, error) {
 	query := `
 		SELECT 
-			(SELECT COUNT(*) FROM registrations WHERE event_id = $1 AND status = 'CONFIRMED') >= 
+			(SELECT COUNT(*) FROM registrations
+				WHERE event_id = $1 AND status = 'CONFIRMED' AND team_registration_id IS NULL) +
+			(SELECT COALESCE(SUM(seats), 0) FROM team_registrations
+				WHERE event_id = $1 AND status = 'CONFIRMED') >= 
 			(SELECT max_capacity FROM events WHERE id = $1)`
 
 	var atCapacity bool
@@ -1169,6 +1360,108 @@ func (s *EventStorePG) IsAtCapacity(ctx context.Context, eventID string) (bool,
 	return atCapacity, err
 }
 
+func (s *EventStorePG) GetShifts(ctx context.Context, eventID string) ([]*event.EventShift, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, event_id, name, role, description, start_time, end_time, capacity,
+			waitlist_enabled, skills, position, created_at, updated_at
+		FROM event_shifts
+		WHERE event_id = $1
+		ORDER BY position`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shifts []*event.EventShift
+	for rows.Next() {
+		shift := &event.EventShift{}
+		var skills []byte
+		if err := rows.Scan(&shift.ID, &shift.EventID, &shift.Name, &shift.Role, &shift.Description,
+			&shift.StartTime, &shift.EndTime, &shift.Capacity, &shift.WaitlistEnabled, &skills,
+			&shift.Position, &shift.CreatedAt, &shift.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(skills, &shift.Skills); err != nil {
+			return nil, fmt.Errorf("decode shift skills: %w", err)
+		}
+		shifts = append(shifts, shift)
+	}
+	return shifts, rows.Err()
+}
+
+func (s *EventStorePG) ReplaceShifts(ctx context.Context, eventID string, shifts []*event.EventShift) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	keep := make([]string, 0, len(shifts))
+	for _, shift := range shifts {
+		skills := shift.Skills
+		if skills == nil {
+			skills = []event.SkillRequirement{}
+		}
+		skillsJSON, err := json.Marshal(skills)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO event_shifts (
+				id, event_id, name, role, description, start_time, end_time, capacity,
+				waitlist_enabled, skills, position, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			ON CONFLICT (id) DO UPDATE SET
+				name = EXCLUDED.name, role = EXCLUDED.role, description = EXCLUDED.description,
+				start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time, capacity = EXCLUDED.capacity,
+				waitlist_enabled = EXCLUDED.waitlist_enabled, skills = EXCLUDED.skills,
+				position = EXCLUDED.position, updated_at = EXCLUDED.updated_at
+			WHERE event_shifts.event_id = EXCLUDED.event_id`,
+			shift.ID, eventID, shift.Name, shift.Role, shift.Description, shift.StartTime, shift.EndTime,
+			shift.Capacity, shift.WaitlistEnabled, skillsJSON, shift.Position, shift.CreatedAt, shift.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		keep = append(keep, shift.ID)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM event_shifts
+		WHERE event_id = $1 AND NOT (id::text = ANY($2))`,
+		eventID, pq.Array(keep))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *EventStorePG) GetShiftSignups(ctx context.Context, eventID string) (map[string]event.ShiftSignups, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT rs.shift_id,
+			COUNT(*) FILTER (WHERE rs.status = 'CONFIRMED'),
+			COUNT(*) FILTER (WHERE rs.status = 'WAITLISTED')
+		FROM registration_shifts rs
+		JOIN event_shifts es ON es.id = rs.shift_id
+		WHERE es.event_id = $1
+		GROUP BY rs.shift_id`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]event.ShiftSignups{}
+	for rows.Next() {
+		var shiftID string
+		var c event.ShiftSignups
+		if err := rows.Scan(&shiftID, &c.Confirmed, &c.Waitlisted); err != nil {
+			return nil, err
+		}
+		counts[shiftID] = c
+	}
+	return counts, rows.Err()
+}
+
 // Utility methods
 func (s *EventStorePG) EventExists(ctx context.Context, id string) (bool, error) {
 	var exists bool
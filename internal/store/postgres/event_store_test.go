@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+func createTestEvent(t *testing.T, db *sql.DB, store *EventStorePG) *event.Event {
+	t.Helper()
+	organizerID := uuid.New().String()
+	now := time.Now().UTC()
+	_, err := db.Exec(`
+		INSERT INTO users (id, name, email, password_hash, created_at, updated_at, is_verified)
+		VALUES ($1, 'Organizer', $2, 'hashed_password', $3, $3, true)`,
+		organizerID, organizerID+"@example.com", now)
+	require.NoError(t, err)
+
+	start := now.Add(72 * time.Hour).Truncate(time.Second)
+	id := uuid.New().String()
+	slug := "park-cleanup-" + id
+	evt := &event.Event{
+		ID:             id,
+		Title:          "Park Cleanup",
+		Description:    "Clearing litter from the park",
+		OrganizerID:    organizerID,
+		Status:         event.EventStatusDraft,
+		StartTime:      start,
+		EndTime:        start.Add(3 * time.Hour),
+		Location:       event.EventLocation{Name: "Central Park", Address: "1 Park Road", City: "Springfield", Country: "US"},
+		Capacity:       event.EventCapacity{Minimum: 1, Maximum: 10},
+		Category:       "environment",
+		TimeCommitment: "one-time",
+		Requirements: event.EventRequirements{
+			Skills: []event.SkillRequirement{{Skill: "Juggling", Proficiency: event.SkillProficiencyBeginner}},
+		},
+		RegistrationSettings: event.RegistrationSettings{ClosesAt: start.Add(-24 * time.Hour)},
+		Slug:                 &slug,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	require.NoError(t, store.Create(context.Background(), evt))
+	return evt
+}
+
+func TestEventStorePG_UpdateRequirements(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewEventStore(db)
+	ctx := context.Background()
+	evt := createTestEvent(t, db, store)
+
+	evt.Title = "Park Cleanup Day"
+	skills := []*event.SkillRequirement{{Skill: "Gardening", Proficiency: event.SkillProficiencyIntermediate, Required: true}}
+	training := []*event.TrainingRequirement{{Name: "Safety briefing", Required: true, ProvidedByOrganizer: true}}
+	err := store.Transaction(ctx, func(ctx context.Context) error {
+		if err := store.Update(ctx, evt); err != nil {
+			return err
+		}
+		if err := store.UpdateSkillRequirements(ctx, evt.ID, skills); err != nil {
+			return err
+		}
+		return store.UpdateTrainingRequirements(ctx, evt.ID, training)
+	})
+	require.NoError(t, err)
+
+	saved, err := store.GetByID(ctx, evt.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Park Cleanup Day", saved.Title)
+	require.Len(t, saved.Requirements.Skills, 1)
+	assert.Equal(t, "Gardening", saved.Requirements.Skills[0].Skill)
+	assert.True(t, saved.Requirements.Skills[0].Required)
+	require.Len(t, saved.Requirements.Training, 1)
+	assert.Equal(t, "Safety briefing", saved.Requirements.Training[0].Name)
+
+	t.Run("a failed transaction keeps the old requirements", func(t *testing.T) {
+		err := store.Transaction(ctx, func(ctx context.Context) error {
+			if err := store.UpdateSkillRequirements(ctx, evt.ID, nil); err != nil {
+				return err
+			}
+			return sql.ErrTxDone
+		})
+		require.Error(t, err)
+
+		saved, err := store.GetByID(ctx, evt.ID)
+		require.NoError(t, err)
+		assert.Len(t, saved.Requirements.Skills, 1)
+	})
+
+	t.Run("catalogue skills come back with their skill ID", func(t *testing.T) {
+		var firstAidID string
+		require.NoError(t, db.QueryRow("SELECT id FROM skills WHERE slug = 'first-aid'").Scan(&firstAidID))
+
+		skills := []*event.SkillRequirement{{Skill: "First Aid", SkillID: &firstAidID, Proficiency: event.SkillProficiencyBeginner, Required: true}}
+		require.NoError(t, store.UpdateSkillRequirements(ctx, evt.ID, skills))
+
+		saved, err := store.GetByID(ctx, evt.ID)
+		require.NoError(t, err)
+		require.Len(t, saved.Requirements.Skills, 1)
+		require.NotNil(t, saved.Requirements.Skills[0].SkillID)
+		assert.Equal(t, firstAidID, *saved.Requirements.Skills[0].SkillID)
+	})
+}
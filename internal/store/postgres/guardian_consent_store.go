@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volunteersync/backend/internal/core/registration"
+)
+
+const guardianConsentSelect = `
+	SELECT id, registration_id, user_id, event_id, requested_email, token_hash, status,
+		requested_at, expires_at, responded_at,
+		COALESCE(guardian_name, ''), COALESCE(guardian_email, ''), COALESCE(guardian_phone, ''),
+		COALESCE(relationship, ''), COALESCE(ip_address, ''), COALESCE(user_agent, '')
+	FROM guardian_consents
+`
+
+func (s *RegistrationStorePG) CreateGuardianConsent(ctx context.Context, c *registration.GuardianConsent) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		// Only the newest link for a registration can be answered
+		if c.RegistrationID != nil {
+			_, err := tx.ExecContext(ctx, `
+				UPDATE guardian_consents SET status = $2
+				WHERE registration_id = $1 AND status = $3`,
+				*c.RegistrationID, registration.ConsentSuperseded, registration.ConsentPending)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO guardian_consents (
+				id, registration_id, user_id, event_id, requested_email, token_hash, status, requested_at, expires_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			c.ID, c.RegistrationID, c.UserID, c.EventID, c.RequestedEmail, c.TokenHash, c.Status, c.RequestedAt, c.ExpiresAt)
+		return err
+	})
+}
+
+func (s *RegistrationStorePG) GetGuardianConsentByTokenHash(ctx context.Context, tokenHash string) (*registration.GuardianConsent, error) {
+	c, err := scanGuardianConsent(s.db.QueryRowContext(ctx, guardianConsentSelect+` WHERE token_hash = $1`, tokenHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, registration.ErrGuardianConsentNotFound
+	}
+	return c, err
+}
+
+func (s *RegistrationStorePG) GetGuardianConsentsByRegistrationID(ctx context.Context, registrationID string) ([]*registration.GuardianConsent, error) {
+	return s.queryGuardianConsents(ctx, guardianConsentSelect+` WHERE registration_id = $1 ORDER BY requested_at DESC`, registrationID)
+}
+
+func (s *RegistrationStorePG) GetEventGuardianConsents(ctx context.Context, eventID string) ([]*registration.GuardianConsent, error) {
+	return s.queryGuardianConsents(ctx, guardianConsentSelect+` WHERE event_id = $1 ORDER BY requested_at DESC`, eventID)
+}
+
+func (s *RegistrationStorePG) RecordGuardianConsent(ctx context.Context, c *registration.GuardianConsent, r *registration.Registration) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE guardian_consents
+		SET status = $2, responded_at = $3, guardian_name = $4, guardian_email = $5, guardian_phone = $6,
+			relationship = $7, ip_address = $8, user_agent = $9
+		WHERE id = $1 AND status = $10`,
+		c.ID, c.Status, c.RespondedAt, c.GuardianName, c.GuardianEmail, c.GuardianPhone,
+		c.Relationship, c.IPAddress, c.UserAgent, registration.ConsentPending)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return registration.ErrGuardianConsentClosed
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE registrations
+		SET status = $2, approval_notes = $3, confirmed_at = $4, waitlist_position = $5, updated_at = NOW()
+		WHERE id = $1`,
+		r.ID, r.Status, r.ApprovalNotes, r.ConfirmedAt, r.WaitlistPosition)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *RegistrationStorePG) queryGuardianConsents(ctx context.Context, query string, args ...any) ([]*registration.GuardianConsent, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var consents []*registration.GuardianConsent
+	for rows.Next() {
+		c, err := scanGuardianConsent(rows)
+		if err != nil {
+			return nil, err
+		}
+		consents = append(consents, c)
+	}
+	return consents, rows.Err()
+}
+
+func scanGuardianConsent(row rowScanner) (*registration.GuardianConsent, error) {
+	c := &registration.GuardianConsent{}
+	err := row.Scan(
+		&c.ID, &c.RegistrationID, &c.UserID, &c.EventID, &c.RequestedEmail, &c.TokenHash, &c.Status,
+		&c.RequestedAt, &c.ExpiresAt, &c.RespondedAt,
+		&c.GuardianName, &c.GuardianEmail, &c.GuardianPhone,
+		&c.Relationship, &c.IPAddress, &c.UserAgent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
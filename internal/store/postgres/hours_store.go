@@ -0,0 +1,259 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/volunteersync/backend/internal/core/hours"
+)
+
+const hoursSelectColumns = `
+	id, user_id, event_id, registration_id, organization_id, adjusts_entry_id, source, status, hours,
+	category, description, reason, activity_date, created_by, reviewed_by, reviewed_at, review_notes, created_at
+`
+
+// HoursStorePG implements the hours.Repository interface using PostgreSQL
+type HoursStorePG struct {
+	db *sql.DB
+}
+
+// NewHoursStore creates a new PostgreSQL hours ledger store
+func NewHoursStore(db *sql.DB) *HoursStorePG {
+	return &HoursStorePG{db: db}
+}
+
+// CreateEntry appends a ledger entry. Attendance credits are unique per
+// registration; a repeat returns hours.ErrAlreadyCredited.
+func (s *HoursStorePG) CreateEntry(ctx context.Context, e *hours.Entry) error {
+	res, err := conn(ctx, s.db).ExecContext(ctx, `
+		INSERT INTO volunteer_hours (
+			id, user_id, event_id, registration_id, organization_id, adjusts_entry_id, source, status, hours,
+			category, description, reason, activity_date, created_by, reviewed_by, reviewed_at, review_notes, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		ON CONFLICT (registration_id) WHERE source = 'ATTENDANCE' DO NOTHING`,
+		e.ID, e.UserID, e.EventID, e.RegistrationID, e.OrganizationID, e.AdjustsEntryID, string(e.Source), string(e.Status), e.Hours,
+		e.Category, e.Description, e.Reason, e.ActivityDate, e.CreatedBy, e.ReviewedBy, e.ReviewedAt, e.ReviewNotes, e.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return hours.ErrAlreadyCredited
+	}
+	return nil
+}
+
+// GetEntry returns a single entry or hours.ErrEntryNotFound
+func (s *HoursStorePG) GetEntry(ctx context.Context, id string) (*hours.Entry, error) {
+	e, err := scanHoursEntry(s.db.QueryRowContext(ctx, "SELECT "+hoursSelectColumns+" FROM volunteer_hours WHERE id = $1", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, hours.ErrEntryNotFound
+	}
+	return e, err
+}
+
+// ListUserEntries returns the user's entries matching filter, newest activity first
+func (s *HoursStorePG) ListUserEntries(ctx context.Context, userID string, filter hours.EntryFilter) ([]*hours.Entry, error) {
+	where, args := hoursRangeClause(userID, filter.Range)
+	if filter.EventID != nil {
+		args = append(args, *filter.EventID)
+		where = append(where, fmt.Sprintf("event_id = $%d", len(args)))
+	}
+	if filter.RegistrationID != nil {
+		args = append(args, *filter.RegistrationID)
+		where = append(where, fmt.Sprintf("registration_id = $%d", len(args)))
+	}
+	if filter.Status != nil {
+		args = append(args, string(*filter.Status))
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	query := "SELECT " + hoursSelectColumns + " FROM volunteer_hours WHERE " + strings.Join(where, " AND ") +
+		" ORDER BY activity_date DESC, created_at DESC"
+	return s.queryEntries(ctx, query, args...)
+}
+
+// ListPendingByOrganization returns submissions awaiting review, oldest first
+func (s *HoursStorePG) ListPendingByOrganization(ctx context.Context, organizationID string) ([]*hours.Entry, error) {
+	query := "SELECT " + hoursSelectColumns + " FROM volunteer_hours WHERE organization_id = $1 AND status = 'PENDING' ORDER BY created_at"
+	return s.queryEntries(ctx, query, organizationID)
+}
+
+// UpdateReview stores the outcome of reviewing a pending entry
+func (s *HoursStorePG) UpdateReview(ctx context.Context, e *hours.Entry) error {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE volunteer_hours SET status = $2, reviewed_by = $3, reviewed_at = $4, review_notes = $5
+		WHERE id = $1 AND status = 'PENDING'`,
+		e.ID, string(e.Status), e.ReviewedBy, e.ReviewedAt, e.ReviewNotes)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return hours.ErrNotPending
+	}
+	return nil
+}
+
+// Summarize totals the user's approved hours over the range, overall and
+// broken down by event and category
+func (s *HoursStorePG) Summarize(ctx context.Context, userID string, rng hours.DateRange) (*hours.Summary, error) {
+	where, args := hoursRangeClause(userID, rng)
+	clause := strings.Join(where, " AND ")
+
+	summary := &hours.Summary{ByEvent: []hours.EventHours{}, ByCategory: []hours.CategoryHours{}}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT
+			COALESCE(SUM(hours) FILTER (WHERE status = 'APPROVED'), 0),
+			COALESCE(SUM(hours) FILTER (WHERE status = 'PENDING'), 0),
+			COUNT(DISTINCT event_id) FILTER (WHERE status = 'APPROVED' AND source = 'ATTENDANCE')
+		FROM volunteer_hours WHERE `+clause, args...).
+		Scan(&summary.TotalHours, &summary.PendingHours, &summary.EventsParticipated)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT event_id, SUM(hours) FROM volunteer_hours
+		WHERE `+clause+` AND status = 'APPROVED' AND event_id IS NOT NULL
+		GROUP BY event_id ORDER BY SUM(hours) DESC, event_id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var eh hours.EventHours
+		if err := rows.Scan(&eh.EventID, &eh.Hours); err != nil {
+			return nil, err
+		}
+		summary.ByEvent = append(summary.ByEvent, eh)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	catRows, err := s.db.QueryContext(ctx, `
+		SELECT category, SUM(hours) FROM volunteer_hours
+		WHERE `+clause+` AND status = 'APPROVED'
+		GROUP BY category ORDER BY SUM(hours) DESC, category`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer catRows.Close()
+	for catRows.Next() {
+		var ch hours.CategoryHours
+		if err := catRows.Scan(&ch.Category, &ch.Hours); err != nil {
+			return nil, err
+		}
+		summary.ByCategory = append(summary.ByCategory, ch)
+	}
+	return summary, catRows.Err()
+}
+
+func (s *HoursStorePG) queryEntries(ctx context.Context, query string, args ...any) ([]*hours.Entry, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*hours.Entry
+	for rows.Next() {
+		e, err := scanHoursEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// hoursRangeClause starts a WHERE clause for one user's entries within rng
+func hoursRangeClause(userID string, rng hours.DateRange) ([]string, []any) {
+	where := []string{"user_id = $1"}
+	args := []any{userID}
+	if rng.Start != nil {
+		args = append(args, *rng.Start)
+		where = append(where, fmt.Sprintf("activity_date >= $%d", len(args)))
+	}
+	if rng.End != nil {
+		args = append(args, *rng.End)
+		where = append(where, fmt.Sprintf("activity_date < $%d", len(args)))
+	}
+	return where, args
+}
+
+func scanHoursEntry(row rowScanner) (*hours.Entry, error) {
+	e := &hours.Entry{}
+	var source, status string
+	if err := row.Scan(&e.ID, &e.UserID, &e.EventID, &e.RegistrationID, &e.OrganizationID, &e.AdjustsEntryID,
+		&source, &status, &e.Hours, &e.Category, &e.Description, &e.Reason, &e.ActivityDate,
+		&e.CreatedBy, &e.ReviewedBy, &e.ReviewedAt, &e.ReviewNotes, &e.CreatedAt); err != nil {
+		return nil, err
+	}
+	e.Source = hours.Source(source)
+	e.Status = hours.Status(status)
+	return e, nil
+}
+
+const certificateSelectColumns = `
+	id, code, user_id, volunteer_name, scope, event_id, range_start, range_end, total_hours, lines, issued_at
+`
+
+// CreateCertificate stores an issued certificate snapshot
+func (s *HoursStorePG) CreateCertificate(ctx context.Context, c *hours.Certificate) error {
+	lines, err := json.Marshal(c.Lines)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO hours_certificates (
+			id, code, user_id, volunteer_name, scope, event_id, range_start, range_end, total_hours, lines, issued_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		c.ID, c.Code, c.UserID, c.VolunteerName, string(c.Scope), c.EventID, c.RangeStart, c.RangeEnd, c.TotalHours, lines, c.IssuedAt)
+	return err
+}
+
+// GetCertificateByCode returns a certificate or hours.ErrCertificateNotFound
+func (s *HoursStorePG) GetCertificateByCode(ctx context.Context, code string) (*hours.Certificate, error) {
+	c, err := scanCertificate(s.db.QueryRowContext(ctx, "SELECT "+certificateSelectColumns+" FROM hours_certificates WHERE code = $1", code))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, hours.ErrCertificateNotFound
+	}
+	return c, err
+}
+
+// ListCertificates returns the user's certificates, newest first
+func (s *HoursStorePG) ListCertificates(ctx context.Context, userID string) ([]*hours.Certificate, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+certificateSelectColumns+" FROM hours_certificates WHERE user_id = $1 ORDER BY issued_at DESC", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var certs []*hours.Certificate
+	for rows.Next() {
+		c, err := scanCertificate(rows)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, c)
+	}
+	return certs, rows.Err()
+}
+
+func scanCertificate(row rowScanner) (*hours.Certificate, error) {
+	c := &hours.Certificate{}
+	var scope string
+	var lines []byte
+	if err := row.Scan(&c.ID, &c.Code, &c.UserID, &c.VolunteerName, &scope, &c.EventID,
+		&c.RangeStart, &c.RangeEnd, &c.TotalHours, &lines, &c.IssuedAt); err != nil {
+		return nil, err
+	}
+	c.Scope = hours.CertificateScope(scope)
+	if err := json.Unmarshal(lines, &c.Lines); err != nil {
+		return nil, fmt.Errorf("decode certificate lines: %w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volunteersync/backend/internal/core/organization"
+)
+
+// OrganizationStorePG implements the organization.Repository interface using PostgreSQL
+type OrganizationStorePG struct {
+	db *sql.DB
+}
+
+const organizationSelectColumns = `
+	o.id, o.name, o.slug, o.description, o.website, o.contact_email, o.logo_url,
+	o.verification_status, o.verified_at, o.verified_by, o.created_at, o.updated_at
+`
+
+// NewOrganizationStore creates a new PostgreSQL organization store
+func NewOrganizationStore(db *sql.DB) *OrganizationStorePG {
+	return &OrganizationStorePG{db: db}
+}
+
+// Create inserts the organization and its first owner in one transaction
+func (s *OrganizationStorePG) Create(ctx context.Context, org *organization.Organization, ownerID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organizations (
+			id, name, slug, description, website, contact_email, logo_url,
+			verification_status, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		org.ID, org.Name, org.Slug, org.Description, org.Website, org.ContactEmail, org.LogoURL,
+		org.VerificationStatus, org.CreatedAt, org.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, $4)`,
+		org.ID, ownerID, organization.RoleOwner, org.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID retrieves an organization by its ID
+func (s *OrganizationStorePG) GetByID(ctx context.Context, id string) (*organization.Organization, error) {
+	query := `SELECT ` + organizationSelectColumns + ` FROM organizations o WHERE o.id = $1`
+	org, err := scanOrganization(s.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, organization.ErrOrganizationNotFound
+	}
+	return org, err
+}
+
+// Update saves profile and verification changes
+func (s *OrganizationStorePG) Update(ctx context.Context, org *organization.Organization) error {
+	query := `
+		UPDATE organizations SET
+			name = $2, description = $3, website = $4, contact_email = $5, logo_url = $6,
+			verification_status = $7, verified_at = $8, verified_by = $9, updated_at = NOW()
+		WHERE id = $1`
+	res, err := s.db.ExecContext(ctx, query, org.ID, org.Name, org.Description, org.Website,
+		org.ContactEmail, org.LogoURL, org.VerificationStatus, org.VerifiedAt, org.VerifiedBy)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return organization.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// SlugExists checks whether an organization slug is already taken
+func (s *OrganizationStorePG) SlugExists(ctx context.Context, slug string) (bool, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM organizations WHERE slug = $1)", slug).Scan(&exists)
+	return exists, err
+}
+
+// GetByMember lists the organizations a user belongs to
+func (s *OrganizationStorePG) GetByMember(ctx context.Context, userID string) ([]*organization.Organization, error) {
+	query := `
+		SELECT ` + organizationSelectColumns + `
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.user_id = $1
+		ORDER BY o.name`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orgs []*organization.Organization
+	for rows.Next() {
+		org, err := scanOrganization(rows)
+		if err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, org)
+	}
+
+	return orgs, rows.Err()
+}
+
+// AddMember inserts a membership
+func (s *OrganizationStorePG) AddMember(ctx context.Context, m *organization.Member) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, $4)`,
+		m.OrganizationID, m.UserID, m.Role, m.JoinedAt)
+	return err
+}
+
+// GetMember returns a single membership or ErrMemberNotFound
+func (s *OrganizationStorePG) GetMember(ctx context.Context, organizationID, userID string) (*organization.Member, error) {
+	m := &organization.Member{}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT organization_id, user_id, role, joined_at
+		FROM organization_members
+		WHERE organization_id = $1 AND user_id = $2`,
+		organizationID, userID).Scan(&m.OrganizationID, &m.UserID, &m.Role, &m.JoinedAt)
+	if err == sql.ErrNoRows {
+		return nil, organization.ErrMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetMembers lists an organization's members, owners first
+func (s *OrganizationStorePG) GetMembers(ctx context.Context, organizationID string) ([]*organization.Member, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT organization_id, user_id, role, joined_at
+		FROM organization_members
+		WHERE organization_id = $1
+		ORDER BY CASE role WHEN 'OWNER' THEN 0 WHEN 'ADMIN' THEN 1 ELSE 2 END, joined_at`,
+		organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*organization.Member
+	for rows.Next() {
+		m := &organization.Member{}
+		if err := rows.Scan(&m.OrganizationID, &m.UserID, &m.Role, &m.JoinedAt); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return members, rows.Err()
+}
+
+// UpdateMemberRole changes a member's role
+func (s *OrganizationStorePG) UpdateMemberRole(ctx context.Context, organizationID, userID string, role organization.MemberRole) error {
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE organization_members SET role = $3 WHERE organization_id = $1 AND user_id = $2",
+		organizationID, userID, role)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return organization.ErrMemberNotFound
+	}
+	return nil
+}
+
+// RemoveMember deletes a membership
+// RemoveMember deletes the membership and moves the member's events to
+// eventsTo in one transaction
+func (s *OrganizationStorePG) RemoveMember(ctx context.Context, organizationID, userID, eventsTo string) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var moved int64
+	if eventsTo != "" {
+		res, err := tx.ExecContext(ctx, `
+			UPDATE events SET organizer_id = $3, updated_at = NOW()
+			WHERE organization_id = $1 AND organizer_id = $2`,
+			organizationID, userID, eventsTo)
+		if err != nil {
+			return 0, fmt.Errorf("reassign events: %w", err)
+		}
+		if moved, err = res.RowsAffected(); err != nil {
+			return 0, err
+		}
+	}
+
+	res, err := tx.ExecContext(ctx,
+		"DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2",
+		organizationID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("delete member: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return 0, organization.ErrMemberNotFound
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(moved), nil
+}
+
+func scanOrganization(row rowScanner) (*organization.Organization, error) {
+	org := &organization.Organization{}
+	err := row.Scan(&org.ID, &org.Name, &org.Slug, &org.Description, &org.Website, &org.ContactEmail,
+		&org.LogoURL, &org.VerificationStatus, &org.VerifiedAt, &org.VerifiedBy, &org.CreatedAt, &org.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return org, nil
+}
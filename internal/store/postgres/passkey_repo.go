@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	auth "github.com/volunteersync/backend/internal/core/auth"
+)
+
+// PasskeyCredentialRepository implements auth.PasskeyCredentialRepository using Postgres
+type PasskeyCredentialRepository struct {
+	db *sql.DB
+}
+
+func NewPasskeyCredentialRepository(db *sql.DB) *PasskeyCredentialRepository {
+	return &PasskeyCredentialRepository{db: db}
+}
+
+// CreateCredential inserts a newly registered passkey
+func (r *PasskeyCredentialRepository) CreateCredential(ctx context.Context, c *auth.PasskeyCredential) error {
+	const q = `INSERT INTO webauthn_credentials (id, user_id, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state, name, created_at)
+               VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`
+	_, err := r.db.ExecContext(ctx, q,
+		c.ID, c.UserID, c.CredentialID, c.PublicKey, c.AttestationType, c.AAGUID, int64(c.SignCount),
+		pq.Array(c.Transports), c.BackupEligible, c.BackupState, c.Name, c.CreatedAt,
+	)
+	return err
+}
+
+// GetCredentialsByUserID lists all passkeys for a user, oldest first
+func (r *PasskeyCredentialRepository) GetCredentialsByUserID(ctx context.Context, userID string) ([]*auth.PasskeyCredential, error) {
+	const q = `SELECT id, user_id, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state, name, created_at, last_used_at
+               FROM webauthn_credentials WHERE user_id=$1 ORDER BY created_at`
+	rows, err := r.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*auth.PasskeyCredential
+	for rows.Next() {
+		var c auth.PasskeyCredential
+		var signCount int64
+		var transports pq.StringArray
+		var name sql.NullString
+		var lastUsed sql.NullTime
+		if err := rows.Scan(&c.ID, &c.UserID, &c.CredentialID, &c.PublicKey, &c.AttestationType, &c.AAGUID, &signCount,
+			&transports, &c.BackupEligible, &c.BackupState, &name, &c.CreatedAt, &lastUsed); err != nil {
+			return nil, err
+		}
+		c.SignCount = uint32(signCount)
+		c.Transports = []string(transports)
+		c.Name = name.String
+		if lastUsed.Valid {
+			t := lastUsed.Time
+			c.LastUsedAt = &t
+		}
+		out = append(out, &c)
+	}
+	return out, rows.Err()
+}
+
+// UpdateCredentialUsage stores the new sign counter and backup state after a login
+func (r *PasskeyCredentialRepository) UpdateCredentialUsage(ctx context.Context, credentialID []byte, signCount uint32, backupState bool) error {
+	const q = `UPDATE webauthn_credentials SET sign_count=$2, backup_state=$3, last_used_at=NOW() WHERE credential_id=$1`
+	res, err := r.db.ExecContext(ctx, q, credentialID, int64(signCount), backupState)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return auth.ErrPasskeyNotFound
+	}
+	return nil
+}
+
+// DeleteCredential removes a passkey owned by the given user
+func (r *PasskeyCredentialRepository) DeleteCredential(ctx context.Context, userID, id string) error {
+	const q = `DELETE FROM webauthn_credentials WHERE id=$1 AND user_id=$2`
+	res, err := r.db.ExecContext(ctx, q, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete passkey: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return auth.ErrPasskeyNotFound
+	}
+	return nil
+}
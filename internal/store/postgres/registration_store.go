@@ -3,6 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/volunteersync/backend/internal/core/registration"
 )
@@ -13,6 +18,13 @@ type RegistrationStorePG struct {
 	db *sql.DB
 }
 
+// execer is satisfied by both *sql.DB and *sql.Tx, so statements can run
+// alone or as part of a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 // NewRegistrationStore creates a new PostgreSQL registration store
 
 func NewRegistrationStore(db *sql.DB) *RegistrationStorePG {
@@ -215,6 +227,103 @@ func (s *RegistrationStorePG) GetWaitlistEntryByRegistrationID(ctx context.Conte
 	return w, nil
 }
 
+func (s *RegistrationStorePG) GetShiftAssignments(ctx context.Context, registrationID string) ([]*registration.ShiftAssignment, error) {
+	return s.queryShiftAssignments(ctx, `
+		SELECT rs.id, rs.registration_id, rs.shift_id, rs.status, rs.waitlist_position,
+			rs.confirmed_at, rs.checked_in_at, rs.checked_out_at, rs.created_at, rs.updated_at
+		FROM registration_shifts rs
+		JOIN event_shifts es ON es.id = rs.shift_id
+		WHERE rs.registration_id = $1
+		ORDER BY es.start_time, es.position
+	`, registrationID)
+}
+
+func (s *RegistrationStorePG) GetEventShiftAssignments(ctx context.Context, eventID string) ([]*registration.ShiftAssignment, error) {
+	return s.queryShiftAssignments(ctx, `
+		SELECT rs.id, rs.registration_id, rs.shift_id, rs.status, rs.waitlist_position,
+			rs.confirmed_at, rs.checked_in_at, rs.checked_out_at, rs.created_at, rs.updated_at
+		FROM registration_shifts rs
+		JOIN event_shifts es ON es.id = rs.shift_id
+		WHERE es.event_id = $1
+		ORDER BY es.position, rs.created_at
+	`, eventID)
+}
+
+func (s *RegistrationStorePG) queryShiftAssignments(ctx context.Context, query string, args ...any) ([]*registration.ShiftAssignment, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := []*registration.ShiftAssignment{}
+	for rows.Next() {
+		a := &registration.ShiftAssignment{}
+		if err := rows.Scan(&a.ID, &a.RegistrationID, &a.ShiftID, &a.Status, &a.WaitlistPosition,
+			&a.ConfirmedAt, &a.CheckedInAt, &a.CheckedOutAt, &a.CreatedAt, &a.UpdatedAt); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, rows.Err()
+}
+
+func (s *RegistrationStorePG) GetShiftCompletionIDs(ctx context.Context, endedBefore time.Time) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT r.id
+		FROM registrations r
+		WHERE r.status = 'CONFIRMED'
+			AND EXISTS (
+				SELECT 1 FROM registration_shifts rs
+				WHERE rs.registration_id = r.id AND rs.status = 'CONFIRMED' AND rs.checked_in_at IS NOT NULL)
+			AND NOT EXISTS (
+				SELECT 1 FROM registration_shifts rs
+				JOIN event_shifts es ON es.id = rs.shift_id
+				WHERE rs.registration_id = r.id AND rs.status = 'CONFIRMED'
+					AND rs.checked_out_at IS NULL AND es.end_time > $1)`, endedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (s *RegistrationStorePG) SaveShiftAssignments(ctx context.Context, assignments []*registration.ShiftAssignment) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		return upsertShiftAssignments(ctx, tx, assignments)
+	})
+}
+
+func upsertShiftAssignments(ctx context.Context, db execer, assignments []*registration.ShiftAssignment) error {
+	for _, a := range assignments {
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO registration_shifts (
+				id, registration_id, shift_id, status, waitlist_position,
+				confirmed_at, checked_in_at, checked_out_at, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+			ON CONFLICT (id) DO UPDATE SET
+				status = EXCLUDED.status, waitlist_position = EXCLUDED.waitlist_position,
+				confirmed_at = EXCLUDED.confirmed_at, checked_in_at = EXCLUDED.checked_in_at,
+				checked_out_at = EXCLUDED.checked_out_at, updated_at = NOW()
+			WHERE registration_shifts.registration_id = EXCLUDED.registration_id`,
+			a.ID, a.RegistrationID, a.ShiftID, a.Status, a.WaitlistPosition,
+			a.ConfirmedAt, a.CheckedInAt, a.CheckedOutAt, a.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *RegistrationStorePG) AddWaitlistEntry(ctx context.Context, w *registration.WaitlistEntry) (*registration.WaitlistEntry, error) {
 	query := `
 		INSERT INTO waitlist_entries (
@@ -242,6 +351,10 @@ func (s *RegistrationStorePG) DeleteRegistration(ctx context.Context, id string)
 }
 
 func (s *RegistrationStorePG) UpdateRegistration(ctx context.Context, r *registration.Registration) error {
+	return updateRegistration(ctx, conn(ctx, s.db), r)
+}
+
+func updateRegistration(ctx context.Context, db execer, r *registration.Registration) error {
 	query := `
 		UPDATE registrations
 		SET
@@ -249,27 +362,97 @@ func (s *RegistrationStorePG) UpdateRegistration(ctx context.Context, r *registr
 			confirmed_at = $7, cancelled_at = $8, checked_in_at = $9, completed_at = $10, waitlist_position = $11,
 			waitlist_promoted_at = $12, promotion_offered_at = $13, promotion_expires_at = $14, auto_promote = $15,
 			emergency_contact_name = $16, emergency_contact_phone = $17, dietary_restrictions = $18, accessibility_needs = $19,
-			checked_in_by = $20, approved_by = $21, updated_at = NOW()
+			checked_in_by = $20, approved_by = $21, reconfirmation_requested_at = $22, late_cancellation = $23,
+			reconfirmed_at = $24, updated_at = NOW()
 		WHERE id = $1
 	`
 
-	_, err := s.db.ExecContext(ctx, query,
+	_, err := db.ExecContext(ctx, query,
 		r.ID, r.Status, r.PersonalMessage, r.ApprovalNotes, r.CancellationReason, r.AttendanceStatus,
 		r.ConfirmedAt, r.CancelledAt, r.CheckedInAt, r.CompletedAt, r.WaitlistPosition, r.WaitlistPromotedAt,
 		r.PromotionOfferedAt, r.PromotionExpiresAt, r.AutoPromote, r.EmergencyContactName, r.EmergencyContactPhone,
-		r.DietaryRestrictions, r.AccessibilityNeeds, r.CheckedInBy, r.ApprovedBy,
+		r.DietaryRestrictions, r.AccessibilityNeeds, r.CheckedInBy, r.ApprovedBy, r.ReconfirmationRequestedAt,
+		r.LateCancellation, r.ReconfirmedAt,
 	)
 
 	return err
 }
 
+// ApplyCapacityChanges saves the changes together, joining the transaction
+// ctx carries if any
+func (s *RegistrationStorePG) ApplyCapacityChanges(ctx context.Context, changes []*registration.CapacityChange) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		for _, c := range changes {
+			r := c.Registration
+			_, err := tx.ExecContext(ctx, `
+				UPDATE registrations
+				SET status = $2, confirmed_at = $3, waitlist_position = $4, waitlist_promoted_at = $5,
+					cancelled_at = $6, cancellation_reason = $7, updated_at = NOW()
+				WHERE id = $1`,
+				r.ID, r.Status, r.ConfirmedAt, r.WaitlistPosition, r.WaitlistPromotedAt,
+				r.CancelledAt, r.CancellationReason)
+			if err != nil {
+				return err
+			}
+
+			if sc := c.StatusChange; sc != nil {
+				if err := insertStatusChange(ctx, tx, sc); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func insertStatusChange(ctx context.Context, db execer, sc *registration.RegistrationStatusChange) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO registration_status_changes (
+			id, registration_id, old_status, new_status, changed_by, reason, notes, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())`,
+		sc.ID, sc.RegistrationID, sc.OldStatus, sc.NewStatus, sc.ChangedBy, sc.Reason, sc.Notes)
+	return err
+}
+
+func (s *RegistrationStorePG) RequestReconfirmation(ctx context.Context, eventID string, at time.Time) error {
+	_, err := conn(ctx, s.db).ExecContext(ctx, `
+		UPDATE registrations
+		SET reconfirmation_requested_at = $2, updated_at = NOW()
+		WHERE event_id = $1 AND status IN ($3, $4, $5, $6)`,
+		eventID, at, registration.StatusConfirmed, registration.StatusWaitlisted,
+		registration.StatusPendingApproval, registration.StatusPendingGuardianConsent)
+	return err
+}
+
+func (s *RegistrationStorePG) GetConfirmationRequiredEventIDs(ctx context.Context, from, to time.Time) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id FROM events
+		WHERE status = 'PUBLISHED' AND confirmation_required
+			AND start_time > $1 AND start_time <= $2
+		ORDER BY start_time`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
 func (s *RegistrationStorePG) GetRegistrationsByUserID(ctx context.Context, userID string) ([]*registration.Registration, error) {
 	query := `
 		SELECT
 			id, user_id, event_id, status, personal_message, approval_notes, cancellation_reason, attendance_status,
 			applied_at, confirmed_at, cancelled_at, checked_in_at, completed_at, waitlist_position, waitlist_promoted_at,
 			promotion_offered_at, promotion_expires_at, auto_promote, emergency_contact_name, emergency_contact_phone,
-			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, created_at, updated_at
+			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, reconfirmation_requested_at, late_cancellation, reconfirmed_at, team_registration_id, created_at, updated_at
 		FROM registrations
 		WHERE user_id = $1
 	`
@@ -287,7 +470,7 @@ func (s *RegistrationStorePG) GetRegistrationsByUserID(ctx context.Context, user
 			&r.ID, &r.UserID, &r.EventID, &r.Status, &r.PersonalMessage, &r.ApprovalNotes, &r.CancellationReason, &r.AttendanceStatus,
 			&r.AppliedAt, &r.ConfirmedAt, &r.CancelledAt, &r.CheckedInAt, &r.CompletedAt, &r.WaitlistPosition, &r.WaitlistPromotedAt,
 			&r.PromotionOfferedAt, &r.PromotionExpiresAt, &r.AutoPromote, &r.EmergencyContactName, &r.EmergencyContactPhone,
-			&r.DietaryRestrictions, &r.AccessibilityNeeds, &r.CheckedInBy, &r.ApprovedBy, &r.CreatedAt, &r.UpdatedAt,
+			&r.DietaryRestrictions, &r.AccessibilityNeeds, &r.CheckedInBy, &r.ApprovedBy, &r.ReconfirmationRequestedAt, &r.LateCancellation, &r.ReconfirmedAt, &r.TeamRegistrationID, &r.CreatedAt, &r.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -303,7 +486,7 @@ func (s *RegistrationStorePG) GetRegistrationsByEventID(ctx context.Context, eve
 			id, user_id, event_id, status, personal_message, approval_notes, cancellation_reason, attendance_status,
 			applied_at, confirmed_at, cancelled_at, checked_in_at, completed_at, waitlist_position, waitlist_promoted_at,
 			promotion_offered_at, promotion_expires_at, auto_promote, emergency_contact_name, emergency_contact_phone,
-			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, created_at, updated_at
+			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, reconfirmation_requested_at, late_cancellation, reconfirmed_at, team_registration_id, created_at, updated_at
 		FROM registrations
 		WHERE event_id = $1
 	`
@@ -321,7 +504,7 @@ func (s *RegistrationStorePG) GetRegistrationsByEventID(ctx context.Context, eve
 			&r.ID, &r.UserID, &r.EventID, &r.Status, &r.PersonalMessage, &r.ApprovalNotes, &r.CancellationReason, &r.AttendanceStatus,
 			&r.AppliedAt, &r.ConfirmedAt, &r.CancelledAt, &r.CheckedInAt, &r.CompletedAt, &r.WaitlistPosition, &r.WaitlistPromotedAt,
 			&r.PromotionOfferedAt, &r.PromotionExpiresAt, &r.AutoPromote, &r.EmergencyContactName, &r.EmergencyContactPhone,
-			&r.DietaryRestrictions, &r.AccessibilityNeeds, &r.CheckedInBy, &r.ApprovedBy, &r.CreatedAt, &r.UpdatedAt,
+			&r.DietaryRestrictions, &r.AccessibilityNeeds, &r.CheckedInBy, &r.ApprovedBy, &r.ReconfirmationRequestedAt, &r.LateCancellation, &r.ReconfirmedAt, &r.TeamRegistrationID, &r.CreatedAt, &r.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -337,7 +520,7 @@ func (s *RegistrationStorePG) GetRegistrationByID(ctx context.Context, id string
 			id, user_id, event_id, status, personal_message, approval_notes, cancellation_reason, attendance_status,
 			applied_at, confirmed_at, cancelled_at, checked_in_at, completed_at, waitlist_position, waitlist_promoted_at,
 			promotion_offered_at, promotion_expires_at, auto_promote, emergency_contact_name, emergency_contact_phone,
-			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, created_at, updated_at
+			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, reconfirmation_requested_at, late_cancellation, reconfirmed_at, team_registration_id, created_at, updated_at
 		FROM registrations
 		WHERE id = $1
 	`
@@ -348,7 +531,7 @@ func (s *RegistrationStorePG) GetRegistrationByID(ctx context.Context, id string
 		&r.ID, &r.UserID, &r.EventID, &r.Status, &r.PersonalMessage, &r.ApprovalNotes, &r.CancellationReason, &r.AttendanceStatus,
 		&r.AppliedAt, &r.ConfirmedAt, &r.CancelledAt, &r.CheckedInAt, &r.CompletedAt, &r.WaitlistPosition, &r.WaitlistPromotedAt,
 		&r.PromotionOfferedAt, &r.PromotionExpiresAt, &r.AutoPromote, &r.EmergencyContactName, &r.EmergencyContactPhone,
-		&r.DietaryRestrictions, &r.AccessibilityNeeds, &r.CheckedInBy, &r.ApprovedBy, &r.CreatedAt, &r.UpdatedAt,
+		&r.DietaryRestrictions, &r.AccessibilityNeeds, &r.CheckedInBy, &r.ApprovedBy, &r.ReconfirmationRequestedAt, &r.LateCancellation, &r.ReconfirmedAt, &r.TeamRegistrationID, &r.CreatedAt, &r.UpdatedAt,
 	)
 
 	if err != nil {
@@ -363,23 +546,67 @@ func (s *RegistrationStorePG) GetRegistrationByID(ctx context.Context, id string
 
 // CreateRegistration creates a new registration in the database
 
+// Transaction runs fn in a transaction that this store's, and the event
+// store's, writes given its context join
+func (s *RegistrationStorePG) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return inTx(ctx, s.db, func(ctx context.Context, _ *sql.Tx) error {
+		return fn(ctx)
+	})
+}
+
 func (s *RegistrationStorePG) CreateRegistration(ctx context.Context, r *registration.Registration) (*registration.Registration, error) {
+	var saved *registration.Registration
+	err := inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		if r.TeamRegistrationID != nil {
+			if err := checkTeamSeat(ctx, tx, *r.TeamRegistrationID); err != nil {
+				return err
+			}
+		}
+		var err error
+		saved, err = insertRegistration(ctx, tx, r)
+		return err
+	})
+	return saved, err
+}
+
+// checkTeamSeat locks the team registration, so members being given seats
+// at the same time queue up, and makes sure one of its seats is still free
+func checkTeamSeat(ctx context.Context, tx *sql.Tx, teamRegistrationID string) error {
+	var seats, taken int
+	err := tx.QueryRowContext(ctx, `SELECT seats FROM team_registrations WHERE id = $1 FOR UPDATE`, teamRegistrationID).Scan(&seats)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM registrations
+		WHERE team_registration_id = $1 AND status NOT IN ('CANCELLED', 'DECLINED')`, teamRegistrationID).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken >= seats {
+		return registration.ErrNoFreeSeat
+	}
+	return nil
+}
+
+func insertRegistration(ctx context.Context, db execer, r *registration.Registration) (*registration.Registration, error) {
 	query := `
 		INSERT INTO registrations (
 			id, user_id, event_id, status, personal_message, approval_notes, cancellation_reason, attendance_status,
 			applied_at, confirmed_at, cancelled_at, checked_in_at, completed_at, waitlist_position, waitlist_promoted_at,
 			promotion_offered_at, promotion_expires_at, auto_promote, emergency_contact_name, emergency_contact_phone,
-			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, created_at, updated_at
+			dietary_restrictions, accessibility_needs, checked_in_by, approved_by, reconfirmation_requested_at, late_cancellation, reconfirmed_at, team_registration_id, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, NOW(), NOW()
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, NOW(), NOW()
 		) RETURNING id, created_at, updated_at
 	`
 
-	err := s.db.QueryRowContext(ctx, query,
+	err := db.QueryRowContext(ctx, query,
 		r.ID, r.UserID, r.EventID, r.Status, r.PersonalMessage, r.ApprovalNotes, r.CancellationReason, r.AttendanceStatus,
 		r.AppliedAt, r.ConfirmedAt, r.CancelledAt, r.CheckedInAt, r.CompletedAt, r.WaitlistPosition, r.WaitlistPromotedAt,
 		r.PromotionOfferedAt, r.PromotionExpiresAt, r.AutoPromote, r.EmergencyContactName, r.EmergencyContactPhone,
-		r.DietaryRestrictions, r.AccessibilityNeeds, r.CheckedInBy, r.ApprovedBy,
+		r.DietaryRestrictions, r.AccessibilityNeeds, r.CheckedInBy, r.ApprovedBy, r.ReconfirmationRequestedAt,
+		r.LateCancellation, r.ReconfirmedAt, r.TeamRegistrationID,
 	).Scan(&r.ID, &r.CreatedAt, &r.UpdatedAt)
 
 	if err != nil {
@@ -388,3 +615,322 @@ func (s *RegistrationStorePG) CreateRegistration(ctx context.Context, r *registr
 
 	return r, nil
 }
+
+func (s *RegistrationStorePG) CreateTicketScan(ctx context.Context, t *registration.TicketScan) (*registration.TicketScan, error) {
+	query := `
+		INSERT INTO ticket_scans (
+			id, registration_id, event_id, scanned_by, client_scan_id, outcome, scanned_at, received_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		) RETURNING id
+	`
+
+	err := s.db.QueryRowContext(ctx, query,
+		t.ID, t.RegistrationID, t.EventID, t.ScannedBy, t.ClientScanID, t.Outcome, t.ScannedAt, t.ReceivedAt,
+	).Scan(&t.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *RegistrationStorePG) GetTicketScanByClientID(ctx context.Context, scannedBy, clientScanID string) (*registration.TicketScan, error) {
+	query := `
+		SELECT
+			id, registration_id, event_id, scanned_by, client_scan_id, outcome, scanned_at, received_at
+		FROM ticket_scans
+		WHERE scanned_by = $1 AND client_scan_id = $2
+	`
+
+	t := &registration.TicketScan{}
+
+	err := s.db.QueryRowContext(ctx, query, scannedBy, clientScanID).Scan(
+		&t.ID, &t.RegistrationID, &t.EventID, &t.ScannedBy, &t.ClientScanID, &t.Outcome, &t.ScannedAt, &t.ReceivedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *RegistrationStorePG) GetRegistrantEmails(ctx context.Context, eventID string) (map[string]string, error) {
+	query := `
+		SELECT DISTINCT u.id, u.email
+		FROM registrations r
+		JOIN users u ON u.id = r.user_id
+		WHERE r.event_id = $1
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emails := map[string]string{}
+	for rows.Next() {
+		var userID, email string
+		if err := rows.Scan(&userID, &email); err != nil {
+			return nil, err
+		}
+		emails[userID] = email
+	}
+
+	return emails, rows.Err()
+}
+
+func (s *RegistrationStorePG) ApplyAttendanceChanges(ctx context.Context, changes []*registration.AttendanceChange) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		for _, c := range changes {
+			r := c.Registration
+			_, err := tx.ExecContext(ctx, `
+				UPDATE registrations
+				SET status = $2, attendance_status = $3, checked_in_at = $4, checked_in_by = $5, completed_at = $6, updated_at = NOW()
+				WHERE id = $1`,
+				r.ID, r.Status, r.AttendanceStatus, r.CheckedInAt, r.CheckedInBy, r.CompletedAt)
+			if err != nil {
+				return err
+			}
+
+			a := c.Record
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO attendance_records (
+					id, registration_id, status, checked_in_at, checked_out_at, checked_in_by, location_verified, notes, created_at
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())`,
+				a.ID, a.RegistrationID, a.Status, a.CheckedInAt, a.CheckedOutAt, a.CheckedInBy, a.LocationVerified, a.Notes)
+			if err != nil {
+				return err
+			}
+
+			if sc := c.StatusChange; sc != nil {
+				if err := insertStatusChange(ctx, tx, sc); err != nil {
+					return err
+				}
+			}
+
+			if c.Shift != nil {
+				if err := upsertShiftAssignments(ctx, tx, []*registration.ShiftAssignment{c.Shift}); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *RegistrationStorePG) GetEventQuestions(ctx context.Context, eventID string) ([]*registration.Question, error) {
+	return s.queryQuestions(ctx, `
+		SELECT id, event_id, position, label, COALESCE(help_text, ''), type, required, options, validation, created_at, updated_at
+		FROM event_registration_questions
+		WHERE event_id = $1
+		ORDER BY position
+	`, eventID)
+}
+
+func (s *RegistrationStorePG) GetTemplateQuestions(ctx context.Context, templateID string) ([]*registration.Question, error) {
+	return s.queryQuestions(ctx, `
+		SELECT id, '', position, label, COALESCE(help_text, ''), type, required, options, validation, created_at, updated_at
+		FROM event_template_questions
+		WHERE template_id = $1
+		ORDER BY position
+	`, templateID)
+}
+
+func (s *RegistrationStorePG) queryQuestions(ctx context.Context, query string, args ...any) ([]*registration.Question, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions := []*registration.Question{}
+	for rows.Next() {
+		q := &registration.Question{}
+		var qType string
+		var options, validation []byte
+		if err := rows.Scan(&q.ID, &q.EventID, &q.Position, &q.Label, &q.HelpText, &qType, &q.Required,
+			&options, &validation, &q.CreatedAt, &q.UpdatedAt); err != nil {
+			return nil, err
+		}
+		q.Type = registration.QuestionType(qType)
+		if err := json.Unmarshal(options, &q.Options); err != nil {
+			return nil, fmt.Errorf("decode question options: %w", err)
+		}
+		if err := json.Unmarshal(validation, &q.Validation); err != nil {
+			return nil, fmt.Errorf("decode question validation: %w", err)
+		}
+		questions = append(questions, q)
+	}
+
+	return questions, rows.Err()
+}
+
+// questionJSON encodes a question's options and validation rules
+func questionJSON(q *registration.Question) (options, validation []byte, err error) {
+	opts := q.Options
+	if opts == nil {
+		opts = []string{}
+	}
+	if options, err = json.Marshal(opts); err != nil {
+		return nil, nil, err
+	}
+	if validation, err = json.Marshal(q.Validation); err != nil {
+		return nil, nil, err
+	}
+	return options, validation, nil
+}
+
+func (s *RegistrationStorePG) ReplaceEventQuestions(ctx context.Context, eventID string, questions []*registration.Question) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	keep := make([]string, 0, len(questions))
+	for _, q := range questions {
+		optionsJSON, validationJSON, err := questionJSON(q)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO event_registration_questions (
+				id, event_id, position, label, help_text, type, required, options, validation, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (id) DO UPDATE SET
+				position = EXCLUDED.position, label = EXCLUDED.label, help_text = EXCLUDED.help_text,
+				required = EXCLUDED.required, options = EXCLUDED.options, validation = EXCLUDED.validation,
+				updated_at = EXCLUDED.updated_at
+			WHERE event_registration_questions.event_id = EXCLUDED.event_id`,
+			q.ID, eventID, q.Position, q.Label, q.HelpText, string(q.Type), q.Required,
+			optionsJSON, validationJSON, q.CreatedAt, q.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		keep = append(keep, q.ID)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM event_registration_questions
+		WHERE event_id = $1 AND NOT (id::text = ANY($2))`,
+		eventID, pq.Array(keep))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *RegistrationStorePG) ReplaceTemplateQuestions(ctx context.Context, templateID string, questions []*registration.Question) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_template_questions WHERE template_id = $1`, templateID); err != nil {
+		return err
+	}
+	for _, q := range questions {
+		optionsJSON, validationJSON, err := questionJSON(q)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO event_template_questions (
+				id, template_id, position, label, help_text, type, required, options, validation, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			q.ID, templateID, q.Position, q.Label, q.HelpText, string(q.Type), q.Required,
+			optionsJSON, validationJSON, q.CreatedAt, q.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *RegistrationStorePG) SaveRegistrationAnswers(ctx context.Context, registrationID string, answers []*registration.Answer) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		for _, a := range answers {
+			var fileName, contentType, path sql.NullString
+			var size sql.NullInt64
+			if a.File != nil {
+				fileName = sql.NullString{String: a.File.Name, Valid: true}
+				contentType = sql.NullString{String: a.File.ContentType, Valid: true}
+				path = sql.NullString{String: a.File.StoragePath, Valid: true}
+				size = sql.NullInt64{Int64: a.File.Size, Valid: true}
+			}
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO registration_answers (
+					registration_id, question_id, value, choices, file_name, file_content_type, file_size, file_path, created_at
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (registration_id, question_id) DO UPDATE SET
+					value = EXCLUDED.value, choices = EXCLUDED.choices, file_name = EXCLUDED.file_name,
+					file_content_type = EXCLUDED.file_content_type, file_size = EXCLUDED.file_size, file_path = EXCLUDED.file_path`,
+				registrationID, a.QuestionID, a.Value, pq.Array(a.Choices), fileName, contentType, size, path, a.CreatedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *RegistrationStorePG) GetRegistrationAnswers(ctx context.Context, registrationID string) ([]*registration.Answer, error) {
+	return s.queryAnswers(ctx, `
+		SELECT a.registration_id, a.question_id, COALESCE(a.value, ''), a.choices,
+			a.file_name, a.file_content_type, a.file_size, a.file_path, a.created_at
+		FROM registration_answers a
+		JOIN event_registration_questions q ON q.id = a.question_id
+		WHERE a.registration_id = $1
+		ORDER BY q.position
+	`, registrationID)
+}
+
+func (s *RegistrationStorePG) GetAnswersByEventID(ctx context.Context, eventID string) ([]*registration.Answer, error) {
+	return s.queryAnswers(ctx, `
+		SELECT a.registration_id, a.question_id, COALESCE(a.value, ''), a.choices,
+			a.file_name, a.file_content_type, a.file_size, a.file_path, a.created_at
+		FROM registration_answers a
+		JOIN event_registration_questions q ON q.id = a.question_id
+		WHERE q.event_id = $1
+		ORDER BY a.registration_id, q.position
+	`, eventID)
+}
+
+func (s *RegistrationStorePG) queryAnswers(ctx context.Context, query string, args ...any) ([]*registration.Answer, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	answers := []*registration.Answer{}
+	for rows.Next() {
+		a := &registration.Answer{}
+		var choices pq.StringArray
+		var fileName, contentType, path sql.NullString
+		var size sql.NullInt64
+		if err := rows.Scan(&a.RegistrationID, &a.QuestionID, &a.Value, &choices,
+			&fileName, &contentType, &size, &path, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		a.Choices = []string(choices)
+		if path.Valid {
+			a.File = &registration.AnswerFile{Name: fileName.String, ContentType: contentType.String, Size: size.Int64, StoragePath: path.String}
+		}
+		answers = append(answers, a)
+	}
+
+	return answers, rows.Err()
+}
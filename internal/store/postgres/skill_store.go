@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/volunteersync/backend/internal/core/skill"
+)
+
+// SkillStorePG implements the skill.Repository interface using PostgreSQL
+type SkillStorePG struct {
+	db *sql.DB
+}
+
+// NewSkillStore creates a new PostgreSQL skills catalogue store
+func NewSkillStore(db *sql.DB) *SkillStorePG {
+	return &SkillStorePG{db: db}
+}
+
+// skillColumns selects a catalogue entry and its synonyms other than its name
+const skillColumns = `
+	s.id, s.slug, s.name, s.category,
+	COALESCE(array_agg(syn.synonym ORDER BY syn.synonym) FILTER (WHERE syn.synonym <> s.name), '{}')`
+
+func (s *SkillStorePG) FindBySynonyms(ctx context.Context, keys []string) (map[string]*skill.Skill, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT m.normalized, `+skillColumns+`
+		FROM skill_synonyms m
+		JOIN skills s ON s.id = m.skill_id
+		JOIN skill_synonyms syn ON syn.skill_id = s.id
+		WHERE m.normalized = ANY($1)
+		GROUP BY m.normalized, s.id`, pq.Array(keys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	found := make(map[string]*skill.Skill, len(keys))
+	for rows.Next() {
+		var key string
+		sk := &skill.Skill{}
+		var synonyms pq.StringArray
+		if err := rows.Scan(&key, &sk.ID, &sk.Slug, &sk.Name, &sk.Category, &synonyms); err != nil {
+			return nil, err
+		}
+		sk.Synonyms = synonyms
+		found[key] = sk
+	}
+	return found, rows.Err()
+}
+
+func (s *SkillStorePG) Search(ctx context.Context, prefix, category string, limit int) ([]*skill.Skill, error) {
+	// Normalized prefixes hold only letters, digits and spaces, so they
+	// need no escaping in a LIKE pattern
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+skillColumns+`
+		FROM skills s
+		JOIN skill_synonyms syn ON syn.skill_id = s.id
+		WHERE $2 = '' OR s.category = $2
+		GROUP BY s.id
+		HAVING $1 = '' OR bool_or(syn.normalized LIKE $1 || '%' OR syn.normalized LIKE '% ' || $1 || '%')
+		ORDER BY normalize_skill_name(s.name) LIKE $1 || '%' DESC, s.name
+		LIMIT $3`, prefix, category, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	skills := []*skill.Skill{}
+	for rows.Next() {
+		sk := &skill.Skill{}
+		var synonyms pq.StringArray
+		if err := rows.Scan(&sk.ID, &sk.Slug, &sk.Name, &sk.Category, &synonyms); err != nil {
+			return nil, err
+		}
+		sk.Synonyms = synonyms
+		skills = append(skills, sk)
+	}
+	return skills, rows.Err()
+}
+
+// skillMatchCondition matches a skill column pair against the names, slugs or
+// catalogue IDs in text array parameter $arg. Skills filed under a catalogue
+// entry match on its ID whatever they were called; others fall back to
+// comparing normalized names.
+func skillMatchCondition(idColumn, nameColumn string, arg int) string {
+	return fmt.Sprintf(`(
+		%[1]s IN (
+			SELECT syn.skill_id FROM skill_synonyms syn
+			WHERE syn.normalized IN (SELECT normalize_skill_name(x) FROM unnest($%[3]d::text[]) x)
+			UNION
+			SELECT sk.id FROM skills sk WHERE sk.id::text = ANY($%[3]d) OR sk.slug = ANY($%[3]d)
+		)
+		OR (%[1]s IS NULL AND normalize_skill_name(%[2]s) IN (SELECT normalize_skill_name(x) FROM unnest($%[3]d::text[]) x))
+	)`, idColumn, nameColumn, arg)
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+// taxonomyTable says where a taxonomy's terms live and which event column
+// and template field hold its slugs
+type taxonomyTable struct {
+	table         string
+	eventColumn   string
+	templateField string
+}
+
+var taxonomyTables = map[event.Taxonomy]taxonomyTable{
+	event.TaxonomyCategory:       {table: "event_categories", eventColumn: "category", templateField: "category"},
+	event.TaxonomyTimeCommitment: {table: "event_time_commitments", eventColumn: "time_commitment", templateField: "timeCommitment"},
+}
+
+func tableFor(taxonomy event.Taxonomy) (taxonomyTable, error) {
+	t, ok := taxonomyTables[taxonomy]
+	if !ok {
+		return taxonomyTable{}, fmt.Errorf("unknown taxonomy %q", taxonomy)
+	}
+	return t, nil
+}
+
+// termSubtreeQuery selects the slugs given in parameter $arg and those of
+// every term beneath them
+func termSubtreeQuery(taxonomy event.Taxonomy, arg int) string {
+	table := taxonomyTables[taxonomy].table
+	return fmt.Sprintf(`
+		WITH RECURSIVE subtree AS (
+			SELECT id, slug FROM %[1]s WHERE slug = ANY($%[2]d)
+			UNION
+			SELECT t.id, t.slug FROM %[1]s t JOIN subtree s ON t.parent_id = s.id
+		)
+		SELECT slug FROM subtree`, table, arg)
+}
+
+func (s *EventStorePG) ListTaxonomyTerms(ctx context.Context, taxonomy event.Taxonomy) ([]*event.TaxonomyTerm, error) {
+	t, err := tableFor(taxonomy)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, slug, name, description, icon, parent_id, sort_order, is_active, created_at, updated_at
+		FROM `+t.table+`
+		ORDER BY sort_order, name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := []*event.TaxonomyTerm{}
+	for rows.Next() {
+		term, err := scanTaxonomyTerm(rows, taxonomy)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return terms, rows.Err()
+}
+
+func (s *EventStorePG) GetTaxonomyTerm(ctx context.Context, taxonomy event.Taxonomy, slug string) (*event.TaxonomyTerm, error) {
+	t, err := tableFor(taxonomy)
+	if err != nil {
+		return nil, err
+	}
+	term, err := scanTaxonomyTerm(s.db.QueryRowContext(ctx, `
+		SELECT id, slug, name, description, icon, parent_id, sort_order, is_active, created_at, updated_at
+		FROM `+t.table+`
+		WHERE slug = $1`, slug), taxonomy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, event.ErrTaxonomyTermNotFound
+	}
+	return term, err
+}
+
+func (s *EventStorePG) CreateTaxonomyTerm(ctx context.Context, term *event.TaxonomyTerm) error {
+	t, err := tableFor(term.Taxonomy)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO `+t.table+` (
+			id, slug, name, description, icon, parent_id, sort_order, is_active, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		term.ID, term.Slug, term.Name, term.Description, term.Icon, term.ParentID,
+		term.SortOrder, term.IsActive, term.CreatedAt, term.UpdatedAt)
+	return err
+}
+
+func (s *EventStorePG) UpdateTaxonomyTerm(ctx context.Context, term *event.TaxonomyTerm, previousSlug string) error {
+	t, err := tableFor(term.Taxonomy)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Events follow a new slug through their ON UPDATE CASCADE foreign key
+	res, err := tx.ExecContext(ctx, `
+		UPDATE `+t.table+`
+		SET slug = $2, name = $3, description = $4, icon = $5, parent_id = $6,
+		    sort_order = $7, is_active = $8, updated_at = $9
+		WHERE id = $1`,
+		term.ID, term.Slug, term.Name, term.Description, term.Icon, term.ParentID,
+		term.SortOrder, term.IsActive, term.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return event.ErrTaxonomyTermNotFound
+	}
+	// Templates hold the slug inside their JSON snapshot, out of the key's reach
+	if term.Slug != previousSlug {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE event_templates
+			SET content = jsonb_set(content, ARRAY['event', $1::text], to_jsonb($2::text))
+			WHERE content->'event'->>$1 = $3`,
+			t.templateField, term.Slug, previousSlug); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *EventStorePG) DeleteTaxonomyTerm(ctx context.Context, taxonomy event.Taxonomy, id string) error {
+	t, err := tableFor(taxonomy)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, `
+		DELETE FROM `+t.table+` term
+		WHERE id = $1
+		  AND NOT EXISTS (SELECT 1 FROM `+t.table+` child WHERE child.parent_id = term.id)
+		  AND NOT EXISTS (SELECT 1 FROM events e WHERE e.`+t.eventColumn+` = term.slug)
+		  AND NOT EXISTS (SELECT 1 FROM event_templates et WHERE et.content->'event'->>$2 = term.slug)`,
+		id, t.templateField)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		var exists bool
+		if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM `+t.table+` WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return event.ErrTaxonomyTermInUse
+		}
+		return event.ErrTaxonomyTermNotFound
+	}
+	return nil
+}
+
+func scanTaxonomyTerm(row rowScanner, taxonomy event.Taxonomy) (*event.TaxonomyTerm, error) {
+	term := &event.TaxonomyTerm{Taxonomy: taxonomy}
+	if err := row.Scan(&term.ID, &term.Slug, &term.Name, &term.Description, &term.Icon, &term.ParentID,
+		&term.SortOrder, &term.IsActive, &term.CreatedAt, &term.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
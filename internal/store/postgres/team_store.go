@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/volunteersync/backend/internal/core/registration"
+)
+
+const teamSelect = `SELECT id, name, description, leader_id, created_at, updated_at FROM teams`
+
+const teamRegistrationSelect = `
+	SELECT id, team_id, event_id, seats, status, waitlist_position, assignment_deadline, registered_by,
+		confirmed_at, seats_released_at, cancelled_at, created_at, updated_at
+	FROM team_registrations
+`
+
+func (s *RegistrationStorePG) CreateTeam(ctx context.Context, team *registration.Team) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO teams (id, name, description, leader_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		team.ID, team.Name, team.Description, team.LeaderID, team.CreatedAt, team.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO team_members (team_id, user_id, joined_at) VALUES ($1, $2, $3)`,
+		team.ID, team.LeaderID, team.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *RegistrationStorePG) GetTeam(ctx context.Context, id string) (*registration.Team, error) {
+	t := &registration.Team{}
+	err := s.db.QueryRowContext(ctx, teamSelect+` WHERE id = $1`, id).Scan(
+		&t.ID, &t.Name, &t.Description, &t.LeaderID, &t.CreatedAt, &t.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, registration.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *RegistrationStorePG) GetUserTeams(ctx context.Context, userID string) ([]*registration.Team, error) {
+	rows, err := s.db.QueryContext(ctx, teamSelect+`
+		WHERE id IN (SELECT team_id FROM team_members WHERE user_id = $1)
+		ORDER BY name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := []*registration.Team{}
+	for rows.Next() {
+		t := &registration.Team{}
+		if err := rows.Scan(&t.ID, &t.Name, &t.Description, &t.LeaderID, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+	return teams, rows.Err()
+}
+
+func (s *RegistrationStorePG) AddTeamMember(ctx context.Context, m *registration.TeamMember) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO team_members (team_id, user_id, joined_at) VALUES ($1, $2, $3)
+		ON CONFLICT (team_id, user_id) DO NOTHING`,
+		m.TeamID, m.UserID, m.JoinedAt)
+	return err
+}
+
+func (s *RegistrationStorePG) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`, teamID, userID)
+	return err
+}
+
+func (s *RegistrationStorePG) GetTeamMembers(ctx context.Context, teamID string) ([]*registration.TeamMember, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT team_id, user_id, joined_at FROM team_members
+		WHERE team_id = $1
+		ORDER BY joined_at`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []*registration.TeamMember{}
+	for rows.Next() {
+		m := &registration.TeamMember{}
+		if err := rows.Scan(&m.TeamID, &m.UserID, &m.JoinedAt); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	return members, rows.Err()
+}
+
+func (s *RegistrationStorePG) CreateTeamRegistration(ctx context.Context, tr *registration.TeamRegistration) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		if tr.Status == registration.StatusConfirmed {
+			free, err := lockFreePlaces(ctx, tx, tr.EventID)
+			if err != nil {
+				return err
+			}
+			if tr.Seats > free {
+				return registration.ErrTeamSeatsUnavailable
+			}
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO team_registrations (
+				id, team_id, event_id, seats, status, waitlist_position, assignment_deadline, registered_by,
+				confirmed_at, seats_released_at, cancelled_at, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			tr.ID, tr.TeamID, tr.EventID, tr.Seats, tr.Status, tr.WaitlistPosition, tr.AssignmentDeadline, tr.RegisteredBy,
+			tr.ConfirmedAt, tr.SeatsReleasedAt, tr.CancelledAt, tr.CreatedAt, tr.UpdatedAt)
+		return err
+	})
+}
+
+func (s *RegistrationStorePG) UpdateTeamRegistration(ctx context.Context, tr *registration.TeamRegistration) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE team_registrations SET
+			seats = $2, status = $3, waitlist_position = $4, assignment_deadline = $5,
+			confirmed_at = $6, seats_released_at = $7, cancelled_at = $8, updated_at = NOW()
+		WHERE id = $1`,
+		tr.ID, tr.Seats, tr.Status, tr.WaitlistPosition, tr.AssignmentDeadline,
+		tr.ConfirmedAt, tr.SeatsReleasedAt, tr.CancelledAt)
+	return err
+}
+
+func (s *RegistrationStorePG) GetTeamRegistration(ctx context.Context, id string) (*registration.TeamRegistration, error) {
+	tr, err := scanTeamRegistration(s.db.QueryRowContext(ctx, teamRegistrationSelect+` WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, registration.ErrTeamRegistrationNotFound
+	}
+	return tr, err
+}
+
+func (s *RegistrationStorePG) GetTeamRegistrations(ctx context.Context, teamID string) ([]*registration.TeamRegistration, error) {
+	return s.queryTeamRegistrations(ctx, teamRegistrationSelect+` WHERE team_id = $1 ORDER BY created_at DESC`, teamID)
+}
+
+func (s *RegistrationStorePG) GetEventTeamRegistrations(ctx context.Context, eventID string) ([]*registration.TeamRegistration, error) {
+	return s.queryTeamRegistrations(ctx, teamRegistrationSelect+` WHERE event_id = $1 ORDER BY created_at`, eventID)
+}
+
+func (s *RegistrationStorePG) GetTeamSeatEventIDs(ctx context.Context, now time.Time) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT DISTINCT tr.event_id
+		FROM team_registrations tr
+		JOIN events e ON e.id = tr.event_id
+		WHERE e.start_time > $1
+			AND (tr.status = 'WAITLISTED'
+				OR (tr.status = 'CONFIRMED' AND tr.seats_released_at IS NULL AND tr.assignment_deadline <= $1))`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (s *RegistrationStorePG) GetTeamStats(ctx context.Context, teamID string) (*registration.TeamStats, error) {
+	stats := &registration.TeamStats{}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM team_registrations WHERE team_id = $1 AND status <> 'CANCELLED'),
+			(SELECT COALESCE(SUM(seats), 0) FROM team_registrations WHERE team_id = $1 AND status <> 'CANCELLED'),
+			COUNT(r.id) FILTER (WHERE r.status NOT IN ('CANCELLED', 'DECLINED')),
+			COUNT(r.id) FILTER (WHERE r.status = 'COMPLETED' OR r.attendance_status IN ('CHECKED_IN', 'COMPLETED')),
+			COUNT(r.id) FILTER (WHERE r.status = 'NO_SHOW' OR r.attendance_status = 'NO_SHOW'),
+			COALESCE((
+				SELECT SUM(vh.hours)
+				FROM volunteer_hours vh
+				JOIN registrations hr ON hr.id = vh.registration_id
+				JOIN team_registrations htr ON htr.id = hr.team_registration_id
+				WHERE htr.team_id = $1 AND vh.status = 'APPROVED'
+			), 0)
+		FROM registrations r
+		JOIN team_registrations tr ON tr.id = r.team_registration_id
+		WHERE tr.team_id = $1 AND tr.status <> 'CANCELLED'`, teamID).Scan(
+		&stats.Events, &stats.SeatsReserved, &stats.SeatsAssigned, &stats.Attended, &stats.NoShows, &stats.Hours)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (s *RegistrationStorePG) queryTeamRegistrations(ctx context.Context, query string, args ...any) ([]*registration.TeamRegistration, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teamRegs := []*registration.TeamRegistration{}
+	for rows.Next() {
+		tr, err := scanTeamRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+		teamRegs = append(teamRegs, tr)
+	}
+	return teamRegs, rows.Err()
+}
+
+func scanTeamRegistration(row interface{ Scan(...any) error }) (*registration.TeamRegistration, error) {
+	tr := &registration.TeamRegistration{}
+	if err := row.Scan(&tr.ID, &tr.TeamID, &tr.EventID, &tr.Seats, &tr.Status, &tr.WaitlistPosition,
+		&tr.AssignmentDeadline, &tr.RegisteredBy, &tr.ConfirmedAt, &tr.SeatsReleasedAt, &tr.CancelledAt,
+		&tr.CreatedAt, &tr.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
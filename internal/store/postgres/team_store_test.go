@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/registration"
+)
+
+func createTestVolunteer(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	id := uuid.New().String()
+	now := time.Now().UTC()
+	_, err := db.Exec(`
+		INSERT INTO users (id, name, email, password_hash, created_at, updated_at, is_verified)
+		VALUES ($1, 'Volunteer', $2, 'hashed_password', $3, $3, true)`,
+		id, id+"@example.com", now)
+	require.NoError(t, err)
+	return id
+}
+
+func TestRegistrationStorePG_TeamSeats(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewRegistrationStore(db)
+	ctx := context.Background()
+	evt := createTestEvent(t, db, NewEventStore(db))
+	now := time.Now().UTC()
+
+	newTeamRegistration := func(seats int) *registration.TeamRegistration {
+		leaderID := createTestVolunteer(t, db)
+		team := &registration.Team{ID: uuid.New().String(), Name: "Litter Pickers", LeaderID: leaderID, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, store.CreateTeam(ctx, team))
+		return &registration.TeamRegistration{
+			ID:                 uuid.New().String(),
+			TeamID:             team.ID,
+			EventID:            evt.ID,
+			Seats:              seats,
+			Status:             registration.StatusConfirmed,
+			AssignmentDeadline: evt.StartTime,
+			RegisteredBy:       leaderID,
+			ConfirmedAt:        &now,
+			CreatedAt:          now,
+			UpdatedAt:          now,
+		}
+	}
+
+	tr := newTeamRegistration(2)
+	require.NoError(t, store.CreateTeamRegistration(ctx, tr))
+
+	t.Run("a team that no longer fits is refused", func(t *testing.T) {
+		err := store.CreateTeamRegistration(ctx, newTeamRegistration(9))
+		assert.ErrorIs(t, err, registration.ErrTeamSeatsUnavailable)
+	})
+
+	t.Run("members fill the reserved seats and no more", func(t *testing.T) {
+		assign := func() error {
+			_, err := store.CreateRegistration(ctx, &registration.Registration{
+				ID:                 uuid.New().String(),
+				UserID:             createTestVolunteer(t, db),
+				EventID:            evt.ID,
+				TeamRegistrationID: &tr.ID,
+				Status:             registration.StatusConfirmed,
+				AttendanceStatus:   registration.AttendanceRegistered,
+				AppliedAt:          now,
+				ConfirmedAt:        &now,
+			})
+			return err
+		}
+		require.NoError(t, assign())
+		require.NoError(t, assign())
+		assert.ErrorIs(t, assign(), registration.ErrNoFreeSeat)
+	})
+}
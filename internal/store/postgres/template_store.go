@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/volunteersync/backend/internal/core/event"
+)
+
+const templateSelect = `
+	SELECT id, name, organizer_id, organization_id, source_event_id, content, created_at, updated_at
+	FROM event_templates
+`
+
+// templateContent is what event_templates.content holds
+type templateContent struct {
+	Event  event.Event         `json:"event"`
+	Shifts []*event.EventShift `json:"shifts"`
+}
+
+func (s *EventStorePG) CreateTemplate(ctx context.Context, t *event.EventTemplate) error {
+	content, err := json.Marshal(templateContent{Event: t.Event, Shifts: t.Shifts})
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO event_templates (
+			id, name, organizer_id, organization_id, source_event_id, content, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		t.ID, t.Name, t.OrganizerID, t.OrganizationID, t.SourceEventID, content, t.CreatedAt, t.UpdatedAt)
+	return err
+}
+
+func (s *EventStorePG) GetTemplate(ctx context.Context, id string) (*event.EventTemplate, error) {
+	t, err := scanTemplate(s.db.QueryRowContext(ctx, templateSelect+` WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, event.ErrTemplateNotFound
+	}
+	return t, err
+}
+
+func (s *EventStorePG) GetTemplatesByOrganizer(ctx context.Context, organizerID string) ([]*event.EventTemplate, error) {
+	return s.queryTemplates(ctx, templateSelect+` WHERE organizer_id = $1 ORDER BY name`, organizerID)
+}
+
+func (s *EventStorePG) GetTemplatesByOrganization(ctx context.Context, organizationID string) ([]*event.EventTemplate, error) {
+	return s.queryTemplates(ctx, templateSelect+` WHERE organization_id = $1 ORDER BY name`, organizationID)
+}
+
+func (s *EventStorePG) DeleteTemplate(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM event_templates WHERE id = $1`, id)
+	return err
+}
+
+func (s *EventStorePG) queryTemplates(ctx context.Context, query string, args ...any) ([]*event.EventTemplate, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := []*event.EventTemplate{}
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, rows.Err()
+}
+
+func scanTemplate(row rowScanner) (*event.EventTemplate, error) {
+	t := &event.EventTemplate{}
+	var content []byte
+	if err := row.Scan(&t.ID, &t.Name, &t.OrganizerID, &t.OrganizationID, &t.SourceEventID,
+		&content, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	var c templateContent
+	if err := json.Unmarshal(content, &c); err != nil {
+		return nil, fmt.Errorf("decode template content: %w", err)
+	}
+	t.Event = c.Event
+	t.Shifts = c.Shifts
+	return t, nil
+}
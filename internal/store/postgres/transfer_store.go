@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/volunteersync/backend/internal/core/registration"
+)
+
+func (s *RegistrationStorePG) ApplyTransfer(ctx context.Context, c *registration.TransferChanges) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Lock the source so concurrent retries of the same transfer queue up
+	// behind each other; the second one finds the source already cancelled
+	var status string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM registrations WHERE id = $1 FOR UPDATE`, c.Source.ID).Scan(&status)
+	if err != nil {
+		return err
+	}
+
+	if t := c.Target; t != nil {
+		if status == string(registration.StatusCancelled) {
+			var transferred bool
+			err := tx.QueryRowContext(ctx, `
+				SELECT EXISTS (SELECT 1 FROM registration_transfers WHERE source_registration_id = $1)`,
+				c.Source.ID).Scan(&transferred)
+			if err != nil {
+				return err
+			}
+			if transferred {
+				return registration.ErrAlreadyTransferred
+			}
+			return registration.ErrNotCancellable
+		}
+
+		if t.Status == registration.StatusConfirmed && t.TeamRegistrationID == nil && len(t.Shifts) == 0 {
+			if err := checkTransferPlace(ctx, tx, t.EventID); err != nil {
+				return err
+			}
+		}
+		if err := checkShiftPlaces(ctx, tx, t.Shifts); err != nil {
+			return err
+		}
+		if _, err := insertRegistration(ctx, tx, t); err != nil {
+			return err
+		}
+		if err := upsertShiftAssignments(ctx, tx, t.Shifts); err != nil {
+			return err
+		}
+
+		tr := c.Transfer
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO registration_transfers (
+				id, source_registration_id, target_registration_id, from_event_id, to_event_id, transferred_by, created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			tr.ID, tr.SourceRegistrationID, tr.TargetRegistrationID, tr.FromEventID, tr.ToEventID, tr.TransferredBy, tr.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := checkShiftPlaces(ctx, tx, c.Source.Shifts); err != nil {
+		return err
+	}
+	if err := updateRegistration(ctx, tx, c.Source); err != nil {
+		return err
+	}
+	if err := upsertShiftAssignments(ctx, tx, c.Source.Shifts); err != nil {
+		return err
+	}
+	if c.Source.Status == registration.StatusCancelled {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM waitlist_entries WHERE registration_id = $1`, c.Source.ID); err != nil {
+			return err
+		}
+	}
+	for _, sc := range c.StatusChanges {
+		if err := insertStatusChange(ctx, tx, sc); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// checkTransferPlace locks the event and makes sure a place is still free
+func checkTransferPlace(ctx context.Context, tx *sql.Tx, eventID string) error {
+	free, err := lockFreePlaces(ctx, tx, eventID)
+	if err != nil {
+		return err
+	}
+	if free < 1 {
+		return registration.ErrTransferSeatTaken
+	}
+	return nil
+}
+
+// checkShiftPlaces locks the shifts the assignments newly confirm and makes
+// sure each still has a place, counting the shift's other confirmed
+// assignments. Shifts are locked in ID order so concurrent transfers
+// can't deadlock.
+func checkShiftPlaces(ctx context.Context, tx *sql.Tx, assignments []*registration.ShiftAssignment) error {
+	confirmed := make([]*registration.ShiftAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		if a.Status == registration.ShiftConfirmed {
+			confirmed = append(confirmed, a)
+		}
+	}
+	slices.SortFunc(confirmed, func(a, b *registration.ShiftAssignment) int { return strings.Compare(a.ShiftID, b.ShiftID) })
+
+	for _, a := range confirmed {
+		var capacity int
+		err := tx.QueryRowContext(ctx, `SELECT capacity FROM event_shifts WHERE id = $1 FOR UPDATE`, a.ShiftID).Scan(&capacity)
+		if err != nil {
+			return err
+		}
+
+		var held bool
+		var taken int
+		err = tx.QueryRowContext(ctx, `
+			SELECT
+				EXISTS (SELECT 1 FROM registration_shifts WHERE id = $2 AND status = 'CONFIRMED'),
+				(SELECT COUNT(*) FROM registration_shifts WHERE shift_id = $1 AND status = 'CONFIRMED' AND id <> $2)`,
+			a.ShiftID, a.ID).Scan(&held, &taken)
+		if err != nil {
+			return err
+		}
+		if !held && taken >= capacity {
+			return registration.ErrTransferSeatTaken
+		}
+	}
+	return nil
+}
+
+// lockFreePlaces locks the event row, so whoever takes places next queues up
+// behind tx, and returns the places left: the maximum less confirmed
+// volunteers outside teams and confirmed teams' seats
+func lockFreePlaces(ctx context.Context, tx execer, eventID string) (int, error) {
+	var maximum int
+	err := tx.QueryRowContext(ctx, `SELECT max_capacity FROM events WHERE id = $1 FOR UPDATE`, eventID).Scan(&maximum)
+	if err != nil {
+		return 0, err
+	}
+
+	var taken int
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM registrations
+				WHERE event_id = $1 AND status = 'CONFIRMED' AND team_registration_id IS NULL) +
+			(SELECT COALESCE(SUM(seats), 0) FROM team_registrations
+				WHERE event_id = $1 AND status = 'CONFIRMED')`, eventID).Scan(&taken)
+	if err != nil {
+		return 0, err
+	}
+	return maximum - taken, nil
+}
+
+func (s *RegistrationStorePG) GetRegistrationTransfer(ctx context.Context, sourceRegistrationID string) (*registration.RegistrationTransfer, error) {
+	tr := &registration.RegistrationTransfer{}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, source_registration_id, target_registration_id, from_event_id, to_event_id,
+			COALESCE(transferred_by::text, ''), created_at
+		FROM registration_transfers
+		WHERE source_registration_id = $1`, sourceRegistrationID).Scan(
+		&tr.ID, &tr.SourceRegistrationID, &tr.TargetRegistrationID, &tr.FromEventID, &tr.ToEventID,
+		&tr.TransferredBy, &tr.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, registration.ErrTransferNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volunteersync/backend/internal/core/registration"
+)
+
+func TestRegistrationStorePG_ApplyTransfer_FullShift(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewRegistrationStore(db)
+	events := NewEventStore(db)
+	ctx := context.Background()
+	from := createTestEvent(t, db, events)
+	to := createTestEvent(t, db, events)
+	now := time.Now().UTC()
+
+	shiftID := uuid.New().String()
+	_, err := db.Exec(`
+		INSERT INTO event_shifts (id, event_id, name, start_time, end_time, capacity, position)
+		VALUES ($1, $2, 'Morning', $3, $4, 1, 0)`,
+		shiftID, to.ID, to.StartTime, to.StartTime.Add(2*time.Hour))
+	require.NoError(t, err)
+
+	newRegistration := func(eventID string) *registration.Registration {
+		return &registration.Registration{
+			ID:               uuid.New().String(),
+			UserID:           createTestVolunteer(t, db),
+			EventID:          eventID,
+			Status:           registration.StatusConfirmed,
+			AttendanceStatus: registration.AttendanceRegistered,
+			AppliedAt:        now,
+			ConfirmedAt:      &now,
+		}
+	}
+	newAssignment := func(reg *registration.Registration) *registration.ShiftAssignment {
+		return &registration.ShiftAssignment{
+			ID:             uuid.New().String(),
+			RegistrationID: reg.ID,
+			ShiftID:        shiftID,
+			Status:         registration.ShiftConfirmed,
+			ConfirmedAt:    &now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+	}
+
+	holder := newRegistration(to.ID)
+	_, err = store.CreateRegistration(ctx, holder)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveShiftAssignments(ctx, []*registration.ShiftAssignment{newAssignment(holder)}))
+
+	source := newRegistration(from.ID)
+	_, err = store.CreateRegistration(ctx, source)
+	require.NoError(t, err)
+
+	target := newRegistration(to.ID)
+	target.UserID = source.UserID
+	target.Shifts = []*registration.ShiftAssignment{newAssignment(target)}
+	cancelled := *source
+	cancelled.Status = registration.StatusCancelled
+	cancelled.CancelledAt = &now
+
+	err = store.ApplyTransfer(ctx, &registration.TransferChanges{
+		Source: &cancelled,
+		Target: target,
+		Transfer: &registration.RegistrationTransfer{
+			ID:                   uuid.New().String(),
+			SourceRegistrationID: source.ID,
+			TargetRegistrationID: target.ID,
+			FromEventID:          from.ID,
+			ToEventID:            to.ID,
+			TransferredBy:        source.UserID,
+			CreatedAt:            now,
+		},
+	})
+	assert.ErrorIs(t, err, registration.ErrTransferSeatTaken)
+
+	saved, err := store.GetRegistrationByID(ctx, source.ID)
+	require.NoError(t, err)
+	assert.Equal(t, registration.StatusConfirmed, saved.Status)
+	_, err = store.GetRegistrationByID(ctx, target.ID)
+	assert.Error(t, err)
+}
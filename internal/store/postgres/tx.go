@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+// txKey carries the transaction a unit of work runs in, so writes by
+// different stores can be saved together
+type txKey struct{}
+
+// inTx runs fn in a transaction and commits it if fn succeeds. Called with a
+// context that already carries a transaction, it joins that one instead and
+// leaves committing to whoever began it.
+func inTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx, tx)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx), tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// conn returns the transaction ctx carries, or db outside one
+func conn(ctx context.Context, db *sql.DB) execer {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
@@ -25,7 +25,8 @@ func (s *UserStorePG) GetProfile(ctx context.Context, userID string) (*user.User
 		profile_visibility, show_email, show_location, allow_messaging,
 		email_notifications, push_notifications, sms_notifications,
 		event_reminders, new_opportunities, newsletter_subscription,
-		created_at, updated_at, last_active_at, is_verified
+		created_at, updated_at, last_active_at, is_verified,
+		date_of_birth, background_check_verified_at, background_check_expires_at
 	  FROM users WHERE id = $1`
 	var (
 		id, name, email                   string
@@ -38,12 +39,14 @@ func (s *UserStorePG) GetProfile(ctx context.Context, userID string) (*user.User
 		createdAt, updatedAt              time.Time
 		lastActive                        sql.NullTime
 		isVerified                        bool
+		dob, bgVerified, bgExpires        sql.NullTime
 	)
 	err := s.db.QueryRowContext(ctx, q, userID).Scan(&id, &name, &email, &bio, &pic, &city, &state, &country, &lat, &lng,
 		&visibility, &showEmail, &showLocation, &allowMsg,
 		&emailNotif, &pushNotif, &smsNotif,
 		&eventRem, &newOpp, &newsSub,
-		&createdAt, &updatedAt, &lastActive, &isVerified)
+		&createdAt, &updatedAt, &lastActive, &isVerified,
+		&dob, &bgVerified, &bgExpires)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("user not found")
@@ -62,6 +65,10 @@ func (s *UserStorePG) GetProfile(ctx context.Context, userID string) (*user.User
 		UpdatedAt:         updatedAt,
 		LastActiveAt:      nullTimePtr(lastActive),
 		IsVerified:        isVerified,
+		DateOfBirth:       nullTimePtr(dob),
+
+		BackgroundCheckVerifiedAt: nullTimePtr(bgVerified),
+		BackgroundCheckExpiresAt:  nullTimePtr(bgExpires),
 	}
 	if city.Valid || state.Valid || country.Valid || lat.Valid || lng.Valid {
 		prof.Location = &user.Location{City: nullStringPtr(city), State: nullStringPtr(state), Country: nullStringPtr(country), Lat: nullFloatPtr(lat), Lng: nullFloatPtr(lng)}
@@ -112,6 +119,11 @@ func (s *UserStorePG) UpdateProfile(ctx context.Context, userID string, input us
 			i++
 		}
 	}
+	if input.DateOfBirth != nil {
+		sets = append(sets, fmt.Sprintf("date_of_birth=$%d", i))
+		args = append(args, input.DateOfBirth.Format("2006-01-02"))
+		i++
+	}
 	if len(sets) == 0 {
 		return s.GetProfile(ctx, userID)
 	}
@@ -215,12 +227,12 @@ func (s *UserStorePG) ListUserInterests(ctx context.Context, userID string) ([]u
 }
 
 func (s *UserStorePG) AddSkill(ctx context.Context, userID string, in user.SkillInput) (*user.Skill, error) {
-	const q = `INSERT INTO user_skills (user_id, name, proficiency, verified)
-			   VALUES ($1,$2,$3,false)
-			   RETURNING id, name, proficiency, verified, created_at, updated_at`
+	const q = `INSERT INTO user_skills (user_id, name, skill_id, proficiency, verified)
+			   VALUES ($1,$2,$3,$4,false)
+			   RETURNING id, name, skill_id, proficiency, verified, created_at, updated_at`
 	var sk user.Skill
-	if err := s.db.QueryRowContext(ctx, q, userID, in.Name, strings.ToUpper(in.Proficiency)).Scan(
-		&sk.ID, &sk.Name, &sk.Proficiency, &sk.Verified, &sk.CreatedAt, &sk.UpdatedAt,
+	if err := s.db.QueryRowContext(ctx, q, userID, in.Name, in.SkillID, strings.ToUpper(in.Proficiency)).Scan(
+		&sk.ID, &sk.Name, &sk.SkillID, &sk.Proficiency, &sk.Verified, &sk.CreatedAt, &sk.UpdatedAt,
 	); err != nil {
 		return nil, err
 	}
@@ -231,7 +243,7 @@ func (s *UserStorePG) RemoveSkill(ctx context.Context, userID, skillID string) e
 	return err
 }
 func (s *UserStorePG) ListSkills(ctx context.Context, userID string) ([]user.Skill, error) {
-	rows, err := s.db.QueryContext(ctx, `SELECT id, name, proficiency, verified, created_at, updated_at FROM user_skills WHERE user_id=$1 ORDER BY name`, userID)
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, skill_id, proficiency, verified, created_at, updated_at FROM user_skills WHERE user_id=$1 ORDER BY name`, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -239,13 +251,60 @@ func (s *UserStorePG) ListSkills(ctx context.Context, userID string) ([]user.Ski
 	var out []user.Skill
 	for rows.Next() {
 		var sk user.Skill
-		if err := rows.Scan(&sk.ID, &sk.Name, &sk.Proficiency, &sk.Verified, &sk.CreatedAt, &sk.UpdatedAt); err != nil {
+		if err := rows.Scan(&sk.ID, &sk.Name, &sk.SkillID, &sk.Proficiency, &sk.Verified, &sk.CreatedAt, &sk.UpdatedAt); err != nil {
 			return nil, err
 		}
 		out = append(out, sk)
 	}
 	return out, rows.Err()
 }
+func (s *UserStorePG) AddTraining(ctx context.Context, userID string, in user.TrainingInput) (*user.Training, error) {
+	const q = `INSERT INTO user_trainings (user_id, name, completed_at, expires_at)
+			   VALUES ($1,$2,$3,$4)
+			   ON CONFLICT (user_id, LOWER(name)) DO UPDATE SET completed_at=EXCLUDED.completed_at, expires_at=EXCLUDED.expires_at
+			   RETURNING id, name, completed_at, expires_at, created_at`
+	var (
+		tr      user.Training
+		expires sql.NullTime
+	)
+	if err := s.db.QueryRowContext(ctx, q, userID, in.Name, in.CompletedAt, in.ExpiresAt).Scan(
+		&tr.ID, &tr.Name, &tr.CompletedAt, &expires, &tr.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	tr.ExpiresAt = nullTimePtr(expires)
+	return &tr, nil
+}
+func (s *UserStorePG) RemoveTraining(ctx context.Context, userID, trainingID string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM user_trainings WHERE id=$1 AND user_id=$2`, trainingID, userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return user.ErrTrainingNotFound
+	}
+	return nil
+}
+func (s *UserStorePG) ListTrainings(ctx context.Context, userID string) ([]user.Training, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, completed_at, expires_at, created_at FROM user_trainings WHERE user_id=$1 ORDER BY name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []user.Training
+	for rows.Next() {
+		var (
+			tr      user.Training
+			expires sql.NullTime
+		)
+		if err := rows.Scan(&tr.ID, &tr.Name, &tr.CompletedAt, &expires, &tr.CreatedAt); err != nil {
+			return nil, err
+		}
+		tr.ExpiresAt = nullTimePtr(expires)
+		out = append(out, tr)
+	}
+	return out, rows.Err()
+}
 func (s *UserStorePG) UpdatePrivacy(ctx context.Context, userID string, in user.PrivacySettings) (user.PrivacySettings, error) {
 	_, err := s.db.ExecContext(ctx, `UPDATE users SET profile_visibility=$1, show_email=$2, show_location=$3, allow_messaging=$4, updated_at=NOW() WHERE id=$5`,
 		strings.ToUpper(in.ProfileVisibility), in.ShowEmail, in.ShowLocation, in.AllowMessaging, userID,
@@ -318,10 +377,10 @@ func (s *UserStorePG) SearchUsers(ctx context.Context, filter user.UserSearchFil
 	var where []string
 	var args []any
 	ai := 1
-	// Skills filter
+	// Skills filter: names, slugs or catalogue IDs, matched on the canonical skill
 	if len(filter.Skills) > 0 {
 		base += ` JOIN user_skills us ON us.user_id=u.id`
-		where = append(where, fmt.Sprintf("us.name = ANY($%d)", ai))
+		where = append(where, skillMatchCondition("us.skill_id", "us.name", ai))
 		args = append(args, pq.Array(filter.Skills))
 		ai++
 	}
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volunteersync/backend/internal/core/registration"
+)
+
+const waiverSelect = `
+	SELECT w.id, w.event_id, w.title, w.required, COALESCE(w.created_by::text, ''), w.created_at, w.updated_at, w.retired_at,
+		v.id, v.version, v.body, v.content_hash, COALESCE(v.created_by::text, ''), v.created_at
+	FROM event_waivers w
+	JOIN LATERAL (
+		SELECT * FROM event_waiver_versions WHERE waiver_id = w.id ORDER BY version DESC LIMIT 1
+	) v ON TRUE
+`
+
+const waiverSignatureSelect = `
+	SELECT s.id, s.waiver_version_id, v.waiver_id, w.title, v.version, s.event_id, s.user_id, s.registration_id,
+		s.signed_name, COALESCE(s.ip_address, ''), COALESCE(s.user_agent, ''), s.document_hash, s.signed_at
+	FROM waiver_signatures s
+	JOIN event_waiver_versions v ON v.id = s.waiver_version_id
+	JOIN event_waivers w ON w.id = v.waiver_id
+`
+
+func (s *RegistrationStorePG) CreateWaiver(ctx context.Context, w *registration.Waiver) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_waivers (id, event_id, title, required, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		w.ID, w.EventID, w.Title, w.Required, w.CreatedBy, w.CreatedAt, w.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if err := insertWaiverVersion(ctx, tx, w.CurrentVersion); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *RegistrationStorePG) UpdateWaiver(ctx context.Context, w *registration.Waiver, version *registration.WaiverVersion) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE event_waivers SET title = $2, required = $3, retired_at = $4, updated_at = $5
+		WHERE id = $1`,
+		w.ID, w.Title, w.Required, w.RetiredAt, w.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return registration.ErrWaiverNotFound
+	}
+	if version != nil {
+		if err := insertWaiverVersion(ctx, tx, version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func insertWaiverVersion(ctx context.Context, tx *sql.Tx, v *registration.WaiverVersion) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO event_waiver_versions (id, waiver_id, version, body, content_hash, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		v.ID, v.WaiverID, v.Version, v.Body, v.ContentHash, v.CreatedBy, v.CreatedAt)
+	return err
+}
+
+func (s *RegistrationStorePG) GetWaiver(ctx context.Context, id string) (*registration.Waiver, error) {
+	w, err := scanWaiver(s.db.QueryRowContext(ctx, waiverSelect+" WHERE w.id = $1", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, registration.ErrWaiverNotFound
+	}
+	return w, err
+}
+
+func (s *RegistrationStorePG) GetEventWaivers(ctx context.Context, eventID string) ([]*registration.Waiver, error) {
+	rows, err := s.db.QueryContext(ctx, waiverSelect+" WHERE w.event_id = $1 ORDER BY w.created_at", eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	waivers := []*registration.Waiver{}
+	for rows.Next() {
+		w, err := scanWaiver(rows)
+		if err != nil {
+			return nil, err
+		}
+		waivers = append(waivers, w)
+	}
+
+	return waivers, rows.Err()
+}
+
+func (s *RegistrationStorePG) GetWaiverVersion(ctx context.Context, id string) (*registration.WaiverVersion, error) {
+	v := &registration.WaiverVersion{}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, waiver_id, version, body, content_hash, COALESCE(created_by::text, ''), created_at
+		FROM event_waiver_versions WHERE id = $1`, id).
+		Scan(&v.ID, &v.WaiverID, &v.Version, &v.Body, &v.ContentHash, &v.CreatedBy, &v.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, registration.ErrWaiverNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s *RegistrationStorePG) CreateWaiverSignatures(ctx context.Context, signatures []*registration.WaiverSignature) error {
+	return inTx(ctx, s.db, func(ctx context.Context, tx *sql.Tx) error {
+		for _, sig := range signatures {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO waiver_signatures (
+					id, waiver_version_id, event_id, user_id, registration_id, signed_name, ip_address, user_agent, document_hash, signed_at
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+				ON CONFLICT (waiver_version_id, user_id) DO NOTHING`,
+				sig.ID, sig.WaiverVersionID, sig.EventID, sig.UserID, sig.RegistrationID, sig.SignedName,
+				sig.IPAddress, sig.UserAgent, sig.DocumentHash, sig.SignedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *RegistrationStorePG) LinkWaiverSignatures(ctx context.Context, userID, eventID, registrationID string) error {
+	_, err := conn(ctx, s.db).ExecContext(ctx, `
+		UPDATE waiver_signatures SET registration_id = $3
+		WHERE user_id = $1 AND event_id = $2 AND registration_id IS NULL`,
+		userID, eventID, registrationID)
+	return err
+}
+
+func (s *RegistrationStorePG) GetUserWaiverSignatures(ctx context.Context, userID, eventID string) ([]*registration.WaiverSignature, error) {
+	return s.queryWaiverSignatures(ctx, waiverSignatureSelect+" WHERE s.user_id = $1 AND s.event_id = $2 ORDER BY s.signed_at", userID, eventID)
+}
+
+func (s *RegistrationStorePG) GetEventWaiverSignatures(ctx context.Context, eventID string) ([]*registration.WaiverSignature, error) {
+	return s.queryWaiverSignatures(ctx, waiverSignatureSelect+" WHERE s.event_id = $1 ORDER BY w.created_at, v.version, s.signed_at", eventID)
+}
+
+func (s *RegistrationStorePG) queryWaiverSignatures(ctx context.Context, query string, args ...any) ([]*registration.WaiverSignature, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	signatures := []*registration.WaiverSignature{}
+	for rows.Next() {
+		sig := &registration.WaiverSignature{}
+		if err := rows.Scan(&sig.ID, &sig.WaiverVersionID, &sig.WaiverID, &sig.WaiverTitle, &sig.Version, &sig.EventID,
+			&sig.UserID, &sig.RegistrationID, &sig.SignedName, &sig.IPAddress, &sig.UserAgent, &sig.DocumentHash, &sig.SignedAt); err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, sig)
+	}
+
+	return signatures, rows.Err()
+}
+
+func scanWaiver(row rowScanner) (*registration.Waiver, error) {
+	w := &registration.Waiver{CurrentVersion: &registration.WaiverVersion{}}
+	v := w.CurrentVersion
+	if err := row.Scan(&w.ID, &w.EventID, &w.Title, &w.Required, &w.CreatedBy, &w.CreatedAt, &w.UpdatedAt, &w.RetiredAt,
+		&v.ID, &v.Version, &v.Body, &v.ContentHash, &v.CreatedBy, &v.CreatedAt); err != nil {
+		return nil, err
+	}
+	v.WaiverID = w.ID
+	return w, nil
+}